@@ -0,0 +1,78 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Searching for symbols by name
+
+package repl
+
+import (
+	"reflect"
+	"regexp"
+	"sort"
+
+	"github.com/rocky/eval"
+)
+
+// AproposKinds are the kinds of symbols Apropos finds, in the order
+// they are shown.
+var AproposKinds = []string{"Packages", "Constants", "Functions", "Types", "Variables", "Methods"}
+
+// Apropos returns, by kind, the qualified names of the symbols in env
+// whose names match re: packages, their constants, functions, types,
+// variables, and the methods of their types, as well as what was
+// defined in the session. If searchDocs is set, symbols whose compiled
+// in documentation summary matches are included too.
+func Apropos(env *eval.SimpleEnv, re *regexp.Regexp, searchDocs bool) map[string][]string {
+	found := make(map[string][]string)
+	seen := make(map[string]bool)
+	add := func(kind string, name string, qualified string) {
+		if seen[qualified] {
+			return
+		}
+		match := re.MatchString(name)
+		if !match && searchDocs {
+			if info, ok := LookupSymbol(qualified); ok {
+				match = re.MatchString(info.Doc)
+			}
+		}
+		if match {
+			seen[qualified] = true
+			found[kind] = append(found[kind], qualified)
+		}
+	}
+	walk := func(prefix string, pkg *eval.SimpleEnv) {
+		for name := range pkg.Consts {
+			add("Constants", name, prefix+name)
+		}
+		for name := range pkg.Funcs {
+			add("Functions", name, prefix+name)
+		}
+		for name := range pkg.Vars {
+			add("Variables", name, prefix+name)
+		}
+		for name, typ := range pkg.Types {
+			add("Types", name, prefix+name)
+			if typ == nil {
+				continue
+			}
+			for _, t := range []reflect.Type{typ, reflect.PtrTo(typ)} {
+				for i := 0; i < t.NumMethod(); i++ {
+					method := t.Method(i).Name
+					add("Methods", method, prefix+name+"."+method)
+				}
+			}
+		}
+	}
+	walk("", env)
+	for pkgName, pkg := range env.Pkgs {
+		add("Packages", pkgName, pkgName)
+		if simple, ok := pkg.(*eval.SimpleEnv); ok && simple != nil {
+			walk(pkgName+".", simple)
+		}
+	}
+	for _, names := range found {
+		sort.Strings(names)
+	}
+	return found
+}
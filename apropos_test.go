@@ -0,0 +1,56 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/rocky/go-fish"
+)
+
+// inShapes returns those of names in package shapes.
+func inShapes(names []string) []string {
+	var in []string
+	for _, name := range names {
+		if strings.HasPrefix(name, "shapes.") {
+			in = append(in, name)
+		}
+	}
+	return in
+}
+
+func TestApropos(t *testing.T) {
+	env := shapesEnv()
+	found := repl.Apropos(env, regexp.MustCompile("^Area$"), false)
+	want := []string{"shapes.Circle.Area", "shapes.Labeled.Area", "shapes.Shape.Area", "shapes.Square.Area"}
+	if got := inShapes(found["Methods"]); !reflect.DeepEqual(got, want) {
+		t.Errorf("methods matching ^Area$ are %q; want %q", got, want)
+	}
+	found = repl.Apropos(env, regexp.MustCompile("^S"), false)
+	if got := inShapes(found["Types"]); !reflect.DeepEqual(got, []string{"shapes.Shape", "shapes.Square"}) {
+		t.Errorf("types matching ^S are %q", got)
+	}
+	if got := inShapes(found["Functions"]); !reflect.DeepEqual(got, []string{"shapes.Scale"}) {
+		t.Errorf("functions matching ^S are %q", got)
+	}
+
+	key := repl.PkgPath(env, "shapes") + ".Scale"
+	repl.SymbolMeta[key] = repl.SymbolInfo{Doc: "Scale grows a square."}
+	defer delete(repl.SymbolMeta, key)
+	re := regexp.MustCompile("grows")
+	if got := inShapes(repl.Apropos(env, re, false)["Functions"]); len(got) != 0 {
+		t.Errorf("without -doc, found %q by documentation", got)
+	}
+	if got := inShapes(repl.Apropos(env, re, true)["Functions"]); !reflect.DeepEqual(got, []string{"shapes.Scale"}) {
+		t.Errorf("with -doc, functions documented as growing are %q", got)
+	}
+
+	if out := runCommands(env, "apropos ^Scal"); !strings.Contains(out, "shapes.Scale") {
+		t.Errorf("\"apropos ^Scal\" shows:\n%s", out)
+	}
+}
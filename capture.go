@@ -0,0 +1,133 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Copying what evaluated code writes to stdout
+
+package repl
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"sync"
+)
+
+// stdout is the real standard output.
+var stdout = os.Stdout
+
+// While output is being teed, os.Stdout is teePipe. A goroutine
+// copies what comes out of the other end to stdout and to the writers
+// in teeTo.
+//
+// The pipe is never closed, since evaluated code may have squirreled
+// away os.Stdout, say in a bufio.Writer, and write to it later.
+var teePipe *os.File
+var teeTo []io.Writer
+var teeMutex sync.Mutex
+
+// teeEcho says whether teed output also goes to the real stdout.
+var teeEcho bool = true
+
+// teeSynced is signalled when teeMarker comes out the other end of
+// the pipe, which means everything written before it has been copied.
+var teeSynced = make(chan bool)
+
+const teeMarker = "\x00go-fish sync\x00"
+
+// TeeStdout runs fn with a copy of whatever it writes to os.Stdout
+// also written to w. Calls may be nested, in which case output goes
+// to the writers of all of the enclosing calls. If w is nil, fn is
+// just run.
+func TeeStdout(w io.Writer, fn func()) {
+	if w == nil {
+		fn()
+		return
+	}
+	if teePipe == nil {
+		r, pw, err := os.Pipe()
+		if err != nil {
+			fn()
+			return
+		}
+		teePipe = pw
+		go copyTee(r)
+	}
+	teeMutex.Lock()
+	teeTo = append(teeTo, w)
+	teeMutex.Unlock()
+	os.Stdout = teePipe
+	defer func() {
+		syncTee()
+		teeMutex.Lock()
+		teeTo = teeTo[:len(teeTo)-1]
+		teeMutex.Unlock()
+		if len(teeTo) == 0 {
+			os.Stdout = stdout
+		}
+	}()
+	fn()
+}
+
+// syncTee waits until everything written to the tee pipe so far has
+// been copied out.
+func syncTee() {
+	if teePipe == nil {
+		return
+	}
+	teePipe.WriteString(teeMarker)
+	<-teeSynced
+}
+
+func copyTee(r *os.File) {
+	marker := []byte(teeMarker)
+	buf := make([]byte, 4096)
+	var pending []byte
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			pending = append(pending, buf[:n]...)
+			for {
+				i := bytes.Index(pending, marker)
+				if i < 0 {
+					break
+				}
+				teeWrite(pending[:i])
+				pending = pending[i+len(marker):]
+				teeSynced <- true
+			}
+			// Hold back anything that might be the start of a marker.
+			keep := partialMarker(pending)
+			teeWrite(pending[:len(pending)-keep])
+			pending = append([]byte(nil), pending[len(pending)-keep:]...)
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func teeWrite(p []byte) {
+	if len(p) == 0 {
+		return
+	}
+	teeMutex.Lock()
+	defer teeMutex.Unlock()
+	if teeEcho {
+		stdout.Write(p)
+	}
+	for _, w := range teeTo {
+		w.Write(p)
+	}
+}
+
+// partialMarker returns the length of the longest suffix of p that is
+// a proper prefix of teeMarker.
+func partialMarker(p []byte) int {
+	for k := len(teeMarker) - 1; k > 0; k-- {
+		if len(p) >= k && string(p[len(p)-k:]) == teeMarker[:k] {
+			return k
+		}
+	}
+	return 0
+}
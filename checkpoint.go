@@ -0,0 +1,159 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Checkpointing and rolling back session variables
+
+package repl
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/rocky/eval"
+)
+
+// Checkpoint is a snapshot of the variables defined in the session
+// and of results.
+type Checkpoint struct {
+	Name    string
+	Time    time.Time
+	vars    map[string]reflect.Value
+	results []interface{}
+}
+
+// Checkpoints are the checkpoints taken in the environment in use, by
+// name. Each named environment has its own; see UseEnv.
+var Checkpoints = make(map[string]*Checkpoint)
+
+// TakeCheckpoint records a deep copy of the variables defined in env,
+// and of results, as checkpoint name, replacing any checkpoint already
+// called that.
+func TakeCheckpoint(name string, env *eval.SimpleEnv) *Checkpoint {
+	cp := &Checkpoint{
+		Name: name,
+		Time: time.Now(),
+		vars: make(map[string]reflect.Value),
+	}
+	seen := make(map[copyKey]reflect.Value)
+	for _, varName := range UserVars(env) {
+		cp.vars[varName] = deepCopy(env.Vars[varName], seen)
+	}
+	cp.results = deepCopy(reflect.ValueOf(results), seen).Interface().([]interface{})
+	Checkpoints[name] = cp
+	return cp
+}
+
+// Rollback restores the variables in env, and results, to what they
+// were in checkpoint name. Variables defined since are removed. The
+// checkpoint is kept, so it can be rolled back to again.
+func Rollback(name string, env *eval.SimpleEnv) (*Checkpoint, error) {
+	cp, ok := Checkpoints[name]
+	if !ok {
+		return nil, fmt.Errorf("no checkpoint %s", name)
+	}
+	for _, varName := range UserVars(env) {
+		delete(env.Vars, varName)
+	}
+	seen := make(map[copyKey]reflect.Value)
+	for varName, v := range cp.vars {
+		env.Vars[varName] = deepCopy(v, seen)
+	}
+	results = deepCopy(reflect.ValueOf(cp.results), seen).Interface().([]interface{})
+	return cp, nil
+}
+
+// CheckpointNames returns the names of the checkpoints, oldest first.
+func CheckpointNames() []string {
+	names := make([]string, 0, len(Checkpoints))
+	for name := range Checkpoints {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return Checkpoints[names[i]].Time.Before(Checkpoints[names[j]].Time)
+	})
+	return names
+}
+
+// NumVars returns the number of variables saved in cp.
+func (cp *Checkpoint) NumVars() int {
+	return len(cp.vars)
+}
+
+// copyKey identifies a pointer or map that has already been copied,
+// so that sharing and cycles are preserved in the copy.
+type copyKey struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+// deepCopy returns a copy of v that shares no pointers, slices or maps
+// with it. Channels, functions and unexported struct fields can't be
+// copied and are shared.
+func deepCopy(v reflect.Value, seen map[copyKey]reflect.Value) reflect.Value {
+	if !v.IsValid() {
+		return v
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		key := copyKey{v.Pointer(), v.Type()}
+		if c, ok := seen[key]; ok {
+			return c
+		}
+		c := reflect.New(v.Type().Elem())
+		seen[key] = c
+		c.Elem().Set(deepCopy(v.Elem(), seen))
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		key := copyKey{v.Pointer(), v.Type()}
+		if c, ok := seen[key]; ok {
+			return c
+		}
+		c := reflect.MakeMap(v.Type())
+		seen[key] = c
+		for _, k := range v.MapKeys() {
+			c.SetMapIndex(deepCopy(k, seen), deepCopy(v.MapIndex(k), seen))
+		}
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Cap())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i), seen))
+		}
+		return c
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i), seen))
+		}
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(deepCopy(v.Field(i), seen))
+			}
+		}
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopy(v.Elem(), seen))
+		return c
+	}
+	return v
+}
@@ -0,0 +1,78 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rocky/go-fish"
+)
+
+// setVar defines variable name in env with value.
+func setVar(env map[string]reflect.Value, name string, value interface{}) {
+	v := reflect.New(reflect.TypeOf(value))
+	v.Elem().Set(reflect.ValueOf(value))
+	env[name] = v
+}
+
+// TestRollback checks that rolling back restores variables as they
+// were when the checkpoint was taken, undoing changes made through
+// the values since, and removes variables defined since.
+func TestRollback(t *testing.T) {
+	repl.Reset(false)
+	setVar(repl.Env.Vars, "m", map[string]int{"a": 1})
+	setVar(repl.Env.Vars, "s", []int{1, 2})
+	repl.TakeCheckpoint("before", repl.Env)
+
+	m, _ := repl.VarValue(repl.Env, "m")
+	m.Interface().(map[string]int)["a"] = 100
+	s, _ := repl.VarValue(repl.Env, "s")
+	s.Interface().([]int)[0] = 100
+	setVar(repl.Env.Vars, "later", 3)
+
+	if _, err := repl.Rollback("before", repl.Env); err != nil {
+		t.Fatalf("Rollback: %s", err)
+	}
+	if m, _ := repl.VarValue(repl.Env, "m"); !reflect.DeepEqual(m.Interface(), map[string]int{"a": 1}) {
+		t.Errorf("m = %v after rollback; want map[a:1]", m)
+	}
+	if s, _ := repl.VarValue(repl.Env, "s"); !reflect.DeepEqual(s.Interface(), []int{1, 2}) {
+		t.Errorf("s = %v after rollback; want [1 2]", s)
+	}
+	if _, ok := repl.VarValue(repl.Env, "later"); ok {
+		t.Errorf("later is still defined after rollback")
+	}
+	if _, err := repl.Rollback("nosuch", repl.Env); err == nil {
+		t.Errorf("rolling back to a missing checkpoint didn't fail")
+	}
+}
+
+// TestCheckpointsPerEnv checks that checkpoints belong to the
+// environment they were taken in.
+func TestCheckpointsPerEnv(t *testing.T) {
+	repl.Reset(false)
+	repl.TakeCheckpoint("in-main", repl.Env)
+	if err := repl.NewNamedEnv("other"); err != nil {
+		t.Fatal(err)
+	}
+	defer repl.DropEnv("other")
+	if err := repl.UseEnv("other"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repl.Rollback("in-main", repl.Env); err == nil {
+		t.Errorf("rolled back to a checkpoint of another environment")
+	}
+	repl.TakeCheckpoint("in-other", repl.Env)
+	if err := repl.UseEnv(repl.MainEnvName); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := repl.Checkpoints["in-main"]; !ok {
+		t.Errorf("checkpoint in-main was lost switching environments")
+	}
+	if _, ok := repl.Checkpoints["in-other"]; ok {
+		t.Errorf("checkpoint in-other, taken in another environment, is in main")
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// apropos command
+
+package fishcmd
+
+import (
+	"regexp"
+
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "apropos"
+	repl.Cmds[name] = &repl.CmdInfo{
+		Fn: AproposCommand,
+		Help: `apropos [-doc] *regexp*
+
+Lists the packages, constants, functions, types, variables and methods
+whose names match *regexp*, grouped by kind. Names are qualified by
+package, and methods by type as well. For example:
+
+    apropos (?i)prefix
+
+finds strings.HasPrefix, strings.TrimPrefix, bytes.TrimPrefix and so on.
+
+With -doc, symbols whose documentation summary matches are listed too.
+That is only available for what was compiled into go-fish with
+documentation; see "make repl_docs.go".
+`,
+		Min_args: 1,
+		Max_args: 2,
+	}
+	repl.AddToCategory("support", name)
+}
+
+// AproposCommand implements the command:
+//    apropos [-doc] *regexp*
+// which searches for symbols by name.
+func AproposCommand(args []string) {
+	searchDocs := false
+	pattern := args[1]
+	if len(args) == 3 {
+		if args[1] != "-doc" {
+			repl.Errmsg("Expecting -doc; got %s", args[1])
+			return
+		}
+		searchDocs = true
+		pattern = args[2]
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		repl.Errmsg("Bad regular expression %s: %s", pattern, err)
+		return
+	}
+	found := repl.Apropos(repl.Env, re, searchDocs)
+	if len(found) == 0 {
+		repl.Msg("Nothing matches %s", pattern)
+		return
+	}
+	for _, kind := range repl.AproposKinds {
+		if names := found[kind]; len(names) > 0 {
+			repl.PrintSorted(kind, names)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// assignable command
+
+package fishcmd
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"reflect"
+	"strings"
+
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "assignable"
+	repl.Cmds[name] = &repl.CmdInfo{
+		Fn: AssignableCommand,
+		Help: `assignable *type1*, *type2*
+
+Says whether a value of *type1* can be assigned to a variable of
+*type2*. Either can be an expression instead, whose type is then used.
+The comma can be left out when neither contains spaces. For example:
+
+    assignable chan int, <-chan int
+    assignable *bytes.Buffer io.Writer
+
+See also "convertible" and "implements".
+`,
+		Min_args: 1,
+		Max_args: -1,
+	}
+	repl.AddToCategory("data", name)
+}
+
+// AssignableCommand implements the command:
+//    assignable *type1*, *type2*
+// which checks whether *type1* values can be assigned to *type2*.
+func AssignableCommand(args []string) {
+	from, to, ok := resolveTypePair(args)
+	if !ok {
+		return
+	}
+	if from.AssignableTo(to) {
+		repl.Msg("%s is assignable to %s", from, to)
+	} else {
+		repl.Msg("%s is not assignable to %s", from, to)
+	}
+}
+
+// typePairArgs returns the two arguments of a two-type command, as
+// expressions along with their text. They are separated by a comma,
+// so that they can contain spaces, or else by just spaces.
+func typePairArgs(args []string) (exprs [2]ast.Expr, texts [2]string, err error) {
+	rest := strings.TrimSpace(repl.CmdLine[len(args[0]):])
+	src := "f(" + rest + ")"
+	if expr, err := parser.ParseExpr(src); err == nil {
+		call, ok := expr.(*ast.CallExpr)
+		if ok && len(call.Args) == 2 && !call.Ellipsis.IsValid() {
+			for i, arg := range call.Args {
+				// ParseExpr positions start at 1.
+				exprs[i], texts[i] = arg, src[arg.Pos()-1:arg.End()-1]
+			}
+			return exprs, texts, nil
+		}
+	}
+	if len(args) != 3 {
+		return exprs, texts, fmt.Errorf("expecting two types or expressions separated by a comma; got %s", rest)
+	}
+	for i, arg := range args[1:] {
+		if exprs[i], err = parser.ParseExpr(arg); err != nil {
+			return exprs, texts, fmt.Errorf("%s: %s", arg, err)
+		}
+		texts[i] = arg
+	}
+	return exprs, texts, nil
+}
+
+// resolveTypePair resolves the types of a two-type command, reporting
+// errors.
+func resolveTypePair(args []string) (t1, t2 reflect.Type, ok bool) {
+	exprs, texts, err := typePairArgs(args)
+	if err != nil {
+		repl.Errmsg("%s", err)
+		return nil, nil, false
+	}
+	var types [2]reflect.Type
+	for i, expr := range exprs {
+		if types[i], err = resolveTypeExpr(expr, texts[i]); err != nil {
+			repl.Errmsg("%s: %s", texts[i], err)
+			return nil, nil, false
+		}
+	}
+	return types[0], types[1], true
+}
@@ -0,0 +1,51 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// checkpoint command
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "checkpoint"
+	repl.Cmds[name] = &repl.CmdInfo{
+		Fn: CheckpointCommand,
+		Help: `checkpoint *name*
+
+Saves a copy of the variables defined in the session, and of
+"results", as checkpoint *name*. "rollback *name*" restores them.
+An existing checkpoint with the same name is replaced.
+
+Values are copied deeply, so later changes, such as appending to a
+slice or setting a map entry, don't affect the checkpoint. Channels,
+functions and unexported struct fields can't be copied, and are
+shared with the checkpoint. Functions and types declared in the
+session aren't part of a checkpoint.
+
+Each environment has its own checkpoints; see "env".
+
+See also "rollback" and "checkpoints".
+`,
+		Min_args: 1,
+		Max_args: 1,
+	}
+	repl.AddToCategory("data", name)
+}
+
+// CheckpointCommand implements the command:
+//    checkpoint *name*
+// which saves the session variables.
+func CheckpointCommand(args []string) {
+	name := args[1]
+	_, replaced := repl.Checkpoints[name]
+	cp := repl.TakeCheckpoint(name, repl.Env)
+	if replaced {
+		repl.Msg("Checkpoint %s replaced; %d variables saved", name, cp.NumVars())
+	} else {
+		repl.Msg("Checkpoint %s taken; %d variables saved", name, cp.NumVars())
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// checkpoints command
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "checkpoints"
+	repl.Cmds[name] = &repl.CmdInfo{
+		Fn: CheckpointsCommand,
+		Help: `checkpoints
+
+Lists the checkpoints taken, oldest first, with when they were taken
+and how many variables they hold.
+
+See also "checkpoint" and "rollback".
+`,
+		Min_args: 0,
+		Max_args: 0,
+	}
+	repl.AddToCategory("data", name)
+}
+
+// CheckpointsCommand implements the command:
+//    checkpoints
+// which lists the checkpoints taken.
+func CheckpointsCommand(args []string) {
+	names := repl.CheckpointNames()
+	if len(names) == 0 {
+		repl.Msg("No checkpoints have been taken")
+		return
+	}
+	repl.Section("Checkpoints")
+	for _, name := range names {
+		cp := repl.Checkpoints[name]
+		repl.Msg("%-15s %s  %d variables", name, cp.Time.Format("15:04:05"), cp.NumVars())
+	}
+}
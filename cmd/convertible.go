@@ -0,0 +1,48 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// convertible command
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "convertible"
+	repl.Cmds[name] = &repl.CmdInfo{
+		Fn: ConvertibleCommand,
+		Help: `convertible *type1*, *type2*
+
+Says whether a value of *type1* can be converted to *type2*, as in
+*type2*(x). Either can be an expression instead, whose type is then
+used. The comma can be left out when neither contains spaces. For
+example:
+
+    convertible []byte string
+    convertible struct{ X int }, struct{ X int "json:\"x\"" }
+
+See also "assignable" and "implements".
+`,
+		Min_args: 1,
+		Max_args: -1,
+	}
+	repl.AddToCategory("data", name)
+}
+
+// ConvertibleCommand implements the command:
+//    convertible *type1*, *type2*
+// which checks whether *type1* values can be converted to *type2*.
+func ConvertibleCommand(args []string) {
+	from, to, ok := resolveTypePair(args)
+	if !ok {
+		return
+	}
+	if from.ConvertibleTo(to) {
+		repl.Msg("%s is convertible to %s", from, to)
+	} else {
+		repl.Msg("%s is not convertible to %s", from, to)
+	}
+}
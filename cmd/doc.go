@@ -0,0 +1,46 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// doc command
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "doc"
+	repl.Cmds[name] = &repl.CmdInfo{
+		Fn: DocCommand,
+		Help: `doc *pkg*
+doc *pkg*.*symbol*
+doc *pkg*.*type*.*method*
+
+Shows the documentation for a package, a package-level constant,
+variable, function or type, or a method or field of a type. For
+example:
+
+    doc strings
+    doc strings.Split
+    doc bytes.Buffer.WriteString
+
+*pkg* is the name of a package in the environment, or an import path
+such as "encoding/json". The documentation is taken from the package
+source, found via GOROOT and GOPATH, and wrapped to the line width.
+`,
+		Min_args: 1,
+		Max_args: 1,
+	}
+	repl.AddToCategory("support", name)
+}
+
+// DocCommand implements the command:
+//    doc *pkg*[.*symbol*[.*method*]]
+// which shows documentation from package source.
+func DocCommand(args []string) {
+	if err := repl.ShowDoc(args[1]); err != nil {
+		repl.Errmsg("%s", err)
+	}
+}
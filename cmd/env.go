@@ -0,0 +1,42 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// env command
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "env"
+	repl.Cmds[name] = &repl.CmdInfo{
+		SubcmdMgr: &repl.SubcmdMgr{
+			Name:    name,
+			Subcmds: make(repl.SubcmdMap),
+		},
+		Fn: EnvCommand,
+		Help: `Manages independent evaluation environments.
+
+Each environment has its own variables, results, declarations and
+imports; the prompt evaluates in the one in use. go-fish starts out in
+environment "main".
+
+Type "help env *" for just a list of "env" subcommands.
+
+Note that variable "env", the environment in use, is still available
+in expressions, e.g. "env.Vars", and just "env" shows it.`,
+		Min_args: 1,
+		Max_args: 2,
+	}
+	repl.AddToCategory("data", name)
+}
+
+// EnvCommand implements the command:
+//    env *subcommand*
+// which manages evaluation environments.
+func EnvCommand(args []string) {
+	repl.SubcmdMgrCommand(args)
+}
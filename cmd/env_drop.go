@@ -0,0 +1,35 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// env drop - remove an evaluation environment
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	parent := "env"
+	repl.AddSubCommand(parent, &repl.SubcmdInfo{
+		Fn: EnvDropSubcmd,
+		Help: `env drop *name*
+
+Removes environment *name* and everything defined in it. The
+environment in use can't be dropped.`,
+		Min_args: 1,
+		Max_args: 1,
+		Short_help: "remove an environment",
+		Name: "drop",
+	})
+}
+
+func EnvDropSubcmd(args []string) {
+	name := args[2]
+	if err := repl.DropEnv(name); err != nil {
+		repl.Errmsg("%s", err)
+		return
+	}
+	repl.Msg("Environment %s dropped", name)
+}
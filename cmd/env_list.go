@@ -0,0 +1,36 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// env list - list evaluation environments
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	parent := "env"
+	repl.AddSubCommand(parent, &repl.SubcmdInfo{
+		Fn: EnvListSubcmd,
+		Help: `env list
+
+Lists the environments, with how many variables each has. The one in
+use is marked with "*".`,
+		Min_args: 0,
+		Max_args: 0,
+		Short_help: "list environments",
+		Name: "list",
+	})
+}
+
+func EnvListSubcmd(args []string) {
+	for _, name := range repl.EnvNames() {
+		mark := " "
+		if name == repl.CurrentEnvName {
+			mark = "*"
+		}
+		repl.Msg("%s %-15s %d variables", mark, name, repl.EnvVarCount(name))
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// env new - create an evaluation environment
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	parent := "env"
+	repl.AddSubCommand(parent, &repl.SubcmdInfo{
+		Fn: EnvNewSubcmd,
+		Help: `env new *name*
+
+Creates a fresh environment called *name*, as go-fish starts out with.
+Use "env use *name*" to switch to it.`,
+		Min_args: 1,
+		Max_args: 1,
+		Short_help: "create an environment",
+		Name: "new",
+	})
+}
+
+func EnvNewSubcmd(args []string) {
+	name := args[2]
+	if err := repl.NewNamedEnv(name); err != nil {
+		repl.Errmsg("%s", err)
+		return
+	}
+	repl.Msg("Environment %s created", name)
+}
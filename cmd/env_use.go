@@ -0,0 +1,35 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// env use - switch evaluation environments
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	parent := "env"
+	repl.AddSubCommand(parent, &repl.SubcmdInfo{
+		Fn: EnvUseSubcmd,
+		Help: `env use *name*
+
+Switches to environment *name*. What is typed at the prompt is
+evaluated there from now on.`,
+		Min_args: 1,
+		Max_args: 1,
+		Short_help: "switch to an environment",
+		Name: "use",
+	})
+}
+
+func EnvUseSubcmd(args []string) {
+	name := args[2]
+	if err := repl.UseEnv(name); err != nil {
+		repl.Errmsg("%s", err)
+		return
+	}
+	repl.Msg("Using environment %s", name)
+}
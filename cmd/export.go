@@ -0,0 +1,68 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// export command
+
+package fishcmd
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "export"
+	repl.Cmds[name] = &repl.CmdInfo{
+		Fn: ExportCommand,
+		Help: `export *file*.go
+
+Writes the statements and expressions of this session that were
+evaluated without error as a standalone Go program in *file*.go, which
+must not already exist.
+
+Imports are derived from the packages referred to, short variable
+declarations are kept as they are, and expressions that produce values
+are turned into fmt.Println calls. Since Go requires variables to be
+used, "_ = name" is added for variables that otherwise wouldn't be.
+
+Warnings are given for things that won't carry over, such as uses of
+the REPL variables "results" and "env".
+`,
+		Min_args: 1,
+		Max_args: 1,
+	}
+	repl.AddToCategory("support", name)
+}
+
+// ExportCommand implements the command:
+//    export *file*.go
+// which writes the session as a Go program.
+func ExportCommand(args []string) {
+	filename := args[1]
+	if !strings.HasSuffix(filename, ".go") {
+		repl.Errmsg("Expecting a file name ending in .go; got %s", filename)
+		return
+	}
+	if _, err := os.Stat(filename); err == nil {
+		repl.Errmsg("%s already exists; nothing done", filename)
+		return
+	}
+	if len(repl.Session) == 0 {
+		repl.Errmsg("Nothing has been evaluated yet; nothing done")
+		return
+	}
+	src, warnings := repl.ExportProgram(repl.Session, repl.Env)
+	for _, warning := range warnings {
+		repl.Errmsg("Warning: %s", warning)
+	}
+	if err := ioutil.WriteFile(filename, src, 0644); err != nil {
+		repl.Errmsg("Can't write %s: %s", filename, err)
+		return
+	}
+	repl.Msg("Wrote %d statements and declarations to %s", repl.ExportedCount(repl.Session),
+		filename)
+}
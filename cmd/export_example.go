@@ -0,0 +1,97 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// export-example command
+
+package fishcmd
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "export-example"
+	repl.Cmds[name] = &repl.CmdInfo{
+		Fn: ExportExampleCommand,
+		Help: `export-example *name* *file*_test.go
+
+Writes the statements and expressions of this session that were
+evaluated without error as a testable example function, Example*name*,
+in *file*_test.go. The "// Output:" comment of the example is what the
+session printed, so "go test" checks that the code still behaves the
+same way.
+
+The statements are exported as with the "export" command. The test
+file is put in the external test package of the Go package in the
+directory of *file*, or in package main_test if there is none.
+
+To replay a whole session, including go-fish commands, and check its
+output, record it with "set logging on" and run it again with:
+
+   go-fish -check *transcript-file*
+`,
+		Min_args: 2,
+		Max_args: 2,
+	}
+	repl.AddToCategory("support", name)
+}
+
+// ExportExampleCommand implements the command:
+//    export-example *name* *file*_test.go
+// which writes the session as a testable example.
+func ExportExampleCommand(args []string) {
+	name, filename := args[1], args[2]
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			repl.Errmsg("Example name %s should be letters, digits, or underscores", name)
+			return
+		}
+	}
+	if !strings.HasSuffix(filename, "_test.go") {
+		repl.Errmsg("Expecting a file name ending in _test.go; got %s", filename)
+		return
+	}
+	if _, err := os.Stat(filename); err == nil {
+		repl.Errmsg("%s already exists; nothing done", filename)
+		return
+	}
+	if len(repl.Session) == 0 {
+		repl.Errmsg("Nothing has been evaluated yet; nothing done")
+		return
+	}
+	pkgName := dirPackageName(filepath.Dir(filename)) + "_test"
+	src, warnings := repl.ExportExample(name, pkgName, repl.Session, repl.Env)
+	for _, warning := range warnings {
+		repl.Errmsg("Warning: %s", warning)
+	}
+	if err := ioutil.WriteFile(filename, src, 0644); err != nil {
+		repl.Errmsg("Can't write %s: %s", filename, err)
+		return
+	}
+	repl.Msg("Wrote Example%s to %s", name, filename)
+}
+
+// dirPackageName returns the name of the Go package whose files are
+// in dir, or "main" if there are none.
+func dirPackageName(dir string) string {
+	files, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.PackageClauseOnly)
+		if err == nil {
+			return f.Name.Name
+		}
+	}
+	return "main"
+}
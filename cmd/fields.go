@@ -0,0 +1,88 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// fields command
+
+package fishcmd
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "fields"
+	repl.Cmds[name] = &repl.CmdInfo{
+		Fn: FieldsCommand,
+		Help: `fields *type-or-expression*
+
+Lists the fields of a struct type, or of the type of an expression:
+exported and unexported fields, embedded fields, their types and tags.
+The fields promoted from embedded structs are listed, indented, under
+the field that embeds them. A pointer to a struct is taken as the
+struct. For example:
+
+    fields http.Request
+    fields myVar
+
+See also "layout" and "whatis".
+`,
+		Min_args: 1,
+		Max_args: -1,
+	}
+	repl.AddToCategory("data", name)
+}
+
+// FieldsCommand implements the command:
+//    fields *type-or-expression*
+// which lists the fields of a struct.
+func FieldsCommand(args []string) {
+	arg := strings.TrimSpace(repl.CmdLine[len(args[0]):])
+	typ, err := resolveStructType(arg)
+	if err != nil {
+		repl.Errmsg("%s", err)
+		return
+	}
+	if typ.NumField() == 0 {
+		repl.Msg("%s has no fields", typ)
+		return
+	}
+	repl.Section("Fields of %s", typ)
+	printFields(typ, "  ", map[reflect.Type]bool{typ: true})
+}
+
+// printFields lists the fields of struct type t, each line starting
+// with indent. seen holds the structs we are inside of, so that
+// recursive embedding via pointers stops.
+func printFields(t reflect.Type, indent string, seen map[reflect.Type]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		var notes []string
+		if f.Anonymous {
+			notes = append(notes, "embedded")
+		}
+		if f.PkgPath != "" {
+			notes = append(notes, "unexported")
+		}
+		line := indent + fieldString(f)
+		if len(notes) > 0 {
+			line += "\t(" + strings.Join(notes, ", ") + ")"
+		}
+		repl.Msg("%s", line)
+		if !f.Anonymous {
+			continue
+		}
+		embedded := f.Type
+		if embedded.Kind() == reflect.Ptr {
+			embedded = embedded.Elem()
+		}
+		if embedded.Kind() == reflect.Struct && !seen[embedded] {
+			seen[embedded] = true
+			printFields(embedded, indent+"  ", seen)
+			delete(seen, embedded)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// implements command
+
+package fishcmd
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "implements"
+	repl.Cmds[name] = &repl.CmdInfo{
+		Fn: ImplementsCommand,
+		Help: `implements *interface*
+implements *type-or-expression*
+
+Given an interface type, lists the known types that satisfy it. A
+type T is listed when T has the interface's methods, and *T when only
+the pointer type does. Given any other type, or an expression, lists
+the known interfaces that the type, or a pointer to it, satisfies.
+
+The types known are those in the environment and its packages. For
+example:
+
+    implements io.Reader
+    implements bytes.Buffer
+
+See also "assignable" and "convertible".
+`,
+		Min_args: 1,
+		Max_args: -1,
+	}
+	repl.AddToCategory("data", name)
+}
+
+// ImplementsCommand implements the command:
+//    implements *type-or-expression*
+// which shows which types satisfy an interface, or which interfaces a
+// type satisfies.
+func ImplementsCommand(args []string) {
+	arg := strings.TrimSpace(repl.CmdLine[len(args[0]):])
+	typ, err := resolveType(arg)
+	if err != nil {
+		repl.Errmsg("%s", err)
+		return
+	}
+	types, names := knownTypes()
+	var found []string
+	if typ.Kind() == reflect.Interface {
+		if typ.NumMethod() == 0 {
+			repl.Msg("Every type implements %s", typ)
+			return
+		}
+		for _, name := range names {
+			t := types[name]
+			if t.Kind() == reflect.Interface {
+				continue
+			}
+			if t.Implements(typ) {
+				found = append(found, name)
+			} else if reflect.PtrTo(t).Implements(typ) {
+				found = append(found, "*"+name)
+			}
+		}
+		if len(found) == 0 {
+			repl.Msg("No known type implements %s", typ)
+			return
+		}
+		repl.PrintSorted("Types implementing "+typ.String(), found)
+		return
+	}
+	for _, name := range names {
+		iface := types[name]
+		if iface.Kind() != reflect.Interface || iface.NumMethod() == 0 {
+			continue
+		}
+		if typ.Implements(iface) {
+			found = append(found, name)
+		} else if typ.Kind() != reflect.Ptr && reflect.PtrTo(typ).Implements(iface) {
+			found = append(found, name+" (via *"+typ.String()+")")
+		}
+	}
+	if len(found) == 0 {
+		repl.Msg("%s implements no known non-empty interface", typ)
+		return
+	}
+	repl.PrintSorted("Interfaces implemented by "+typ.String(), found)
+}
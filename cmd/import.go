@@ -0,0 +1,65 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// import command
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "import"
+	repl.Cmds[name] = &repl.CmdInfo{
+		Fn: ImportCommand,
+		Help: `import [*name*] "*path*"
+import ( ... )
+
+Makes the package with import path *path* available, as in a Go import
+declaration. For example:
+
+    import "encoding/json"
+    import j "encoding/json"
+    import (
+        "encoding/csv"
+        "hash/crc32"
+    )
+
+Packages compiled into go-fish are available without importing them.
+For others, make_env extracts the package's symbols into a shim which
+is built with "go build -buildmode=plugin" inside the go-fish source
+tree and then loaded. The package source, and that of the packages it
+imports, must be available locally; nothing is fetched over the
+network. Plugins are only supported on some systems, and the go tool
+used must be the one go-fish was built with.
+`,
+		Min_args: 1,
+		Max_args: -1,
+	}
+	repl.AddToCategory("support", name)
+}
+
+// ImportCommand implements the command:
+//    import [*name*] "*path*"
+// which loads a package, building a plugin for it if needed.
+func ImportCommand(args []string) {
+	specs, err := repl.ParseImports(repl.CmdLine)
+	if err != nil {
+		repl.Errmsg("%s", err)
+		return
+	}
+	for _, spec := range specs {
+		pkgPath, name := repl.ImportSpecPath(spec)
+		if err := repl.ImportPackage(pkgPath, name, repl.Env); err != nil {
+			repl.Errmsg("Can't import %s: %s", pkgPath, err)
+			continue
+		}
+		if name == "" || name == "_" {
+			repl.Msg("Imported %s", pkgPath)
+		} else {
+			repl.Msg("Imported %s as %s", pkgPath, name)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// info command
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "info"
+	repl.Cmds[name] = &repl.CmdInfo{
+		SubcmdMgr: &repl.SubcmdMgr{
+			Name:    name,
+			Subcmds: make(repl.SubcmdMap),
+		},
+		Fn: InfoCommand,
+		Help: `Shows information about the session.
+
+Type "info" for a list of "info" subcommands and what they do.
+Type "help info *" for just a list of "info" subcommands.`,
+		Min_args: 0,
+		Max_args: 3,
+	}
+	repl.AddToCategory("support", name)
+}
+
+// InfoCommand implements the command:
+//    info [*subcommand*]
+// which shows information about the session.
+func InfoCommand(args []string) {
+	repl.SubcmdMgrCommand(args)
+}
@@ -0,0 +1,51 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// info vars - list the variables defined in the session
+
+package fishcmd
+
+import (
+	"strings"
+
+	"github.com/rocky/eval"
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	parent := "info"
+	repl.AddSubCommand(parent, &repl.SubcmdInfo{
+		Fn: InfoVarsSubcmd,
+		Help: `info vars
+
+Lists the variables defined in the session, with their types and
+values. Values too long to fit on a line are truncated. The variables
+go-fish provides, "env" and "results", aren't listed.`,
+		Min_args: 0,
+		Max_args: 0,
+		Short_help: "list variables defined in the session",
+		Name: "vars",
+	})
+}
+
+func InfoVarsSubcmd(args []string) {
+	names := repl.UserVars(repl.Env)
+	if len(names) == 0 {
+		repl.Msg("No variables have been defined")
+		return
+	}
+	repl.Section("Variables")
+	for _, name := range names {
+		v, _ := repl.VarValue(repl.Env, name)
+		line := name
+		if v.IsValid() {
+			line += " " + v.Type().String() + " = " +
+				strings.Replace(eval.Inspect(v), "\n", " ", -1)
+		}
+		if repl.Maxwidth > 3 && len(line) > repl.Maxwidth {
+			line = line[:repl.Maxwidth-3] + "..."
+		}
+		repl.Msg("%s", line)
+	}
+}
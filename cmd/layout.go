@@ -0,0 +1,91 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// layout command
+
+package fishcmd
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "layout"
+	repl.Cmds[name] = &repl.CmdInfo{
+		Fn: LayoutCommand,
+		Help: `layout *type*
+
+Shows the memory layout of a struct type, or the type of an
+expression, as unsafe.Offsetof, unsafe.Sizeof and unsafe.Alignof would
+give it: each field's offset, size and alignment, and the padding
+after it. The total size and alignment follow, along with the size the
+struct would have if its fields were ordered by decreasing alignment.
+
+See also "fields".
+`,
+		Min_args: 1,
+		Max_args: -1,
+	}
+	repl.AddToCategory("data", name)
+}
+
+// LayoutCommand implements the command:
+//    layout *type*
+// which shows the memory layout of a struct.
+func LayoutCommand(args []string) {
+	arg := strings.TrimSpace(repl.CmdLine[len(args[0]):])
+	typ, err := resolveStructType(arg)
+	if err != nil {
+		repl.Errmsg("%s", err)
+		return
+	}
+	repl.Section("Layout of %s", typ)
+	repl.Msg("%6s %6s %5s %7s  %s", "offset", "size", "align", "padding", "field")
+	padding := uintptr(0)
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		end := typ.Size()
+		if i+1 < typ.NumField() {
+			end = typ.Field(i + 1).Offset
+		}
+		pad := end - f.Offset - f.Type.Size()
+		padding += pad
+		repl.Msg("%6d %6d %5d %7d  %s", f.Offset, f.Type.Size(), f.Type.FieldAlign(), pad, fieldString(f))
+	}
+	repl.Msg("size %d bytes, align %d, padding %d bytes", typ.Size(), typ.Align(), padding)
+	if best := packedSize(typ); best < typ.Size() {
+		repl.Msg("ordering fields by decreasing alignment would make the size %d bytes", best)
+	}
+}
+
+// packedSize returns the size struct type t would have with its fields
+// ordered by decreasing alignment.
+func packedSize(t reflect.Type) uintptr {
+	fields := make([]reflect.Type, t.NumField())
+	for i := range fields {
+		fields[i] = t.Field(i).Type
+	}
+	// Zero-size fields go first: as the last field, one would be
+	// padded so that a pointer to it doesn't point past the struct.
+	sort.SliceStable(fields, func(i, j int) bool {
+		if (fields[i].Size() == 0) != (fields[j].Size() == 0) {
+			return fields[i].Size() == 0
+		}
+		return fields[i].FieldAlign() > fields[j].FieldAlign()
+	})
+	size := uintptr(0)
+	for _, f := range fields {
+		size = alignUp(size, uintptr(f.FieldAlign())) + f.Size()
+	}
+	return alignUp(size, uintptr(t.Align()))
+}
+
+// alignUp rounds n up to a multiple of align.
+func alignUp(n uintptr, align uintptr) uintptr {
+	return (n + align - 1) &^ (align - 1)
+}
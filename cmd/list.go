@@ -0,0 +1,48 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// list command
+
+package fishcmd
+
+import (
+	"strings"
+
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "list"
+	repl.Cmds[name] = &repl.CmdInfo{
+		Fn: ListCommand,
+		Help: `list *pkg*.*func*
+list *pkg*.*type*.*method*
+list *expression*
+
+Shows the source of a function, with line numbers. For example:
+
+    list strings.Fields
+    list bytes.Buffer.Grow
+    list myFunc
+
+A function declared in the session is shown as it was entered.
+Otherwise, if the argument evaluates to a function, the source file and
+line the function was compiled from are used. Failing that, the
+package source is looked up via GOROOT and GOPATH.
+`,
+		Min_args: 1,
+		Max_args: -1,
+	}
+	repl.AddToCategory("support", name)
+}
+
+// ListCommand implements the command:
+//    list {*pkg*.*func*|*pkg*.*type*.*method*|*expression*}
+// which shows the source of a function.
+func ListCommand(args []string) {
+	arg := strings.TrimSpace(repl.CmdLine[len(args[0]):])
+	if err := repl.ListFunc(arg); err != nil {
+		repl.Errmsg("%s", err)
+	}
+}
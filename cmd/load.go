@@ -0,0 +1,61 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// load command
+
+package fishcmd
+
+import (
+	"strings"
+
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "load"
+	repl.Cmds[name] = &repl.CmdInfo{
+		Fn: LoadCommand,
+		Help: `load *file*.go
+load *import-path*
+
+In the first form, the package-level declarations of Go source file
+*file*.go are interpreted and added to the session, just as if they
+had been typed in. Its imports are done first, then types, constants,
+functions and lastly variables, whose initial values may call the
+functions. The package clause is ignored.
+
+Declarations that can't be handled, such as methods or init functions,
+are reported and skipped; the rest are still loaded.
+
+In the second form, the argument isn't a Go file, and this is the same
+as: import "*import-path*".
+
+See also "import".
+`,
+		Min_args: 1,
+		Max_args: 1,
+	}
+	repl.AddToCategory("support", name)
+}
+
+// LoadCommand implements the command:
+//    load {*file*.go|*import-path*}
+// which loads a Go source file's declarations or imports a package.
+func LoadCommand(args []string) {
+	arg := args[1]
+	if !strings.HasSuffix(arg, ".go") {
+		if err := repl.ImportPackage(arg, "", repl.Env); err != nil {
+			repl.Errmsg("Can't import %s: %s", arg, err)
+			return
+		}
+		repl.Msg("Imported %s", arg)
+		return
+	}
+	n, err := repl.LoadFile(arg, repl.Env)
+	if err != nil {
+		repl.Errmsg("Can't load %s: %s", arg, err)
+		return
+	}
+	repl.Msg("Loaded %d declarations from %s", n, arg)
+}
@@ -0,0 +1,48 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// load-vars command
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "load-vars"
+	repl.Cmds[name] = &repl.CmdInfo{
+		Fn: LoadVarsCommand,
+		Help: `load-vars *file*
+
+Defines the variables saved in *file* by "save-vars", replacing any
+variables with the same names.
+
+Types are looked up in the current environment, so packages they come
+from must be available, via "import" if they aren't compiled in.
+Variables whose types can't be found are skipped with a warning.
+
+See also "save-vars".
+`,
+		Min_args: 1,
+		Max_args: 1,
+	}
+	repl.AddToCategory("data", name)
+}
+
+// LoadVarsCommand implements the command:
+//    load-vars *file*
+// which defines variables saved in a file.
+func LoadVarsCommand(args []string) {
+	filename := args[1]
+	n, warnings, err := repl.LoadVars(filename, repl.Env)
+	for _, warning := range warnings {
+		repl.Errmsg("Warning: %s", warning)
+	}
+	if err != nil {
+		repl.Errmsg("Can't load variables from %s: %s", filename, err)
+		return
+	}
+	repl.Msg("Loaded %d variables from %s", n, filename)
+}
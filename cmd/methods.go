@@ -5,8 +5,13 @@
 package fishcmd
 
 import (
+	"fmt"
+	"go/parser"
 	"reflect"
+	"regexp"
+	"sort"
 	"strings"
+
 	"github.com/rocky/eval"
 	"github.com/rocky/go-fish"
 )
@@ -15,90 +20,197 @@ func init() {
 	name := "method"
 	repl.Cmds[name] = &repl.CmdInfo{
 		Fn: MethodCommand,
-		Help: `method *package-type-or-value* [*package-type-or-value* ...]
+		Help: `method [-r *regexp*] *package-type-or-expression* [*package-type-or-expression* ...]
+
+Show the methods of a type or of the value of an expression, or the
+functions of a package, with their signatures.
 
-Show information about methods of a package, type, or value.
+For a type T, the methods with value receivers are listed, followed by
+those that only *T has because they have pointer receivers. For an
+expression, its type is used; if that is an interface, the methods of
+the value's dynamic type are listed as well. For example:
 
-If a type name is given, then information is given about the methods
-of that type. Likewise, if a value or package name is given, the methods
-of that value or package are given.
+    method bytes.Buffer
+    method bufio.NewReader(os.Stdin)
+    method -r ^Read strings
+
+With -r, only names matching *regexp* are listed. Since arguments are
+separated by spaces, an expression can't contain any.
 `,
 
 		Min_args: 1,
-		Max_args: -1,  // Max_args < 0 means an arbitrary number
+		Max_args: -1, // Max_args < 0 means an arbitrary number
 	}
 	repl.AddToCategory("support", name)
 	repl.AddAlias("fn", name)
 	repl.AddAlias("func", name)
 }
 
-func printMethodsOf(fullname string) {
-	pkgName  := "."
-	name     :=  fullname
-	names    := strings.Split(fullname, ".")
-	pkg      := repl.Env
-	ok       := true
-	if len(names) > 1 {
-		pkgName = names[0]
-		name    = names[1]
-		pkg, ok = repl.Env.Pkg(pkgName).(*eval.SimpleEnv)
-		if !ok || pkg == nil {
-			repl.Errmsg("Can't find package %s", pkgName)
-			return
-		}
-	} else {
-		pkg, ok = repl.Env.Pkg(fullname).(*eval.SimpleEnv)
-		if !ok || pkg == nil {
-			repl.Errmsg("Can't find package %s", pkgName)
-			return
-		}
-		fnNames := []string {}
-		for name := range pkg.Funcs {
-			fnNames = append(fnNames, name)
+// methodLines returns the signatures of the methods of t whose names
+// match re, each followed by its documentation summary if that was
+// compiled in.
+func methodLines(t reflect.Type, re *regexp.Regexp, skip map[string]bool) []string {
+	var lines []string
+	skipRecv := t.Kind() != reflect.Interface
+	named := t
+	if named.Kind() == reflect.Ptr {
+		named = named.Elem()
+	}
+	for i := 0; i < t.NumMethod(); i++ {
+		m := t.Method(i)
+		if skip[m.Name] || (re != nil && !re.MatchString(m.Name)) {
+			continue
 		}
-		repl.PrintSorted("Functions of package " + fullname, fnNames)
+		lines = append(lines, symbolLine(repl.TypeSymbolName(named)+"."+m.Name,
+			m.Name+repl.FuncSignature(m.Type, skipRecv)))
+	}
+	return lines
+}
+
+// symbolLine returns sig followed, on the next line, by the
+// documentation summary compiled in for the symbol called fullname, if
+// there is one.
+func symbolLine(fullname, sig string) string {
+	if info, ok := repl.LookupSymbol(fullname); ok && info.Doc != "" {
+		return sig + "\n      " + info.Doc
+	}
+	return sig
+}
+
+// printLines prints lines under title, or says there are none.
+func printLines(title string, lines []string, none string) {
+	if len(lines) == 0 {
+		repl.Msg("%s", none)
+		return
+	}
+	repl.Section(title)
+	for _, line := range lines {
+		repl.Msg("  %s", line)
+	}
+}
+
+// printMethodsOfType lists the methods of t matching re. For a
+// non-interface type, the methods of T and those only *T has are
+// listed separately.
+func printMethodsOfType(t reflect.Type, re *regexp.Regexp) {
+	if t.Kind() == reflect.Interface {
+		printLines("Methods of interface "+t.String(), methodLines(t, re, nil),
+			"No methods found for "+t.String())
 		return
 	}
-	if v, ok := pkg.Vars[name]; ok {
-		methods := map[string] reflect.Value {}
-		for i:= 0; i < v.Type().NumMethod(); i++ {
-			meth := v.Method(i)
-			name := meth.Type().Name()
-			if name != "" {
-				methods[name] = v
-			}
+	value, ptr := t, reflect.PtrTo(t)
+	if t.Kind() == reflect.Ptr {
+		value, ptr = t.Elem(), t
+	}
+	valueLines := methodLines(value, re, nil)
+	have := make(map[string]bool)
+	for i := 0; i < value.NumMethod(); i++ {
+		have[value.Method(i).Name] = true
+	}
+	ptrLines := methodLines(ptr, re, have)
+	if len(valueLines)+len(ptrLines) == 0 {
+		repl.Msg("No methods found for %s", t)
+		return
+	}
+	if len(valueLines) > 0 {
+		printLines("Methods of "+value.String(), valueLines, "")
+	}
+	if len(ptrLines) > 0 {
+		printLines("Methods of "+ptr.String()+" only (pointer receivers)", ptrLines, "")
+	}
+}
+
+// printFuncsOf lists the functions of the package called pkgName
+// matching re.
+func printFuncsOf(pkgName string, pkg *eval.SimpleEnv, re *regexp.Regexp) {
+	var names []string
+	for name := range pkg.Funcs {
+		if re == nil || re.MatchString(name) {
+			names = append(names, name)
 		}
-		if len(methods) == 0 {
-			repl.Msg("No methods found for variable %s", fullname)
-		} else {
-			printReflectMap("Methods for variable " + fullname, methods)
+	}
+	sort.Strings(names)
+	var lines []string
+	for _, name := range names {
+		fn := pkg.Funcs[name]
+		sig := name
+		if fn.IsValid() && fn.Kind() == reflect.Func {
+			sig += repl.FuncSignature(fn.Type(), false)
 		}
-	} else if v, ok := pkg.Types[name]; ok {
-		if v == nil {
-			repl.Errmsg("Don't have method info recorded for type %s", fullname)
+		lines = append(lines, symbolLine(pkgName+"."+name, sig))
+	}
+	printLines(fmt.Sprintf("Functions of package %s (%d)", pkgName, len(lines)),
+		lines, "No functions found in package "+pkgName)
+}
+
+// dynamicType returns the type of the value that expression arg, of
+// interface type, holds, or nil if it can't be evaluated or is nil.
+func dynamicType(arg string) reflect.Type {
+	vals, err := repl.EvalString(arg, repl.Env)
+	if err != nil || len(vals) != 1 {
+		return nil
+	}
+	v := vals[0]
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	if !v.IsValid() || v.Kind() == reflect.Interface {
+		return nil
+	}
+	return v.Type()
+}
+
+// printMethodsOf lists the functions of package arg, or else the
+// methods of type or expression arg, whose names match re.
+func printMethodsOf(arg string, re *regexp.Regexp) {
+	if !strings.ContainsAny(arg, ".()[]*") && !repl.Env.Var(arg).IsValid() &&
+		repl.Env.Type(arg) == nil {
+		if pkg, ok := repl.Env.Pkg(arg).(*eval.SimpleEnv); ok && pkg != nil {
+			printFuncsOf(arg, pkg, re)
 			return
 		}
-		methods := map[string] reflect.Type {}
-		for i:= 0; i < v.NumMethod(); i++ {
-			meth := v.Method(i)
-			name := meth.Name
-			methods[name] = v
-		}
-		if len(methods) == 0 {
-			repl.Msg("No methods found for type %s", fullname)
-		} else {
-			printReflectTypeMap("Methods for type " + fullname, methods)
+	}
+	typ, err := resolveType(arg)
+	if err != nil {
+		repl.Errmsg("%s: %s", arg, err)
+		return
+	}
+	printMethodsOfType(typ, re)
+	if typ.Kind() != reflect.Interface {
+		return
+	}
+	if expr, err := parser.ParseExpr(arg); err == nil {
+		if _, err := repl.EvalType(expr, repl.Env); err == nil {
+			return
 		}
-	} else {
-		repl.Errmsg("Can't find member %s in package %s", name, pkgName)
+	}
+	if dyn := dynamicType(arg); dyn != nil {
+		repl.Msg("")
+		repl.Msg("%s holds a %s", arg, dyn)
+		printMethodsOfType(dyn, re)
 	}
 }
 
 // MethodCommand implements the command:
-//    method *name* [name*...]
-// which shows information about a package or lists all packages.
+//    method [-r *regexp*] *name* [name*...]
+// which shows the methods of types and expressions, or the functions
+// of packages.
 func MethodCommand(args []string) {
-	for _, name := range args[1:len(args)] {
-		printMethodsOf(name)
+	var re *regexp.Regexp
+	names := args[1:]
+	if len(names) > 0 && names[0] == "-r" {
+		if len(names) < 3 {
+			repl.Errmsg("Expecting a regular expression and something to show the methods of")
+			return
+		}
+		var err error
+		if re, err = regexp.Compile(names[1]); err != nil {
+			repl.Errmsg("Bad regular expression %s: %s", names[1], err)
+			return
+		}
+		names = names[2:]
+	}
+	for _, name := range names {
+		printMethodsOf(name, re)
 	}
 }
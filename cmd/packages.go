@@ -5,7 +5,11 @@
 package fishcmd
 
 import (
+	"fmt"
 	"reflect"
+	"regexp"
+	"sort"
+
 	"github.com/rocky/eval"
 	"github.com/rocky/go-fish"
 )
@@ -14,16 +18,26 @@ func init() {
 	name := "packages"
 	repl.Cmds[name] = &repl.CmdInfo{
 		Fn: PackageCommand,
-		Help: `packages [*package* [*package* ...] ]
+		Help: `packages [-consts|-funcs|-types|-vars] [-r *regexp*] [*package* [*package* ...] ]
 
 Show information about imported packages.
 
-If a package name is given, then detailed information is given about
-that package import. Otherwise we give a list of imported packages.
+Without a package, each imported package is listed with its import
+path and how many constants, functions, types and variables it has.
+
+If a package name or import path is given, then the members of that
+package are listed, functions with their signatures. -consts, -funcs,
+-types and -vars limit that to the given kinds of members.
+
+With -r, only packages, or members, whose names match *regexp* are
+listed. For example:
+
+    packages -funcs -r ^Has strings
+    packages -r rand
 `,
 
 		Min_args: 0,
-		Max_args: -1,  // Max_args < 0 means an arbitrary number
+		Max_args: -1, // Max_args < 0 means an arbitrary number
 	}
 	repl.AddToCategory("support", name)
 	repl.AddAlias("pkg", name)
@@ -31,50 +45,161 @@ that package import. Otherwise we give a list of imported packages.
 	repl.AddAlias("package", name)
 }
 
-func printReflectMap(title string, m map[string] reflect.Value) {
-	if len(m) > 0 {
-		list := []string {}
-		for item := range m {
-			list = append(list, item)
+// packageKinds are the kinds of package members by option name.
+var packageKinds = []string{"consts", "funcs", "types", "vars"}
+
+// findPackage returns the package in the environment with name or
+// import path arg, along with its name.
+func findPackage(arg string) (string, *eval.SimpleEnv) {
+	if pkg, ok := repl.Env.Pkgs[arg].(*eval.SimpleEnv); ok && pkg != nil {
+		return arg, pkg
+	}
+	for name := range repl.Env.Pkgs {
+		if repl.PkgPath(repl.Env, name) == arg {
+			pkg, _ := repl.Env.Pkgs[name].(*eval.SimpleEnv)
+			return name, pkg
+		}
+	}
+	return "", nil
+}
+
+// matchingNames returns the sorted keys of m, a map of package members,
+// that match re.
+func matchingNames(m interface{}, re *regexp.Regexp) []string {
+	var names []string
+	for _, key := range reflect.ValueOf(m).MapKeys() {
+		if name := key.String(); re == nil || re.MatchString(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// printPackageList lists the imported packages matching re, by name or
+// import path, with the number of members of each kind.
+func printPackageList(re *regexp.Regexp) {
+	var names []string
+	nameWidth, pathWidth := len("Package"), len("Import path")
+	for name := range repl.Env.Pkgs {
+		path := repl.PkgPath(repl.Env, name)
+		if re != nil && !re.MatchString(name) && !re.MatchString(path) {
+			continue
+		}
+		names = append(names, name)
+		if len(name) > nameWidth {
+			nameWidth = len(name)
+		}
+		if len(path) > pathWidth {
+			pathWidth = len(path)
+		}
+	}
+	if len(names) == 0 {
+		repl.Msg("No imported packages match")
+		return
+	}
+	sort.Strings(names)
+	repl.Section("%-*s  %-*s  %6s  %5s  %5s  %4s", nameWidth, "Package",
+		pathWidth, "Import path", "Consts", "Funcs", "Types", "Vars")
+	for _, name := range names {
+		path := repl.PkgPath(repl.Env, name)
+		if path == "" {
+			path = "?"
+		}
+		pkg, ok := repl.Env.Pkgs[name].(*eval.SimpleEnv)
+		if !ok || pkg == nil {
+			repl.Msg("%-*s  %-*s", nameWidth, name, pathWidth, path)
+			continue
 		}
-		repl.PrintSorted(title, list)
+		repl.Msg("%-*s  %-*s  %6d  %5d  %5d  %4d", nameWidth, name,
+			pathWidth, path, len(pkg.Consts), len(pkg.Funcs), len(pkg.Types),
+			len(pkg.Vars))
 	}
 }
 
-func printReflectTypeMap(title string, m map[string] reflect.Type) {
-	if len(m) > 0 {
-		list := []string {}
-		for item := range m {
-			list = append(list, item)
+// printPackage lists the members of pkg of the kinds in kinds whose
+// names match re.
+func printPackage(name string, pkg *eval.SimpleEnv, kinds map[string]bool, re *regexp.Regexp) {
+	title := "=== Package " + name
+	if path := repl.PkgPath(repl.Env, name); path != "" && path != name {
+		title += " (" + path + ")"
+	}
+	repl.Section("%s ===", title)
+	if info, ok := repl.LookupSymbol(name); ok && info.Doc != "" {
+		repl.Msg("%s", info.Doc)
+	}
+	members := map[string]interface{}{
+		"consts": pkg.Consts, "types": pkg.Types, "vars": pkg.Vars,
+	}
+	titles := map[string]string{
+		"consts": "Constants", "types": "Types", "vars": "Variables",
+	}
+	shown := false
+	for _, kind := range packageKinds {
+		if len(kinds) > 0 && !kinds[kind] {
+			continue
+		}
+		if kind == "funcs" {
+			if names := matchingNames(pkg.Funcs, re); len(names) > 0 {
+				printFuncsOf(name, pkg, re)
+				shown = true
+			}
+			continue
 		}
-		repl.PrintSorted(title, list)
+		if names := matchingNames(members[kind], re); len(names) > 0 {
+			repl.PrintSorted(fmt.Sprintf("%s of package %s (%d)", titles[kind], name, len(names)), names)
+			shown = true
+		}
+	}
+	if !shown {
+		repl.Msg("Nothing to show")
 	}
 }
 
 // PackageCommand implements the command:
-//    package [*name* [name*...]]
+//    package [-consts|-funcs|-types|-vars] [-r *regexp*] [*name* [name*...]]
 // which shows information about a package or lists all packages.
 func PackageCommand(args []string) {
-	if len(args) > 1 {
-		for _, pkg_name := range args[1:len(args)] {
-
-			pkg := repl.Env.Pkg(pkg_name)
-			if pkg != nil {
-				repl.Section("=== Package %s: ===", pkg_name)
-				simplePkg := pkg.(*eval.SimpleEnv)
-				printReflectMap("Constants of "+pkg_name, simplePkg.Consts)
-				printReflectMap("Functions of "+pkg_name, simplePkg.Funcs)
-				printReflectTypeMap("Types of "+pkg_name, simplePkg.Types)
-				printReflectMap("Variables of "+pkg_name, simplePkg.Vars)
-			} else {
-				repl.Errmsg("Package %s not imported", pkg_name)
+	kinds := make(map[string]bool)
+	var re *regexp.Regexp
+	names := args[1:]
+	for len(names) > 0 && len(names[0]) > 1 && names[0][0] == '-' {
+		opt := names[0][1:]
+		if opt == "r" {
+			if len(names) < 2 {
+				repl.Errmsg("Expecting a regular expression after -r")
+				return
+			}
+			var err error
+			if re, err = regexp.Compile(names[1]); err != nil {
+				repl.Errmsg("Bad regular expression %s: %s", names[1], err)
+				return
+			}
+			names = names[2:]
+			continue
+		}
+		known := false
+		for _, kind := range packageKinds {
+			if opt == kind {
+				known = true
 			}
 		}
-	} else {
-		pkgNames := []string {}
-		for pkg := range repl.Env.Pkgs {
-			pkgNames = append(pkgNames, pkg)
+		if !known {
+			repl.Errmsg("Unknown option %s; expecting -consts, -funcs, -types, -vars or -r", names[0])
+			return
+		}
+		kinds[opt] = true
+		names = names[1:]
+	}
+	if len(names) == 0 {
+		printPackageList(re)
+		return
+	}
+	for _, arg := range names {
+		if name, pkg := findPackage(arg); pkg != nil {
+			printPackage(name, pkg, kinds, re)
+		} else {
+			repl.Errmsg("Package %s not imported", arg)
 		}
-		repl.PrintSorted("All imported packages", pkgNames)
 	}
 }
@@ -0,0 +1,50 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// reset command
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "reset"
+	repl.Cmds[name] = &repl.CmdInfo{
+		Fn: ResetCommand,
+		Help: `reset [keep-results]
+
+Returns the environment to the state it was in when go-fish started.
+Variables, functions and types defined in the session, and packages
+imported, are dropped, as is the session history that "export" uses.
+
+The values in "results" are cleared too unless "keep-results" is
+given.
+`,
+		Min_args: 0,
+		Max_args: 1,
+	}
+	repl.AddToCategory("data", name)
+}
+
+// ResetCommand implements the command:
+//    reset [keep-results]
+// which returns the environment to its initial state.
+func ResetCommand(args []string) {
+	keepResults := false
+	if len(args) == 2 {
+		if args[1] != "keep-results" {
+			repl.Errmsg("Expecting \"keep-results\"; got %s", args[1])
+			return
+		}
+		keepResults = true
+	}
+	repl.Reset(keepResults)
+	if keepResults {
+		repl.Msg("Environment reset; results kept")
+	} else {
+		repl.Msg("Environment reset")
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// restart command
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "restart"
+	repl.Cmds[name] = &repl.CmdInfo{
+		Fn: RestartCommand,
+		Help: `restart [replay]
+
+Restarts go-fish with the arguments it was originally run with, from
+the directory it was started in. If environment variable
+GOFISH_RESTART_CMD is set, that shell command is run instead.
+
+This is useful after regenerating repl_imports.go or rebuilding go-fish
+with new packages.
+
+With "replay", the imports, declarations, statements and expressions
+that were evaluated without error are entered again after the restart,
+so that variables and functions are reconstructed. Since they are
+evaluated again, any side effects they have happen again too.
+`,
+		Min_args: 0,
+		Max_args: 1,
+	}
+	repl.AddToCategory("support", name)
+}
+
+// RestartCommand implements the command:
+//    restart [replay]
+// which re-executes go-fish.
+func RestartCommand(args []string) {
+	replay := false
+	if len(args) == 2 {
+		if args[1] != "replay" {
+			repl.Errmsg("Expecting \"replay\"; got %s", args[1])
+			return
+		}
+		replay = true
+	}
+	if err := repl.Restart(replay); err != nil {
+		repl.Errmsg("Can't restart: %s", err)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// rollback command
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "rollback"
+	repl.Cmds[name] = &repl.CmdInfo{
+		Fn: RollbackCommand,
+		Help: `rollback *name*
+
+Restores the variables defined in the session, and "results", to what
+they were when "checkpoint *name*" was run. Variables defined since
+then are removed. The checkpoint is kept, so you can roll back to it
+again.
+
+See also "checkpoint" and "checkpoints".
+`,
+		Min_args: 1,
+		Max_args: 1,
+	}
+	repl.AddToCategory("data", name)
+}
+
+// RollbackCommand implements the command:
+//    rollback *name*
+// which restores the session variables from a checkpoint.
+func RollbackCommand(args []string) {
+	cp, err := repl.Rollback(args[1], repl.Env)
+	if err != nil {
+		repl.Errmsg("%s", err)
+		return
+	}
+	repl.Msg("Rolled back to checkpoint %s; %d variables restored", cp.Name, cp.NumVars())
+}
@@ -0,0 +1,49 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// save-vars command
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "save-vars"
+	repl.Cmds[name] = &repl.CmdInfo{
+		Fn: SaveVarsCommand,
+		Help: `save-vars *file*
+
+Writes the variables defined in the session, along with their types, to
+*file* as JSON. "load-vars *file*" in a later session reads them back.
+
+Values are written the way encoding/json does. Variables holding
+functions or channels, or values that wouldn't read back the same --
+say structs with unexported fields, or numbers held in an interface{}
+-- are skipped with a warning.
+
+See also "load-vars".
+`,
+		Min_args: 1,
+		Max_args: 1,
+	}
+	repl.AddToCategory("data", name)
+}
+
+// SaveVarsCommand implements the command:
+//    save-vars *file*
+// which writes the session variables to a file.
+func SaveVarsCommand(args []string) {
+	filename := args[1]
+	n, warnings, err := repl.SaveVars(filename, repl.Env)
+	for _, warning := range warnings {
+		repl.Errmsg("Warning: %s", warning)
+	}
+	if err != nil {
+		repl.Errmsg("Can't save variables to %s: %s", filename, err)
+		return
+	}
+	repl.Msg("Saved %d variables to %s", n, filename)
+}
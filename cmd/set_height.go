@@ -0,0 +1,42 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// set height - set number of lines on the screen
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	parent := "set"
+	repl.AddSubCommand(parent, &repl.SubcmdInfo{
+		Fn: SetHeightSubcmd,
+		Help: `set height {num|auto}
+
+Sets the number of lines on the screen. Output longer than this is
+paged; see "set pager".
+
+Normally the height follows the terminal. Giving a number pins the
+height to that value until "set height auto" is used.`,
+		Min_args: 1,
+		Max_args: 1,
+		Short_help: "set screen height",
+		Name: "height",
+	})
+}
+
+func SetHeightSubcmd(args []string) {
+	if args[2] == "auto" {
+		repl.AutoHeight = true
+		repl.UpdateHeight()
+	} else {
+		i, err := repl.GetInt(args[2], "screen height", 0, 10000)
+		if err != nil { return }
+		repl.AutoHeight = false
+		repl.Maxheight = i
+	}
+	ShowHeightSubcmd(args)
+}
@@ -0,0 +1,98 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// set logging - write a session transcript to a file
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	parent := "set"
+	repl.AddSubCommand(parent, &repl.SubcmdInfo{
+		Fn: SetLoggingSubcmd,
+		Help: `set logging on [*file*]
+set logging off
+set logging strip [on|off]
+set logging timestamps [on|off]
+
+Writes a transcript of the session to a file: prompts, input lines,
+results, errors, and whatever evaluated code writes to standard
+output. The transcript is appended to *file*, or to "gofish.txt" if no
+file is given.
+
+"set logging strip" sets whether terminal escape sequences, such as
+those used for highlighting, are removed from the transcript. This is
+on by default.
+
+"set logging timestamps" sets whether each line of the transcript is
+prefixed with the time it was written. This is off by default.`,
+		Min_args: 1,
+		Max_args: 2,
+		Short_help: "write a session transcript",
+		Name: "logging",
+	})
+}
+
+func SetLoggingSubcmd(args []string) {
+	switch args[2] {
+	case "strip":
+		if onoff, ok := onOffArg(args, 3); ok {
+			repl.LogStripANSI = onoff
+		}
+	case "timestamps":
+		if onoff, ok := onOffArg(args, 3); ok {
+			repl.LogTimestamps = onoff
+		}
+	default:
+		switch ParseOnOff(args[2]) {
+		case ONOFF_ON:
+			filename := repl.DefaultLogFile
+			if len(args) == 4 {
+				filename = args[3]
+			}
+			if err := repl.StartLogging(filename); err != nil {
+				repl.Errmsg("Can't log to %s: %s", filename, err)
+				return
+			}
+		case ONOFF_OFF:
+			if len(args) == 4 {
+				repl.Errmsg("\"set logging off\" takes no file name")
+				return
+			}
+			if !repl.Logging() {
+				repl.Errmsg("Logging is already off")
+				return
+			}
+			if err := repl.StopLogging(); err != nil {
+				repl.Errmsg("Error closing transcript: %s", err)
+			}
+		default:
+			repl.Errmsg("Expecting 'on', 'off', 'strip' or 'timestamps', got '%s'; nothing done",
+				args[2])
+			return
+		}
+	}
+	ShowLoggingSubcmd(args)
+}
+
+// onOffArg parses an optional on/off value in args[i]. A missing
+// value means "on". ok is false if the value is neither.
+func onOffArg(args []string, i int) (on bool, ok bool) {
+	onoff := "on"
+	if len(args) > i {
+		onoff = args[i]
+	}
+	switch ParseOnOff(onoff) {
+	case ONOFF_ON:
+		return true, true
+	case ONOFF_OFF:
+		return false, true
+	default:
+		repl.Errmsg("Expecting 'on' or 'off', got '%s'; nothing done", onoff)
+		return false, false
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// set pager - page long output?
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	parent := "set"
+	repl.AddSubCommand(parent, &repl.SubcmdInfo{
+		Fn: SetPagerSubcmd,
+		Help: `set pager [on|off|auto]
+
+Sets whether output longer than the screen height is paged.
+
+With "auto", output is paged only when it goes to a terminal. With
+"on", it is paged whenever it is longer than the height given by
+"set height". If the environment variable PAGER is set, that program
+is used as the pager; otherwise a simple built-in pager is used.`,
+		Min_args: 0,
+		Max_args: 1,
+		Short_help: "page long output",
+		Name: "pager",
+	})
+}
+
+func SetPagerSubcmd(args []string) {
+	onoff := "on"
+	if len(args) == 3 {
+		onoff = args[2]
+	}
+	if onoff == "auto" {
+		repl.Pager = repl.PAGER_AUTO
+	} else {
+		switch ParseOnOff(onoff) {
+		case ONOFF_ON:
+			repl.Pager = repl.PAGER_ON
+		case ONOFF_OFF:
+			repl.Pager = repl.PAGER_OFF
+		case ONOFF_UNKNOWN:
+			repl.Msg("Expecting 'on', 'off' or 'auto', got '%s'; nothing done", onoff)
+			return
+		}
+	}
+	ShowPagerSubcmd(args)
+}
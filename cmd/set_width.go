@@ -14,9 +14,13 @@ func init() {
 	parent := "set"
 	repl.AddSubCommand(parent, &repl.SubcmdInfo{
 		Fn: SetWidthSubcmd,
-		Help: `set width num
+		Help: `set width {num|auto}
 
-Sets the line length the REPL thinks we have`,
+Sets the line length the REPL thinks we have.
+
+Normally the width follows the terminal and is updated when the
+terminal is resized. Giving a number pins the width to that value
+until "set width auto" is used.`,
 		Min_args: 1,
 		Max_args: 1,
 		Short_help: "set line width",
@@ -25,8 +29,14 @@ Sets the line length the REPL thinks we have`,
 }
 
 func SetWidthSubcmd(args []string) {
-	i, err := repl.GetInt(args[2], "line width", 0, 10000)
-	if err != nil { return }
-	repl.Maxwidth = i
+	if args[2] == "auto" {
+		repl.AutoWidth = true
+		repl.UpdateWidth()
+	} else {
+		i, err := repl.GetInt(args[2], "line width", 0, 10000)
+		if err != nil { return }
+		repl.AutoWidth = false
+		repl.Maxwidth = i
+	}
 	ShowWidthSubcmd(args)
 }
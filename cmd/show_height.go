@@ -0,0 +1,33 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// show height - show number of lines on the screen
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	parent := "show"
+	repl.AddSubCommand(parent, &repl.SubcmdInfo{
+		Fn: ShowHeightSubcmd,
+		Help: `show height
+
+Show the number of lines on the screen the REPL thinks we have`,
+		Min_args: 0,
+		Max_args: 0,
+		Short_help: "show screen height",
+		Name: "height",
+	})
+}
+
+func ShowHeightSubcmd(args []string) {
+	if repl.AutoHeight {
+		repl.Msg("Screen height is %d (auto)", repl.Maxheight)
+	} else {
+		repl.Msg("Screen height is %d", repl.Maxheight)
+	}
+}
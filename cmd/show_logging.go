@@ -0,0 +1,35 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// show logging - where the session transcript is written
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	parent := "show"
+	repl.AddSubCommand(parent, &repl.SubcmdInfo{
+		Fn: ShowLoggingSubcmd,
+		Help: `show logging
+
+Show whether a session transcript is being written and how`,
+		Min_args: 0,
+		Max_args: 0,
+		Short_help: "show session transcript settings",
+		Name: "logging",
+	})
+}
+
+func ShowLoggingSubcmd(args []string) {
+	if repl.Logging() {
+		repl.Msg("Logging is on, to file %s.", repl.LogFileName)
+	} else {
+		repl.Msg("Logging is off.")
+	}
+	ShowOnOff("Stripping of terminal escape sequences", repl.LogStripANSI)
+	ShowOnOff("Timestamps", repl.LogTimestamps)
+}
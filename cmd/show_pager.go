@@ -0,0 +1,36 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// show pager - whether long output is paged
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	parent := "show"
+	repl.AddSubCommand(parent, &repl.SubcmdInfo{
+		Fn: ShowPagerSubcmd,
+		Help: `show pager
+
+Show whether output longer than the screen height is paged`,
+		Min_args: 0,
+		Max_args: 0,
+		Short_help: "show paging of long output",
+		Name: "pager",
+	})
+}
+
+func ShowPagerSubcmd(args []string) {
+	switch repl.Pager {
+	case repl.PAGER_ON:
+		repl.Msg("pager is on.")
+	case repl.PAGER_OFF:
+		repl.Msg("pager is off.")
+	default:
+		repl.Msg("pager is auto.")
+	}
+}
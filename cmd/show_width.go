@@ -25,5 +25,9 @@ Show the line length the REPL thinks we have`,
 }
 
 func ShowWidthSubcmd(args []string) {
-	repl.Msg("Line width is %d", repl.Maxwidth)
+	if repl.AutoWidth {
+		repl.Msg("Line width is %d (auto)", repl.Maxwidth)
+	} else {
+		repl.Msg("Line width is %d", repl.Maxwidth)
+	}
 }
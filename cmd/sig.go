@@ -0,0 +1,61 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// sig command
+
+package fishcmd
+
+import (
+	"go/parser"
+	"strings"
+
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "sig"
+	repl.Cmds[name] = &repl.CmdInfo{
+		Fn: SigCommand,
+		Help: `sig *function-or-method*
+
+Shows the signature of a function or method: its parameter and result
+types, the receiver for a method, and what the last parameter takes if
+the function is variadic. Parameter names are shown when the
+declaration is known, for functions declared in the session and those
+whose documentation was compiled in. For example:
+
+    sig strings.Split
+    sig fmt.Printf
+    sig buf.Write
+    sig bytes.Buffer.Write
+
+The signature is also shown when a call has the wrong number or types
+of arguments.
+`,
+		Min_args: 1,
+		Max_args: -1,
+	}
+	repl.AddToCategory("support", name)
+	repl.AddAlias("args", name)
+}
+
+// SigCommand implements the command:
+//    sig *function-or-method*
+// which shows the signature of a function or method.
+func SigCommand(args []string) {
+	arg := strings.TrimSpace(repl.CmdLine[len(args[0]):])
+	expr, err := parser.ParseExpr(arg)
+	if err != nil {
+		repl.Errmsg("%s", err)
+		return
+	}
+	lines, err := repl.CallSignature(expr, repl.Env)
+	if err != nil {
+		repl.Errmsg("%s", err)
+		return
+	}
+	for _, line := range lines {
+		repl.Msg("%s", line)
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Helpers for commands that describe types
+
+package fishcmd
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/rocky/eval"
+	"github.com/rocky/go-fish"
+)
+
+// underlyingString returns the type literal that named type t is
+// defined as, e.g. "struct { X int }" for a struct type. For unnamed
+// types, that is just t.String().
+func underlyingString(t reflect.Type) string {
+	if t.Name() == "" {
+		return t.String()
+	}
+	switch t.Kind() {
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), t.Elem())
+	case reflect.Chan:
+		switch t.ChanDir() {
+		case reflect.RecvDir:
+			return "<-chan " + t.Elem().String()
+		case reflect.SendDir:
+			return "chan<- " + t.Elem().String()
+		}
+		return "chan " + t.Elem().String()
+	case reflect.Func:
+		return "func" + repl.FuncSignature(t, false)
+	case reflect.Interface:
+		sigs := repl.MethodSignatures(t)
+		if len(sigs) == 0 {
+			return "interface {}"
+		}
+		return "interface { " + strings.Join(sigs, "; ") + " }"
+	case reflect.Map:
+		return fmt.Sprintf("map[%s]%s", t.Key(), t.Elem())
+	case reflect.Ptr:
+		return "*" + t.Elem().String()
+	case reflect.Slice:
+		return "[]" + t.Elem().String()
+	case reflect.Struct:
+		var fields []string
+		for i := 0; i < t.NumField(); i++ {
+			fields = append(fields, fieldString(t.Field(i)))
+		}
+		if len(fields) == 0 {
+			return "struct {}"
+		}
+		return "struct { " + strings.Join(fields, "; ") + " }"
+	}
+	// Basic types: the kind names the underlying type.
+	return t.Kind().String()
+}
+
+// fieldString returns a struct field as it would be declared.
+func fieldString(f reflect.StructField) string {
+	s := f.Type.String()
+	if !f.Anonymous {
+		s = f.Name + " " + s
+	}
+	if f.Tag != "" {
+		s += fmt.Sprintf(" %q", string(f.Tag))
+	}
+	return s
+}
+
+// printTypeDetails shows what there is to know about t beyond its
+// name: its underlying type, size and alignment, struct fields, and
+// the method sets of t and, unless t is a pointer or interface, *t.
+func printTypeDetails(t reflect.Type) {
+	if t.Name() != "" {
+		repl.Msg("underlying:\t%s", underlyingString(t))
+	}
+	repl.Msg("kind:\t%s", t.Kind())
+	repl.Msg("size:\t%d bytes, align %d, field align %d", t.Size(), t.Align(), t.FieldAlign())
+	if t.Kind() == reflect.Struct && t.NumField() > 0 {
+		repl.Section("Fields")
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			line := "  " + fieldString(f)
+			if f.Anonymous {
+				line += "\t(embedded)"
+			}
+			if f.PkgPath != "" {
+				line += "\t(unexported)"
+			}
+			repl.Msg("%s", line)
+		}
+	}
+	printMethodSet(t.String(), t)
+	if t.Kind() != reflect.Ptr && t.Kind() != reflect.Interface {
+		printMethodSet("*"+t.String(), reflect.PtrTo(t))
+	}
+}
+
+// printMethodSet lists the method set of t, calling the type name.
+func printMethodSet(name string, t reflect.Type) {
+	sigs := repl.MethodSignatures(t)
+	if len(sigs) == 0 {
+		repl.Msg("method set of %s is empty", name)
+		return
+	}
+	repl.Section("Method set of %s", name)
+	for _, sig := range sigs {
+		repl.Msg("  %s", sig)
+	}
+}
+
+// isAddressable returns true if expr is addressable in env, going by
+// its form: variables, pointer indirections, slice indexing, and
+// field selectors and array indexing of addressable operands.
+func isAddressable(expr ast.Expr, env eval.Env) bool {
+	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		return isAddressable(e.X, env)
+	case *ast.Ident:
+		return env.Var(e.Name).IsValid()
+	case *ast.StarExpr:
+		return true
+	case *ast.IndexExpr:
+		switch t := exprType(e.X, env); {
+		case t == nil:
+			return false
+		case t.Kind() == reflect.Slice:
+			return true
+		case t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Array:
+			return true
+		case t.Kind() == reflect.Array:
+			return isAddressable(e.X, env)
+		}
+		return false
+	case *ast.SelectorExpr:
+		if id, ok := e.X.(*ast.Ident); ok && !env.Var(id.Name).IsValid() {
+			if pkg := env.Pkg(id.Name); pkg != nil {
+				return pkg.Var(e.Sel.Name).IsValid()
+			}
+		}
+		t := exprType(e.X, env)
+		if t == nil {
+			return false
+		}
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+			if t.Kind() != reflect.Struct {
+				return false
+			}
+			_, ok := t.FieldByName(e.Sel.Name)
+			return ok
+		}
+		if t.Kind() != reflect.Struct {
+			return false
+		}
+		if _, ok := t.FieldByName(e.Sel.Name); !ok {
+			return false
+		}
+		return isAddressable(e.X, env)
+	}
+	return false
+}
+
+// exprType returns the type of expr in env, or nil if it doesn't have
+// a single one.
+func exprType(expr ast.Expr, env eval.Env) reflect.Type {
+	cexpr, errs := eval.CheckExpr(expr, env)
+	if len(errs) != 0 {
+		return nil
+	}
+	if types := cexpr.KnownType(); len(types) == 1 {
+		return types[0]
+	}
+	return nil
+}
+
+// resolveType returns the type that arg denotes, if it is a type, or
+// else the type of arg as an expression.
+func resolveType(arg string) (reflect.Type, error) {
+	expr, err := parser.ParseExpr(arg)
+	if err != nil {
+		return nil, err
+	}
+	return resolveTypeExpr(expr, arg)
+}
+
+// resolveTypeExpr is resolveType for expr, parsed from arg.
+func resolveTypeExpr(expr ast.Expr, arg string) (reflect.Type, error) {
+	if typ, err := repl.EvalType(expr, repl.Env); err == nil {
+		return typ, nil
+	}
+	cexpr, errs := eval.CheckExpr(expr, repl.Env)
+	if len(errs) != 0 {
+		return nil, errs[0]
+	}
+	types := cexpr.KnownType()
+	if len(types) != 1 || types[0] == nil {
+		return nil, fmt.Errorf("%s doesn't have a single type", arg)
+	}
+	return types[0], nil
+}
+
+// resolveStructType is resolveType for commands that want a struct
+// type. A pointer to a struct is taken as the struct.
+func resolveStructType(arg string) (reflect.Type, error) {
+	typ, err := resolveType(arg)
+	if err != nil {
+		return nil, err
+	}
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%s is a %s, not a struct", typ, typ.Kind())
+	}
+	return typ, nil
+}
+
+// knownTypes returns the types in the environment and its packages,
+// by qualified name, along with the names sorted.
+func knownTypes() (map[string]reflect.Type, []string) {
+	types := make(map[string]reflect.Type)
+	for name, typ := range repl.Env.Types {
+		if typ != nil {
+			types[name] = typ
+		}
+	}
+	for pkgName, pkg := range repl.Env.Pkgs {
+		simple, ok := pkg.(*eval.SimpleEnv)
+		if !ok || simple == nil {
+			continue
+		}
+		for name, typ := range simple.Types {
+			if typ != nil {
+				types[pkgName+"."+name] = typ
+			}
+		}
+	}
+	names := make([]string, 0, len(types))
+	for name := range types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return types, names
+}
@@ -0,0 +1,41 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// unset command
+
+package fishcmd
+
+import (
+	"github.com/rocky/go-fish"
+)
+
+func init() {
+	name := "unset"
+	repl.Cmds[name] = &repl.CmdInfo{
+		Fn: UnsetCommand,
+		Help: `unset *name*...
+
+Removes each variable *name* from the environment. Functions and types
+declared in the session can be removed this way too.
+
+See also "info vars" and "reset".
+`,
+		Min_args: 1,
+		Max_args: -1,
+	}
+	repl.AddToCategory("data", name)
+}
+
+// UnsetCommand implements the command:
+//    unset *name*...
+// which removes variables from the environment.
+func UnsetCommand(args []string) {
+	for _, name := range args[1:] {
+		if kind, err := repl.Unset(repl.Env, name); err != nil {
+			repl.Errmsg("%s", err)
+		} else {
+			repl.Msg("Removed %s %s", kind, name)
+		}
+	}
+}
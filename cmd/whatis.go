@@ -19,10 +19,16 @@ func init() {
 		Fn: WhatisCommand,
 		Help: `whatis expression
 
-Shows the type checker information for an expression. As a special
+Shows the type checker information for an expression. As special
 cases
    if expression is a package name, we'll confirm that.
-   if the expression is a type, we'll show reflect.Kind information
+   if the expression is a type, we'll show its kind, underlying type,
+   size and alignment, struct fields with their tags, and the method
+   sets of both the type and a pointer to it.
+
+For other expressions we show the type, and the constant value if
+there is one. We also say whether the expression is addressable, and
+for a value of a single type, show the details of that type as above.
  `,
 
 		Min_args: 0,
@@ -36,13 +42,20 @@ func WhatisCommand(args []string) {
 		arg := args[1]
 		if _, ok := repl.Env.Pkg(arg).(*eval.SimpleEnv); ok  {
 			repl.Msg("`%s' is a package", arg)
+			if info, ok := repl.LookupSymbol(arg); ok && info.Doc != "" {
+				repl.Msg("%s", info.Doc)
+			}
 			return
 		}
 		ids := strings.Split(arg, ".")
 		if len(ids) == 1 {
 			name := ids[0]
 			if typ := repl.Env.Type(name); typ != nil  {
-				repl.Msg("%s is a type: %s", typ.String())
+				repl.Msg("%s is a type: %s", name, typ)
+				if _, ok := repl.UserTypes[name]; ok {
+					repl.Msg("%s was defined in the REPL and stands for its underlying type", name)
+				}
+				printTypeDetails(typ)
 				return
 			}
 		}
@@ -51,8 +64,9 @@ func WhatisCommand(args []string) {
 			name     := ids[1]
 			if pkg, ok := repl.Env.Pkg(pkgName).(*eval.SimpleEnv); ok  {
 				if typ := pkg.Type(name); typ != nil  {
-					repl.Msg("%s is a kind: %s", arg, typ.Kind())
 					repl.Msg("%s is a type: %v", arg, typ)
+					printSymbolMeta(arg)
+					printTypeDetails(typ)
 					return
 				}
 			}
@@ -82,5 +96,33 @@ func WhatisCommand(args []string) {
 				repl.Msg("type[%d]:\t%s", i, v)
 			}
 		}
+		if !cexpr.IsConst() {
+			repl.Msg("addressable:\t%t", isAddressable(expr, repl.Env))
+		}
+		if len(args) == 2 {
+			printSymbolMeta(args[1])
+		}
+		if len(knownTypes) == 1 && knownTypes[0] != nil && !cexpr.IsConst() {
+			printTypeDetails(knownTypes[0])
+		}
+	}
+}
+
+// printSymbolMeta shows the signature, documentation summary and
+// position compiled in for name, e.g. "strings.Split", if there are
+// any.
+func printSymbolMeta(name string) {
+	info, ok := repl.LookupSymbol(name)
+	if !ok {
+		return
+	}
+	if info.Sig != "" {
+		repl.Msg("signature:\t%s", info.Sig)
+	}
+	if info.Doc != "" {
+		repl.Msg("doc:\t%s", info.Doc)
+	}
+	if info.Pos != "" {
+		repl.Msg("declared:\t%s", info.Pos)
 	}
 }
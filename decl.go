@@ -0,0 +1,135 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Declarations entered at the prompt
+
+package repl
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"io"
+	"regexp"
+
+	"github.com/rocky/eval"
+)
+
+// ContinuationPrompt is what we prompt with for further lines of a
+// declaration that spans several lines.
+const ContinuationPrompt = "...> "
+
+// declStartRE matches the start of a function or type declaration.
+// For functions a name is required so that function literals, and the
+// "func" alias of the "method" command, aren't taken as declarations.
+var declStartRE = regexp.MustCompile(`^\s*(func\s+(\([^)]*\)\s*)?[\pL_][\pL\pN_]*\s*\(|type(\s*\(|\s+[\pL_]))`)
+
+// isDeclStart returns true if line starts a declaration, which
+// eval.ParseStmt doesn't handle.
+func isDeclStart(line string) bool {
+	return declStartRE.MatchString(line)
+}
+
+// errDeclAbandoned is returned by readDecl when input ends, say with
+// Ctrl-D at the continuation prompt, before the declaration does.
+var errDeclAbandoned = errors.New("input ended inside the declaration; abandoned it")
+
+// readDecl returns the text of a declaration that starts with line,
+// reading further lines until its parentheses, brackets and braces
+// balance.
+func readDecl(line string) (string, error) {
+	src := line
+	for bracketDepth(src) > 0 {
+		more, err := readLineFn(ContinuationPrompt, true)
+		if err == io.EOF {
+			return src, errDeclAbandoned
+		} else if err != nil {
+			return src, err
+		}
+		LogInput(ContinuationPrompt, more)
+		src += "\n" + more
+	}
+	return src, nil
+}
+
+// bracketDepth returns the number of parentheses, brackets and braces
+// in src that haven't been closed.
+func bracketDepth(src string) int {
+	var s scanner.Scanner
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(src))
+	s.Init(file, []byte(src), nil, 0)
+	depth := 0
+	for {
+		_, tok, _ := s.Scan()
+		switch tok {
+		case token.EOF:
+			return depth
+		case token.LPAREN, token.LBRACK, token.LBRACE:
+			depth++
+		case token.RPAREN, token.RBRACK, token.RBRACE:
+			depth--
+		}
+	}
+}
+
+// evalDecls parses the declarations in src and defines them in env.
+func evalDecls(src string, env *eval.SimpleEnv) {
+	const header = "package main; "
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", header+src, 0)
+	if err != nil {
+		Errmsg("parse error: %s", err)
+		return
+	}
+	for _, decl := range file.Decls {
+		start := fset.Position(decl.Pos()).Offset - len(header)
+		end := fset.Position(decl.End()).Offset - len(header)
+		text := src[start:end]
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if err := CheckReserved(decl.Name.Name); err != nil {
+				Errmsg("%s", err)
+				continue
+			}
+			_, redefined := UserFuncs[decl.Name.Name]
+			fn, err := DefineFunc(decl, text, env)
+			if err != nil {
+				Errmsg("%s", err)
+				continue
+			}
+			if redefined {
+				Msg("Function %s redefined: %s", fn.Name, fn.Type)
+			} else {
+				Msg("Function %s defined: %s", fn.Name, fn.Type)
+			}
+			recordSession(SessionEntry{Input: text, Decl: decl, Values: -1})
+		case *ast.GenDecl:
+			if decl.Tok != token.TYPE {
+				Errmsg("Only function and type declarations are supported here")
+				continue
+			}
+			for _, spec := range decl.Specs {
+				spec := spec.(*ast.TypeSpec)
+				typ, err := DefineType(spec, env)
+				if err != nil {
+					Errmsg("type %s: %s", spec.Name.Name, err)
+					continue
+				}
+				Msg("Type %s defined: %s", spec.Name.Name, typ)
+				// Each type is recorded on its own, even when
+				// declared in a group.
+				start := fset.Position(spec.Pos()).Offset - len(header)
+				end := fset.Position(spec.End()).Offset - len(header)
+				single := &ast.GenDecl{Tok: token.TYPE, Specs: []ast.Spec{spec}}
+				recordSession(SessionEntry{Input: "type " + src[start:end],
+					Decl: single, Values: -1})
+			}
+		default:
+			Errmsg("Only function and type declarations are supported here")
+		}
+	}
+}
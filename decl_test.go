@@ -0,0 +1,105 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rocky/go-fish"
+)
+
+// TestReadDecl checks that a declaration is read until its brackets
+// balance, not counting those in strings and comments, and that the
+// input after it is read as usual.
+func TestReadDecl(t *testing.T) {
+	repl.Reset(false)
+	out := captureOutput(func() {
+		repl.REPL(repl.Env, scriptedInput(
+			"type point struct {",
+			"\tX, Y int // {",
+			"\tName string `json:\"name,omitempty\" x:\"(\"`",
+			"\tTag  string \"[\"",
+			"}",
+			"type (celsius float64; kelvin float64)",
+			"help set",
+		), repl.SimpleInspect)
+	})
+	for _, name := range []string{"point", "celsius", "kelvin"} {
+		if repl.Env.Type(name) == nil {
+			t.Errorf("type %s wasn't declared; output:\n%s", name, out)
+		}
+	}
+	if typ := repl.Env.Type("point"); typ != nil && typ.NumField() != 4 {
+		t.Errorf("point has %d fields, want 4", typ.NumField())
+	}
+	if !strings.Contains(out, "Modifies parts of the REPL environment") {
+		t.Errorf("the line after the declarations wasn't read as a command; output:\n%s", out)
+	}
+}
+
+// TestDeclStart checks which lines are taken to start declarations:
+// a function literal, the "func" alias of "method", or a call of a
+// function whose name starts with "func" isn't one.
+func TestDeclStart(t *testing.T) {
+	repl.Reset(false)
+	repl.Env.Funcs["function"] = reflect.ValueOf(func(n int) int { return n + 1 })
+	repl.Env.Funcs["funcs"] = reflect.ValueOf(func(s string) string { return s + "!" })
+	repl.Env.Funcs["funcName"] = reflect.ValueOf(func() string { return "funcName" })
+	setVar(repl.Env.Vars, "x", "fish")
+	out := captureOutput(func() {
+		repl.REPL(repl.Env, scriptedInput(
+			"type meters int",
+			"func strings",
+			"function(1)",
+			"funcs(x)",
+			"funcName()",
+		), repl.SimpleInspect)
+	})
+	if strings.Contains(out, "parse error") {
+		t.Errorf("a call was taken as a declaration; output:\n%s", out)
+	}
+	results := *repl.Env.Vars["results"].Interface().(*[]interface{})
+	if want := []interface{}{2, "fish!", "funcName"}; !reflect.DeepEqual(results, want) {
+		t.Errorf("results are %v; want %v", results, want)
+	}
+	if repl.Env.Type("meters") == nil {
+		t.Errorf("type meters wasn't declared; output:\n%s", out)
+	}
+	if !strings.Contains(out, "Functions of package strings") {
+		t.Errorf("\"func strings\" wasn't run as the method command; output:\n%s", out)
+	}
+}
+
+// TestDeclEOF checks that ending input at the continuation prompt
+// abandons the declaration, and go-fish carries on reading.
+func TestDeclEOF(t *testing.T) {
+	for _, first := range []string{"type t struct {", "import ("} {
+		repl.Reset(false)
+		lines := []string{first, "", "help quit"}
+		readLine := func(prompt string, add_history ...bool) (string, error) {
+			if len(lines) == 0 {
+				return "", io.EOF
+			}
+			line := lines[0]
+			lines = lines[1:]
+			if line == "" {
+				return "", io.EOF
+			}
+			return line, nil
+		}
+		out := captureOutput(func() {
+			repl.REPL(repl.Env, readLine, repl.SimpleInspect)
+		})
+		if !strings.Contains(out, "abandoned") {
+			t.Errorf("%s: the declaration wasn't reported as abandoned; output:\n%s", first, out)
+		}
+		if len(lines) != 0 {
+			t.Errorf("%s: go-fish stopped reading after the declaration; output:\n%s", first, out)
+		}
+	}
+}
@@ -0,0 +1,348 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package documentation from source, via go/doc
+
+package repl
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/doc"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"strings"
+)
+
+// docPkgs caches the documentation of packages by import path.
+var docPkgs = make(map[string]*docPkg)
+
+// docPkg is the documentation of a package, along with the file set
+// its declarations were parsed into.
+type docPkg struct {
+	*doc.Package
+	fset *token.FileSet
+}
+
+// DocPkgPath returns the import path for name, which is either the
+// name of a package in the environment or an import path.
+func DocPkgPath(name string) string {
+	if Env != nil {
+		if _, ok := Env.Pkgs[name]; ok {
+			if path := PkgPath(Env, name); path != "" {
+				return path
+			}
+		}
+	}
+	return name
+}
+
+// loadDoc finds the source of the package with import path pkgPath
+// via go/build and extracts its documentation.
+func loadDoc(pkgPath string) (*docPkg, error) {
+	if p, ok := docPkgs[pkgPath]; ok {
+		return p, nil
+	}
+	bpkg, err := build.Import(pkgPath, initial_cwd, 0)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]bool)
+	for _, name := range bpkg.GoFiles {
+		files[name] = true
+	}
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, bpkg.Dir, func(fi os.FileInfo) bool {
+		return files[fi.Name()]
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	astPkg, ok := pkgs[bpkg.Name]
+	if !ok {
+		return nil, fmt.Errorf("no Go source for package %s in %s", bpkg.Name, bpkg.Dir)
+	}
+	p := &docPkg{doc.New(astPkg, bpkg.ImportPath, 0), fset}
+	docPkgs[pkgPath] = p
+	return p, nil
+}
+
+// splitDocArg splits arg, e.g. "encoding/json.Decoder.Decode" or
+// "json.Marshal", into the package part and the names after it. The
+// last element of an import path can have dots in it, as in
+// gopkg.in/yaml.v2, so the longest prefix that is a package's path is
+// taken.
+func splitDocArg(arg string) (pkg string, names []string) {
+	dir := ""
+	if i := strings.LastIndex(arg, "/"); i >= 0 {
+		dir, arg = arg[:i+1], arg[i+1:]
+	}
+	parts := strings.Split(arg, ".")
+	if dir != "" {
+		for n := len(parts); n > 1; n-- {
+			if path := dir + strings.Join(parts[:n], "."); isPkgPath(path) {
+				return path, parts[n:]
+			}
+		}
+	}
+	return dir + parts[0], parts[1:]
+}
+
+// pkgPaths caches what isPkgPath found out from go/build.
+var pkgPaths = make(map[string]bool)
+
+// isPkgPath returns true if path is the import path of a package that
+// was compiled in, imported at the prompt, or can be found by go/build.
+func isPkgPath(path string) bool {
+	if info, ok := SymbolMeta[path]; ok {
+		// A package has a synopsis but, unlike its symbols, no signature.
+		return info.Sig == ""
+	}
+	for _, imported := range ImportPaths {
+		if imported == path {
+			return true
+		}
+	}
+	found, ok := pkgPaths[path]
+	if !ok {
+		_, err := build.Import(path, initial_cwd, 0)
+		found = err == nil
+		pkgPaths[path] = found
+	}
+	return found
+}
+
+// ShowDoc prints the documentation for arg, which is a package, a
+// package-level symbol as pkg.Symbol, or a method or field as
+// pkg.Type.Name.
+func ShowDoc(arg string) error {
+	pkgName, names := splitDocArg(arg)
+	if len(names) > 2 {
+		return fmt.Errorf("expecting pkg, pkg.Symbol or pkg.Type.Method; got %s", arg)
+	}
+	pkgPath := DocPkgPath(pkgName)
+	p, err := loadDoc(pkgPath)
+	if err != nil {
+		// Without the source, show what was compiled in, if anything.
+		if showSymbolMeta(pkgPath, names) {
+			return nil
+		}
+		return err
+	}
+	switch len(names) {
+	case 0:
+		showPkgDoc(p)
+		return nil
+	case 1:
+		return showSymbolDoc(p, names[0])
+	}
+	return showMethodDoc(p, names[0], names[1])
+}
+
+// showSymbolMeta prints the signature and documentation summary
+// compiled in for names in the package with import path pkgPath, and
+// returns false if there are none.
+func showSymbolMeta(pkgPath string, names []string) bool {
+	info, ok := SymbolMeta[strings.Join(append([]string{pkgPath}, names...), ".")]
+	if !ok {
+		return false
+	}
+	if len(names) == 0 {
+		Section("package %s", pkgPath)
+	} else if info.Sig != "" {
+		Msg("%s", info.Sig)
+	}
+	Msg("")
+	printDocText(info.Doc)
+	Msg("")
+	if info.Pos != "" {
+		Msg("(Package source not found; summary compiled in. Declared at %s.)", info.Pos)
+	} else {
+		Msg("(Package source not found; summary compiled in.)")
+	}
+	return true
+}
+
+// showPkgDoc prints the package comment and the exported names of p.
+func showPkgDoc(p *docPkg) {
+	Section("package %s // import %q", p.Name, p.ImportPath)
+	Msg("")
+	printDocText(p.Doc)
+	var funcs, types []string
+	for _, f := range p.Funcs {
+		funcs = append(funcs, f.Name)
+	}
+	for _, t := range p.Types {
+		types = append(types, t.Name)
+		for _, f := range t.Funcs {
+			funcs = append(funcs, f.Name)
+		}
+	}
+	if len(funcs) > 0 {
+		Section("Functions")
+		Msg("%s", wrapNames(funcs))
+	}
+	if len(types) > 0 {
+		Section("Types")
+		Msg("%s", wrapNames(types))
+	}
+}
+
+// showSymbolDoc prints the declaration and documentation of name in p.
+func showSymbolDoc(p *docPkg, name string) error {
+	for _, f := range p.Funcs {
+		if f.Name == name {
+			printDecl(p, f.Decl, f.Doc)
+			return nil
+		}
+	}
+	if v := findValue(p.Consts, name); v != nil {
+		printDecl(p, v.Decl, v.Doc)
+		return nil
+	}
+	if v := findValue(p.Vars, name); v != nil {
+		printDecl(p, v.Decl, v.Doc)
+		return nil
+	}
+	for _, t := range p.Types {
+		if t.Name == name {
+			printDecl(p, t.Decl, t.Doc)
+			if len(t.Funcs)+len(t.Methods) > 0 {
+				Msg("")
+			}
+			for _, f := range t.Funcs {
+				Msg("%s", declString(p.fset, f.Decl))
+			}
+			for _, m := range t.Methods {
+				Msg("%s", declString(p.fset, m.Decl))
+			}
+			return nil
+		}
+		for _, f := range t.Funcs {
+			if f.Name == name {
+				printDecl(p, f.Decl, f.Doc)
+				return nil
+			}
+		}
+		if v := findValue(t.Consts, name); v != nil {
+			printDecl(p, v.Decl, v.Doc)
+			return nil
+		}
+		if v := findValue(t.Vars, name); v != nil {
+			printDecl(p, v.Decl, v.Doc)
+			return nil
+		}
+	}
+	return fmt.Errorf("no symbol %s in package %s", name, p.ImportPath)
+}
+
+// showMethodDoc prints the documentation of method, or field, name of
+// type typeName in p.
+func showMethodDoc(p *docPkg, typeName string, name string) error {
+	for _, t := range p.Types {
+		if t.Name != typeName {
+			continue
+		}
+		for _, m := range t.Methods {
+			if m.Name == name {
+				printDecl(p, m.Decl, m.Doc)
+				return nil
+			}
+		}
+		if field := findField(t.Decl, name); field != nil {
+			Msg("%s.%s %s", typeName, name, declString(p.fset, field.Type))
+			Msg("")
+			printDocText(field.Doc.Text())
+			return nil
+		}
+		return fmt.Errorf("type %s.%s has no method or field %s", p.Name, typeName, name)
+	}
+	return fmt.Errorf("no type %s in package %s", typeName, p.ImportPath)
+}
+
+// findValue returns the const or var group among values that declares
+// name.
+func findValue(values []*doc.Value, name string) *doc.Value {
+	for _, v := range values {
+		for _, n := range v.Names {
+			if n == name {
+				return v
+			}
+		}
+	}
+	return nil
+}
+
+// findField returns the field called name of the struct type declared
+// in decl.
+func findField(decl *ast.GenDecl, name string) *ast.Field {
+	for _, spec := range decl.Specs {
+		st, ok := spec.(*ast.TypeSpec).Type.(*ast.StructType)
+		if !ok {
+			continue
+		}
+		for _, field := range st.Fields.List {
+			for _, id := range field.Names {
+				if id.Name == name {
+					return field
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// printDecl prints a declaration followed by its documentation.
+func printDecl(p *docPkg, decl ast.Node, text string) {
+	Msg("%s", declString(p.fset, decl))
+	Msg("")
+	printDocText(text)
+}
+
+// declString returns the source of decl, whose positions are in fset;
+// for functions, just the signature.
+func declString(fset *token.FileSet, decl ast.Node) string {
+	if f, ok := decl.(*ast.FuncDecl); ok {
+		sig := *f
+		sig.Body = nil
+		sig.Doc = nil
+		decl = &sig
+	}
+	var buf bytes.Buffer
+	printer.Fprint(&buf, fset, decl)
+	return buf.String()
+}
+
+// printDocText prints documentation text wrapped to Maxwidth.
+func printDocText(text string) {
+	if text == "" {
+		return
+	}
+	var buf bytes.Buffer
+	doc.ToText(&buf, text, "    ", "\t", Maxwidth-4)
+	MsgNoCr("%s", buf.String())
+}
+
+// wrapNames returns names separated by spaces, wrapped to Maxwidth.
+func wrapNames(names []string) string {
+	var buf bytes.Buffer
+	col := 0
+	for _, name := range names {
+		if col > 0 && col+1+len(name) > Maxwidth {
+			buf.WriteString("\n")
+			col = 0
+		}
+		if col > 0 {
+			buf.WriteString(" ")
+			col++
+		}
+		buf.WriteString(name)
+		col += len(name)
+	}
+	return buf.String()
+}
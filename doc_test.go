@@ -0,0 +1,104 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/rocky/go-fish"
+)
+
+// captureOutput returns what fn writes to standard output, including
+// what it shows with Msg and Errmsg.
+func captureOutput(fn func()) string {
+	var buf bytes.Buffer
+	repl.TeeStdout(&buf, fn)
+	return buf.String()
+}
+
+func TestLookupSymbol(t *testing.T) {
+	repl.Reset(false)
+	info, ok := repl.LookupSymbol("strings.Split")
+	if !ok || info.Sig != "func Split(s, sep string) []string" {
+		t.Errorf("strings.Split: got %+v, %t", info, ok)
+	}
+	// rand is math/rand in the environment, not crypto/rand.
+	if info, ok := repl.LookupSymbol("rand.Intn"); !ok || !strings.HasPrefix(info.Sig, "func Intn(") {
+		t.Errorf("rand.Intn: got %+v, %t", info, ok)
+	}
+	if _, ok := repl.LookupSymbol("math/rand.Intn"); !ok {
+		t.Errorf("math/rand.Intn not found")
+	}
+	if _, ok := repl.LookupSymbol("strings.NoSuchThing"); ok {
+		t.Errorf("strings.NoSuchThing found")
+	}
+
+	// The last element of this import path has a dot in it.
+	repl.SymbolMeta["gopkg.in/yaml.v2"] = repl.SymbolInfo{Doc: "Package yaml implements YAML support."}
+	repl.SymbolMeta["gopkg.in/yaml.v2.Marshal"] = repl.SymbolInfo{Sig: "func Marshal(in interface{}) (out []byte, err error)"}
+	defer delete(repl.SymbolMeta, "gopkg.in/yaml.v2")
+	defer delete(repl.SymbolMeta, "gopkg.in/yaml.v2.Marshal")
+	for _, name := range []string{"gopkg.in/yaml.v2", "gopkg.in/yaml.v2.Marshal"} {
+		if _, ok := repl.LookupSymbol(name); !ok {
+			t.Errorf("%s not found", name)
+		}
+	}
+}
+
+// TestShowDocCompiledIn checks that doc falls back to what was
+// compiled in when the package source isn't around.
+func TestShowDocCompiledIn(t *testing.T) {
+	repl.SymbolMeta["example.com/gone.Hello"] = repl.SymbolInfo{
+		Sig: "func Hello(name string) string",
+		Doc: "Hello greets name.",
+		Pos: "gone.go:7",
+	}
+	defer delete(repl.SymbolMeta, "example.com/gone.Hello")
+	var err error
+	out := captureOutput(func() {
+		err = repl.ShowDoc("example.com/gone.Hello")
+	})
+	if err != nil {
+		t.Fatalf("ShowDoc: %s", err)
+	}
+	for _, want := range []string{"func Hello(name string) string", "Hello greets name.", "gone.go:7"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output doesn't contain %q:\n%s", want, out)
+		}
+	}
+	if err := repl.ShowDoc("example.com/gone.Goodbye"); err == nil {
+		t.Errorf("expecting an error for a symbol that is neither compiled in nor in source")
+	}
+}
+
+// TestShowDocSource checks the declarations doc shows from package
+// source: just the signature for a function, and a field's type.
+func TestShowDocSource(t *testing.T) {
+	tests := []struct {
+		arg, want, notWant string
+	}{
+		{"strings.Split", "func Split(s, sep string) []string", "{"},
+		{"go/ast.Field.Names", "Field.Names []*Ident", ""},
+	}
+	for _, test := range tests {
+		var err error
+		out := captureOutput(func() {
+			err = repl.ShowDoc(test.arg)
+		})
+		if err != nil {
+			t.Errorf("ShowDoc(%s): %s", test.arg, err)
+			continue
+		}
+		firstLine := strings.SplitN(out, "\n", 2)[0]
+		if !strings.Contains(firstLine, test.want) {
+			t.Errorf("ShowDoc(%s) starts %q; want %q", test.arg, firstLine, test.want)
+		}
+		if test.notWant != "" && strings.Contains(firstLine, test.notWant) {
+			t.Errorf("ShowDoc(%s) starts %q, which has %q", test.arg, firstLine, test.notWant)
+		}
+	}
+}
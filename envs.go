@@ -0,0 +1,122 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Multiple named evaluation environments
+
+package repl
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/rocky/eval"
+)
+
+// MainEnvName is the name of the environment go-fish starts with.
+const MainEnvName = "main"
+
+// NamedEnv is an evaluation environment along with the session state
+// that goes with it. The state of the environment in use lives in the
+// package variables, Env, Session and so on, and is saved here when
+// we switch to another.
+type NamedEnv struct {
+	Name        string
+	Env         *eval.SimpleEnv
+	results     []interface{}
+	session     []SessionEntry
+	userFuncs   map[string]*UserFunc
+	userTypes   map[string]reflect.Type
+	importPaths map[string]string
+	checkpoints map[string]*Checkpoint
+}
+
+// Envs are the environments by name, except for the one in use, whose
+// entry is only up to date after a switch.
+var Envs = make(map[string]*NamedEnv)
+
+// CurrentEnvName is the name of the environment in use.
+var CurrentEnvName = MainEnvName
+
+// NewNamedEnv creates a fresh environment called name. It doesn't
+// switch to it.
+func NewNamedEnv(name string) error {
+	if _, ok := Envs[name]; ok || name == CurrentEnvName {
+		return fmt.Errorf("environment %s already exists", name)
+	}
+	Envs[name] = &NamedEnv{
+		Name:        name,
+		Env:         NewEnv(),
+		results:     make([]interface{}, 0, 10),
+		userFuncs:   make(map[string]*UserFunc),
+		userTypes:   make(map[string]reflect.Type),
+		importPaths: make(map[string]string),
+		checkpoints: make(map[string]*Checkpoint),
+	}
+	return nil
+}
+
+// UseEnv switches to the environment called name.
+func UseEnv(name string) error {
+	if name == CurrentEnvName {
+		return nil
+	}
+	next, ok := Envs[name]
+	if !ok {
+		return fmt.Errorf("no environment %s", name)
+	}
+	Envs[CurrentEnvName] = &NamedEnv{
+		Name:        CurrentEnvName,
+		Env:         Env,
+		results:     results,
+		session:     Session,
+		userFuncs:   UserFuncs,
+		userTypes:   UserTypes,
+		importPaths: ImportPaths,
+		checkpoints: Checkpoints,
+	}
+	Env = next.Env
+	results = next.results
+	Session = next.session
+	UserFuncs = next.userFuncs
+	UserTypes = next.userTypes
+	ImportPaths = next.importPaths
+	Checkpoints = next.checkpoints
+	CurrentEnvName = name
+	return nil
+}
+
+// DropEnv removes the environment called name, which can't be the one
+// in use.
+func DropEnv(name string) error {
+	if name == CurrentEnvName {
+		return fmt.Errorf("can't drop environment %s; it is in use", name)
+	}
+	if _, ok := Envs[name]; !ok {
+		return fmt.Errorf("no environment %s", name)
+	}
+	delete(Envs, name)
+	return nil
+}
+
+// EnvNames returns the sorted names of all the environments.
+func EnvNames() []string {
+	names := []string{CurrentEnvName}
+	for name := range Envs {
+		if name != CurrentEnvName {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// EnvVarCount returns the number of variables defined in the
+// environment called name.
+func EnvVarCount(name string) int {
+	if name == CurrentEnvName {
+		return len(UserVars(Env))
+	}
+	return len(UserVars(Envs[name].Env))
+}
@@ -0,0 +1,55 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rocky/go-fish"
+)
+
+// TestNamedEnvs checks that variables stay in the environment they
+// were defined in.
+func TestNamedEnvs(t *testing.T) {
+	repl.Reset(false)
+	setVar(repl.Env.Vars, "x", 1)
+	out := runCommands(repl.Env, "env new scratch", "env use scratch")
+	defer repl.DropEnv("scratch")
+	defer repl.UseEnv(repl.MainEnvName)
+	if !strings.Contains(out, "Using environment scratch") || repl.CurrentEnvName != "scratch" {
+		t.Fatalf("didn't switch to environment scratch:\n%s", out)
+	}
+	if _, ok := repl.VarValue(repl.Env, "x"); ok {
+		t.Errorf("x from main is defined in scratch")
+	}
+	setVar(repl.Env.Vars, "y", 2)
+	if err := repl.DropEnv("scratch"); err == nil {
+		t.Errorf("dropped the environment in use")
+	}
+	if names := repl.EnvNames(); !reflect.DeepEqual(names, []string{"main", "scratch"}) {
+		t.Errorf("EnvNames = %q; want [main scratch]", names)
+	}
+
+	if err := repl.UseEnv(repl.MainEnvName); err != nil {
+		t.Fatal(err)
+	}
+	if x, ok := repl.VarValue(repl.Env, "x"); !ok || x.Int() != 1 {
+		t.Errorf("x = %v back in main; want 1", x)
+	}
+	if _, ok := repl.VarValue(repl.Env, "y"); ok {
+		t.Errorf("y from scratch is defined in main")
+	}
+	if n := repl.EnvVarCount("scratch"); n != 1 {
+		t.Errorf("scratch has %d variables; want 1", n)
+	}
+	if err := repl.DropEnv("scratch"); err != nil {
+		t.Errorf("DropEnv: %s", err)
+	}
+	if err := repl.UseEnv("scratch"); err == nil {
+		t.Errorf("switched to a dropped environment")
+	}
+}
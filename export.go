@@ -0,0 +1,357 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Exporting a session as a Go program
+
+package repl
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/rocky/eval"
+)
+
+// replOnlyVars are variables, and the pseudo-package, the REPL
+// provides that an exported program won't have.
+var replOnlyVars = []string{"env", "results", GofishPkgName}
+
+// declUse records whether a variable declared in the session is used
+// before it is redeclared. Go insists that variables be used.
+type declUse struct {
+	name string
+	used bool
+}
+
+// exportInfo is what we figure out about a session entry before
+// writing it.
+type exportInfo struct {
+	newBlock bool       // entry redeclares names, so needs a new block
+	vars     []*declUse // variables declared by the entry
+}
+
+// ExportProgram returns the source text of a standalone "package main"
+// program that does what entries did. Imports come from the
+// packages of env referred to. Expressions that produce values are
+// turned into fmt.Println calls. Warnings are returned for things that
+// won't carry over into the program.
+func ExportProgram(entries []SessionEntry, env *eval.SimpleEnv) (src []byte, warnings []string) {
+	imports, decls, body, warnings := exportBody(entries, env)
+	var out bytes.Buffer
+	out.WriteString("// Program exported from a go-fish session.\n\npackage main\n\n")
+	writeImports(&out, imports)
+	out.WriteString(decls)
+	out.WriteString("func main() {\n")
+	out.WriteString(body)
+	out.WriteString("}\n")
+	return formatExport(out.Bytes(), warnings)
+}
+
+// ExportedCount returns the number of statements and declarations the
+// exported form of entries has. Only the last declaration of a name is
+// kept, so that can be fewer than there are entries.
+func ExportedCount(entries []SessionEntry) int {
+	count := 0
+	decls := map[string]bool{}
+	for _, entry := range entries {
+		if entry.Decl == nil {
+			count++
+		} else if name := declName(entry.Decl); !decls[name] {
+			decls[name] = true
+			count++
+		}
+	}
+	return count
+}
+
+// ExportExample is like ExportProgram, but the source text is of a
+// test file in package pkgName containing function Example<name>. The
+// function's "// Output:" comment is what the session printed.
+func ExportExample(name string, pkgName string, entries []SessionEntry,
+	env *eval.SimpleEnv) (src []byte, warnings []string) {
+	imports, decls, body, warnings := exportBody(entries, env)
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Example exported from a go-fish session.\n\npackage %s\n\n", pkgName)
+	writeImports(&out, imports)
+	out.WriteString(decls)
+	fmt.Fprintf(&out, "func Example%s() {\n", name)
+	out.WriteString(body)
+	out.WriteString("// Output:\n")
+	for _, entry := range entries {
+		output := strings.TrimSuffix(entry.Output, "\n")
+		if entry.Output == "" {
+			continue
+		}
+		for _, line := range strings.Split(output, "\n") {
+			out.WriteString(strings.TrimRight("// "+line, " \t") + "\n")
+		}
+	}
+	out.WriteString("}\n")
+	return formatExport(out.Bytes(), warnings)
+}
+
+func writeImports(out *bytes.Buffer, imports []string) {
+	if len(imports) == 0 {
+		return
+	}
+	out.WriteString("import (\n")
+	for _, imp := range imports {
+		out.WriteString("\t" + imp + "\n")
+	}
+	out.WriteString(")\n\n")
+}
+
+// formatExport gofmts exported source text, adding a warning if it
+// can't.
+func formatExport(src []byte, warnings []string) ([]byte, []string) {
+	formatted, err := format.Source(src)
+	if err != nil {
+		warnings = append(warnings, fmt.Sprintf("source doesn't gofmt: %s", err))
+		return src, warnings
+	}
+	return formatted, warnings
+}
+
+// exportBody returns the import specs, the top-level declarations,
+// and the statements making up the exported form of entries.
+func exportBody(entries []SessionEntry, env *eval.SimpleEnv) (imports []string,
+	decls string, body string, warnings []string) {
+	infos := make([]exportInfo, len(entries))
+	current := map[string]*declUse{}
+	declared := map[string]bool{}
+	pkgsUsed := map[string]bool{}
+	declText := map[string]string{}
+	var declOrder []string
+	for i, entry := range entries {
+		if entry.Decl != nil {
+			// Only the last definition of a name is kept.
+			name := declName(entry.Decl)
+			if _, seen := declText[name]; !seen {
+				declOrder = append(declOrder, name)
+			}
+			declText[name] = strings.TrimSpace(entry.Input)
+			used := map[string]bool{}
+			usedNames(entry.Decl, used)
+			for name := range used {
+				if _, ok := env.Pkgs[name]; ok && !declared[name] && usedAsPkg(entry.Decl, name) {
+					pkgsUsed[name] = true
+				} else if declared[name] {
+					warnings = append(warnings,
+						fmt.Sprintf("%s refers to %s, which is local to main in the program",
+							declName(entry.Decl), name))
+				}
+			}
+			continue
+		}
+		used := map[string]bool{}
+		usedNames(entry.Stmt, used)
+		for name := range used {
+			if d := current[name]; d != nil {
+				d.used = true
+			}
+		}
+		for _, name := range replOnlyVars {
+			if used[name] && !declared[name] {
+				warnings = append(warnings,
+					fmt.Sprintf("%q refers to REPL variable %s, which the program won't have",
+						entry.Input, name))
+			}
+		}
+		for name := range used {
+			if _, ok := env.Pkgs[name]; ok && !declared[name] && name != GofishPkgName &&
+				usedAsPkg(entry.Stmt, name) {
+				pkgsUsed[name] = true
+			}
+		}
+		names, isVar, define := definedNames(entry.Stmt)
+		if len(names) > 0 {
+			redeclared := 0
+			for _, name := range names {
+				if _, ok := current[name]; ok {
+					redeclared++
+				}
+			}
+			// := needs at least one new name; var, const and
+			// type can't have any names already declared.
+			infos[i].newBlock = (define && redeclared == len(names)) ||
+				(!define && redeclared > 0)
+		}
+		for _, name := range names {
+			d := &declUse{name: name}
+			if !isVar {
+				d.used = true
+			}
+			current[name] = d
+			declared[name] = true
+			if isVar {
+				infos[i].vars = append(infos[i].vars, d)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	depth := 0
+	for i, entry := range entries {
+		if entry.Decl != nil {
+			continue
+		}
+		if infos[i].newBlock {
+			buf.WriteString("{\n")
+			depth++
+		}
+		input := strings.TrimSpace(entry.Input)
+		if _, ok := entry.Stmt.(*ast.ExprStmt); ok && entry.Values > 0 {
+			fmt.Fprintf(&buf, "fmt.Println(%s)\n", input)
+			pkgsUsed["fmt"] = true
+		} else {
+			buf.WriteString(input + "\n")
+		}
+		for _, d := range infos[i].vars {
+			if !d.used {
+				fmt.Fprintf(&buf, "_ = %s\n", d.name)
+			}
+		}
+	}
+	buf.WriteString(strings.Repeat("}\n", depth))
+
+	for name := range pkgsUsed {
+		pkgPath := PkgPath(env, name)
+		if pkgPath == "" {
+			if name != "fmt" {
+				warnings = append(warnings,
+					fmt.Sprintf("can't find the import path of package %s", name))
+			}
+			pkgPath = name
+		}
+		if path.Base(pkgPath) == name {
+			imports = append(imports, fmt.Sprintf("%q", pkgPath))
+		} else {
+			imports = append(imports, fmt.Sprintf("%s %q", name, pkgPath))
+		}
+	}
+	sort.Strings(imports)
+
+	for _, name := range declOrder {
+		decls += declText[name] + "\n\n"
+	}
+	return imports, decls, buf.String(), warnings
+}
+
+// declName returns the name declared by a top-level declaration.
+func declName(decl ast.Decl) string {
+	switch decl := decl.(type) {
+	case *ast.FuncDecl:
+		return decl.Name.Name
+	case *ast.GenDecl:
+		for _, spec := range decl.Specs {
+			switch spec := spec.(type) {
+			case *ast.TypeSpec:
+				return spec.Name.Name
+			case *ast.ValueSpec:
+				return spec.Names[0].Name
+			}
+		}
+	}
+	return ""
+}
+
+// definedNames returns the names a statement declares. isVar is true
+// if the names are variables, and define is true if they come from a
+// short variable declaration.
+func definedNames(stmt ast.Stmt) (names []string, isVar bool, define bool) {
+	switch stmt := stmt.(type) {
+	case *ast.AssignStmt:
+		if stmt.Tok != token.DEFINE {
+			return nil, false, false
+		}
+		for _, lhs := range stmt.Lhs {
+			if id, ok := lhs.(*ast.Ident); ok && id.Name != "_" {
+				names = append(names, id.Name)
+			}
+		}
+		return names, true, true
+	case *ast.DeclStmt:
+		gen, ok := stmt.Decl.(*ast.GenDecl)
+		if !ok {
+			return nil, false, false
+		}
+		for _, spec := range gen.Specs {
+			switch spec := spec.(type) {
+			case *ast.ValueSpec:
+				for _, id := range spec.Names {
+					if id.Name != "_" {
+						names = append(names, id.Name)
+					}
+				}
+			case *ast.TypeSpec:
+				names = append(names, spec.Name.Name)
+			}
+		}
+		return names, gen.Tok == token.VAR, false
+	}
+	return nil, false, false
+}
+
+// usedNames adds to used the identifiers that node refers to. Plain
+// assignment to a variable doesn't count as using it.
+func usedNames(node ast.Node, used map[string]bool) {
+	if node == nil {
+		return
+	}
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				_, isIdent := lhs.(*ast.Ident)
+				plain := n.Tok == token.ASSIGN || n.Tok == token.DEFINE
+				if !isIdent || !plain {
+					usedNames(lhs, used)
+				}
+			}
+			for _, rhs := range n.Rhs {
+				usedNames(rhs, used)
+			}
+			return false
+		case *ast.ValueSpec:
+			if n.Type != nil {
+				usedNames(n.Type, used)
+			}
+			for _, value := range n.Values {
+				usedNames(value, used)
+			}
+			return false
+		case *ast.SelectorExpr:
+			usedNames(n.X, used)
+			return false
+		case *ast.Field:
+			// Field and parameter names aren't references.
+			usedNames(n.Type, used)
+			return false
+		case *ast.Ident:
+			used[n.Name] = true
+		}
+		return true
+	})
+}
+
+// usedAsPkg returns true if name appears in node as the left side of
+// a selector, e.g. "strings" in strings.ToUpper.
+func usedAsPkg(node ast.Node, name string) bool {
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok && id.Name == name {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
@@ -0,0 +1,132 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/rocky/go-fish"
+)
+
+// sessionOf makes session entries out of lines of Go statements.
+// values gives the number of values each expression statement
+// produced when it was evaluated.
+func sessionOf(t *testing.T, lines []string, values []int) []repl.SessionEntry {
+	entries := []repl.SessionEntry{}
+	for i, line := range lines {
+		file, err := parser.ParseFile(token.NewFileSet(), "", "package p; func f() {"+line+"}", 0)
+		if err != nil {
+			t.Fatalf("can't parse %q: %s", line, err)
+		}
+		stmt := file.Decls[0].(*ast.FuncDecl).Body.List[0]
+		entries = append(entries, repl.SessionEntry{Input: line, Stmt: stmt, Values: values[i]})
+	}
+	return entries
+}
+
+func TestExportProgram(t *testing.T) {
+	lines := []string{
+		`s := "abc"`,
+		`strings.ToUpper(s)`,
+		`n := 5`,
+		`n := 6`,
+		`fmt.Println(n)`,
+		`results[0]`,
+	}
+	values := []int{-1, 1, -1, -1, 0, 1}
+	src, warnings := repl.ExportProgram(sessionOf(t, lines, values), repl.MakeEvalEnv())
+	want := `// Program exported from a go-fish session.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+func main() {
+	s := "abc"
+	fmt.Println(strings.ToUpper(s))
+	n := 5
+	_ = n
+	{
+		n := 6
+		fmt.Println(n)
+		fmt.Println(results[0])
+	}
+}
+`
+	if string(src) != want {
+		t.Errorf("exported program:\n%s\nwant:\n%s", src, want)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "results") {
+		t.Errorf("expecting a warning about results, got %v", warnings)
+	}
+}
+
+func TestExportExample(t *testing.T) {
+	entries := sessionOf(t, []string{`s := "abc"`, `strings.ToUpper(s)`}, []int{-1, 1})
+	entries[1].Output = "ABC\n"
+	src, warnings := repl.ExportExample("Upper", "strings_test", entries, repl.MakeEvalEnv())
+	want := `// Example exported from a go-fish session.
+
+package strings_test
+
+import (
+	"fmt"
+	"strings"
+)
+
+func ExampleUpper() {
+	s := "abc"
+	fmt.Println(strings.ToUpper(s))
+	// Output:
+	// ABC
+}
+`
+	if string(src) != want {
+		t.Errorf("exported example:\n%s\nwant:\n%s", src, want)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("expecting no warnings, got %v", warnings)
+	}
+}
+
+func TestExportedCount(t *testing.T) {
+	entries := sessionOf(t, []string{`x := 1`, `x++`, `fmt.Println(x)`}, []int{-1, -1, 0})
+	for _, src := range []string{"func f() int { return 1 }", "func f() int { return 2 }",
+		"type T int"} {
+		file, err := parser.ParseFile(token.NewFileSet(), "", "package p; "+src, 0)
+		if err != nil {
+			t.Fatalf("can't parse %q: %s", src, err)
+		}
+		entries = append(entries, repl.SessionEntry{Input: src, Decl: file.Decls[0], Values: -1})
+	}
+	// The first f is replaced by the second.
+	if got := repl.ExportedCount(entries); got != 5 {
+		t.Errorf("ExportedCount is %d, want 5", got)
+	}
+}
+
+// TestExportExisting checks that export won't overwrite a file.
+func TestExportExisting(t *testing.T) {
+	const src = "package main\n"
+	filename, cleanup := writeTemp(t, "main.go", src)
+	defer cleanup()
+	repl.Reset(false)
+	repl.Session = sessionOf(t, []string{"x := 1"}, []int{-1})
+	out := runCommands(repl.Env, "export "+filename)
+	if !strings.Contains(out, "already exists") {
+		t.Errorf("export of an existing file wasn't refused; output:\n%s", out)
+	}
+	if got, err := ioutil.ReadFile(filename); err != nil || string(got) != src {
+		t.Errorf("%s was changed to %q, %v", filename, got, err)
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import "testing"
+
+func TestFields(t *testing.T) {
+	env := shapesEnv()
+	checkCommand(t, env, "fields shapes.Labeled",
+		"Fields of repl_test.Labeled",
+		"  *repl_test.Square\t(embedded)\n"+
+			"    Side float64\n"+
+			"    label string\t(unexported)\n"+
+			"    ok bool\t(unexported)\n"+
+			`  Name string "json:\"name\""`)
+	checkCommand(t, env, "fields *shapes.Circle", "Fields of repl_test.Circle\n  R float64")
+	checkCommand(t, env, "fields shapes.Shape", "not a struct")
+}
+
+func TestLayout(t *testing.T) {
+	env := shapesEnv()
+	checkCommand(t, env, "layout shapes.Padded",
+		"     0      1     1       7  A bool",
+		"     8      8     8       0  B int64",
+		"    16      1     1       7  C bool",
+		"size 24 bytes, align 8, padding 14 bytes",
+		"ordering fields by decreasing alignment would make the size 16 bytes")
+}
@@ -0,0 +1,568 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Checking functions declared at the prompt before they are called
+
+package repl
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"reflect"
+
+	"github.com/rocky/eval"
+)
+
+// errUnchecked is returned while checking a function body when we
+// can't work out the type of a variable it declares. Rather than
+// report uses of that variable as errors, the rest of the body is left
+// to be checked when it runs.
+var errUnchecked = errors.New("can't check further")
+
+// check type checks the body of fn in env and makes sure a function
+// with results doesn't run off its end. eval checks the statements
+// other than those we run ourselves; we declare the variables
+// statements define as we go, so that later statements can be
+// checked.
+func (fn *UserFunc) check(env eval.Env) (err error) {
+	defer recoverPanic(&err)
+	body := fn.Decl.Body
+	if fn.Type.NumOut() > 0 && !isTerminating(body, "") {
+		return fmt.Errorf("missing return at end of function")
+	}
+	scope := env.PushScope()
+	for i, name := range fn.params {
+		if name != "_" {
+			scope.AddVar(name, reflect.New(fn.Type.In(i)))
+		}
+	}
+	for i, name := range fn.results {
+		if name != "_" {
+			scope.AddVar(name, reflect.New(fn.Type.Out(i)))
+		}
+	}
+	if err := fn.checkStmts(body.List, scope); err != nil && err != errUnchecked {
+		return err
+	}
+	return nil
+}
+
+func (fn *UserFunc) checkStmts(stmts []ast.Stmt, env eval.Env) error {
+	for _, stmt := range stmts {
+		if err := fn.checkStmt(stmt, env); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkStmt checks stmt, adding to env the variables it declares.
+func (fn *UserFunc) checkStmt(stmt ast.Stmt, env eval.Env) error {
+	switch s := stmt.(type) {
+	case *ast.ReturnStmt:
+		return fn.checkReturn(s, env)
+	case *ast.BranchStmt, *ast.EmptyStmt:
+		return nil
+	case *ast.LabeledStmt:
+		return fn.checkStmt(s.Stmt, env)
+	case *ast.BlockStmt:
+		return fn.checkStmts(s.List, env.PushScope())
+	case *ast.IfStmt:
+		scope := env.PushScope()
+		if err := fn.checkSimple(s.Init, scope); err != nil {
+			return err
+		}
+		if err := checkExprs(scope, s.Cond); err != nil {
+			return err
+		}
+		if err := fn.checkStmts(s.Body.List, scope.PushScope()); err != nil {
+			return err
+		}
+		if s.Else != nil {
+			return fn.checkStmt(s.Else, scope)
+		}
+		return nil
+	case *ast.ForStmt:
+		scope := env.PushScope()
+		if err := fn.checkSimple(s.Init, scope); err != nil {
+			return err
+		}
+		if err := checkExprs(scope, s.Cond); err != nil {
+			return err
+		}
+		if err := fn.checkSimple(s.Post, scope); err != nil {
+			return err
+		}
+		return fn.checkStmts(s.Body.List, scope.PushScope())
+	case *ast.RangeStmt:
+		return fn.checkRange(s, env)
+	case *ast.SwitchStmt:
+		scope := env.PushScope()
+		if err := fn.checkSimple(s.Init, scope); err != nil {
+			return err
+		}
+		if err := checkExprs(scope, s.Tag); err != nil {
+			return err
+		}
+		for _, clause := range s.Body.List {
+			clause := clause.(*ast.CaseClause)
+			if err := checkExprs(scope, clause.List...); err != nil {
+				return err
+			}
+			if err := fn.checkStmts(clause.Body, scope.PushScope()); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *ast.TypeSwitchStmt:
+		return fn.checkTypeSwitch(s, env)
+	case *ast.SelectStmt:
+		return fn.checkSelect(s, env)
+	}
+	return fn.checkSimple(stmt, env)
+}
+
+// checkExprs checks exprs in env, skipping nil ones.
+func checkExprs(env eval.Env, exprs ...ast.Expr) error {
+	for _, expr := range exprs {
+		if expr == nil {
+			continue
+		}
+		if _, errs := eval.CheckExpr(expr, env); len(errs) != 0 {
+			return errs[0]
+		}
+	}
+	return nil
+}
+
+// exprTypes returns the types of the values of expr. The default type
+// is used for a constant.
+func exprTypes(expr ast.Expr, env eval.Env) ([]reflect.Type, error) {
+	cexpr, errs := eval.CheckExpr(expr, env)
+	if len(errs) != 0 {
+		return nil, errs[0]
+	}
+	if !cexpr.IsConst() {
+		return cexpr.KnownType(), nil
+	}
+	// Evaluating a constant has no side effects.
+	vals, err := eval.EvalExpr(cexpr, env)
+	if err != nil {
+		return nil, err
+	}
+	types := make([]reflect.Type, len(vals))
+	for i, v := range vals {
+		types[i] = v.Type()
+	}
+	return types, nil
+}
+
+// declare adds variables names, of types types, to env. If there is a
+// type we don't know, checking stops.
+func declare(env eval.Env, names []*ast.Ident, types []reflect.Type) error {
+	if len(names) != len(types) {
+		return errUnchecked
+	}
+	for i, id := range names {
+		if types[i] == nil {
+			return errUnchecked
+		}
+		if id.Name != "_" {
+			env.AddVar(id.Name, reflect.New(types[i]))
+		}
+	}
+	return nil
+}
+
+// commaOk returns true if expr can give a second, boolean, value: a
+// map index, type assertion or receive.
+func commaOk(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.IndexExpr, *ast.TypeAssertExpr:
+		return true
+	case *ast.UnaryExpr:
+		return e.Op == token.ARROW
+	case *ast.ParenExpr:
+		return commaOk(e.X)
+	}
+	return false
+}
+
+// checkSimple checks a statement that eval handles, if it isn't nil,
+// declaring what it defines in env.
+func (fn *UserFunc) checkSimple(stmt ast.Stmt, env eval.Env) error {
+	if stmt == nil {
+		return nil
+	}
+	switch s := stmt.(type) {
+	case *ast.AssignStmt:
+		if s.Tok == token.DEFINE {
+			return fn.checkDefine(s, env)
+		}
+	case *ast.DeclStmt:
+		if gen, ok := s.Decl.(*ast.GenDecl); ok && gen.Tok == token.VAR {
+			return checkVarDecl(gen, env)
+		}
+		// Constants and types have no side effects, so we can just
+		// declare them.
+		return interpret(stmt, env)
+	}
+	if _, errs := eval.CheckStmt(stmt, env); len(errs) != 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// checkDefine checks a := statement and declares the variables on its
+// left.
+func (fn *UserFunc) checkDefine(s *ast.AssignStmt, env eval.Env) error {
+	if _, errs := eval.CheckStmt(s, env); len(errs) != 0 {
+		return errs[0]
+	}
+	var types []reflect.Type
+	for _, rhs := range s.Rhs {
+		t, err := exprTypes(rhs, env)
+		if err != nil {
+			return err
+		}
+		types = append(types, t...)
+	}
+	if len(s.Rhs) == 1 && len(s.Lhs) == 2 && len(types) == 1 && commaOk(s.Rhs[0]) {
+		types = append(types, reflect.TypeOf(false))
+	}
+	names := make([]*ast.Ident, len(s.Lhs))
+	for i, lhs := range s.Lhs {
+		id, ok := lhs.(*ast.Ident)
+		if !ok {
+			return fmt.Errorf("non-name %s on left side of :=", exprString(lhs))
+		}
+		names[i] = id
+	}
+	return declare(env, names, types)
+}
+
+// checkVarDecl checks a var declaration and declares its variables.
+// Their initial values aren't evaluated, since they may have side
+// effects.
+func checkVarDecl(decl *ast.GenDecl, env eval.Env) error {
+	for _, spec := range decl.Specs {
+		spec := spec.(*ast.ValueSpec)
+		var types []reflect.Type
+		if spec.Type != nil {
+			t, err := EvalType(spec.Type, env)
+			if err != nil {
+				return err
+			}
+			if err := checkExprs(env, spec.Values...); err != nil {
+				return err
+			}
+			for range spec.Names {
+				types = append(types, t)
+			}
+		} else {
+			for _, value := range spec.Values {
+				t, err := exprTypes(value, env)
+				if err != nil {
+					return err
+				}
+				types = append(types, t...)
+			}
+			if len(spec.Values) == 1 && len(spec.Names) == 2 && len(types) == 1 &&
+				commaOk(spec.Values[0]) {
+				types = append(types, reflect.TypeOf(false))
+			}
+		}
+		if err := declare(env, spec.Names, types); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (fn *UserFunc) checkReturn(s *ast.ReturnStmt, env eval.Env) error {
+	numOut := fn.Type.NumOut()
+	if len(s.Results) == 0 {
+		if numOut > 0 && fn.results[0] == "_" {
+			return fmt.Errorf("not enough arguments to return")
+		}
+		return nil
+	}
+	var types []reflect.Type
+	if len(s.Results) == 1 && numOut > 1 {
+		t, err := exprTypes(s.Results[0], env)
+		if err != nil {
+			return err
+		}
+		types = t
+	} else {
+		for i, result := range s.Results {
+			cexpr, errs := eval.CheckExpr(result, env)
+			if len(errs) != 0 {
+				return errs[0]
+			}
+			if cexpr.IsConst() && i < numOut {
+				// Let eval check that the constant converts.
+				conv := &ast.CallExpr{Fun: fn.resultTypes[i], Args: []ast.Expr{result}}
+				if _, errs := eval.CheckExpr(conv, env); len(errs) != 0 {
+					return errs[0]
+				}
+				types = append(types, fn.Type.Out(i))
+				continue
+			}
+			types = append(types, cexpr.KnownType()...)
+		}
+	}
+	if len(types) != numOut {
+		return fmt.Errorf("wrong number of return values; want %d, got %d", numOut, len(types))
+	}
+	for i, t := range types {
+		if t != nil && !t.AssignableTo(fn.Type.Out(i)) {
+			return fmt.Errorf("cannot use value of type %s as type %s in return", t, fn.Type.Out(i))
+		}
+	}
+	return nil
+}
+
+// rangeTypes returns the types of the iteration variables of ranging
+// over a value of type t.
+func rangeTypes(t reflect.Type) (key, value reflect.Type, ok bool) {
+	intType := reflect.TypeOf(0)
+	if t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		return intType, t.Elem(), true
+	case reflect.String:
+		return intType, reflect.TypeOf(rune(0)), true
+	case reflect.Map:
+		return t.Key(), t.Elem(), true
+	case reflect.Chan:
+		return t.Elem(), nil, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return t, nil, true
+	}
+	return nil, nil, false
+}
+
+func (fn *UserFunc) checkRange(s *ast.RangeStmt, env eval.Env) error {
+	types, err := exprTypes(s.X, env)
+	if err != nil {
+		return err
+	}
+	if len(types) != 1 || types[0] == nil {
+		return errUnchecked
+	}
+	key, value, ok := rangeTypes(types[0])
+	if !ok {
+		return fmt.Errorf("cannot range over %s (type %s)", exprString(s.X), types[0])
+	}
+	scope := env.PushScope()
+	if s.Tok == token.DEFINE {
+		for _, v := range []struct {
+			expr ast.Expr
+			t    reflect.Type
+		}{{s.Key, key}, {s.Value, value}} {
+			if v.expr == nil {
+				continue
+			}
+			if v.t == nil {
+				return fmt.Errorf("range over %s permits only one iteration variable", exprString(s.X))
+			}
+			if err := declare(scope, []*ast.Ident{v.expr.(*ast.Ident)}, []reflect.Type{v.t}); err != nil {
+				return err
+			}
+		}
+	} else if err := checkExprs(scope, s.Key, s.Value); err != nil {
+		return err
+	}
+	return fn.checkStmts(s.Body.List, scope)
+}
+
+func (fn *UserFunc) checkTypeSwitch(s *ast.TypeSwitchStmt, env eval.Env) error {
+	scope := env.PushScope()
+	if err := fn.checkSimple(s.Init, scope); err != nil {
+		return err
+	}
+	guard, name := typeSwitchGuard(s)
+	types, err := exprTypes(guard, scope)
+	if err != nil {
+		return err
+	}
+	if len(types) != 1 || types[0] == nil {
+		return errUnchecked
+	}
+	if types[0].Kind() != reflect.Interface {
+		return fmt.Errorf("%s (type %s) is not an interface", exprString(guard), types[0])
+	}
+	for _, clause := range s.Body.List {
+		clause := clause.(*ast.CaseClause)
+		t := types[0]
+		for _, expr := range clause.List {
+			if id, ok := expr.(*ast.Ident); ok && id.Name == "nil" {
+				continue
+			}
+			caseType, err := EvalType(expr, scope)
+			if err != nil {
+				return err
+			}
+			if len(clause.List) == 1 {
+				t = caseType
+			}
+		}
+		body := scope.PushScope()
+		if name != "" {
+			body.AddVar(name, reflect.New(t))
+		}
+		if err := fn.checkStmts(clause.Body, body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (fn *UserFunc) checkSelect(s *ast.SelectStmt, env eval.Env) error {
+	for _, clause := range s.Body.List {
+		clause := clause.(*ast.CommClause)
+		body := env.PushScope()
+		if assign, ok := clause.Comm.(*ast.AssignStmt); ok && assign.Tok == token.DEFINE {
+			recv := assign.Rhs[0].(*ast.UnaryExpr)
+			types, err := exprTypes(recv.X, env)
+			if err != nil {
+				return err
+			}
+			if len(types) != 1 || types[0] == nil || types[0].Kind() != reflect.Chan {
+				return errUnchecked
+			}
+			types = append([]reflect.Type{types[0].Elem()}, reflect.TypeOf(false))
+			names := make([]*ast.Ident, len(assign.Lhs))
+			for i, lhs := range assign.Lhs {
+				names[i] = lhs.(*ast.Ident)
+			}
+			if err := declare(body, names, types[:len(names)]); err != nil {
+				return err
+			}
+		} else if err := fn.checkSimple(clause.Comm, env); err != nil {
+			return err
+		}
+		if err := fn.checkStmts(clause.Body, body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isTerminating returns true if stmt, labeled label, is a terminating
+// statement as the Go spec defines it: control can't reach the end of
+// it.
+func isTerminating(stmt ast.Stmt, label string) bool {
+	switch s := stmt.(type) {
+	case *ast.ReturnStmt:
+		return true
+	case *ast.BranchStmt:
+		return s.Tok == token.GOTO
+	case *ast.ExprStmt:
+		call, ok := s.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		id, ok := call.Fun.(*ast.Ident)
+		return ok && id.Name == "panic"
+	case *ast.BlockStmt:
+		return isTerminatingList(s.List)
+	case *ast.IfStmt:
+		return s.Else != nil && isTerminating(s.Body, "") && isTerminating(s.Else, "")
+	case *ast.ForStmt:
+		return s.Cond == nil && !hasBreak(s.Body, label)
+	case *ast.LabeledStmt:
+		return isTerminating(s.Stmt, s.Label.Name)
+	case *ast.SwitchStmt:
+		return clausesTerminate(s.Body, label)
+	case *ast.TypeSwitchStmt:
+		return clausesTerminate(s.Body, label)
+	case *ast.SelectStmt:
+		if hasBreak(s.Body, label) {
+			return false
+		}
+		for _, clause := range s.Body.List {
+			if !isTerminatingList(clause.(*ast.CommClause).Body) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// isTerminatingList returns true if the last non-empty statement of
+// stmts is terminating.
+func isTerminatingList(stmts []ast.Stmt) bool {
+	for i := len(stmts) - 1; i >= 0; i-- {
+		if _, empty := stmts[i].(*ast.EmptyStmt); !empty {
+			return isTerminating(stmts[i], "")
+		}
+	}
+	return false
+}
+
+// clausesTerminate returns true if the switch with body body, labeled
+// label, is terminating: it has a default case, no break out of it,
+// and each clause ends in a terminating statement or fallthrough.
+func clausesTerminate(body *ast.BlockStmt, label string) bool {
+	if hasBreak(body, label) {
+		return false
+	}
+	hasDefault := false
+	for _, clause := range body.List {
+		clause := clause.(*ast.CaseClause)
+		if clause.List == nil {
+			hasDefault = true
+		}
+		stmts := clause.Body
+		if n := len(stmts); n > 0 {
+			if branch, ok := stmts[n-1].(*ast.BranchStmt); ok && branch.Tok == token.FALLTHROUGH {
+				continue
+			}
+		}
+		if !isTerminatingList(stmts) {
+			return false
+		}
+	}
+	return hasDefault
+}
+
+// hasBreak returns true if there is a break in node that leaves the
+// statement whose body node is, which is labeled label.
+func hasBreak(node ast.Node, label string) bool {
+	found := false
+	var inspect func(n ast.Node, nested bool)
+	inspect = func(n ast.Node, nested bool) {
+		ast.Inspect(n, func(n ast.Node) bool {
+			if found {
+				return false
+			}
+			switch n := n.(type) {
+			case *ast.FuncLit:
+				return false
+			case *ast.ForStmt, *ast.RangeStmt, *ast.SwitchStmt, *ast.TypeSwitchStmt, *ast.SelectStmt:
+				if !nested {
+					// Unlabeled breaks inside refer to n.
+					inspect(n, true)
+					return false
+				}
+			case *ast.BranchStmt:
+				if n.Tok == token.BREAK {
+					found = (n.Label == nil && !nested) ||
+						(n.Label != nil && n.Label.Name == label && label != "")
+				}
+			}
+			return true
+		})
+	}
+	inspect(node, false)
+	return found
+}
@@ -0,0 +1,871 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Functions declared at the prompt
+
+package repl
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"reflect"
+
+	"github.com/rocky/eval"
+)
+
+// UserFunc is a function declared in the REPL. Calls to it are
+// interpreted, in whatever environment is current at the time.
+type UserFunc struct {
+	Name   string
+	Decl   *ast.FuncDecl
+	Source string       // the declaration as it was entered
+	Type   reflect.Type // the function's signature
+	Value  reflect.Value
+
+	params      []string
+	results     []string
+	resultTypes []ast.Expr
+}
+
+// UserFuncs are the functions declared in the REPL, by name.
+var UserFuncs = make(map[string]*UserFunc)
+
+// DefineFunc makes the function declared by decl callable in env,
+// replacing any previous function with the same name. source is the
+// text of the declaration. The body is checked first, so that errors
+// show up now rather than when the function is called.
+func DefineFunc(decl *ast.FuncDecl, source string, env *eval.SimpleEnv) (*UserFunc, error) {
+	name := decl.Name.Name
+	prevValue, hadValue := env.Funcs[name]
+	prevFunc, hadFunc := UserFuncs[name]
+	fn, err := defineFunc(decl, source, env)
+	if err != nil {
+		return nil, err
+	}
+	// fn is defined while it is checked, since it may call itself.
+	if err := fn.check(env); err != nil {
+		delete(env.Funcs, name)
+		delete(UserFuncs, name)
+		if hadValue {
+			env.Funcs[name] = prevValue
+		}
+		if hadFunc {
+			UserFuncs[name] = prevFunc
+		}
+		return nil, fmt.Errorf("function %s: %s", name, err)
+	}
+	return fn, nil
+}
+
+// defineFunc is DefineFunc without checking the function body, for
+// when what it refers to isn't defined yet.
+func defineFunc(decl *ast.FuncDecl, source string, env *eval.SimpleEnv) (*UserFunc, error) {
+	name := decl.Name.Name
+	if decl.Recv != nil {
+		if len(decl.Recv.List) == 1 {
+			recv := embeddedName(decl.Recv.List[0].Type)
+			if _, ok := UserTypes[recv]; ok {
+				return nil, fmt.Errorf("can't declare method %s; %s was defined in the REPL "+
+					"and stands for %s, which can't have methods", name, recv, UserTypes[recv])
+			}
+		}
+		return nil, fmt.Errorf("can't declare method %s; methods can't be declared in the REPL", name)
+	}
+	if decl.Body == nil {
+		return nil, fmt.Errorf("function %s has no body", name)
+	}
+	typ, params, results, err := funcTypeOf(decl.Type, env)
+	if err != nil {
+		return nil, fmt.Errorf("function %s: %s", name, err)
+	}
+	fn := &UserFunc{
+		Name:    name,
+		Decl:    decl,
+		Source:  source,
+		Type:    typ,
+		params:  params,
+		results: results,
+	}
+	if decl.Type.Results != nil {
+		for _, field := range decl.Type.Results.List {
+			n := len(field.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				fn.resultTypes = append(fn.resultTypes, field.Type)
+			}
+		}
+	}
+	fn.Value = reflect.MakeFunc(typ, fn.call)
+	env.Funcs[name] = fn.Value
+	UserFuncs[name] = fn
+	return fn, nil
+}
+
+// call runs the body of fn with args. It is what reflect.MakeFunc
+// calls. Errors are raised as panics.
+func (fn *UserFunc) call(args []reflect.Value) []reflect.Value {
+	scope := Env.PushScope()
+	for i, name := range fn.params {
+		if name != "_" {
+			bindVar(scope, name, args[i], fn.Type.In(i))
+		}
+	}
+	out := make([]reflect.Value, fn.Type.NumOut())
+	for i := range out {
+		result := reflect.New(fn.Type.Out(i))
+		out[i] = result.Elem()
+		if fn.results[i] != "_" {
+			scope.AddVar(fn.results[i], result)
+		}
+	}
+	c, err := fn.execStmts(fn.Decl.Body.List, scope)
+	if err != nil {
+		panic(fmt.Errorf("in %s: %s", fn.Name, err))
+	}
+	switch c.flow {
+	case flowReturn:
+		if c.vals != nil {
+			out = c.vals
+		}
+	case flowBreak, flowContinue, flowFallthrough:
+		panic(fmt.Errorf("in %s: %s", fn.Name, c.misplaced()))
+	}
+	return out
+}
+
+// bindVar adds to env a variable called name, of type t, holding v.
+func bindVar(env eval.Env, name string, v reflect.Value, t reflect.Type) {
+	addr := reflect.New(t)
+	if v.IsValid() {
+		addr.Elem().Set(v)
+	}
+	env.AddVar(name, addr)
+}
+
+// flow is how control leaves a statement.
+type flow int
+
+const (
+	flowNormal flow = iota
+	flowBreak
+	flowContinue
+	flowFallthrough
+	flowReturn
+)
+
+// control is how control left a statement: for break and continue,
+// the label given, if any, and for return, the values returned.
+type control struct {
+	flow  flow
+	label string
+	vals  []reflect.Value
+}
+
+// misplaced describes a branch that left the function body.
+func (c control) misplaced() string {
+	what := map[flow]string{flowBreak: "break", flowContinue: "continue",
+		flowFallthrough: "fallthrough"}[c.flow]
+	if c.label != "" {
+		return fmt.Sprintf("%s %s refers to no enclosing statement", what, c.label)
+	}
+	return what + " is not in a loop, switch or select"
+}
+
+// breaks returns true if c is a break out of the statement with label
+// label, or of the innermost one if c has no label.
+func (c control) breaks(label string) bool {
+	return c.flow == flowBreak && (c.label == "" || c.label == label)
+}
+
+// continues is like breaks, but for continue.
+func (c control) continues(label string) bool {
+	return c.flow == flowContinue && (c.label == "" || c.label == label)
+}
+
+func (fn *UserFunc) execStmts(stmts []ast.Stmt, env eval.Env) (control, error) {
+	for _, stmt := range stmts {
+		c, err := fn.execStmt(stmt, env)
+		if err != nil || c.flow != flowNormal {
+			return c, err
+		}
+	}
+	return control{}, nil
+}
+
+// execStmt runs a statement of a function body. eval doesn't know
+// about returning from REPL functions, so we handle statements that
+// affect the flow of control ourselves and give the rest to eval.
+func (fn *UserFunc) execStmt(stmt ast.Stmt, env eval.Env) (control, error) {
+	return fn.exec(stmt, env, "")
+}
+
+// exec is execStmt for a statement that may have a label.
+func (fn *UserFunc) exec(stmt ast.Stmt, env eval.Env, label string) (control, error) {
+	if !containsReturn(stmt) && !containsFreeBranch(stmt) {
+		return control{}, interpret(stmt, env)
+	}
+	switch s := stmt.(type) {
+	case *ast.ReturnStmt:
+		vals, err := fn.returnValues(s, env)
+		return control{flow: flowReturn, vals: vals}, err
+	case *ast.BranchStmt:
+		c := control{}
+		if s.Label != nil {
+			c.label = s.Label.Name
+		}
+		switch s.Tok {
+		case token.BREAK:
+			c.flow = flowBreak
+		case token.CONTINUE:
+			c.flow = flowContinue
+		case token.FALLTHROUGH:
+			c.flow = flowFallthrough
+		default:
+			return c, fmt.Errorf("%s is not supported in functions declared in the REPL", s.Tok)
+		}
+		return c, nil
+	case *ast.LabeledStmt:
+		c, err := fn.exec(s.Stmt, env, s.Label.Name)
+		if err == nil && c.flow == flowBreak && c.label == s.Label.Name {
+			c = control{}
+		}
+		return c, err
+	case *ast.BlockStmt:
+		return fn.execStmts(s.List, env.PushScope())
+	case *ast.IfStmt:
+		scope := env.PushScope()
+		if s.Init != nil {
+			if err := interpret(s.Init, scope); err != nil {
+				return control{}, err
+			}
+		}
+		cond, err := evalBool(s.Cond, scope)
+		if err != nil {
+			return control{}, err
+		}
+		if cond {
+			return fn.execStmts(s.Body.List, scope.PushScope())
+		} else if s.Else != nil {
+			return fn.execStmt(s.Else, scope)
+		}
+		return control{}, nil
+	case *ast.ForStmt:
+		return fn.execFor(s, env, label)
+	case *ast.RangeStmt:
+		return fn.execRange(s, env, label)
+	case *ast.SwitchStmt:
+		return fn.execSwitch(s, env, label)
+	case *ast.TypeSwitchStmt:
+		return fn.execTypeSwitch(s, env, label)
+	case *ast.SelectStmt:
+		return fn.execSelect(s, env, label)
+	}
+	return control{}, fmt.Errorf("return, break or continue inside %s is not supported in functions declared in the REPL",
+		stmtKind(stmt))
+}
+
+// loopBody runs the body of a loop labeled label. done is true if the
+// loop should stop, in which case c is how control leaves the loop.
+func (fn *UserFunc) loopBody(body *ast.BlockStmt, env eval.Env, label string) (c control, done bool, err error) {
+	c, err = fn.execStmts(body.List, env.PushScope())
+	switch {
+	case err != nil:
+		return c, true, err
+	case c.breaks(label):
+		return control{}, true, nil
+	case c.continues(label), c.flow == flowNormal:
+		return control{}, false, nil
+	}
+	return c, true, nil
+}
+
+func (fn *UserFunc) execFor(s *ast.ForStmt, env eval.Env, label string) (control, error) {
+	scope := env.PushScope()
+	if s.Init != nil {
+		if err := interpret(s.Init, scope); err != nil {
+			return control{}, err
+		}
+	}
+	for {
+		if s.Cond != nil {
+			cond, err := evalBool(s.Cond, scope)
+			if err != nil || !cond {
+				return control{}, err
+			}
+		}
+		if c, done, err := fn.loopBody(s.Body, scope, label); done {
+			return c, err
+		}
+		if s.Post != nil {
+			if err := interpret(s.Post, scope); err != nil {
+				return control{}, err
+			}
+		}
+	}
+}
+
+// Names of the variables we use to pass values we computed to eval.
+// They can't clash with any the user can write.
+const (
+	keyVar   = "·key"
+	valueVar = "·value"
+	tagVar   = "·tag"
+)
+
+// rangeAssign returns a function that assigns key and value, if
+// valid, to the iteration variables of range statement s in a new
+// scope of env, which it returns.
+func rangeAssign(s *ast.RangeStmt, env eval.Env, keyType, valueType reflect.Type) func(key, value reflect.Value) (eval.Env, error) {
+	return func(key, value reflect.Value) (eval.Env, error) {
+		scope := env.PushScope()
+		pairs := []struct {
+			lhs ast.Expr
+			v   reflect.Value
+			t   reflect.Type
+			tmp string
+		}{{s.Key, key, keyType, keyVar}, {s.Value, value, valueType, valueVar}}
+		for _, p := range pairs {
+			if p.lhs == nil || !p.v.IsValid() {
+				continue
+			}
+			if id, ok := p.lhs.(*ast.Ident); ok && id.Name == "_" {
+				continue
+			}
+			if s.Tok == token.DEFINE {
+				bindVar(scope, p.lhs.(*ast.Ident).Name, p.v, p.t)
+				continue
+			}
+			bindVar(scope, p.tmp, p.v, p.t)
+			assign := &ast.AssignStmt{Lhs: []ast.Expr{p.lhs}, Tok: token.ASSIGN,
+				Rhs: []ast.Expr{ast.NewIdent(p.tmp)}}
+			if err := interpret(assign, scope); err != nil {
+				return nil, err
+			}
+		}
+		return scope, nil
+	}
+}
+
+func (fn *UserFunc) execRange(s *ast.RangeStmt, env eval.Env, label string) (control, error) {
+	vals, _, err := evalCheck(s.X, env)
+	if err != nil {
+		return control{}, err
+	}
+	if len(vals) != 1 {
+		return control{}, fmt.Errorf("%s is not a single value", exprString(s.X))
+	}
+	x := vals[0]
+	if x.Kind() == reflect.Ptr && x.Type().Elem().Kind() == reflect.Array {
+		x = x.Elem()
+	}
+	intType := reflect.TypeOf(0)
+	var keyType, valueType reflect.Type
+	switch x.Kind() {
+	case reflect.Slice, reflect.Array:
+		keyType, valueType = intType, x.Type().Elem()
+	case reflect.String:
+		keyType, valueType = intType, reflect.TypeOf(rune(0))
+	case reflect.Map:
+		keyType, valueType = x.Type().Key(), x.Type().Elem()
+	case reflect.Chan:
+		keyType = x.Type().Elem()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		keyType = x.Type()
+	default:
+		return control{}, fmt.Errorf("cannot range over %s (type %s)", exprString(s.X), x.Type())
+	}
+	assign := rangeAssign(s, env, keyType, valueType)
+	iterate := func(key, value reflect.Value) (control, bool, error) {
+		scope, err := assign(key, value)
+		if err != nil {
+			return control{}, true, err
+		}
+		return fn.loopBody(s.Body, scope, label)
+	}
+	switch x.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < x.Len(); i++ {
+			if c, done, err := iterate(reflect.ValueOf(i), x.Index(i)); done {
+				return c, err
+			}
+		}
+	case reflect.String:
+		for i, r := range x.String() {
+			if c, done, err := iterate(reflect.ValueOf(i), reflect.ValueOf(r)); done {
+				return c, err
+			}
+		}
+	case reflect.Map:
+		for _, key := range x.MapKeys() {
+			value := x.MapIndex(key)
+			if !value.IsValid() {
+				// Deleted while we were ranging over the map.
+				continue
+			}
+			if c, done, err := iterate(key, value); done {
+				return c, err
+			}
+		}
+	case reflect.Chan:
+		for {
+			v, ok := x.Recv()
+			if !ok {
+				break
+			}
+			if c, done, err := iterate(v, reflect.Value{}); done {
+				return c, err
+			}
+		}
+	default:
+		for i := int64(0); ; i++ {
+			n := reflect.ValueOf(i).Convert(x.Type())
+			if !lessInt(n, x) {
+				break
+			}
+			if c, done, err := iterate(n, reflect.Value{}); done {
+				return c, err
+			}
+		}
+	}
+	return control{}, nil
+}
+
+// lessInt returns true if integer a is less than integer b, both of
+// the same type.
+func lessInt(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() < b.Uint()
+	}
+	return a.Int() < b.Int()
+}
+
+// switchBodies runs the bodies of the clauses of a switch labeled
+// label, starting at clause i and going on to the next one for each
+// fallthrough.
+func (fn *UserFunc) switchBodies(clauses []ast.Stmt, i int, env eval.Env, label string) (control, error) {
+	for ; i < len(clauses); i++ {
+		c, err := fn.execStmts(clauses[i].(*ast.CaseClause).Body, env.PushScope())
+		if err != nil {
+			return c, err
+		}
+		if c.breaks(label) {
+			return control{}, nil
+		}
+		if c.flow != flowFallthrough {
+			return c, nil
+		}
+	}
+	return control{}, nil
+}
+
+func (fn *UserFunc) execSwitch(s *ast.SwitchStmt, env eval.Env, label string) (control, error) {
+	scope := env.PushScope()
+	if s.Init != nil {
+		if err := interpret(s.Init, scope); err != nil {
+			return control{}, err
+		}
+	}
+	if s.Tag != nil {
+		vals, _, err := evalCheck(s.Tag, scope)
+		if err != nil {
+			return control{}, err
+		}
+		if len(vals) != 1 {
+			return control{}, fmt.Errorf("%s is not a single value", exprString(s.Tag))
+		}
+		bindVar(scope, tagVar, vals[0], vals[0].Type())
+	}
+	match := -1
+	for i, clause := range s.Body.List {
+		clause := clause.(*ast.CaseClause)
+		if clause.List == nil {
+			if match < 0 {
+				match = i
+			}
+			continue
+		}
+		for _, expr := range clause.List {
+			cond := expr
+			if s.Tag != nil {
+				cond = &ast.BinaryExpr{X: ast.NewIdent(tagVar), Op: token.EQL, Y: expr}
+			}
+			ok, err := evalBool(cond, scope)
+			if err != nil {
+				return control{}, err
+			}
+			if ok {
+				return fn.switchBodies(s.Body.List, i, scope, label)
+			}
+		}
+	}
+	if match < 0 {
+		return control{}, nil
+	}
+	return fn.switchBodies(s.Body.List, match, scope, label)
+}
+
+// typeSwitchGuard returns the expression a type switch is on, and the
+// name of the variable it binds, if any.
+func typeSwitchGuard(s *ast.TypeSwitchStmt) (ast.Expr, string) {
+	switch a := s.Assign.(type) {
+	case *ast.AssignStmt:
+		return a.Rhs[0].(*ast.TypeAssertExpr).X, a.Lhs[0].(*ast.Ident).Name
+	case *ast.ExprStmt:
+		return a.X.(*ast.TypeAssertExpr).X, ""
+	}
+	return nil, ""
+}
+
+// typeMatches returns true if x, of interface type, matches case type
+// expr of a type switch. The type is nil for the nil case.
+func typeMatches(x reflect.Value, expr ast.Expr, env eval.Env) (bool, reflect.Type, error) {
+	if id, ok := expr.(*ast.Ident); ok && id.Name == "nil" {
+		return x.IsNil(), nil, nil
+	}
+	t, err := EvalType(expr, env)
+	if err != nil {
+		return false, nil, err
+	}
+	if x.IsNil() {
+		return false, t, nil
+	}
+	if t.Kind() == reflect.Interface {
+		return x.Elem().Type().Implements(t), t, nil
+	}
+	return x.Elem().Type() == t, t, nil
+}
+
+func (fn *UserFunc) execTypeSwitch(s *ast.TypeSwitchStmt, env eval.Env, label string) (control, error) {
+	scope := env.PushScope()
+	if s.Init != nil {
+		if err := interpret(s.Init, scope); err != nil {
+			return control{}, err
+		}
+	}
+	guard, name := typeSwitchGuard(s)
+	vals, _, err := evalCheck(guard, scope)
+	if err != nil {
+		return control{}, err
+	}
+	if len(vals) != 1 || vals[0].Kind() != reflect.Interface {
+		return control{}, fmt.Errorf("%s is not an interface", exprString(guard))
+	}
+	x := vals[0]
+	run := func(clause *ast.CaseClause, t reflect.Type) (control, error) {
+		body := scope.PushScope()
+		if name != "" && t != nil {
+			bindVar(body, name, x.Elem(), t)
+		} else if name != "" {
+			bindVar(body, name, x, x.Type())
+		}
+		c, err := fn.execStmts(clause.Body, body)
+		if err == nil && c.breaks(label) {
+			c = control{}
+		}
+		return c, err
+	}
+	var deflt *ast.CaseClause
+	for _, clause := range s.Body.List {
+		clause := clause.(*ast.CaseClause)
+		if clause.List == nil {
+			deflt = clause
+			continue
+		}
+		for _, expr := range clause.List {
+			ok, t, err := typeMatches(x, expr, scope)
+			if err != nil {
+				return control{}, err
+			}
+			if !ok {
+				continue
+			}
+			if len(clause.List) > 1 || t == nil {
+				// The variable keeps the switch's type.
+				t = nil
+			}
+			return run(clause, t)
+		}
+	}
+	if deflt != nil {
+		return run(deflt, nil)
+	}
+	return control{}, nil
+}
+
+func (fn *UserFunc) execSelect(s *ast.SelectStmt, env eval.Env, label string) (control, error) {
+	scope := env.PushScope()
+	cases := make([]reflect.SelectCase, len(s.Body.List))
+	for i, clause := range s.Body.List {
+		clause := clause.(*ast.CommClause)
+		var ch ast.Expr
+		switch comm := clause.Comm.(type) {
+		case nil:
+			cases[i].Dir = reflect.SelectDefault
+			continue
+		case *ast.SendStmt:
+			cases[i].Dir = reflect.SelectSend
+			ch = comm.Chan
+			vals, _, err := evalCheck(comm.Value, scope)
+			if err != nil {
+				return control{}, err
+			}
+			if len(vals) != 1 {
+				return control{}, fmt.Errorf("%s is not a single value", exprString(comm.Value))
+			}
+			cases[i].Send = vals[0]
+		case *ast.ExprStmt:
+			cases[i].Dir = reflect.SelectRecv
+			ch = comm.X.(*ast.UnaryExpr).X
+		case *ast.AssignStmt:
+			cases[i].Dir = reflect.SelectRecv
+			ch = comm.Rhs[0].(*ast.UnaryExpr).X
+		}
+		vals, _, err := evalCheck(ch, scope)
+		if err != nil {
+			return control{}, err
+		}
+		if len(vals) != 1 || vals[0].Kind() != reflect.Chan {
+			return control{}, fmt.Errorf("%s is not a channel", exprString(ch))
+		}
+		cases[i].Chan = vals[0]
+		if cases[i].Dir == reflect.SelectSend {
+			if cases[i].Send, err = assignValue(cases[i].Send, vals[0].Type().Elem()); err != nil {
+				return control{}, err
+			}
+		}
+	}
+	chosen, v, ok := reflect.Select(cases)
+	clause := s.Body.List[chosen].(*ast.CommClause)
+	body := scope.PushScope()
+	if assign, isAssign := clause.Comm.(*ast.AssignStmt); isAssign {
+		received := []reflect.Value{v, reflect.ValueOf(ok)}
+		types := []reflect.Type{cases[chosen].Chan.Type().Elem(), reflect.TypeOf(ok)}
+		for i, lhs := range assign.Lhs {
+			if id, isIdent := lhs.(*ast.Ident); isIdent && id.Name == "_" {
+				continue
+			}
+			if assign.Tok == token.DEFINE {
+				bindVar(body, lhs.(*ast.Ident).Name, received[i], types[i])
+				continue
+			}
+			bindVar(body, valueVar, received[i], types[i])
+			set := &ast.AssignStmt{Lhs: []ast.Expr{lhs}, Tok: token.ASSIGN,
+				Rhs: []ast.Expr{ast.NewIdent(valueVar)}}
+			if err := interpret(set, body); err != nil {
+				return control{}, err
+			}
+		}
+	}
+	c, err := fn.execStmts(clause.Body, body)
+	if err == nil && c.breaks(label) {
+		c = control{}
+	}
+	return c, err
+}
+
+// returnValues evaluates the values of a return statement. nil is
+// returned for a bare return, which returns the named results.
+func (fn *UserFunc) returnValues(ret *ast.ReturnStmt, env eval.Env) ([]reflect.Value, error) {
+	if len(ret.Results) == 0 {
+		return nil, nil
+	}
+	numOut := fn.Type.NumOut()
+	if len(ret.Results) == 1 && numOut > 1 {
+		// return f(), where f returns all of the results.
+		vals, _, err := evalCheck(ret.Results[0], env)
+		if err != nil {
+			return nil, err
+		}
+		if len(vals) != numOut {
+			return nil, fmt.Errorf("wrong number of return values; want %d, got %d",
+				numOut, len(vals))
+		}
+		for i, v := range vals {
+			if vals[i], err = assignValue(v, fn.Type.Out(i)); err != nil {
+				return nil, err
+			}
+		}
+		return vals, nil
+	}
+	if len(ret.Results) != numOut {
+		return nil, fmt.Errorf("wrong number of return values; want %d, got %d",
+			numOut, len(ret.Results))
+	}
+	out := make([]reflect.Value, numOut)
+	for i, result := range ret.Results {
+		vals, isConst, err := evalCheck(result, env)
+		if err != nil {
+			return nil, err
+		}
+		if isConst {
+			// Let eval convert untyped constants to the result type.
+			conv := &ast.CallExpr{Fun: fn.resultTypes[i], Args: []ast.Expr{result}}
+			if vals, _, err = evalCheck(conv, env); err != nil {
+				return nil, err
+			}
+		}
+		if len(vals) != 1 {
+			return nil, fmt.Errorf("%s is not a single value", exprString(result))
+		}
+		if out[i], err = assignValue(vals[0], fn.Type.Out(i)); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// assignValue returns v as a value of type t, if it can be assigned to
+// that type.
+func assignValue(v reflect.Value, t reflect.Type) (reflect.Value, error) {
+	if !v.IsValid() {
+		return reflect.Zero(t), nil
+	}
+	if !v.Type().AssignableTo(t) {
+		return v, fmt.Errorf("cannot use value of type %s as type %s", v.Type(), t)
+	}
+	if v.Type() != t {
+		converted := reflect.New(t).Elem()
+		converted.Set(v)
+		return converted, nil
+	}
+	return v, nil
+}
+
+// interpret type checks and runs stmt in env.
+func interpret(stmt ast.Stmt, env eval.Env) error {
+	cstmt, errs := eval.CheckStmt(stmt, env)
+	if len(errs) != 0 {
+		return errs[0]
+	}
+	_, err := eval.InterpStmt(cstmt, env)
+	return err
+}
+
+// evalCheck type checks and evaluates expr in env. isConst tells
+// whether expr is a constant.
+func evalCheck(expr ast.Expr, env eval.Env) (vals []reflect.Value, isConst bool, err error) {
+	cexpr, errs := eval.CheckExpr(expr, env)
+	if len(errs) != 0 {
+		return nil, false, errs[0]
+	}
+	vals, err = eval.EvalExpr(cexpr, env)
+	return vals, cexpr.IsConst(), err
+}
+
+func evalBool(expr ast.Expr, env eval.Env) (bool, error) {
+	vals, _, err := evalCheck(expr, env)
+	if err != nil {
+		return false, err
+	}
+	if len(vals) != 1 || vals[0].Kind() != reflect.Bool {
+		return false, fmt.Errorf("non-bool %s used as condition", exprString(expr))
+	}
+	return vals[0].Bool(), nil
+}
+
+// containsReturn returns true if there is a return statement in node,
+// not counting ones in function literals.
+func containsReturn(node ast.Node) bool {
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// containsFreeBranch returns true if there is a break, continue or
+// fallthrough in node that refers to a statement outside of node, or
+// a goto.
+func containsFreeBranch(node ast.Node) bool {
+	labels := make(map[string]bool)
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.LabeledStmt:
+			labels[n.Label.Name] = true
+		}
+		return true
+	})
+	return freeBranch(node, labels, false, false, false)
+}
+
+// freeBranch is containsFreeBranch for node, where labels are the
+// labels declared in the statement being checked. inLoop, inBreakable
+// and inSwitch tell whether node is inside a loop, a statement break
+// can leave, or a switch clause, within that statement.
+func freeBranch(node ast.Node, labels map[string]bool, inLoop, inBreakable, inSwitch bool) bool {
+	switch n := node.(type) {
+	case *ast.FuncLit:
+		return false
+	case *ast.ForStmt:
+		return freeBranch(n.Body, labels, true, true, false)
+	case *ast.RangeStmt:
+		return freeBranch(n.Body, labels, true, true, false)
+	case *ast.SwitchStmt:
+		return freeBranch(n.Body, labels, inLoop, true, true)
+	case *ast.TypeSwitchStmt:
+		return freeBranch(n.Body, labels, inLoop, true, false)
+	case *ast.SelectStmt:
+		return freeBranch(n.Body, labels, inLoop, true, false)
+	case *ast.BranchStmt:
+		return isFreeBranch(n, labels, inLoop, inBreakable, inSwitch)
+	}
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		if found || n == nil || n == node {
+			return !found
+		}
+		switch n.(type) {
+		case *ast.FuncLit, *ast.ForStmt, *ast.RangeStmt, *ast.SwitchStmt,
+			*ast.TypeSwitchStmt, *ast.SelectStmt, *ast.BranchStmt:
+			found = freeBranch(n, labels, inLoop, inBreakable, inSwitch)
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+func isFreeBranch(branch *ast.BranchStmt, labels map[string]bool, inLoop, inBreakable, inSwitch bool) bool {
+	switch {
+	case branch.Tok == token.GOTO:
+		return true
+	case branch.Label != nil:
+		return !labels[branch.Label.Name]
+	case branch.Tok == token.BREAK:
+		return !inBreakable
+	case branch.Tok == token.CONTINUE:
+		return !inLoop
+	}
+	return !inSwitch
+}
+
+// stmtKind describes the kind of statement stmt is.
+func stmtKind(stmt ast.Stmt) string {
+	switch stmt.(type) {
+	case *ast.RangeStmt:
+		return "range"
+	case *ast.SwitchStmt:
+		return "switch"
+	case *ast.TypeSwitchStmt:
+		return "type switch"
+	case *ast.SelectStmt:
+		return "select"
+	case *ast.LabeledStmt:
+		return "labeled statement"
+	}
+	return "statement"
+}
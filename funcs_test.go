@@ -0,0 +1,202 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rocky/go-fish"
+)
+
+// defineFuncs declares the functions in src in repl.Env, returning the
+// error from the first one that can't be.
+func defineFuncs(t *testing.T, src string) error {
+	file, err := parser.ParseFile(token.NewFileSet(), "", "package p; "+src, 0)
+	if err != nil {
+		t.Fatalf("can't parse %q: %s", src, err)
+	}
+	for _, decl := range file.Decls {
+		if _, err := repl.DefineFunc(decl.(*ast.FuncDecl), src, repl.Env); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// callFunc calls REPL function name with args, returning its first
+// result.
+func callFunc(t *testing.T, name string, args ...interface{}) interface{} {
+	fn, ok := repl.UserFuncs[name]
+	if !ok {
+		t.Fatalf("function %s isn't defined", name)
+	}
+	in := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		in[i] = reflect.ValueOf(arg)
+	}
+	out := fn.Value.Call(in)
+	if len(out) == 0 {
+		return nil
+	}
+	return out[0].Interface()
+}
+
+func TestFuncControlFlow(t *testing.T) {
+	repl.Reset(false)
+	src := `
+func find(xs []int, x int) int {
+	for i, v := range xs {
+		if v == x {
+			return i
+		}
+	}
+	return -1
+}
+
+func name(n int) string {
+	switch n {
+	case 1:
+		return "one"
+	case 2:
+		fallthrough
+	case 3:
+		return "two or three"
+	}
+	return "many"
+}
+
+func count() int {
+	n := 0
+outer:
+	for i := 0; i < 5; i++ {
+		for j := 0; j < 5; j++ {
+			if j == 2 {
+				continue outer
+			}
+			if i == 3 {
+				break outer
+			}
+			n++
+		}
+	}
+	return n
+}
+
+func kind(x interface{}) string {
+	switch v := x.(type) {
+	case int:
+		return "int " + fmt.Sprint(v+1)
+	case string:
+		return "string " + v
+	}
+	return "other"
+}
+
+func poll(c chan int) int {
+	select {
+	case v := <-c:
+		return v
+	default:
+		return -1
+	}
+}
+`
+	if err := defineFuncs(t, src); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		args []interface{}
+		want interface{}
+	}{
+		{"find", []interface{}{[]int{4, 5, 6}, 5}, 1},
+		{"find", []interface{}{[]int{4, 5, 6}, 7}, -1},
+		{"name", []interface{}{1}, "one"},
+		{"name", []interface{}{2}, "two or three"},
+		{"name", []interface{}{4}, "many"},
+		{"count", nil, 6},
+		{"kind", []interface{}{41}, "int 42"},
+		{"kind", []interface{}{"s"}, "string s"},
+		{"kind", []interface{}{1.5}, "other"},
+		{"poll", []interface{}{make(chan int)}, -1},
+	}
+	for _, test := range tests {
+		if got := callFunc(t, test.name, test.args...); got != test.want {
+			t.Errorf("%s%v = %v, want %v", test.name, test.args, got, test.want)
+		}
+	}
+	c := make(chan int, 1)
+	c <- 7
+	if got := callFunc(t, "poll", c); got != 7 {
+		t.Errorf("poll of a ready channel = %v, want 7", got)
+	}
+}
+
+// TestFuncChecked checks that errors in a function body are reported
+// when it is declared.
+func TestFuncChecked(t *testing.T) {
+	repl.Reset(false)
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"func f(x int) int { if x > 0 { return 1 } }", "missing return"},
+		{"func f(x int) int { for { if x > 0 { break } } }", "missing return"},
+		{"func f() int { return \"s\" }", ""},
+		{"func f() int { return 1, 2 }", "wrong number of return values"},
+		{"func f() { undefinedThing() }", "undefinedThing"},
+		{"func f() { x := 1; y := x + \"s\"; _ = y }", ""},
+	}
+	for _, test := range tests {
+		err := defineFuncs(t, test.src)
+		if err == nil {
+			t.Errorf("%s: expecting an error", test.src)
+		} else if !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%s: error %q doesn't mention %q", test.src, err, test.want)
+		}
+		if _, ok := repl.UserFuncs["f"]; ok {
+			t.Errorf("%s: f was defined anyway", test.src)
+		}
+	}
+	for _, src := range []string{
+		"func f(x int) int { if x > 0 { return 1 } else { return 2 } }",
+		"func f(x int) int { for { } }",
+		"func f(x int) int { switch { case x > 0: return 1; default: panic(x) } }",
+		"func f(n int) int { if n <= 1 { return 1 }; return n * f(n-1) }",
+	} {
+		if err := defineFuncs(t, src); err != nil {
+			t.Errorf("%s: %s", src, err)
+		}
+	}
+}
+
+// TestFuncCurrentEnv checks that a function uses the environment that
+// is current when it is called, not the one it was declared in.
+func TestFuncCurrentEnv(t *testing.T) {
+	repl.Reset(false)
+	x := 1
+	repl.Env.Vars["x"] = reflect.ValueOf(&x)
+	if err := defineFuncs(t, "func getX() int { return x }"); err != nil {
+		t.Fatal(err)
+	}
+	if got := callFunc(t, "getX"); got != 1 {
+		t.Errorf("getX() = %v, want 1", got)
+	}
+	other := repl.NewEnv()
+	y := 2
+	other.Vars["x"] = reflect.ValueOf(&y)
+	other.Funcs["getX"] = repl.UserFuncs["getX"].Value
+	saved := repl.Env
+	repl.Env = other
+	defer func() { repl.Env = saved }()
+	if got := callFunc(t, "getX"); got != 2 {
+		t.Errorf("getX() in another environment = %v, want 2", got)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestImplements(t *testing.T) {
+	env := shapesEnv()
+	checkCommand(t, env, "implements shapes.Shape",
+		"Types implementing repl_test.Shape",
+		"*shapes.Circle", "shapes.Square")
+	if out := runCommands(env, "implements shapes.Shape"); strings.Contains(out, " shapes.Circle") {
+		t.Errorf("shapes.Circle, whose Area method has a pointer receiver, is listed:\n%s", out)
+	}
+	checkCommand(t, env, "implements shapes.Square",
+		"Interfaces implemented by repl_test.Square", "shapes.Shape")
+	checkCommand(t, env, "implements interface{}", "Every type implements interface {}")
+}
+
+func TestAssignableConvertible(t *testing.T) {
+	env := shapesEnv()
+	checkCommand(t, env, "assignable shapes.Square shapes.Shape",
+		"repl_test.Square is assignable to repl_test.Shape")
+	checkCommand(t, env, "assignable *shapes.Circle shapes.Shape",
+		"*repl_test.Circle is assignable to repl_test.Shape")
+	checkCommand(t, env, "assignable shapes.Circle shapes.Shape",
+		"repl_test.Circle is not assignable to repl_test.Shape")
+	checkCommand(t, env, "assignable int int64", "int is not assignable to int64")
+	checkCommand(t, env, "assignable chan int, <-chan int", "chan int is assignable to <-chan int")
+	checkCommand(t, env, "assignable func(int) error, func(n int) error",
+		"func(int) error is assignable to func(int) error")
+	checkCommand(t, env, "assignable map[string] int, map[string]int64",
+		"map[string]int is not assignable to map[string]int64")
+	checkCommand(t, env, "assignable int", "expecting two types or expressions separated by a comma")
+	checkCommand(t, env, "convertible int int64", "int is convertible to int64")
+	checkCommand(t, env, `convertible struct{ X int }, struct{ X int "json:\"x\"" }`,
+		`struct { X int } is convertible to struct { X int "json:\"x\"" }`)
+	checkCommand(t, env, "convertible shapes.Square shapes.Circle",
+		"repl_test.Square is not convertible to repl_test.Circle")
+}
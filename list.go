@@ -0,0 +1,153 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Showing the source of functions
+
+package repl
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+// ListFunc prints, with line numbers, the source of the function arg
+// names. arg can be a function declared in the session, an expression
+// whose value is a function, or pkg.Func or pkg.Type.Method. For
+// function values, where the compiler says the function is defined is
+// used; failing that, the package source is found via go/build.
+func ListFunc(arg string) error {
+	if fn, ok := UserFuncs[arg]; ok {
+		Section("%s (declared in the session)", fn.Name)
+		printNumbered(fn.Source, 1)
+		return nil
+	}
+	if file, line, ok := funcFileLine(arg); ok {
+		if err := listFuncAt(file, line); err == nil {
+			return nil
+		}
+	}
+	pkgName, names := splitDocArg(arg)
+	if len(names) == 0 || len(names) > 2 {
+		return fmt.Errorf("%s is not a function; expecting pkg.Func or pkg.Type.Method", arg)
+	}
+	return listFuncByName(DocPkgPath(pkgName), names)
+}
+
+// funcFileLine evaluates expr and, if it is a compiled function,
+// returns the file and line where it is defined.
+func funcFileLine(expr string) (string, int, bool) {
+	vals, err := EvalString(expr, Env)
+	if err != nil || len(vals) != 1 {
+		return "", 0, false
+	}
+	v := vals[0]
+	for v.IsValid() && (v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr) && !v.IsNil() {
+		v = v.Elem()
+	}
+	if !v.IsValid() || v.Kind() != reflect.Func || v.IsNil() {
+		return "", 0, false
+	}
+	f := runtime.FuncForPC(v.Pointer())
+	if f == nil {
+		return "", 0, false
+	}
+	file, line := f.FileLine(f.Entry())
+	return file, line, file != ""
+}
+
+// listFuncAt prints the function declared in filename that includes
+// line.
+func listFuncAt(filename string, line int) error {
+	src, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		start := fset.Position(fn.Pos()).Line
+		end := fset.Position(fn.End()).Line
+		if start <= line && line <= end {
+			printFuncDecl(fset, src, fn)
+			return nil
+		}
+	}
+	return fmt.Errorf("no function at %s:%d", filename, line)
+}
+
+// listFuncByName finds the package with import path pkgPath via
+// go/build and prints the function, or method if names has two
+// parts, called names in it.
+func listFuncByName(pkgPath string, names []string) error {
+	bpkg, err := build.Import(pkgPath, initial_cwd, 0)
+	if err != nil {
+		return err
+	}
+	for _, name := range bpkg.GoFiles {
+		filename := filepath.Join(bpkg.Dir, name)
+		src, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && funcDeclMatches(fn, names) {
+				printFuncDecl(fset, src, fn)
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("can't find %s in package %s", strings.Join(names, "."), bpkg.ImportPath)
+}
+
+// funcDeclMatches returns true if fn declares Func, given as names
+// {"Func"}, or method Type.Method, given as {"Type", "Method"}.
+func funcDeclMatches(fn *ast.FuncDecl, names []string) bool {
+	if fn.Name.Name != names[len(names)-1] {
+		return false
+	}
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return len(names) == 1
+	}
+	return len(names) == 2 && embeddedName(fn.Recv.List[0].Type) == names[0]
+}
+
+// printFuncDecl prints fn, including its doc comment, from src with
+// line numbers.
+func printFuncDecl(fset *token.FileSet, src []byte, fn *ast.FuncDecl) {
+	start := fn.Pos()
+	if fn.Doc != nil {
+		start = fn.Doc.Pos()
+	}
+	from := fset.Position(start)
+	to := fset.Position(fn.End())
+	Section("%s:%d", from.Filename, from.Line)
+	printNumbered(string(src[from.Offset:to.Offset]), from.Line)
+}
+
+// printNumbered prints text with line numbers starting at first.
+func printNumbered(text string, first int) {
+	for i, line := range strings.Split(text, "\n") {
+		Msg("%4d  %s", first+i, line)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/rocky/go-fish"
+)
+
+func TestListFunc(t *testing.T) {
+	repl.Reset(false)
+	tests := []struct {
+		arg  string
+		want *regexp.Regexp
+	}{
+		{"strings.Split", regexp.MustCompile(`(?m)^ *\d+  func Split\(s, sep string\) \[\]string`)},
+		{"strings.Builder.WriteString", regexp.MustCompile(`(?m)^ *\d+  func \(b \*Builder\) WriteString\(s string\)`)},
+	}
+	for _, test := range tests {
+		var err error
+		out := captureOutput(func() {
+			err = repl.ListFunc(test.arg)
+		})
+		if err != nil {
+			t.Errorf("ListFunc(%s): %s", test.arg, err)
+		} else if !test.want.MatchString(out) {
+			t.Errorf("ListFunc(%s) doesn't show the declaration:\n%s", test.arg, out)
+		}
+	}
+	for _, arg := range []string{"strings", "strings.NoSuchFunc", "strings.Builder.NoSuchMethod"} {
+		if err := repl.ListFunc(arg); err == nil {
+			t.Errorf("ListFunc(%s): expecting an error", arg)
+		}
+	}
+
+	repl.UserFuncs["twice"] = &repl.UserFunc{
+		Name:   "twice",
+		Source: "func twice(x int) int {\n\treturn 2 * x\n}",
+	}
+	out := captureOutput(func() {
+		if err := repl.ListFunc("twice"); err != nil {
+			t.Errorf("ListFunc(twice): %s", err)
+		}
+	})
+	want := "twice (declared in the session)\n   1  func twice(x int) int {\n   2  \treturn 2 * x\n   3  }\n"
+	if !strings.Contains(out, want) {
+		t.Errorf("ListFunc(twice) shows:\n%s\nwant:\n%s", out, want)
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Loading the declarations of a Go source file
+
+package repl
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/rocky/eval"
+)
+
+// LoadFile interprets the package-level declarations of Go source file
+// filename in env. Imports are done first, then types, constants,
+// functions and finally variables, which may call the functions.
+// Declarations that can't be handled are reported, and the rest are
+// still loaded. The number of declarations loaded is returned.
+func LoadFile(filename string, env *eval.SimpleEnv) (int, error) {
+	src, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return 0, err
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, 0)
+	if err != nil {
+		return 0, err
+	}
+	for _, spec := range file.Imports {
+		pkgPath, name := ImportSpecPath(spec)
+		if err := ImportPackage(pkgPath, name, env); err != nil {
+			Errmsg("%s: can't import %s: %s", fset.Position(spec.Pos()), pkgPath, err)
+		}
+	}
+
+	var types []*ast.TypeSpec
+	var consts, vars []*ast.GenDecl
+	var funcs []*ast.FuncDecl
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			funcs = append(funcs, decl)
+		case *ast.GenDecl:
+			switch decl.Tok {
+			case token.TYPE:
+				for _, spec := range decl.Specs {
+					types = append(types, spec.(*ast.TypeSpec))
+				}
+			case token.CONST:
+				consts = append(consts, decl)
+			case token.VAR:
+				vars = append(vars, decl)
+			}
+		}
+	}
+
+	loaded := 0
+	report := func(node ast.Node, err error) {
+		Errmsg("%s: %s", fset.Position(node.Pos()), err)
+	}
+	// Types may refer to ones declared later in the file, so keep
+	// going while we make progress.
+	for len(types) > 0 {
+		var failed []*ast.TypeSpec
+		var errs []error
+		for _, spec := range types {
+			if _, err := DefineType(spec, env); err != nil {
+				failed = append(failed, spec)
+				errs = append(errs, fmt.Errorf("type %s: %s", spec.Name.Name, err))
+				continue
+			}
+			loaded++
+			recordSession(SessionEntry{Input: "type " + nodeSource(fset, src, spec),
+				Decl: &ast.GenDecl{Tok: token.TYPE, Specs: []ast.Spec{spec}}, Values: -1})
+		}
+		if len(failed) == len(types) {
+			for i, spec := range failed {
+				report(spec, errs[i])
+			}
+			break
+		}
+		types = failed
+	}
+	for _, decl := range consts {
+		for _, spec := range constSpecs(decl) {
+			stmt := &ast.DeclStmt{Decl: &ast.GenDecl{Tok: token.CONST, Specs: []ast.Spec{spec}}}
+			if err := loadStmt(stmt, env); err != nil {
+				report(spec, err)
+				continue
+			}
+			loaded++
+		}
+	}
+	// Functions may refer to each other and to variables, so they are
+	// only checked once everything is defined.
+	var defined []*UserFunc
+	for _, decl := range funcs {
+		if err := CheckReserved(decl.Name.Name); err != nil {
+			report(decl, err)
+			continue
+		}
+		if decl.Name.Name == "init" && decl.Recv == nil {
+			report(decl, fmt.Errorf("init functions aren't run; rename it and call it instead"))
+			continue
+		}
+		fn, err := defineFunc(decl, nodeSource(fset, src, decl), env)
+		if err != nil {
+			report(decl, err)
+			continue
+		}
+		defined = append(defined, fn)
+		loaded++
+		recordSession(SessionEntry{Input: nodeSource(fset, src, decl), Decl: decl, Values: -1})
+	}
+	for _, decl := range vars {
+		for _, spec := range decl.Specs {
+			stmt := &ast.DeclStmt{Decl: &ast.GenDecl{Tok: token.VAR, Specs: []ast.Spec{spec}}}
+			if err := loadStmt(stmt, env); err != nil {
+				report(spec, err)
+				continue
+			}
+			loaded++
+		}
+	}
+	for _, fn := range defined {
+		if err := fn.check(env); err != nil {
+			report(fn.Decl, fmt.Errorf("function %s: %s", fn.Name, err))
+			removeFunc(fn, env)
+			loaded--
+		}
+	}
+	return loaded, nil
+}
+
+// removeFunc undoes the definition of fn in env, and drops it from the
+// session.
+func removeFunc(fn *UserFunc, env *eval.SimpleEnv) {
+	delete(env.Funcs, fn.Name)
+	delete(UserFuncs, fn.Name)
+	for i, entry := range Session {
+		if entry.Decl == fn.Decl {
+			Session = append(Session[:i], Session[i+1:]...)
+			break
+		}
+	}
+}
+
+// loadStmt interprets the const or var declaration stmt, recording it
+// in the session.
+func loadStmt(stmt *ast.DeclStmt, env eval.Env) error {
+	if err := checkReservedStmt(stmt); err != nil {
+		return err
+	}
+	if err := loadInterpret(stmt, env); err != nil {
+		return err
+	}
+	recordSession(SessionEntry{Input: exprString(stmt), Stmt: stmt, Values: -1})
+	return nil
+}
+
+// loadInterpret is interpret, with a panic, say from a function
+// declared in the file, turned into an error.
+func loadInterpret(stmt ast.Stmt, env eval.Env) (err error) {
+	defer recoverPanic(&err)
+	return interpret(stmt, env)
+}
+
+// constSpecs returns the specs of const declaration decl with omitted
+// types and values filled in from the ones before, and iota replaced
+// by its value.
+func constSpecs(decl *ast.GenDecl) []*ast.ValueSpec {
+	var specs []*ast.ValueSpec
+	var typ ast.Expr
+	var values []ast.Expr
+	for i, spec := range decl.Specs {
+		spec := spec.(*ast.ValueSpec)
+		if spec.Type != nil || len(spec.Values) > 0 {
+			typ, values = spec.Type, spec.Values
+		}
+		iota := &ast.BasicLit{Kind: token.INT, Value: strconv.Itoa(i)}
+		filled := make([]ast.Expr, len(values))
+		for j, value := range values {
+			filled[j] = replaceIota(value, iota)
+		}
+		specs = append(specs, &ast.ValueSpec{Names: spec.Names, Type: typ, Values: filled})
+	}
+	return specs
+}
+
+// replaceIota returns a copy of expr with iota replaced by lit. Only
+// the expression forms allowed in constants are copied.
+func replaceIota(expr ast.Expr, lit *ast.BasicLit) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if e.Name == "iota" {
+			return lit
+		}
+	case *ast.ParenExpr:
+		return &ast.ParenExpr{X: replaceIota(e.X, lit)}
+	case *ast.UnaryExpr:
+		return &ast.UnaryExpr{Op: e.Op, X: replaceIota(e.X, lit)}
+	case *ast.BinaryExpr:
+		return &ast.BinaryExpr{X: replaceIota(e.X, lit), Op: e.Op, Y: replaceIota(e.Y, lit)}
+	case *ast.CallExpr:
+		args := make([]ast.Expr, len(e.Args))
+		for i, arg := range e.Args {
+			args[i] = replaceIota(arg, lit)
+		}
+		return &ast.CallExpr{Fun: e.Fun, Args: args}
+	}
+	return expr
+}
+
+// nodeSource returns the text of node in src, which was parsed using
+// fset.
+func nodeSource(fset *token.FileSet, src []byte, node ast.Node) string {
+	start := fset.Position(node.Pos()).Offset
+	end := fset.Position(node.End()).Offset
+	return string(src[start:end])
+}
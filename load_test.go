@@ -0,0 +1,65 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rocky/go-fish"
+)
+
+// writeTemp writes src to a file called name in a new temporary
+// directory, returning its path and a function that removes it.
+func writeTemp(t *testing.T, name, src string) (string, func()) {
+	dir, err := ioutil.TempDir("", "go-fish-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	filename := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(filename, []byte(src), 0644); err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return filename, func() { os.RemoveAll(dir) }
+}
+
+// TestLoadFilePanic checks that a variable whose initializer panics is
+// reported, rather than taking down the REPL, and the rest of the file
+// is still loaded.
+func TestLoadFilePanic(t *testing.T) {
+	filename, cleanup := writeTemp(t, "boom.go", `package helpers
+
+const (
+	A = iota
+	B
+)
+
+func boom() int {
+	panic("boom")
+}
+
+var y = boom()
+var z = B + 1
+`)
+	defer cleanup()
+	repl.Reset(false)
+	loaded, err := repl.LoadFile(filename, repl.Env)
+	if err != nil {
+		t.Fatalf("LoadFile: %s", err)
+	}
+	// A, B, boom and z
+	if loaded != 4 {
+		t.Errorf("loaded %d declarations; want 4", loaded)
+	}
+	if _, ok := repl.VarValue(repl.Env, "y"); ok {
+		t.Errorf("y was defined even though its initializer panicked")
+	}
+	if z, ok := repl.VarValue(repl.Env, "z"); !ok || z.Int() != 2 {
+		t.Errorf("z = %v; want 2", z)
+	}
+}
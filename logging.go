@@ -0,0 +1,116 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Session transcript logging
+
+package repl
+
+import (
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DefaultLogFile is the transcript file used when "set logging on"
+// isn't given a file name.
+const DefaultLogFile = "gofish.txt"
+
+// LogFileName is the name of the transcript file while logging is on.
+var LogFileName string
+
+// LogStripANSI says whether terminal escape sequences, e.g. for
+// highlighting, are removed before writing to the transcript.
+var LogStripANSI bool = true
+
+// LogTimestamps says whether each line of the transcript is prefixed
+// with the time it was written.
+var LogTimestamps bool = false
+
+var logFile *os.File
+
+// logAtLineStart is true when the next thing logged starts a new line,
+// and so gets a timestamp.
+var logAtLineStart bool = true
+
+var ansiRE = regexp.MustCompile("\x1b\\[[0-9;]*[A-Za-z]")
+
+// logWriter is an io.Writer for the transcript.
+type logWriter struct{}
+
+func (logWriter) Write(p []byte) (int, error) {
+	logText(string(p))
+	return len(p), nil
+}
+
+var logOut io.Writer = logWriter{}
+
+// StartLogging starts appending everything we read and write to
+// file filename. Any previous transcript file is closed.
+func StartLogging(filename string) error {
+	f, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	StopLogging()
+	logFile = f
+	LogFileName = filename
+	logAtLineStart = true
+	return nil
+}
+
+// StopLogging closes the transcript file if there is one.
+func StopLogging() error {
+	if logFile == nil {
+		return nil
+	}
+	err := logFile.Close()
+	logFile = nil
+	LogFileName = ""
+	return err
+}
+
+// Logging returns true if a transcript is being written.
+func Logging() bool {
+	return logFile != nil
+}
+
+// logWriterOrNil returns the transcript writer if we are logging,
+// and nil otherwise.
+func logWriterOrNil() io.Writer {
+	if logFile == nil {
+		return nil
+	}
+	return logOut
+}
+
+// LogInput adds a prompt and the line entered at it to the transcript.
+func LogInput(prompt string, line string) {
+	logText(prompt + line + "\n")
+}
+
+func logText(text string) {
+	if logFile == nil || text == "" {
+		return
+	}
+	if LogStripANSI {
+		text = ansiRE.ReplaceAllString(text, "")
+	}
+	if LogTimestamps {
+		stamp := time.Now().Format("2006-01-02 15:04:05 ")
+		lines := strings.SplitAfter(text, "\n")
+		for i, line := range lines {
+			if line == "" {
+				continue
+			}
+			if logAtLineStart {
+				lines[i] = stamp + line
+			}
+			logAtLineStart = strings.HasSuffix(line, "\n")
+		}
+		text = strings.Join(lines, "")
+	}
+	logFile.WriteString(text)
+}
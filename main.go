@@ -14,9 +14,9 @@ package main
 // See also main_gr.go for GNU readline code.
 import (
 	"bufio"
+	"flag"
 	"fmt"
 	"os"
-	"reflect"
 
 	"github.com/rocky/go-fish"
 	"github.com/rocky/go-fish/cmd"
@@ -27,6 +27,7 @@ func intro_text() {
 	fmt.Printf(`
 Results of expression are stored in variable slice "results".
 The environment is stored in global variable "env".
+These are also gofish.Results and gofish.Env, and can't be redefined.
 Short form assignment, e.g. a, b := 1, 2, is supported.
 
 Enter expressions to be evaluated at the "gofish>" prompt.
@@ -43,20 +44,30 @@ To get help, enter: "help".
 // (Read, Eval, Print, and Loop).
 func main() {
 
-	// A place to store result values of expressions entered
-	// interactively
-	env := repl.MakeEvalEnv()
+	flag.Parse()
 
-	// Make this truly self-referential
-	env.Vars["env"] = reflect.ValueOf(env)
+	// The evaluation environment. Make this truly
+	// self-referential: the environment is variable "env" in it.
+	env := repl.NewEnv()
+
+	// Initialize REPL commands
+	fishcmd.Init()
+
+	if *repl.CheckFile != "" {
+		failures, err := repl.CheckTranscript(*repl.CheckFile, env, repl.SimpleInspect)
+		if err != nil {
+			repl.Errmsg("%s", err)
+			os.Exit(2)
+		} else if failures > 0 {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
 
 	intro_text()
 
 	repl.Input = bufio.NewReader(os.Stdin)
 
-	// Initialize REPL commands
-	fishcmd.Init()
-
 	repl.REPL(env, repl.SimpleReadLine, repl.SimpleInspect)
 	os.Exit(repl.ExitCode)
 }
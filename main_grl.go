@@ -9,6 +9,7 @@ package main
 // This simple REPL (read-eval-print loop) for Go using GNU Readline
 
 import (
+	"flag"
 	"fmt"
 	"os"
 	"reflect"
@@ -24,6 +25,7 @@ func intro_text() {
 	fmt.Printf(`
 Results of expression are stored in variable slice "results".
 The environment is stored in global variable "env".
+These are also gofish.Results and gofish.Env, and can't be redefined.
 Short form assignment, e.g. a, b := 1, 2, is supported.
 
 Enter expressions to be evaluated at the "gofish>" prompt.
@@ -76,20 +78,31 @@ func spewInspect(a ...interface{}) string {
 // (Read, Eval, Print, and Loop).
 func main() {
 
-	// A place to store result values of expressions entered
-	// interactively
-	env := repl.MakeEvalEnv()
+	flag.Parse()
 
-	// Make this truly self-referential
-	env.Vars["env"] = reflect.ValueOf(env)
+	// The evaluation environment. Make this truly
+	// self-referential: the environment is variable "env" in it.
+	env := repl.NewEnv()
+
+	// Initialize REPL commands
+	fishcmd.Init()
+
+	if *repl.CheckFile != "" {
+		failures, err := repl.CheckTranscript(*repl.CheckFile, env, spewInspect)
+		if err != nil {
+			repl.Errmsg("%s", err)
+			os.Exit(2)
+		} else if failures > 0 {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
 
 	intro_text()
 	gnuReadLineSetup()
 
 	defer gnuReadLineTermination()
-
-	// Initialize REPL commands
-	fishcmd.Init()
+	repl.BeforeRestart = gnuReadLineTermination
 
 	repl.REPL(env, gnureadline.Readline, spewInspect)
 	os.Exit(repl.ExitCode)
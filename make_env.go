@@ -7,12 +7,17 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
 	"go/ast"
 	"go/build"
+	"go/doc"
+	"go/parser"
+	"go/printer"
 	"go/token"
 	"log"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"unicode"
@@ -260,11 +265,23 @@ func (s *packageInfoSorter) Less(i, j int) bool {
 	return s.by(s.pkg_infos[i], s.pkg_infos[j])
 }
 
+// importable returns false for packages that can't be imported from
+// outside of the tree they are in: internal and vendored ones.
+func importable(path string) bool {
+	for _, elem := range strings.Split(path, "/") {
+		if elem == "internal" || elem == "vendor" {
+			return false
+		}
+	}
+	return true
+}
+
 // writePreamble prints the initial boiler-plate Go package code. That
 // is it starts out:
 //     package repl; import (... )
-// Packages that end in _test are removed from the list of imported
-// packages and this stripped down list is returned.
+// Packages that end in _test, and internal and vendored packages, are
+// removed from the list of imported packages and this stripped down
+// list is returned.
 func writePreamble(pkg_infos map[*types.Package]*loader.PackageInfo,
 	name string, startingImport string, pkgName string) []*loader.PackageInfo {
 	path := func(p1, p2 *loader.PackageInfo) bool {
@@ -280,15 +297,27 @@ func writePreamble(pkg_infos map[*types.Package]*loader.PackageInfo,
 import (
 `, pkgName)
 	kept_pkgs := []*loader.PackageInfo {}
+	// The code we write needs these, whether or not the starting
+	// import brings them in.
+	needed := map[string]bool{"reflect": true, "github.com/rocky/eval": true}
+	imports := []string {}
 	for _, pkg_info := range pkg_infos2 {
 		path := pkg_info.Pkg.Path()
-		if !strings.HasSuffix(path, "_test") {
+		if !strings.HasSuffix(path, "_test") && importable(path) {
 			if	MyImport != path {
-				fmt.Printf("\t\"%s\"\n", path)
+				imports = append(imports, path)
 			}
+			delete(needed, path)
 			kept_pkgs = append(kept_pkgs, pkg_info)
 		}
 	}
+	for path := range needed {
+		imports = append(imports, path)
+	}
+	sort.Strings(imports)
+	for _, path := range imports {
+		fmt.Printf("\t\"%s\"\n", path)
+	}
 	fmt.Printf(`)
 
 // %sEnvironment adds to eval.Env those packages included
@@ -314,20 +343,201 @@ func writePostamble() {
 }`)
 }
 
+// symbolDoc is the documentation make_env -docs records for a symbol.
+type symbolDoc struct {
+	key, sig, doc, pos string
+}
+
+// declSource returns the source text of node, without any function
+// body. It is declString in package repl, which we can't import as we
+// generate part of it.
+func declSource(program *loader.Program, node ast.Node) string {
+	if fn, ok := node.(*ast.FuncDecl); ok {
+		sig := *fn
+		sig.Body = nil
+		sig.Doc = nil
+		node = &sig
+	}
+	var buf bytes.Buffer
+	printer.Fprint(&buf, program.Fset, node)
+	return buf.String()
+}
+
+// shortTypeSource returns the source of a type expression, but just
+// "struct" or "interface" for those, whose fields or methods would
+// make for a long signature.
+func shortTypeSource(program *loader.Program, expr ast.Expr) string {
+	switch expr.(type) {
+	case *ast.StructType:
+		return "struct"
+	case *ast.InterfaceType:
+		return "interface"
+	}
+	return declSource(program, expr)
+}
+
+// docsFromDecl returns the documentation of the exported symbols that
+// decl declares in the package with import path pkgPath.
+func docsFromDecl(decl ast.Decl, pkgPath string, program *loader.Program) []symbolDoc {
+	position := func(node ast.Node) string {
+		pos := program.Fset.Position(node.Pos())
+		return fmt.Sprintf("%s:%d", filepath.Base(pos.Filename), pos.Line)
+	}
+	var docs []symbolDoc
+	switch decl := decl.(type) {
+	case *ast.GenDecl:
+		for _, spec := range decl.Specs {
+			switch spec := spec.(type) {
+			case *ast.TypeSpec:
+				if !isExportedIdent(spec.Name) {
+					continue
+				}
+				text := spec.Doc.Text()
+				if text == "" {
+					text = decl.Doc.Text()
+				}
+				docs = append(docs, symbolDoc{
+					key: pkgPath + "." + spec.Name.Name,
+					sig: "type " + spec.Name.Name + " " + shortTypeSource(program, spec.Type),
+					doc: doc.Synopsis(text),
+					pos: position(spec),
+				})
+			case *ast.ValueSpec:
+				text := spec.Doc.Text()
+				if text == "" {
+					text = decl.Doc.Text()
+				}
+				for _, id := range spec.Names {
+					if !isExportedIdent(id) {
+						continue
+					}
+					sig := decl.Tok.String() + " " + id.Name
+					if spec.Type != nil {
+						sig += " " + declSource(program, spec.Type)
+					}
+					docs = append(docs, symbolDoc{
+						key: pkgPath + "." + id.Name,
+						sig: sig,
+						doc: doc.Synopsis(text),
+						pos: position(id),
+					})
+				}
+			}
+		}
+	case *ast.FuncDecl:
+		if !isExportedIdent(decl.Name) || strings.HasPrefix(decl.Name.Name, "Test") {
+			return nil
+		}
+		key := pkgPath + "." + decl.Name.Name
+		if decl.Recv != nil {
+			recv := decl.Recv.List[0].Type
+			if star, ok := recv.(*ast.StarExpr); ok {
+				recv = star.X
+			}
+			id, ok := recv.(*ast.Ident)
+			if !ok || !isExportedIdent(id) {
+				return nil
+			}
+			key = pkgPath + "." + id.Name + "." + decl.Name.Name
+		}
+		docs = append(docs, symbolDoc{
+			key: key,
+			sig: declSource(program, decl),
+			doc: doc.Synopsis(decl.Doc.Text()),
+			pos: position(decl),
+		})
+	}
+	return docs
+}
+
+// packageSynopsis returns the synopsis of the package comment of
+// pkg_info. If more than one file has a package comment, the one in
+// doc.go, or else the first, is used, as godoc would show it first.
+func packageSynopsis(pkg_info *loader.PackageInfo, program *loader.Program) string {
+	text := ""
+	for _, file := range pkg_info.Files {
+		filename := program.Fset.File(file.Pos()).Name()
+		if file.Doc == nil || strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+		if text == "" || filepath.Base(filename) == "doc.go" {
+			text = file.Doc.Text()
+		}
+	}
+	return doc.Synopsis(text)
+}
+
+// writeDocs prints Go code for package pkgName that adds the
+// documentation summary, signature and position of each exported
+// symbol in pkg_infos to the REPL's symbol table. Keys start with the
+// import path: "path.Symbol", or "path.Type.Method" for methods; the
+// package's own synopsis is under "path". Package names alone aren't
+// unique enough, consider math/rand and crypto/rand. As with the
+// imports, go-fish itself is left out.
+func writeDocs(pkg_infos map[*types.Package]*loader.PackageInfo,
+	program *loader.Program, pkgName string) {
+	path := func(p1, p2 *loader.PackageInfo) bool {
+		return p1.Pkg.Path() < p2.Pkg.Path()
+	}
+	sorted := []*loader.PackageInfo {}
+	for _, pi := range pkg_infos {
+		pkgPath := pi.Pkg.Path()
+		if !strings.HasSuffix(pkgPath, "_test") && importable(pkgPath) && pkgPath != MyImport {
+			sorted = append(sorted, pi)
+		}
+	}
+	By(path).Sort(sorted)
+	fmt.Printf(`// Code generated by make_env -docs. DO NOT EDIT.
+
+package %s
+
+func init() {
+	addSymbolMeta(map[string]SymbolInfo{
+`, pkgName)
+	for _, pkg_info := range sorted {
+		pkgPath := pkg_info.Pkg.Path()
+		if synopsis := packageSynopsis(pkg_info, program); synopsis != "" {
+			fmt.Printf("\t\t%q: {Doc: %q},\n", pkgPath, synopsis)
+		}
+		for _, file := range pkg_info.Files {
+			filename := program.Fset.File(file.Pos()).Name()
+			if strings.HasSuffix(filename, "_test.go") {
+				continue
+			}
+			for _, decl := range file.Decls {
+				for _, d := range docsFromDecl(decl, pkgPath, program) {
+					fmt.Printf("\t\t%q: {Sig: %q, Doc: %q, Pos: %q},\n",
+						d.key, d.sig, d.doc, d.pos)
+				}
+			}
+		}
+	}
+	fmt.Printf("\t})\n}\n")
+}
+
 // main creates a Go program that adds to a github.com/0xfaded/eval
 // environment (of type eval.Env) the transitive closure of imports
 // for a given starting package. Here we use github.com/0xfaded/eval.
+//
+// With -docs, the Go code written instead records documentation and
+// signatures of the symbols, for go-fish to show when package sources
+// aren't around.
 func main() {
 	startingImport := DefaultStartingImport
 	pkgName := DefaultPackage
-	numArgs := len(os.Args)
+	args := os.Args
+	docs := len(args) > 1 && args[1] == "-docs"
+	if docs {
+		args = append(args[:1], args[2:]...)
+	}
+	numArgs := len(args)
 	if numArgs == 2 {
-		startingImport = os.Args[1]
+		startingImport = args[1]
 	} else if numArgs == 3 {
-		startingImport = os.Args[1]
-		pkgName = os.Args[2]
+		startingImport = args[1]
+		pkgName = args[2]
 	} else if numArgs > 3 {
-		fmt.Printf("usage: %s [starting-import [package-name]]\n")
+		fmt.Printf("usage: %s [-docs] [starting-import [package-name]]\n", os.Args[0])
 		os.Exit(1)
 	}
 	fmt.Printf("// starting import: \"%s\"\n", startingImport)
@@ -340,6 +550,10 @@ func main() {
 		SourceImports: true,
 		ImportPkgs: importPkgs,
 	}
+	if docs {
+		// Documentation comments are dropped otherwise.
+		config.ParserMode = parser.ParseComments
+	}
 
 	var pkgs_string []string = make([] string, 0, 10)
 	pkgs_string = append(pkgs_string, startingImport)
@@ -349,6 +563,11 @@ func main() {
 		log.Fatal(err)
 	}
 
+	if docs {
+		writeDocs(program.AllPackages, program, pkgName)
+		return
+	}
+
 	var errpkgs []string
 
 	pkg_infos := writePreamble(program.AllPackages, "Eval",
@@ -2,10 +2,14 @@ package repl_test
 
 import (
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"io/ioutil"
 	"log"
 	"os"
 	"os/exec"
+	"strconv"
 	"strings"
 	"testing"
 )
@@ -80,3 +84,50 @@ func TestMakeEnv(t *testing.T) {
 	hint = "" // call off the hounds
 	return
 }
+
+// Runs make_env -docs and checks that each symbol's documentation is
+// recorded once, under its import path.
+func TestMakeEnvDocs(t *testing.T) {
+	out, err := exec.Command("go", "build", "-o", "make_env", "make_env.go").CombinedOutput()
+	if err != nil {
+		t.Fatalf("go build -o make_env make_env.go: %s\n%s", err, out)
+	}
+	got, err := exec.Command("./make_env", "-docs", "strings").Output()
+	if err != nil {
+		t.Fatalf("./make_env -docs strings: %s", err)
+	}
+	file, err := parser.ParseFile(token.NewFileSet(), "docs.go", got, 0)
+	if err != nil {
+		t.Fatalf("make_env -docs wrote bad Go: %s", err)
+	}
+	entries := make(map[string]string)
+	ast.Inspect(file, func(n ast.Node) bool {
+		kv, ok := n.(*ast.KeyValueExpr)
+		if !ok {
+			return true
+		}
+		lit, ok := kv.Key.(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return true
+		}
+		key, _ := strconv.Unquote(lit.Value)
+		if _, dup := entries[key]; dup {
+			t.Errorf("%s is documented more than once", key)
+		}
+		entries[key] = string(got[kv.Value.Pos()-1 : kv.Value.End()-1])
+		return false
+	})
+	for _, key := range []string{"strings", "strings.Split", "strings.Builder.WriteString", "unicode/utf8.RuneLen"} {
+		if _, ok := entries[key]; !ok {
+			t.Errorf("no documentation for %s", key)
+		}
+	}
+	if entry := entries["strings.Split"]; !strings.Contains(entry, `Sig: "func Split(s, sep string) []string"`) {
+		t.Errorf("strings.Split is recorded as %s", entry)
+	}
+	for key := range entries {
+		if strings.HasPrefix(key, "internal/") || strings.Contains(key, "/internal/") {
+			t.Errorf("internal package symbol %s is documented", key)
+		}
+	}
+}
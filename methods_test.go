@@ -0,0 +1,33 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rocky/go-fish"
+)
+
+func TestMethod(t *testing.T) {
+	env := shapesEnv()
+	key := repl.TypeSymbolName(reflect.TypeOf(Square{})) + ".Area"
+	repl.SymbolMeta[key] = repl.SymbolInfo{Doc: "Area is the square of the side."}
+	defer delete(repl.SymbolMeta, key)
+
+	checkCommand(t, env, "method shapes.Square",
+		"Methods of repl_test.Square\n  Area() float64\n      Area is the square of the side.")
+	checkCommand(t, env, "method shapes.Circle",
+		"Methods of *repl_test.Circle only (pointer receivers)\n  Area() float64")
+	checkCommand(t, env, "method shapes.Shape",
+		"Methods of interface repl_test.Shape\n  Area() float64")
+	checkCommand(t, env, "method -r ^Sc shapes",
+		"Functions of package shapes (1)\n  Scale(repl_test.Square, float64) repl_test.Square")
+	if out := runCommands(env, "method -r ^Sc shapes"); strings.Contains(out, "Area") {
+		t.Errorf("-r ^Sc lists Area:\n%s", out)
+	}
+	checkCommand(t, env, "method shapes.Padded", "No methods found for repl_test.Padded")
+}
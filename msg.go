@@ -6,6 +6,7 @@ package repl
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"sort"
 	"strings"
@@ -22,23 +23,36 @@ func init() {
 	termHighlight = ansi.ColorCode("+h")
 }
 
+// msgOut is where Msg, Errmsg and friends write to: stdout, or the
+// page buffer when output is being collected for paging. When
+// logging, it also goes to the transcript.
+func msgOut() io.Writer {
+	var w io.Writer = os.Stdout
+	if pageBuf != nil {
+		w = pageBuf
+	}
+	if logFile != nil {
+		w = io.MultiWriter(w, logOut)
+	}
+	return w
+}
+
 func Errmsg(format string, a ...interface{}) (n int, err error) {
 	if *Highlight {
 		format = termHighlight + format + termReset + "\n"
 	} else {
 		format = "** " + format + "\n"
 	}
-	return fmt.Fprintf(os.Stdout, format, a...)
+	return fmt.Fprintf(msgOut(), format, a...)
 }
 
 func MsgNoCr(format string, a ...interface{}) (n int, err error) {
-	format = format
-	return fmt.Fprintf(os.Stdout, format, a...)
+	return fmt.Fprintf(msgOut(), format, a...)
 }
 
 func Msg(format string, a ...interface{}) (n int, err error) {
 	format = format + "\n"
-	return fmt.Fprintf(os.Stdout, format, a...)
+	return fmt.Fprintf(msgOut(), format, a...)
 }
 
 // A more emphasized version of msg. For section headings.
@@ -48,7 +62,7 @@ func Section(format string, a ...interface{}) (n int, err error) {
 	} else {
 		format = format + "\n"
 	}
-	return fmt.Fprintf(os.Stdout, format, a...)
+	return fmt.Fprintf(msgOut(), format, a...)
 }
 
 func PrintSorted(title string, names []string) {
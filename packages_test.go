@@ -0,0 +1,41 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestPackages(t *testing.T) {
+	env := shapesEnv()
+	out := runCommands(env, "packages -r ^shap")
+	row := regexp.MustCompile(`(?m)^shapes +github\.com/rocky/go-fish_test +0 +1 +5 +0$`)
+	if !row.MatchString(out) {
+		t.Errorf("\"packages -r ^shap\" doesn't count shapes' members:\n%s", out)
+	}
+	if strings.Contains(out, "strings") {
+		t.Errorf("\"packages -r ^shap\" lists strings:\n%s", out)
+	}
+	checkCommand(t, env, "packages -r ^nosuch", "No imported packages match")
+
+	out = runCommands(env, "packages -types shapes")
+	for _, want := range []string{
+		"=== Package shapes (github.com/rocky/go-fish_test) ===",
+		"Types of package shapes (5)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("\"packages -types shapes\" doesn't show %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Functions") {
+		t.Errorf("\"packages -types shapes\" shows functions:\n%s", out)
+	}
+	checkCommand(t, env, "packages -funcs -r ^Sc github.com/rocky/go-fish_test",
+		"Functions of package shapes (1)\n  Scale(repl_test.Square, float64) repl_test.Square")
+	checkCommand(t, env, "packages -funcs -r ^Nothing shapes", "Nothing to show")
+	checkCommand(t, env, "packages -bogus", "Unknown option -bogus")
+}
@@ -0,0 +1,149 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Paging of long output
+
+package repl
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// Values for Pager
+const (
+	PAGER_OFF = iota
+	PAGER_ON
+	PAGER_AUTO
+)
+
+// Pager says when output longer than the screen is paged: never
+// (PAGER_OFF), always (PAGER_ON), or only when stdout is a terminal
+// (PAGER_AUTO).
+var Pager int = PAGER_AUTO
+
+// Maxheight is the number of lines on the screen. Output longer than
+// this is paged.
+var Maxheight int
+
+// AutoHeight is true when Maxheight follows the height of the
+// terminal. Setting the height explicitly with "set height" clears it.
+var AutoHeight bool = true
+
+// pageBuf collects output while paging is in effect; pageDepth allows
+// StartPaging and FlushPaging calls to nest.
+var pageBuf *bytes.Buffer
+var pageDepth int
+
+func init() {
+	UpdateHeight()
+}
+
+// UpdateHeight sets Maxheight from the terminal size when AutoHeight
+// is set. If the terminal size is not available, the LINES
+// environment variable is used and failing that, 24.
+func UpdateHeight() {
+	if !AutoHeight {
+		return
+	}
+	if _, height := TermSize(); height > 0 {
+		Maxheight = height
+	} else if i, err := strconv.Atoi(os.Getenv("LINES")); err == nil && i > 0 {
+		Maxheight = i
+	} else {
+		Maxheight = 24
+	}
+}
+
+// StartPaging starts collecting output written via Msg, Errmsg and
+// friends so that it can be paged by a matching FlushPaging.
+func StartPaging() {
+	if pageDepth == 0 && Pager != PAGER_OFF {
+		pageBuf = new(bytes.Buffer)
+	}
+	pageDepth++
+}
+
+// FlushPaging writes out what was collected since StartPaging, going
+// through a pager if it doesn't fit on the screen. If the environment
+// variable PAGER is set, that program is used; otherwise we use a
+// simple built-in pager.
+func FlushPaging() {
+	if pageDepth > 0 {
+		pageDepth--
+	}
+	if pageDepth > 0 || pageBuf == nil {
+		return
+	}
+	text := pageBuf.String()
+	pageBuf = nil
+	if !needsPaging(text) {
+		os.Stdout.WriteString(text)
+		return
+	}
+	if pager := os.Getenv("PAGER"); pager != "" {
+		cmd := exec.Command("/bin/sh", "-c", pager)
+		cmd.Stdin = strings.NewReader(text)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err == nil {
+			return
+		}
+	}
+	pageText(text)
+}
+
+// needsPaging returns true if text takes more lines on the screen than
+// we have.
+func needsPaging(text string) bool {
+	switch Pager {
+	case PAGER_OFF:
+		return false
+	case PAGER_AUTO:
+		if _, height := TermSize(); height == 0 {
+			return false
+		}
+	}
+	if Maxheight <= 1 || readLineFn == nil {
+		return false
+	}
+	screenLines := 0
+	for _, line := range strings.Split(strings.TrimSuffix(text, "\n"), "\n") {
+		screenLines++
+		if Maxwidth > 0 && len(line) > Maxwidth {
+			screenLines += (len(line) - 1) / Maxwidth
+		}
+		if screenLines >= Maxheight {
+			return true
+		}
+	}
+	return false
+}
+
+// pageText is our built-in pager. It shows a screenful of text at a
+// time and stops if asked to.
+func pageText(text string) {
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	page := Maxheight - 1
+	for i := 0; i < len(lines); i += page {
+		end := i + page
+		if end > len(lines) {
+			end = len(lines)
+		}
+		os.Stdout.WriteString(strings.Join(lines[i:end], ""))
+		if end == len(lines) {
+			break
+		}
+		answer, err := readLineFn("--More-- (RET for more, q to quit) ", false)
+		if err != nil || strings.HasPrefix(strings.TrimSpace(answer), "q") {
+			break
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+
+	"github.com/rocky/eval"
+)
+
+// PkgPath returns the import path of the package known as name in
+// env, e.g. "math/rand" for "rand", or "" if we can't tell.
+//
+// Unless the package was imported at the prompt, the environment only
+// records package names, so we work the path out from the names the
+// runtime gives the package's functions. A package's types may be
+// aliases for types of other packages, os.FileMode is io/fs.FileMode,
+// so they are only used if a package has no functions, and then only
+// if they all agree.
+func PkgPath(env *eval.SimpleEnv, name string) string {
+	if path, ok := ImportPaths[name]; ok {
+		return path
+	}
+	pkg, ok := env.Pkgs[name].(*eval.SimpleEnv)
+	if !ok || pkg == nil {
+		return ""
+	}
+	for _, fn := range pkg.Funcs {
+		if path := funcPkgPath(fn); path != "" {
+			return path
+		}
+	}
+	path := ""
+	for _, typ := range pkg.Types {
+		if typ == nil || typ.PkgPath() == "" {
+			continue
+		}
+		if path != "" && typ.PkgPath() != path {
+			return ""
+		}
+		path = typ.PkgPath()
+	}
+	return path
+}
+
+// funcPkgPath returns the import path of the package that function fn
+// is defined in.
+func funcPkgPath(fn reflect.Value) string {
+	if fn.Kind() != reflect.Func || fn.IsNil() {
+		return ""
+	}
+	f := runtime.FuncForPC(fn.Pointer())
+	if f == nil {
+		return ""
+	}
+	// f.Name() is something like "github.com/mgutz/ansi.Color".
+	full := f.Name()
+	slash := strings.LastIndex(full, "/")
+	dot := strings.Index(full[slash+1:], ".")
+	if dot < 0 {
+		return ""
+	}
+	return full[:slash+1+dot]
+}
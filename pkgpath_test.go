@@ -0,0 +1,41 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/rocky/eval"
+	"github.com/rocky/go-fish"
+)
+
+// TestPkgPathAliases checks that a package's path isn't taken from a
+// type that is an alias for one in another package: os.FileMode is
+// io/fs.FileMode.
+func TestPkgPathAliases(t *testing.T) {
+	env := eval.MakeSimpleEnv()
+	pkg := eval.MakeSimpleEnv()
+	pkg.Types["FileMode"] = reflect.TypeOf(os.FileMode(0))
+	pkg.Types["File"] = reflect.TypeOf(os.File{})
+	pkg.Funcs["Getwd"] = reflect.ValueOf(os.Getwd)
+	env.Pkgs["os"] = pkg
+	for i := 0; i < 10; i++ {
+		if got := repl.PkgPath(env, "os"); got != "os" {
+			t.Fatalf("PkgPath of os is %q", got)
+		}
+	}
+
+	// Without functions, types only decide if they agree.
+	delete(pkg.Funcs, "Getwd")
+	if got := repl.PkgPath(env, "os"); got != "" {
+		t.Errorf("PkgPath of os, from disagreeing types, is %q; want \"\"", got)
+	}
+	delete(pkg.Types, "FileMode")
+	if got := repl.PkgPath(env, "os"); got != "os" {
+		t.Errorf("PkgPath of os, from type File, is %q", got)
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Importing packages at run time via Go plugins
+
+package repl
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"plugin"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/rocky/eval"
+)
+
+// MyImport is the import path of go-fish itself. Plugin shims are
+// built inside its source directory so that they see the same
+// versions of eval and the other packages that we were built with.
+const MyImport = "github.com/rocky/go-fish"
+
+// pluginDir is where, under the go-fish source directory, plugin
+// shims and the plugins built from them go. Go tools ignore
+// directories starting with "_".
+const pluginDir = "_plugins"
+
+// ImportPaths maps the names of packages imported at the prompt to
+// their import paths.
+var ImportPaths = make(map[string]string)
+
+// ParseImports parses the import declaration that starts with line,
+// reading further lines if it is a group that isn't closed yet.
+func ParseImports(line string) ([]*ast.ImportSpec, error) {
+	src, err := readDecl(line)
+	if err != nil {
+		return nil, err
+	}
+	file, err := parser.ParseFile(token.NewFileSet(), "", "package main; "+src,
+		parser.ImportsOnly)
+	if err != nil {
+		return nil, err
+	}
+	if len(file.Decls) != 1 {
+		return nil, fmt.Errorf("expecting a single import declaration")
+	}
+	return file.Imports, nil
+}
+
+// ImportPackage makes the package with import path pkgPath available
+// in env as name, or under its package name if name is "". Packages
+// that weren't compiled into go-fish are extracted with make_env,
+// built as a plugin, and loaded. Packages they import come along too
+// if they aren't there already.
+func ImportPackage(pkgPath string, name string, env *eval.SimpleEnv) error {
+	if name == "." {
+		return fmt.Errorf("dot imports aren't supported")
+	}
+	if err := CheckReserved(name); err != nil {
+		return err
+	}
+	bpkg, err := build.Import(pkgPath, initial_cwd, 0)
+	if err != nil {
+		return err
+	}
+	if bpkg.Name == "main" {
+		return fmt.Errorf("%s is a program, not an importable package", pkgPath)
+	}
+	if name == "" {
+		name = bpkg.Name
+	}
+	if _, ok := env.Pkgs[name]; ok && name != "_" {
+		if path := PkgPath(env, name); path != bpkg.ImportPath {
+			return fmt.Errorf("%s redeclared; it is already %s. Use another name, as in: import %s %q",
+				name, path, importAlias(bpkg.ImportPath, name), bpkg.ImportPath)
+		}
+	}
+	if pkg := env.Pkgs[bpkg.Name]; pkg != nil && PkgPath(env, bpkg.Name) == bpkg.ImportPath {
+		// Already compiled in, or imported before.
+		addImport(env, name, bpkg.ImportPath, pkg)
+		return nil
+	}
+	pkgs, err := loadPlugin(bpkg.ImportPath)
+	if err != nil {
+		return err
+	}
+	pkg := pkgs[bpkg.Name]
+	if pkg == nil {
+		return fmt.Errorf("plugin for %s doesn't define package %s", pkgPath, bpkg.Name)
+	}
+	for pkgName, dep := range pkgs {
+		if _, ok := env.Pkgs[pkgName]; !ok && pkgName != bpkg.Name {
+			env.Pkgs[pkgName] = dep
+		}
+	}
+	addImport(env, name, bpkg.ImportPath, pkg)
+	return nil
+}
+
+// importAlias suggests a name to import the package with path pkgPath
+// and package name name under, when name is taken: name prefixed by the
+// first letter of the path element before it, as in crand for
+// crypto/rand.
+func importAlias(pkgPath string, name string) string {
+	elems := strings.Split(pkgPath, "/")
+	if len(elems) < 2 || elems[len(elems)-2] == "" {
+		return name + "2"
+	}
+	return elems[len(elems)-2][:1] + name
+}
+
+// addImport records that pkg, with path pkgPath, is known as name.
+func addImport(env *eval.SimpleEnv, name string, pkgPath string, pkg eval.Env) {
+	if name == "_" {
+		return
+	}
+	env.Pkgs[name] = pkg
+	ImportPaths[name] = pkgPath
+}
+
+// loadPlugin builds, if needed, and opens a plugin for the package with
+// import path pkgPath, returning the packages it defines. plugin.Open
+// won't load a file it has opened before again, so the plugin's file
+// name has a hash of its sources in it; a plugin is only rebuilt, under
+// a new name, when those change.
+func loadPlugin(pkgPath string) (map[string]eval.Env, error) {
+	src, err := build.Import(MyImport, initial_cwd, build.FindOnly)
+	if err != nil {
+		return nil, fmt.Errorf("can't find go-fish source to build a plugin in: %s", err)
+	}
+	dir := filepath.Join(src.Dir, pluginDir, strings.Replace(pkgPath, "/", "_", -1))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	hash, err := sourceHash(src.Dir, pkgPath)
+	if err != nil {
+		return nil, err
+	}
+	shim := filepath.Join(dir, "main.go")
+	so := filepath.Join(dir, "plugin-"+hash+".so")
+	if _, err := os.Stat(so); err != nil {
+		Msg("Building plugin for %s...", pkgPath)
+		if err := makeShim(src.Dir, pkgPath, shim); err != nil {
+			return nil, err
+		}
+		if err := goCommand(src.Dir, nil, "build", "-buildmode=plugin", "-o", so, shim); err != nil {
+			return nil, err
+		}
+		removeStalePlugins(dir, so)
+	}
+	p, err := plugin.Open(so)
+	if err != nil {
+		return nil, err
+	}
+	sym, err := p.Lookup("EvalEnvironment")
+	if err != nil {
+		return nil, err
+	}
+	envFn, ok := sym.(func() *eval.SimpleEnv)
+	if !ok {
+		return nil, fmt.Errorf("plugin %s: EvalEnvironment has type %T", so, sym)
+	}
+	return envFn().Pkgs, nil
+}
+
+// makeShim writes to shim the program make_env extracts for pkgPath.
+func makeShim(srcDir string, pkgPath string, shim string) error {
+	out, err := os.Create(shim)
+	if err != nil {
+		return err
+	}
+	err = goCommand(srcDir, out, "run", filepath.Join(srcDir, "make_env.go"), pkgPath, "main")
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// sourceHash returns a hash of what a plugin for pkgPath is built
+// from: the Go release, make_env, and the source files of pkgPath and
+// of the packages outside of the standard library it imports.
+func sourceHash(srcDir string, pkgPath string) (string, error) {
+	h := sha256.New()
+	fmt.Fprintln(h, runtime.Version())
+	files := []string{filepath.Join(srcDir, "make_env.go")}
+	seen := make(map[string]bool)
+	var walk func(path string, from string) error
+	walk = func(path string, from string) error {
+		if path == "C" || path == "unsafe" || seen[path] {
+			return nil
+		}
+		seen[path] = true
+		bpkg, err := build.Import(path, from, 0)
+		if err != nil {
+			return err
+		}
+		if bpkg.Goroot && path != pkgPath {
+			return nil
+		}
+		for _, name := range append(bpkg.GoFiles, bpkg.CgoFiles...) {
+			files = append(files, filepath.Join(bpkg.Dir, name))
+		}
+		for _, imp := range bpkg.Imports {
+			if err := walk(imp, bpkg.Dir); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(pkgPath, initial_cwd); err != nil {
+		return "", err
+	}
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "%s %d\n", file, len(data))
+		h.Write(data)
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16], nil
+}
+
+// removeStalePlugins removes the plugins in dir other than keep, which
+// were built from older sources.
+func removeStalePlugins(dir string, keep string) {
+	old, _ := filepath.Glob(filepath.Join(dir, "plugin*.so"))
+	for _, so := range old {
+		if so != keep {
+			os.Remove(so)
+		}
+	}
+}
+
+// goCommand runs the go tool in dir with args, sending its standard
+// output to stdout if that isn't nil. Error output is included in the
+// error returned. If dir isn't inside a module, GOPATH mode is asked
+// for, since newer go tools otherwise insist on one.
+func goCommand(dir string, stdout *os.File, args ...string) error {
+	var stderr bytes.Buffer
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+	if !inModule(dir) {
+		cmd.Env = append(os.Environ(), "GO111MODULE=off")
+	}
+	if stdout != nil {
+		cmd.Stdout = stdout
+	}
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("go %s: %s\n%s", args[0], err, stderr.String())
+	}
+	return nil
+}
+
+// inModule returns true if the go tool sees dir as being in a module.
+func inModule(dir string) bool {
+	cmd := exec.Command("go", "env", "GOMOD")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return false
+	}
+	gomod := strings.TrimSpace(string(out))
+	return gomod != "" && gomod != os.DevNull
+}
+
+// ImportSpecPath returns the import path and name given in spec.
+func ImportSpecPath(spec *ast.ImportSpec) (pkgPath string, name string) {
+	pkgPath, _ = strconv.Unquote(spec.Path.Value)
+	if spec.Name != nil {
+		name = spec.Name.Name
+	}
+	return pkgPath, name
+}
@@ -0,0 +1,66 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rocky/go-fish"
+)
+
+func TestParseImports(t *testing.T) {
+	tests := []struct {
+		line  string
+		paths []string
+		names []string
+	}{
+		{`import "strings"`, []string{"strings"}, []string{""}},
+		{`import m "math/rand"`, []string{"math/rand"}, []string{"m"}},
+		{`import ("bytes"; _ "image/png")`, []string{"bytes", "image/png"}, []string{"", "_"}},
+	}
+	for _, test := range tests {
+		specs, err := repl.ParseImports(test.line)
+		if err != nil {
+			t.Errorf("%s: %s", test.line, err)
+			continue
+		}
+		if len(specs) != len(test.paths) {
+			t.Errorf("%s: got %d imports, want %d", test.line, len(specs), len(test.paths))
+			continue
+		}
+		for i, spec := range specs {
+			path, name := repl.ImportSpecPath(spec)
+			if path != test.paths[i] || name != test.names[i] {
+				t.Errorf("%s: import %d is %q %q, want %q %q", test.line, i,
+					name, path, test.names[i], test.paths[i])
+			}
+		}
+	}
+	for _, line := range []string{`import "no-end`, `import strings`} {
+		if _, err := repl.ParseImports(line); err == nil {
+			t.Errorf("%s: expecting an error", line)
+		}
+	}
+}
+
+// TestImportNameClash checks that importing a package under a name
+// already used by a package with another path is refused, as Go does.
+func TestImportNameClash(t *testing.T) {
+	repl.Reset(false)
+	if repl.PkgPath(repl.Env, "rand") != "math/rand" {
+		t.Skip("rand isn't math/rand in this environment")
+	}
+	err := repl.ImportPackage("crypto/rand", "", repl.Env)
+	if err == nil || !strings.Contains(err.Error(), `import crand "crypto/rand"`) {
+		t.Errorf("importing crypto/rand over math/rand gave %v", err)
+	}
+	if path := repl.PkgPath(repl.Env, "rand"); path != "math/rand" {
+		t.Errorf("rand is now %s", path)
+	}
+	if err := repl.ImportPackage("math/rand", "", repl.Env); err != nil {
+		t.Errorf("importing math/rand again: %s", err)
+	}
+}
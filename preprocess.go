@@ -7,11 +7,60 @@
 package repl
 
 import (
+	"go/scanner"
+	"go/token"
 	"strings"
 )
 
 var CmdLine string
 
+// readsAsGo returns true if line, whose first word is name, is Go
+// rather than a command: an assignment to name or, when name is
+// defined in the environment, a selector, index or call on it, or
+// just the name of a variable. This way variables can share a name
+// with a command, as in "fields := strings.Fields(s)".
+func readsAsGo(line string, name string) bool {
+	var s scanner.Scanner
+	src := []byte(line)
+	s.Init(token.NewFileSet().AddFile("", -1, len(src)), src, nil, 0)
+	if _, tok, lit := s.Scan(); tok != token.IDENT || lit != name {
+		return false
+	}
+	_, tok, _ := s.Scan()
+	switch tok {
+	case token.DEFINE, token.ASSIGN, token.INC, token.DEC,
+		token.ADD_ASSIGN, token.SUB_ASSIGN, token.MUL_ASSIGN,
+		token.QUO_ASSIGN, token.REM_ASSIGN, token.AND_ASSIGN,
+		token.OR_ASSIGN, token.XOR_ASSIGN, token.SHL_ASSIGN,
+		token.SHR_ASSIGN, token.AND_NOT_ASSIGN:
+		return true
+	case token.SEMICOLON, token.EOF:
+		return Env != nil && Env.Var(name).IsValid()
+	case token.PERIOD, token.LBRACK, token.LPAREN:
+		if Env == nil {
+			return false
+		}
+		_, isPkg := Env.Pkgs[name]
+		return isPkg || Env.Var(name).IsValid() || Env.Func(name).IsValid()
+	}
+	return false
+}
+
+// CommandName returns the name of the command that line runs, with
+// aliases resolved, or "" if line isn't a command.
+func CommandName(line string) string {
+	line = strings.Trim(line, " \t\n")
+	word := strings.Split(line, " ")[0]
+	name := word
+	if newname := LookupCmd(name); newname != "" {
+		name = newname
+	}
+	if Cmds[name] == nil || readsAsGo(line, word) {
+		return ""
+	}
+	return name
+}
+
 func wasProcessed(line string) bool {
 	CmdLine = strings.Trim(line, " \t\n")
 	args  := strings.Split(CmdLine, " ")
@@ -26,15 +75,13 @@ func wasProcessed(line string) bool {
 		return true
 	}
 
-	name := args[0]
-	if newname := LookupCmd(name); newname != "" {
-		name = newname
-	}
-	cmd := Cmds[name];
-
-	if cmd != nil {
+	name := CommandName(CmdLine)
+	if name != "" {
+		cmd := Cmds[name]
 		if ArgCountOK(cmd.Min_args, cmd.Max_args, args) {
+			StartPaging()
 			Cmds[name].Fn(args)
+			FlushPaging()
 		}
 		return true
 	}
@@ -0,0 +1,112 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"go/token"
+	"reflect"
+	"testing"
+
+	"github.com/rocky/go-fish"
+	"github.com/rocky/go-fish/cmd"
+)
+
+func init() {
+	fishcmd.Init()
+}
+
+// checkCommandNames checks what command, if any, each of lines runs.
+func checkCommandNames(t *testing.T, lines map[string]string) {
+	for line, want := range lines {
+		if got := repl.CommandName(line); got != want {
+			t.Errorf("CommandName(%q) = %q; want %q", line, got, want)
+		}
+	}
+}
+
+func TestCommandNameSig(t *testing.T) {
+	checkCommandNames(t, map[string]string{
+		"sig strings.Split":       "sig",
+		"sig  bytes.Buffer.Write": "sig",
+		"args := os.Args":         "",
+		"args = append(args, x)":  "",
+		"args strings.Split":      "sig",
+		"sig := strings.Split":    "",
+		"sig += 1":                "",
+	})
+}
+
+func TestCommandNameFieldsLayout(t *testing.T) {
+	checkCommandNames(t, map[string]string{
+		"fields bytes.Buffer":         "fields",
+		"layout bytes.Buffer":         "layout",
+		"fields := strings.Fields(s)": "",
+		`layout := "2006-01-02"`:      "",
+		"fields = fields[1:]":         "",
+		`layout = time.RFC3339`:       "",
+	})
+
+	repl.Env = repl.NewEnv()
+	repl.Env.Vars["fields"] = reflect.ValueOf(&[]string{"a", "b"})
+	defer delete(repl.Env.Vars, "fields")
+	checkCommandNames(t, map[string]string{
+		"fields [0]":          "",
+		"fields bytes.Buffer": "fields",
+	})
+}
+
+func TestCommandNameList(t *testing.T) {
+	checkCommandNames(t, map[string]string{
+		"list strings.Fields": "list",
+		"l strings.Fields":    "",
+		"l := len(xs)":        "",
+		"l = append(l, 1)":    "",
+		"list := []int{1, 2}": "",
+	})
+}
+
+// TestCommandNamesAsVariables checks that every command name and alias
+// that can be a Go identifier can still be assigned to, and shown, as
+// a variable.
+func TestCommandNamesAsVariables(t *testing.T) {
+	repl.Env = repl.NewEnv()
+	var names []string
+	for name := range repl.Cmds {
+		names = append(names, name)
+	}
+	for alias := range repl.Aliases {
+		names = append(names, alias)
+	}
+	for _, name := range names {
+		if !token.IsIdentifier(name) {
+			continue
+		}
+		checkCommandNames(t, map[string]string{
+			name + " := 1":                      "",
+			name + " = 1":                       "",
+			name + " += 1":                      "",
+			name + ", err := f()":               "",
+			name + " = append(" + name + ", 1)": "",
+		})
+		if _, ok := repl.Env.Vars[name]; !ok {
+			repl.Env.Vars[name] = reflect.ValueOf(new(int))
+			checkCommandNames(t, map[string]string{name: ""})
+			delete(repl.Env.Vars, name)
+		}
+	}
+	checkCommandNames(t, map[string]string{
+		"env":         "",
+		"env list":    "env",
+		"env use b":   "env",
+		"info vars":   "info",
+		"load x.go":   "load",
+		"doc strings": "doc",
+		"reset":       "reset",
+		"info := 1":   "",
+		"load := 1":   "",
+		"doc := 1":    "",
+		"reset := 1":  "",
+	})
+}
@@ -17,14 +17,15 @@ package repl
 // GNU Readline, lineedit or something else.
 import (
 	"bufio"
+	"bytes"
 	"flag"
 	"fmt"
 	"go/ast"
+	"go/parser"
 	"io"
 	"os"
 	"path/filepath"
 	"reflect"
-	"strconv"
 	"strings"
 
 	"github.com/rocky/eval"
@@ -32,8 +33,13 @@ import (
 
 var Highlight = flag.Bool("highlight", true, `use syntax highlighting in output`)
 
+// CheckFile, if set, is a transcript to replay and check instead of
+// reading input interactively.
+var CheckFile = flag.String("check", "", `replay transcript file and report output that differs`)
+
 // Maxwidth is the size of the line. We will try to wrap text that is
-// longer than this. It like the COLUMNS environment variable
+// longer than this. Unless pinned with "set width", it tracks the
+// terminal width, falling back to the COLUMNS environment variable.
 var Maxwidth int
 
 // ReadLineFnType is function signature for a common read line
@@ -41,13 +47,19 @@ var Maxwidth int
 type ReadLineFnType func(prompt string, add_history ... bool) (string, error)
 var  readLineFn ReadLineFnType
 
+// setReadLineFn records the read line function REPL was given so
+// that others, like the pager, can prompt for input.
+func setReadLineFn(fn ReadLineFnType) {
+	readLineFn = fn
+}
+
 type InspectFnType func(a ...interface{}) string
 
 
 var initial_cwd string
 
 // GOFISH_RESTART_CMD is a string that was used to invoke gofish.
-//If we want to restart gofish, this is what we'll use.
+//If we want to restart gofish, this is what we'll use. See Restart.
 var GOFISH_RESTART_CMD string
 
 
@@ -98,14 +110,9 @@ func SimpleInspect(a ...interface{}) string {
 }
 
 func init() {
-	widthstr := os.Getenv("COLUMNS")
 	initial_cwd, _ = os.Getwd()
 	GOFISH_RESTART_CMD = os.Getenv("GOFISH_RESTART_CMD")
-	if len(widthstr) == 0 {
-		Maxwidth = 80
-	} else if i, err := strconv.Atoi(widthstr); err == nil {
-		Maxwidth = i
-	}
+	UpdateWidth()
 }
 
 // MakeEvalEnv creates an environment to use in evaluation.  The
@@ -116,6 +123,9 @@ func MakeEvalEnv() *eval.SimpleEnv {
 	return EvalEnvironment()
 }
 
+// Prompt is what the REPL prompts for input with.
+const Prompt = "gofish> "
+
 // LeaveREPL is set when we want to quit.
 var LeaveREPL bool = false
 
@@ -125,6 +135,79 @@ var ExitCode  int  = 0
 // Env is the evaluation environment we are working with.
 var Env *eval.SimpleEnv
 
+// results holds the values of expressions entered interactively. It
+// is variable "results" in Env.
+var results []interface{}
+
+// evalExpr is eval.EvalExpr which also returns what the evaluation
+// wrote to stdout. That is copied to the transcript too, if we are
+// logging.
+func evalExpr(expr eval.Expr, env eval.Env) (vals []reflect.Value, output string, err error) {
+	var buf bytes.Buffer
+	TeeStdout(evalOutput(&buf), func() {
+		defer recoverPanic(&err)
+		vals, err = eval.EvalExpr(expr, env)
+	})
+	return vals, buf.String(), err
+}
+
+// EvalString parses, type checks and evaluates expression src in env,
+// as evalExpr does, so that a panic comes back as an error.
+func EvalString(src string, env eval.Env) ([]reflect.Value, error) {
+	expr, err := parser.ParseExpr(src)
+	if err != nil {
+		return nil, err
+	}
+	cexpr, errs := eval.CheckExpr(expr, env)
+	if len(errs) != 0 {
+		return nil, errs[0]
+	}
+	vals, _, err := evalExpr(cexpr, env)
+	return vals, err
+}
+
+// interpStmt is eval.InterpStmt which also returns what the
+// evaluation wrote to stdout. That is copied to the transcript too,
+// if we are logging.
+func interpStmt(stmt eval.Stmt, env eval.Env) (output string, err error) {
+	var buf bytes.Buffer
+	TeeStdout(evalOutput(&buf), func() {
+		defer recoverPanic(&err)
+		_, err = eval.InterpStmt(stmt, env)
+	})
+	return buf.String(), err
+}
+
+// recoverPanic turns a panic, say from a function declared in the
+// REPL, into an error.
+func recoverPanic(err *error) {
+	if x := recover(); x != nil {
+		if e, ok := x.(error); ok {
+			*err = e
+		} else {
+			*err = fmt.Errorf("%v", x)
+		}
+	}
+}
+
+// evalOutput returns the writer that output of evaluated code should
+// be copied to: buf, and the transcript if we are logging.
+func evalOutput(buf *bytes.Buffer) io.Writer {
+	if w := logWriterOrNil(); w != nil {
+		return io.MultiWriter(buf, w)
+	}
+	return buf
+}
+
+// printedValues returns what fmt.Println would print for vals.
+func printedValues(vals []reflect.Value) string {
+	args := make([]interface{}, len(vals))
+	for i, v := range vals {
+		args[i] = v
+	}
+	return fmt.Sprintln(args...)
+}
+
 // REPL is the read, eval, and print loop.
 func REPL(env *eval.SimpleEnv, readLineFn ReadLineFnType, inspectFn InspectFnType) {
 
@@ -132,74 +215,105 @@ func REPL(env *eval.SimpleEnv, readLineFn ReadLineFnType, inspectFn InspectFnTyp
 
 	// A place to store result values of expressions entered
 	// interactively
-	results := make([]interface{}, 0, 10)
+	results = make([]interface{}, 0, 10)
 	env.Vars["results"] = reflect.ValueOf(&results)
 
+	// Env, rather than env, is used below since commands like
+	// "reset" replace it.
 	Env = env
-	exprs := 0
-	line, err := readLineFn("gofish> ", true)
+	readLineFn = replayReadLineFn(readLineFn)
+	setReadLineFn(readLineFn)
+	watchResize()
+	line, err := readLineFn(Prompt, true)
 	for true {
 		if err != nil {
 			if err == io.EOF { break }
 			panic(err)
 		}
+		LogInput(Prompt, line)
+		CheckResize()
+		if isDeclStart(line) {
+			if src, err := readDecl(line); err != nil {
+				Errmsg("%s", err)
+			} else {
+				StartPaging()
+				evalDecls(src, Env)
+				FlushPaging()
+			}
+			line, err = readLineFn(Prompt, true)
+			continue
+		}
 		if wasProcessed(line) {
 			if LeaveREPL {break}
-			line, err = readLineFn("gofish> ", true)
+			line, err = readLineFn(Prompt, true)
 			continue
 		}
+		StartPaging()
 		if stmt, err := eval.ParseStmt(line); err != nil {
 			if pair := eval.FormatErrorPos(line, err.Error()); len(pair) == 2 {
 				Msg(pair[0])
 				Msg(pair[1])
 			}
 			Errmsg("parse error: %s", err)
+		} else if err := checkReservedStmt(stmt); err != nil {
+			Errmsg("%s", err)
 		} else if expr, ok := stmt.(*ast.ExprStmt); ok {
-			if cexpr, errs := eval.CheckExpr(expr.X, env); len(errs) != 0 {
+			if cexpr, errs := eval.CheckExpr(expr.X, Env); len(errs) != 0 {
 				for _, cerr := range errs {
 					Errmsg("%v", cerr)
 				}
-			} else if vals, err := eval.EvalExpr(cexpr, env); err != nil {
+				PrintCallHints(expr.X, errs)
+			} else if vals, output, err := evalExpr(cexpr, Env); err != nil {
 				Errmsg("panic: %s", err)
-			} else if len(vals) == 0 {
-				fmt.Printf("Kind=Slice\nvoid\n")
-			} else if len(vals) == 1 {
-				value := (vals)[0]
-				if value.IsValid() {
-					kind := value.Kind().String()
-					typ  := value.Type().String()
-					if typ != kind {
-						Msg("Kind = %v", kind)
-						Msg("Type = %v", typ)
+			} else {
+				if len(vals) > 0 {
+					output += printedValues(vals)
+				}
+				recordSession(SessionEntry{Input: line, Stmt: stmt,
+					Values: len(vals), Output: output})
+				if len(vals) == 0 {
+					Msg("Kind=Slice\nvoid")
+				} else if len(vals) == 1 {
+					value := (vals)[0]
+					if value.IsValid() {
+						kind := value.Kind().String()
+						typ  := value.Type().String()
+						if typ != kind {
+							Msg("Kind = %v", kind)
+							Msg("Type = %v", typ)
+						} else {
+							Msg("Kind = Type = %v", kind)
+						}
+						Msg("results[%d] = %s", len(results), inspectFn(value))
+						results = append(results, (vals)[0].Interface())
 					} else {
-						Msg("Kind = Type = %v", kind)
+						Msg("%s", value)
 					}
-					Msg("results[%d] = %s", exprs, inspectFn(value))
-					exprs += 1
-					results = append(results, (vals)[0].Interface())
 				} else {
-					Msg("%s", value)
-				}
-			} else {
-				Msg("Kind = Multi-Value")
-				size := len(vals)
-				for i, v := range vals {
-					fmt.Printf("%s", inspectFn(v))
-					if i < size-1 { fmt.Printf(", ") }
+					Msg("Kind = Multi-Value")
+					size := len(vals)
+					for i, v := range vals {
+						MsgNoCr("%s", inspectFn(v))
+						if i < size-1 { MsgNoCr(", ") }
+					}
+					Msg("")
+					results = append(results, vals)
 				}
-				Msg("")
-				exprs += 1
-				results = append(results, vals)
 			}
 		} else {
-			if cstmt, errs := eval.CheckStmt(stmt, env); len(errs) != 0 {
+			if cstmt, errs := eval.CheckStmt(stmt, Env); len(errs) != 0 {
 				for _, cerr := range errs {
 					Errmsg("%v", cerr)
 				}
-			} else if _, err := eval.InterpStmt(cstmt, env); err != nil {
+				PrintCallHints(stmt, errs)
+			} else if output, err := interpStmt(cstmt, Env); err != nil {
 				Errmsg("panic: %s", err)
+			} else {
+				recordSession(SessionEntry{Input: line, Stmt: stmt,
+					Values: -1, Output: output})
 			}
 		}
-		line, err = readLineFn("gofish> ", true)
+		FlushPaging()
+		line, err = readLineFn(Prompt, true)
 	}
 }
@@ -0,0 +1,4857 @@
+// starting import: "github.com/rocky/go-fish"
+// Code generated by make_env -docs. DO NOT EDIT.
+
+package repl
+
+func init() {
+	addSymbolMeta(map[string]SymbolInfo{
+		"bufio": {Doc: "Package bufio implements buffered I/O. It wraps an io.Reader or io.Writer object, creating another object (Reader or Writer) that also implements the interface but provides buffering and some help for textual I/O."},
+		"bufio.ErrInvalidUnreadByte": {Sig: "var ErrInvalidUnreadByte", Doc: "", Pos: "bufio.go:23"},
+		"bufio.ErrInvalidUnreadRune": {Sig: "var ErrInvalidUnreadRune", Doc: "", Pos: "bufio.go:24"},
+		"bufio.ErrBufferFull": {Sig: "var ErrBufferFull", Doc: "", Pos: "bufio.go:25"},
+		"bufio.ErrNegativeCount": {Sig: "var ErrNegativeCount", Doc: "", Pos: "bufio.go:26"},
+		"bufio.Reader": {Sig: "type Reader struct", Doc: "Reader implements buffering for an io.Reader object.", Pos: "bufio.go:35"},
+		"bufio.NewReaderSize": {Sig: "func NewReaderSize(rd io.Reader, size int) *Reader", Doc: "NewReaderSize returns a new [Reader] whose buffer has at least the specified size.", Pos: "bufio.go:50"},
+		"bufio.NewReader": {Sig: "func NewReader(rd io.Reader) *Reader", Doc: "NewReader returns a new [Reader] whose buffer has the default size.", Pos: "bufio.go:62"},
+		"bufio.Reader.Size": {Sig: "func (b *Reader) Size() int", Doc: "Size returns the size of the underlying buffer in bytes.", Pos: "bufio.go:67"},
+		"bufio.Reader.Reset": {Sig: "func (b *Reader) Reset(r io.Reader)", Doc: "Reset discards any buffered data, resets all state, and switches the buffered reader to read from r.", Pos: "bufio.go:74"},
+		"bufio.Reader.Peek": {Sig: "func (b *Reader) Peek(n int) ([]byte, error)", Doc: "Peek returns the next n bytes without advancing the reader.", Pos: "bufio.go:143"},
+		"bufio.Reader.Discard": {Sig: "func (b *Reader) Discard(n int) (discarded int, err error)", Doc: "Discard skips the next n bytes, returning the number of bytes discarded.", Pos: "bufio.go:177"},
+		"bufio.Reader.Read": {Sig: "func (b *Reader) Read(p []byte) (n int, err error)", Doc: "Read reads data into p.", Pos: "bufio.go:216"},
+		"bufio.Reader.ReadByte": {Sig: "func (b *Reader) ReadByte() (byte, error)", Doc: "ReadByte reads and returns a single byte.", Pos: "bufio.go:267"},
+		"bufio.Reader.UnreadByte": {Sig: "func (b *Reader) UnreadByte() error", Doc: "UnreadByte unreads the last byte.", Pos: "bufio.go:286"},
+		"bufio.Reader.ReadRune": {Sig: "func (b *Reader) ReadRune() (r rune, size int, err error)", Doc: "ReadRune reads a single UTF-8 encoded Unicode character and returns the rune and its size in bytes.", Pos: "bufio.go:306"},
+		"bufio.Reader.UnreadRune": {Sig: "func (b *Reader) UnreadRune() error", Doc: "UnreadRune unreads the last rune.", Pos: "bufio.go:325"},
+		"bufio.Reader.Buffered": {Sig: "func (b *Reader) Buffered() int", Doc: "Buffered returns the number of bytes that can be read from the current buffer.", Pos: "bufio.go:336"},
+		"bufio.Reader.ReadSlice": {Sig: "func (b *Reader) ReadSlice(delim byte) (line []byte, err error)", Doc: "ReadSlice reads until the first occurrence of delim in the input, returning a slice pointing at the bytes in the buffer.", Pos: "bufio.go:348"},
+		"bufio.Reader.ReadLine": {Sig: "func (b *Reader) ReadLine() (line []byte, isPrefix bool, err error)", Doc: "ReadLine is a low-level line-reading primitive.", Pos: "bufio.go:405"},
+		"bufio.Reader.ReadBytes": {Sig: "func (b *Reader) ReadBytes(delim byte) ([]byte, error)", Doc: "ReadBytes reads until the first occurrence of delim in the input, returning a slice containing the data up to and including the delimiter.", Pos: "bufio.go:478"},
+		"bufio.Reader.ReadString": {Sig: "func (b *Reader) ReadString(delim byte) (string, error)", Doc: "ReadString reads until the first occurrence of delim in the input, returning a string containing the data up to and including the delimiter.", Pos: "bufio.go:498"},
+		"bufio.Reader.WriteTo": {Sig: "func (b *Reader) WriteTo(w io.Writer) (n int64, err error)", Doc: "WriteTo implements io.WriterTo.", Pos: "bufio.go:515"},
+		"bufio.Writer": {Sig: "type Writer struct", Doc: "Writer implements buffering for an io.Writer object.", Pos: "bufio.go:579"},
+		"bufio.NewWriterSize": {Sig: "func NewWriterSize(w io.Writer, size int) *Writer", Doc: "NewWriterSize returns a new [Writer] whose buffer has at least the specified size.", Pos: "bufio.go:589"},
+		"bufio.NewWriter": {Sig: "func NewWriter(w io.Writer) *Writer", Doc: "NewWriter returns a new [Writer] whose buffer has the default size.", Pos: "bufio.go:607"},
+		"bufio.Writer.Size": {Sig: "func (b *Writer) Size() int", Doc: "Size returns the size of the underlying buffer in bytes.", Pos: "bufio.go:612"},
+		"bufio.Writer.Reset": {Sig: "func (b *Writer) Reset(w io.Writer)", Doc: "Reset discards any unflushed buffered data, clears any error, and resets b to write its output to w.", Pos: "bufio.go:619"},
+		"bufio.Writer.Flush": {Sig: "func (b *Writer) Flush() error", Doc: "Flush writes any buffered data to the underlying io.Writer.", Pos: "bufio.go:635"},
+		"bufio.Writer.Available": {Sig: "func (b *Writer) Available() int", Doc: "Available returns how many bytes are unused in the buffer.", Pos: "bufio.go:659"},
+		"bufio.Writer.AvailableBuffer": {Sig: "func (b *Writer) AvailableBuffer() []byte", Doc: "AvailableBuffer returns an empty buffer with b.Available() capacity.", Pos: "bufio.go:665"},
+		"bufio.Writer.Buffered": {Sig: "func (b *Writer) Buffered() int", Doc: "Buffered returns the number of bytes that have been written into the current buffer.", Pos: "bufio.go:670"},
+		"bufio.Writer.Write": {Sig: "func (b *Writer) Write(p []byte) (nn int, err error)", Doc: "Write writes the contents of p into the buffer.", Pos: "bufio.go:676"},
+		"bufio.Writer.WriteByte": {Sig: "func (b *Writer) WriteByte(c byte) error", Doc: "WriteByte writes a single byte.", Pos: "bufio.go:701"},
+		"bufio.Writer.WriteRune": {Sig: "func (b *Writer) WriteRune(r rune) (size int, err error)", Doc: "WriteRune writes a single Unicode code point, returning the number of bytes written and any error.", Pos: "bufio.go:715"},
+		"bufio.Writer.WriteString": {Sig: "func (b *Writer) WriteString(s string) (int, error)", Doc: "WriteString writes a string.", Pos: "bufio.go:747"},
+		"bufio.Writer.ReadFrom": {Sig: "func (b *Writer) ReadFrom(r io.Reader) (n int64, err error)", Doc: "ReadFrom implements io.ReaderFrom.", Pos: "bufio.go:784"},
+		"bufio.ReadWriter": {Sig: "type ReadWriter struct", Doc: "ReadWriter stores pointers to a [Reader] and a [Writer].", Pos: "bufio.go:834"},
+		"bufio.NewReadWriter": {Sig: "func NewReadWriter(r *Reader, w *Writer) *ReadWriter", Doc: "NewReadWriter allocates a new [ReadWriter] that dispatches to r and w.", Pos: "bufio.go:840"},
+		"bufio.Scanner": {Sig: "type Scanner struct", Doc: "Scanner provides a convenient interface for reading data such as a file of newline-delimited lines of text.", Pos: "scan.go:29"},
+		"bufio.SplitFunc": {Sig: "type SplitFunc func(data []byte, atEOF bool) (advance int, token []byte, err error)", Doc: "SplitFunc is the signature of the split function used to tokenize the input.", Pos: "scan.go:67"},
+		"bufio.ErrTooLong": {Sig: "var ErrTooLong", Doc: "Errors returned by Scanner.", Pos: "scan.go:71"},
+		"bufio.ErrNegativeAdvance": {Sig: "var ErrNegativeAdvance", Doc: "Errors returned by Scanner.", Pos: "scan.go:72"},
+		"bufio.ErrAdvanceTooFar": {Sig: "var ErrAdvanceTooFar", Doc: "Errors returned by Scanner.", Pos: "scan.go:73"},
+		"bufio.ErrBadReadCount": {Sig: "var ErrBadReadCount", Doc: "Errors returned by Scanner.", Pos: "scan.go:74"},
+		"bufio.MaxScanTokenSize": {Sig: "const MaxScanTokenSize", Doc: "MaxScanTokenSize is the maximum size used to buffer a token unless the user provides an explicit buffer with [Scanner.Buffer].", Pos: "scan.go:82"},
+		"bufio.NewScanner": {Sig: "func NewScanner(r io.Reader) *Scanner", Doc: "NewScanner returns a new [Scanner] to read from r.", Pos: "scan.go:89"},
+		"bufio.Scanner.Err": {Sig: "func (s *Scanner) Err() error", Doc: "Err returns the first non-EOF error that was encountered by the [Scanner].", Pos: "scan.go:98"},
+		"bufio.Scanner.Bytes": {Sig: "func (s *Scanner) Bytes() []byte", Doc: "Bytes returns the most recent token generated by a call to [Scanner.Scan].", Pos: "scan.go:108"},
+		"bufio.Scanner.Text": {Sig: "func (s *Scanner) Text() string", Doc: "Text returns the most recent token generated by a call to [Scanner.Scan] as a newly allocated string holding its bytes.", Pos: "scan.go:114"},
+		"bufio.ErrFinalToken": {Sig: "var ErrFinalToken", Doc: "ErrFinalToken is a special sentinel error value.", Pos: "scan.go:128"},
+		"bufio.Scanner.Scan": {Sig: "func (s *Scanner) Scan() bool", Doc: "Scan advances the [Scanner] to the next token, which will then be available through the [Scanner.Bytes] or [Scanner.Text] method.", Pos: "scan.go:139"},
+		"bufio.Scanner.Buffer": {Sig: "func (s *Scanner) Buffer(buf []byte, max int)", Doc: "Buffer controls memory allocation by the Scanner.", Pos: "scan.go:275"},
+		"bufio.Scanner.Split": {Sig: "func (s *Scanner) Split(split SplitFunc)", Doc: "Split sets the split function for the [Scanner].", Pos: "scan.go:287"},
+		"bufio.ScanBytes": {Sig: "func ScanBytes(data []byte, atEOF bool) (advance int, token []byte, err error)", Doc: "ScanBytes is a split function for a [Scanner] that returns each byte as a token.", Pos: "scan.go:297"},
+		"bufio.ScanRunes": {Sig: "func ScanRunes(data []byte, atEOF bool) (advance int, token []byte, err error)", Doc: "ScanRunes is a split function for a [Scanner] that returns each UTF-8-encoded rune as a token.", Pos: "scan.go:312"},
+		"bufio.ScanLines": {Sig: "func ScanLines(data []byte, atEOF bool) (advance int, token []byte, err error)", Doc: "ScanLines is a split function for a [Scanner] that returns each line of text, stripped of any trailing end-of-line marker.", Pos: "scan.go:358"},
+		"bufio.ScanWords": {Sig: "func ScanWords(data []byte, atEOF bool) (advance int, token []byte, err error)", Doc: "ScanWords is a split function for a [Scanner] that returns each space-separated word of text, with surrounding spaces deleted.", Pos: "scan.go:403"},
+		"bytes": {Doc: "Package bytes implements functions for the manipulation of byte slices."},
+		"bytes.Buffer": {Sig: "type Buffer struct", Doc: "A Buffer is a variable-sized buffer of bytes with [Buffer.Read] and [Buffer.Write] methods.", Pos: "buffer.go:20"},
+		"bytes.ErrTooLarge": {Sig: "var ErrTooLarge", Doc: "ErrTooLarge is passed to panic if memory cannot be allocated to store data in a buffer.", Pos: "buffer.go:50"},
+		"bytes.Buffer.Bytes": {Sig: "func (b *Buffer) Bytes() []byte", Doc: "Bytes returns a slice of length b.Len() holding the unread portion of the buffer.", Pos: "buffer.go:60"},
+		"bytes.Buffer.AvailableBuffer": {Sig: "func (b *Buffer) AvailableBuffer() []byte", Doc: "AvailableBuffer returns an empty buffer with b.Available() capacity.", Pos: "buffer.go:66"},
+		"bytes.Buffer.String": {Sig: "func (b *Buffer) String() string", Doc: "String returns the contents of the unread portion of the buffer as a string.", Pos: "buffer.go:72"},
+		"bytes.Buffer.Peek": {Sig: "func (b *Buffer) Peek(n int) ([]byte, error)", Doc: "Peek returns the next n bytes without advancing the buffer.", Pos: "buffer.go:85"},
+		"bytes.Buffer.Len": {Sig: "func (b *Buffer) Len() int", Doc: "Len returns the number of bytes of the unread portion of the buffer; b.Len() == len(b.Bytes()).", Pos: "buffer.go:97"},
+		"bytes.Buffer.Cap": {Sig: "func (b *Buffer) Cap() int", Doc: "Cap returns the capacity of the buffer's underlying byte slice, that is, the total space allocated for the buffer's data.", Pos: "buffer.go:101"},
+		"bytes.Buffer.Available": {Sig: "func (b *Buffer) Available() int", Doc: "Available returns how many bytes are unused in the buffer.", Pos: "buffer.go:104"},
+		"bytes.Buffer.Truncate": {Sig: "func (b *Buffer) Truncate(n int)", Doc: "Truncate discards all but the first n unread bytes from the buffer but continues to use the same allocated storage.", Pos: "buffer.go:109"},
+		"bytes.Buffer.Reset": {Sig: "func (b *Buffer) Reset()", Doc: "Reset resets the buffer to be empty, but it retains the underlying storage for use by future writes.", Pos: "buffer.go:124"},
+		"bytes.Buffer.Grow": {Sig: "func (b *Buffer) Grow(n int)", Doc: "Grow grows the buffer's capacity, if necessary, to guarantee space for another n bytes.", Pos: "buffer.go:182"},
+		"bytes.Buffer.Write": {Sig: "func (b *Buffer) Write(p []byte) (n int, err error)", Doc: "Write appends the contents of p to the buffer, growing the buffer as needed.", Pos: "buffer.go:193"},
+		"bytes.Buffer.WriteString": {Sig: "func (b *Buffer) WriteString(s string) (n int, err error)", Doc: "WriteString appends the contents of s to the buffer, growing the buffer as needed.", Pos: "buffer.go:205"},
+		"bytes.MinRead": {Sig: "const MinRead", Doc: "MinRead is the minimum slice size passed to a [Buffer.Read] call by [Buffer.ReadFrom].", Pos: "buffer.go:218"},
+		"bytes.Buffer.ReadFrom": {Sig: "func (b *Buffer) ReadFrom(r io.Reader) (n int64, err error)", Doc: "ReadFrom reads data from r until EOF and appends it to the buffer, growing the buffer as needed.", Pos: "buffer.go:224"},
+		"bytes.Buffer.WriteTo": {Sig: "func (b *Buffer) WriteTo(w io.Writer) (n int64, err error)", Doc: "WriteTo writes data to w until the buffer is drained or an error occurs.", Pos: "buffer.go:276"},
+		"bytes.Buffer.WriteByte": {Sig: "func (b *Buffer) WriteByte(c byte) error", Doc: "WriteByte appends the byte c to the buffer, growing the buffer as needed.", Pos: "buffer.go:303"},
+		"bytes.Buffer.WriteRune": {Sig: "func (b *Buffer) WriteRune(r rune) (n int, err error)", Doc: "WriteRune appends the UTF-8 encoding of Unicode code point r to the buffer, returning the number of bytes written and a nil error.", Pos: "buffer.go:317"},
+		"bytes.Buffer.Read": {Sig: "func (b *Buffer) Read(p []byte) (n int, err error)", Doc: "Read reads the next len(p) bytes from the buffer or until the buffer is drained.", Pos: "buffer.go:336"},
+		"bytes.Buffer.Next": {Sig: "func (b *Buffer) Next(n int) []byte", Doc: "Next returns a slice containing the next n bytes from the buffer, advancing the buffer as if the bytes had been returned by [Buffer.Read].", Pos: "buffer.go:358"},
+		"bytes.Buffer.ReadByte": {Sig: "func (b *Buffer) ReadByte() (byte, error)", Doc: "ReadByte reads and returns the next byte from the buffer.", Pos: "buffer.go:374"},
+		"bytes.Buffer.ReadRune": {Sig: "func (b *Buffer) ReadRune() (r rune, size int, err error)", Doc: "ReadRune reads and returns the next UTF-8-encoded Unicode code point from the buffer.", Pos: "buffer.go:391"},
+		"bytes.Buffer.UnreadRune": {Sig: "func (b *Buffer) UnreadRune() error", Doc: "UnreadRune unreads the last rune returned by [Buffer.ReadRune].", Pos: "buffer.go:414"},
+		"bytes.Buffer.UnreadByte": {Sig: "func (b *Buffer) UnreadByte() error", Doc: "UnreadByte unreads the last byte returned by the most recent successful read operation that read at least one byte.", Pos: "buffer.go:431"},
+		"bytes.Buffer.ReadBytes": {Sig: "func (b *Buffer) ReadBytes(delim byte) (line []byte, err error)", Doc: "ReadBytes reads until the first occurrence of delim in the input, returning a slice containing the data up to and including the delimiter.", Pos: "buffer.go:448"},
+		"bytes.Buffer.ReadString": {Sig: "func (b *Buffer) ReadString(delim byte) (line string, err error)", Doc: "ReadString reads until the first occurrence of delim in the input, returning a string containing the data up to and including the delimiter.", Pos: "buffer.go:476"},
+		"bytes.NewBuffer": {Sig: "func NewBuffer(buf []byte) *Buffer", Doc: "NewBuffer creates and initializes a new [Buffer] using buf as its initial contents.", Pos: "buffer.go:490"},
+		"bytes.NewBufferString": {Sig: "func NewBufferString(s string) *Buffer", Doc: "NewBufferString creates and initializes a new [Buffer] using string s as its initial contents.", Pos: "buffer.go:498"},
+		"bytes.Equal": {Sig: "func Equal(a, b []byte) bool", Doc: "Equal reports whether a and b are the same length and contain the same bytes.", Pos: "bytes.go:20"},
+		"bytes.Compare": {Sig: "func Compare(a, b []byte) int", Doc: "Compare returns an integer comparing two byte slices lexicographically.", Pos: "bytes.go:28"},
+		"bytes.Count": {Sig: "func Count(s, sep []byte) int", Doc: "Count counts the number of non-overlapping instances of sep in s.", Pos: "bytes.go:57"},
+		"bytes.Contains": {Sig: "func Contains(b, subslice []byte) bool", Doc: "Contains reports whether subslice is within b.", Pos: "bytes.go:77"},
+		"bytes.ContainsAny": {Sig: "func ContainsAny(b []byte, chars string) bool", Doc: "ContainsAny reports whether any of the UTF-8-encoded code points in chars are within b.", Pos: "bytes.go:82"},
+		"bytes.ContainsRune": {Sig: "func ContainsRune(b []byte, r rune) bool", Doc: "ContainsRune reports whether the rune is contained in the UTF-8-encoded byte slice b.", Pos: "bytes.go:87"},
+		"bytes.ContainsFunc": {Sig: "func ContainsFunc(b []byte, f func(rune) bool) bool", Doc: "ContainsFunc reports whether any of the UTF-8-encoded code points r within b satisfy f(r).", Pos: "bytes.go:93"},
+		"bytes.IndexByte": {Sig: "func IndexByte(b []byte, c byte) int", Doc: "IndexByte returns the index of the first instance of c in b, or -1 if c is not present in b.", Pos: "bytes.go:98"},
+		"bytes.LastIndex": {Sig: "func LastIndex(s, sep []byte) int", Doc: "LastIndex returns the index of the last instance of sep in s, or -1 if sep is not present in s.", Pos: "bytes.go:103"},
+		"bytes.LastIndexByte": {Sig: "func LastIndexByte(s []byte, c byte) int", Doc: "LastIndexByte returns the index of the last instance of c in s, or -1 if c is not present in s.", Pos: "bytes.go:122"},
+		"bytes.IndexRune": {Sig: "func IndexRune(s []byte, r rune) int", Doc: "IndexRune interprets s as a sequence of UTF-8-encoded code points.", Pos: "bytes.go:131"},
+		"bytes.IndexAny": {Sig: "func IndexAny(s []byte, chars string) int", Doc: "IndexAny interprets s as a sequence of UTF-8-encoded Unicode code points.", Pos: "bytes.go:213"},
+		"bytes.LastIndexAny": {Sig: "func LastIndexAny(s []byte, chars string) int", Doc: "LastIndexAny interprets s as a sequence of UTF-8-encoded Unicode code points.", Pos: "bytes.go:291"},
+		"bytes.SplitN": {Sig: "func SplitN(s, sep []byte, n int) [][]byte", Doc: "SplitN slices s into subslices separated by sep and returns a slice of the subslices between those separators.", Pos: "bytes.go:410"},
+		"bytes.SplitAfterN": {Sig: "func SplitAfterN(s, sep []byte, n int) [][]byte", Doc: "SplitAfterN slices s into subslices after each instance of sep and returns a slice of those subslices.", Pos: "bytes.go:419"},
+		"bytes.Split": {Sig: "func Split(s, sep []byte) [][]byte", Doc: "Split slices s into all subslices separated by sep and returns a slice of the subslices between those separators.", Pos: "bytes.go:429"},
+		"bytes.SplitAfter": {Sig: "func SplitAfter(s, sep []byte) [][]byte", Doc: "SplitAfter slices s into all subslices after each instance of sep and returns a slice of those subslices.", Pos: "bytes.go:435"},
+		"bytes.Fields": {Sig: "func Fields(s []byte) [][]byte", Doc: "Fields interprets s as a sequence of UTF-8-encoded code points.", Pos: "bytes.go:447"},
+		"bytes.FieldsFunc": {Sig: "func FieldsFunc(s []byte, f func(rune) bool) [][]byte", Doc: "FieldsFunc interprets s as a sequence of UTF-8-encoded code points.", Pos: "bytes.go:506"},
+		"bytes.Join": {Sig: "func Join(s [][]byte, sep []byte) []byte", Doc: "Join concatenates the elements of s to create a new byte slice.", Pos: "bytes.go:551"},
+		"bytes.HasPrefix": {Sig: "func HasPrefix(s, prefix []byte) bool", Doc: "HasPrefix reports whether the byte slice s begins with prefix.", Pos: "bytes.go:584"},
+		"bytes.HasSuffix": {Sig: "func HasSuffix(s, suffix []byte) bool", Doc: "HasSuffix reports whether the byte slice s ends with suffix.", Pos: "bytes.go:589"},
+		"bytes.Map": {Sig: "func Map(mapping func(r rune) rune, s []byte) []byte", Doc: "Map returns a copy of the byte slice s with all its characters modified according to the mapping function.", Pos: "bytes.go:597"},
+		"bytes.Repeat": {Sig: "func Repeat(b []byte, count int) []byte", Doc: "Repeat returns a new byte slice consisting of count copies of b.", Pos: "bytes.go:629"},
+		"bytes.ToUpper": {Sig: "func ToUpper(s []byte) []byte", Doc: "ToUpper returns a copy of the byte slice s with all Unicode letters mapped to their upper case.", Pos: "bytes.go:679"},
+		"bytes.ToLower": {Sig: "func ToLower(s []byte) []byte", Doc: "ToLower returns a copy of the byte slice s with all Unicode letters mapped to their lower case.", Pos: "bytes.go:710"},
+		"bytes.ToTitle": {Sig: "func ToTitle(s []byte) []byte", Doc: "ToTitle treats s as UTF-8-encoded bytes and returns a copy with all the Unicode letters mapped to their title case.", Pos: "bytes.go:739"},
+		"bytes.ToUpperSpecial": {Sig: "func ToUpperSpecial(c unicode.SpecialCase, s []byte) []byte", Doc: "ToUpperSpecial treats s as UTF-8-encoded bytes and returns a copy with all the Unicode letters mapped to their upper case, giving priority to the special casing rules.", Pos: "bytes.go:743"},
+		"bytes.ToLowerSpecial": {Sig: "func ToLowerSpecial(c unicode.SpecialCase, s []byte) []byte", Doc: "ToLowerSpecial treats s as UTF-8-encoded bytes and returns a copy with all the Unicode letters mapped to their lower case, giving priority to the special casing rules.", Pos: "bytes.go:749"},
+		"bytes.ToTitleSpecial": {Sig: "func ToTitleSpecial(c unicode.SpecialCase, s []byte) []byte", Doc: "ToTitleSpecial treats s as UTF-8-encoded bytes and returns a copy with all the Unicode letters mapped to their title case, giving priority to the special casing rules.", Pos: "bytes.go:755"},
+		"bytes.ToValidUTF8": {Sig: "func ToValidUTF8(s, replacement []byte) []byte", Doc: "ToValidUTF8 treats s as UTF-8-encoded bytes and returns a copy with each run of bytes representing invalid UTF-8 replaced with the bytes in replacement, which may be empty.", Pos: "bytes.go:761"},
+		"bytes.Title": {Sig: "func Title(s []byte) []byte", Doc: "Title treats s as UTF-8-encoded bytes and returns a copy with all Unicode letters that begin words mapped to their title case.", Pos: "bytes.go:818"},
+		"bytes.TrimLeftFunc": {Sig: "func TrimLeftFunc(s []byte, f func(r rune) bool) []byte", Doc: "TrimLeftFunc treats s as UTF-8-encoded bytes and returns a subslice of s by slicing off all leading UTF-8-encoded code points c that satisfy f(c).", Pos: "bytes.go:837"},
+		"bytes.TrimRightFunc": {Sig: "func TrimRightFunc(s []byte, f func(r rune) bool) []byte", Doc: "TrimRightFunc returns a subslice of s by slicing off all trailing UTF-8-encoded code points c that satisfy f(c).", Pos: "bytes.go:847"},
+		"bytes.TrimFunc": {Sig: "func TrimFunc(s []byte, f func(r rune) bool) []byte", Doc: "TrimFunc returns a subslice of s by slicing off all leading and trailing UTF-8-encoded code points c that satisfy f(c).", Pos: "bytes.go:860"},
+		"bytes.TrimPrefix": {Sig: "func TrimPrefix(s, prefix []byte) []byte", Doc: "TrimPrefix returns s without the provided leading prefix string.", Pos: "bytes.go:866"},
+		"bytes.TrimSuffix": {Sig: "func TrimSuffix(s, suffix []byte) []byte", Doc: "TrimSuffix returns s without the provided trailing suffix string.", Pos: "bytes.go:875"},
+		"bytes.IndexFunc": {Sig: "func IndexFunc(s []byte, f func(r rune) bool) int", Doc: "IndexFunc interprets s as a sequence of UTF-8-encoded code points.", Pos: "bytes.go:885"},
+		"bytes.LastIndexFunc": {Sig: "func LastIndexFunc(s []byte, f func(r rune) bool) int", Doc: "LastIndexFunc interprets s as a sequence of UTF-8-encoded code points.", Pos: "bytes.go:892"},
+		"bytes.Trim": {Sig: "func Trim(s []byte, cutset string) []byte", Doc: "Trim returns a subslice of s by slicing off all leading and trailing UTF-8-encoded code points contained in cutset.", Pos: "bytes.go:987"},
+		"bytes.TrimLeft": {Sig: "func TrimLeft(s []byte, cutset string) []byte", Doc: "TrimLeft returns a subslice of s by slicing off all leading UTF-8-encoded code points contained in cutset.", Pos: "bytes.go:1006"},
+		"bytes.TrimRight": {Sig: "func TrimRight(s []byte, cutset string) []byte", Doc: "TrimRight returns a subslice of s by slicing off all trailing UTF-8-encoded code points that are contained in cutset.", Pos: "bytes.go:1065"},
+		"bytes.TrimSpace": {Sig: "func TrimSpace(s []byte) []byte", Doc: "TrimSpace returns a subslice of s by slicing off all leading and trailing white space, as defined by Unicode.", Pos: "bytes.go:1111"},
+		"bytes.Runes": {Sig: "func Runes(s []byte) []rune", Doc: "Runes interprets s as a sequence of UTF-8-encoded code points.", Pos: "bytes.go:1144"},
+		"bytes.Replace": {Sig: "func Replace(s, old, new []byte, n int) []byte", Doc: "Replace returns a copy of the slice s with the first n non-overlapping instances of old replaced by new.", Pos: "bytes.go:1162"},
+		"bytes.ReplaceAll": {Sig: "func ReplaceAll(s, old, new []byte) []byte", Doc: "ReplaceAll returns a copy of the slice s with all non-overlapping instances of old replaced by new.", Pos: "bytes.go:1206"},
+		"bytes.EqualFold": {Sig: "func EqualFold(s, t []byte) bool", Doc: "EqualFold reports whether s and t, interpreted as UTF-8 strings, are equal under simple Unicode case-folding, which is a more general form of case-insensitivity.", Pos: "bytes.go:1213"},
+		"bytes.Index": {Sig: "func Index(s, sep []byte) int", Doc: "Index returns the index of the first instance of sep in s, or -1 if sep is not present in s.", Pos: "bytes.go:1288"},
+		"bytes.Cut": {Sig: "func Cut(s, sep []byte) (before, after []byte, found bool)", Doc: "Cut slices s around the first instance of sep, returning the text before and after sep.", Pos: "bytes.go:1381"},
+		"bytes.Clone": {Sig: "func Clone(b []byte) []byte", Doc: "Clone returns a copy of b[:len(b)].", Pos: "bytes.go:1391"},
+		"bytes.CutPrefix": {Sig: "func CutPrefix(s, prefix []byte) (after []byte, found bool)", Doc: "CutPrefix returns s without the provided leading prefix byte slice and reports whether it found the prefix.", Pos: "bytes.go:1404"},
+		"bytes.CutSuffix": {Sig: "func CutSuffix(s, suffix []byte) (before []byte, found bool)", Doc: "CutSuffix returns s without the provided ending suffix byte slice and reports whether it found the suffix.", Pos: "bytes.go:1417"},
+		"bytes.CutLast": {Sig: "func CutLast(s, sep []byte) (before, after []byte, found bool)", Doc: "CutLast slices s around the last instance of sep, returning the text before and after sep.", Pos: "bytes.go:1430"},
+		"bytes.Lines": {Sig: "func Lines(s []byte) iter.Seq[[]byte]", Doc: "Lines returns an iterator over the newline-terminated lines in the byte slice s.", Pos: "iter.go:18"},
+		"bytes.SplitSeq": {Sig: "func SplitSeq(s, sep []byte) iter.Seq[[]byte]", Doc: "SplitSeq returns an iterator over all subslices of s separated by sep.", Pos: "iter.go:67"},
+		"bytes.SplitAfterSeq": {Sig: "func SplitAfterSeq(s, sep []byte) iter.Seq[[]byte]", Doc: "SplitAfterSeq returns an iterator over subslices of s split after each instance of sep.", Pos: "iter.go:75"},
+		"bytes.FieldsSeq": {Sig: "func FieldsSeq(s []byte) iter.Seq[[]byte]", Doc: "FieldsSeq returns an iterator over subslices of s split around runs of whitespace characters, as defined by unicode.IsSpace.", Pos: "iter.go:83"},
+		"bytes.FieldsFuncSeq": {Sig: "func FieldsFuncSeq(s []byte, f func(rune) bool) iter.Seq[[]byte]", Doc: "FieldsFuncSeq returns an iterator over subslices of s split around runs of Unicode code points satisfying f(c).", Pos: "iter.go:116"},
+		"bytes.Reader": {Sig: "type Reader struct", Doc: "A Reader implements the io.Reader, io.ReaderAt, io.WriterTo, io.Seeker, io.ByteScanner, and io.RuneScanner interfaces by reading from a byte slice.", Pos: "reader.go:18"},
+		"bytes.Reader.Len": {Sig: "func (r *Reader) Len() int", Doc: "Len returns the number of bytes of the unread portion of the slice.", Pos: "reader.go:26"},
+		"bytes.Reader.Size": {Sig: "func (r *Reader) Size() int64", Doc: "Size returns the original length of the underlying byte slice.", Pos: "reader.go:36"},
+		"bytes.Reader.Read": {Sig: "func (r *Reader) Read(b []byte) (n int, err error)", Doc: "Read implements the io.Reader interface.", Pos: "reader.go:39"},
+		"bytes.Reader.ReadAt": {Sig: "func (r *Reader) ReadAt(b []byte, off int64) (n int, err error)", Doc: "ReadAt implements the io.ReaderAt interface.", Pos: "reader.go:50"},
+		"bytes.Reader.ReadByte": {Sig: "func (r *Reader) ReadByte() (byte, error)", Doc: "ReadByte implements the io.ByteReader interface.", Pos: "reader.go:66"},
+		"bytes.Reader.UnreadByte": {Sig: "func (r *Reader) UnreadByte() error", Doc: "UnreadByte complements [Reader.ReadByte] in implementing the io.ByteScanner interface.", Pos: "reader.go:77"},
+		"bytes.Reader.ReadRune": {Sig: "func (r *Reader) ReadRune() (ch rune, size int, err error)", Doc: "ReadRune implements the io.RuneReader interface.", Pos: "reader.go:87"},
+		"bytes.Reader.UnreadRune": {Sig: "func (r *Reader) UnreadRune() error", Doc: "UnreadRune complements [Reader.ReadRune] in implementing the io.RuneScanner interface.", Pos: "reader.go:103"},
+		"bytes.Reader.Seek": {Sig: "func (r *Reader) Seek(offset int64, whence int) (int64, error)", Doc: "Seek implements the io.Seeker interface.", Pos: "reader.go:116"},
+		"bytes.Reader.WriteTo": {Sig: "func (r *Reader) WriteTo(w io.Writer) (n int64, err error)", Doc: "WriteTo implements the io.WriterTo interface.", Pos: "reader.go:137"},
+		"bytes.Reader.Reset": {Sig: "func (r *Reader) Reset(b []byte)", Doc: "Reset resets the [Reader] to be reading from b.", Pos: "reader.go:156"},
+		"bytes.NewReader": {Sig: "func NewReader(b []byte) *Reader", Doc: "NewReader returns a new [Reader] reading from b.", Pos: "reader.go:159"},
+		"encoding/binary": {Doc: "Package binary implements simple translation between numbers and byte sequences and encoding and decoding of varints."},
+		"encoding/binary.ByteOrder": {Sig: "type ByteOrder interface", Doc: "A ByteOrder specifies how to convert byte slices into 16-, 32-, or 64-bit unsigned integers.", Pos: "binary.go:39"},
+		"encoding/binary.AppendByteOrder": {Sig: "type AppendByteOrder interface", Doc: "AppendByteOrder specifies how to append 16-, 32-, or 64-bit unsigned integers into a byte slice.", Pos: "binary.go:53"},
+		"encoding/binary.LittleEndian": {Sig: "var LittleEndian littleEndian", Doc: "LittleEndian is the little-endian implementation of [ByteOrder] and [AppendByteOrder].", Pos: "binary.go:61"},
+		"encoding/binary.BigEndian": {Sig: "var BigEndian bigEndian", Doc: "BigEndian is the big-endian implementation of [ByteOrder] and [AppendByteOrder].", Pos: "binary.go:64"},
+		"encoding/binary.Read": {Sig: "func Read(r io.Reader, order ByteOrder, data any) error", Doc: "Read reads structured binary data from r into data.", Pos: "binary.go:258"},
+		"encoding/binary.Decode": {Sig: "func Decode(buf []byte, order ByteOrder, data any) (int, error)", Doc: "Decode decodes binary data from buf into data according to the given byte order.", Pos: "binary.go:297"},
+		"encoding/binary.Write": {Sig: "func Write(w io.Writer, order ByteOrder, data any) error", Doc: "Write writes the binary representation of data into w.", Pos: "binary.go:410"},
+		"encoding/binary.Encode": {Sig: "func Encode(buf []byte, order ByteOrder, data any) (int, error)", Doc: "Encode encodes the binary representation of data into buf according to the given byte order.", Pos: "binary.go:440"},
+		"encoding/binary.Append": {Sig: "func Append(buf []byte, order ByteOrder, data any) ([]byte, error)", Doc: "Append appends the binary representation of data to buf.", Pos: "binary.go:470"},
+		"encoding/binary.Size": {Sig: "func Size(v any) int", Doc: "Size returns how many bytes [Write] would generate to encode the value v, which must be a fixed-size value or a slice of fixed-size values, or a pointer to such data.", Pos: "binary.go:597"},
+		"encoding/binary.NativeEndian": {Sig: "var NativeEndian nativeEndian", Doc: "NativeEndian is the native-endian implementation of [ByteOrder] and [AppendByteOrder].", Pos: "native_endian_little.go:14"},
+		"encoding/binary.MaxVarintLen16": {Sig: "const MaxVarintLen16", Doc: "MaxVarintLenN is the maximum length of a varint-encoded N-bit integer.", Pos: "varint.go:34"},
+		"encoding/binary.MaxVarintLen32": {Sig: "const MaxVarintLen32", Doc: "MaxVarintLenN is the maximum length of a varint-encoded N-bit integer.", Pos: "varint.go:35"},
+		"encoding/binary.MaxVarintLen64": {Sig: "const MaxVarintLen64", Doc: "MaxVarintLenN is the maximum length of a varint-encoded N-bit integer.", Pos: "varint.go:36"},
+		"encoding/binary.AppendUvarint": {Sig: "func AppendUvarint(buf []byte, x uint64) []byte", Doc: "AppendUvarint appends the varint-encoded form of x, as generated by [PutUvarint], to buf and returns the extended buffer.", Pos: "varint.go:41"},
+		"encoding/binary.PutUvarint": {Sig: "func PutUvarint(buf []byte, x uint64) int", Doc: "PutUvarint encodes a uint64 into buf and returns the number of bytes written.", Pos: "varint.go:51"},
+		"encoding/binary.Uvarint": {Sig: "func Uvarint(buf []byte) (uint64, int)", Doc: "Uvarint decodes a uint64 from buf and returns that value and the number of bytes read (> 0).", Pos: "varint.go:68"},
+		"encoding/binary.AppendVarint": {Sig: "func AppendVarint(buf []byte, x int64) []byte", Doc: "AppendVarint appends the varint-encoded form of x, as generated by [PutVarint], to buf and returns the extended buffer.", Pos: "varint.go:91"},
+		"encoding/binary.PutVarint": {Sig: "func PutVarint(buf []byte, x int64) int", Doc: "PutVarint encodes an int64 into buf and returns the number of bytes written.", Pos: "varint.go:101"},
+		"encoding/binary.Varint": {Sig: "func Varint(buf []byte) (int64, int)", Doc: "Varint decodes an int64 from buf and returns that value and the number of bytes read (> 0).", Pos: "varint.go:115"},
+		"encoding/binary.ReadUvarint": {Sig: "func ReadUvarint(r io.ByteReader) (uint64, error)", Doc: "ReadUvarint reads an encoded unsigned integer from r and returns it as a uint64.", Pos: "varint.go:130"},
+		"encoding/binary.ReadVarint": {Sig: "func ReadVarint(r io.ByteReader) (int64, error)", Doc: "ReadVarint reads an encoded signed integer from r and returns it as an int64.", Pos: "varint.go:157"},
+		"errors": {Doc: "Package errors implements functions to manipulate errors."},
+		"errors.New": {Sig: "func New(text string) error", Doc: "New returns an error that formats as the given text.", Pos: "errors.go:64"},
+		"errors.ErrUnsupported": {Sig: "var ErrUnsupported", Doc: "ErrUnsupported indicates that a requested operation cannot be performed, because it is unsupported.", Pos: "errors.go:90"},
+		"errors.Join": {Sig: "func Join(errs ...error) error", Doc: "Join returns an error that wraps the given errors.", Pos: "join.go:20"},
+		"errors.Unwrap": {Sig: "func Unwrap(err error) error", Doc: "Unwrap returns the result of calling the Unwrap method on err, if err's type contains an Unwrap method returning error.", Pos: "wrap.go:17"},
+		"errors.Is": {Sig: "func Is(err, target error) bool", Doc: "Is reports whether any error in err's tree matches target.", Pos: "wrap.go:45"},
+		"errors.As": {Sig: "func As(err error, target any) bool", Doc: "As finds the first error in err's tree that matches target, and if one is found, sets target to that error value and returns true.", Pos: "wrap.go:102"},
+		"errors.AsType": {Sig: "func AsType[E error](err error) (E, bool)", Doc: "AsType finds the first error in err's tree that matches the type E, and if one is found, returns that error value and true.", Pos: "wrap.go:167"},
+		"flag": {Doc: "Package flag implements command-line flag parsing."},
+		"flag.ErrHelp": {Sig: "var ErrHelp", Doc: "ErrHelp is the error returned if the -help or -h flag is invoked but no such flag is defined.", Pos: "flag.go:101"},
+		"flag.Value": {Sig: "type Value interface", Doc: "Value is the interface to the dynamic value stored in a flag.", Pos: "flag.go:360"},
+		"flag.Getter": {Sig: "type Getter interface", Doc: "Getter is an interface that allows the contents of a [Value] to be retrieved.", Pos: "flag.go:369"},
+		"flag.ErrorHandling": {Sig: "type ErrorHandling int", Doc: "ErrorHandling defines how [FlagSet.Parse] behaves if the parse fails.", Pos: "flag.go:375"},
+		"flag.ContinueOnError": {Sig: "const ContinueOnError ErrorHandling", Doc: "These constants cause [FlagSet.Parse] to behave as described if the parse fails.", Pos: "flag.go:379"},
+		"flag.ExitOnError": {Sig: "const ExitOnError", Doc: "These constants cause [FlagSet.Parse] to behave as described if the parse fails.", Pos: "flag.go:380"},
+		"flag.PanicOnError": {Sig: "const PanicOnError", Doc: "These constants cause [FlagSet.Parse] to behave as described if the parse fails.", Pos: "flag.go:381"},
+		"flag.FlagSet": {Sig: "type FlagSet struct", Doc: "A FlagSet represents a set of defined flags.", Pos: "flag.go:389"},
+		"flag.Flag": {Sig: "type Flag struct", Doc: "A Flag represents the state of a flag.", Pos: "flag.go:408"},
+		"flag.FlagSet.Output": {Sig: "func (f *FlagSet) Output() io.Writer", Doc: "Output returns the destination for usage and error messages.", Pos: "flag.go:431"},
+		"flag.FlagSet.Name": {Sig: "func (f *FlagSet) Name() string", Doc: "Name returns the name of the flag set.", Pos: "flag.go:439"},
+		"flag.FlagSet.ErrorHandling": {Sig: "func (f *FlagSet) ErrorHandling() ErrorHandling", Doc: "ErrorHandling returns the error handling behavior of the flag set.", Pos: "flag.go:444"},
+		"flag.FlagSet.SetOutput": {Sig: "func (f *FlagSet) SetOutput(output io.Writer)", Doc: "SetOutput sets the destination for usage and error messages.", Pos: "flag.go:450"},
+		"flag.FlagSet.VisitAll": {Sig: "func (f *FlagSet) VisitAll(fn func(*Flag))", Doc: "VisitAll visits the flags in lexicographical order, calling fn for each.", Pos: "flag.go:456"},
+		"flag.VisitAll": {Sig: "func VisitAll(fn func(*Flag))", Doc: "VisitAll visits the command-line flags in lexicographical order, calling fn for each.", Pos: "flag.go:464"},
+		"flag.FlagSet.Visit": {Sig: "func (f *FlagSet) Visit(fn func(*Flag))", Doc: "Visit visits the flags in lexicographical order, calling fn for each.", Pos: "flag.go:470"},
+		"flag.Visit": {Sig: "func Visit(fn func(*Flag))", Doc: "Visit visits the command-line flags in lexicographical order, calling fn for each.", Pos: "flag.go:478"},
+		"flag.FlagSet.Lookup": {Sig: "func (f *FlagSet) Lookup(name string) *Flag", Doc: "Lookup returns the [Flag] structure of the named flag, returning nil if none exists.", Pos: "flag.go:483"},
+		"flag.Lookup": {Sig: "func Lookup(name string) *Flag", Doc: "Lookup returns the [Flag] structure of the named command-line flag, returning nil if none exists.", Pos: "flag.go:489"},
+		"flag.FlagSet.Set": {Sig: "func (f *FlagSet) Set(name, value string) error", Doc: "Set sets the value of the named flag.", Pos: "flag.go:494"},
+		"flag.Set": {Sig: "func Set(name, value string) error", Doc: "Set sets the value of the named command-line flag.", Pos: "flag.go:532"},
+		"flag.UnquoteUsage": {Sig: "func UnquoteUsage(flag *Flag) (name string, usage string)", Doc: "UnquoteUsage extracts a back-quoted name from the usage string for a flag and returns it and the un-quoted usage.", Pos: "flag.go:568"},
+		"flag.FlagSet.PrintDefaults": {Sig: "func (f *FlagSet) PrintDefaults()", Doc: "PrintDefaults prints, to standard error unless configured otherwise, the default values of all defined command-line flags in the set.", Pos: "flag.go:607"},
+		"flag.PrintDefaults": {Sig: "func PrintDefaults()", Doc: "PrintDefaults prints, to standard error unless configured otherwise, a usage message showing the default settings of all defined command-line flags.", Pos: "flag.go:679"},
+		"flag.Usage": {Sig: "var Usage", Doc: "Usage prints a usage message documenting all defined command-line flags to [CommandLine]'s output, which by default is os.Stderr.", Pos: "flag.go:706"},
+		"flag.FlagSet.NFlag": {Sig: "func (f *FlagSet) NFlag() int", Doc: "NFlag returns the number of flags that have been set.", Pos: "flag.go:712"},
+		"flag.NFlag": {Sig: "func NFlag() int", Doc: "NFlag returns the number of command-line flags that have been set.", Pos: "flag.go:715"},
+		"flag.FlagSet.Arg": {Sig: "func (f *FlagSet) Arg(i int) string", Doc: "Arg returns the i'th argument.", Pos: "flag.go:720"},
+		"flag.Arg": {Sig: "func Arg(i int) string", Doc: "Arg returns the i'th command-line argument.", Pos: "flag.go:730"},
+		"flag.FlagSet.NArg": {Sig: "func (f *FlagSet) NArg() int", Doc: "NArg is the number of arguments remaining after flags have been processed.", Pos: "flag.go:735"},
+		"flag.NArg": {Sig: "func NArg() int", Doc: "NArg is the number of arguments remaining after flags have been processed.", Pos: "flag.go:738"},
+		"flag.FlagSet.Args": {Sig: "func (f *FlagSet) Args() []string", Doc: "Args returns the non-flag arguments.", Pos: "flag.go:741"},
+		"flag.Args": {Sig: "func Args() []string", Doc: "Args returns the non-flag command-line arguments.", Pos: "flag.go:744"},
+		"flag.FlagSet.BoolVar": {Sig: "func (f *FlagSet) BoolVar(p *bool, name string, value bool, usage string)", Doc: "BoolVar defines a bool flag with specified name, default value, and usage string.", Pos: "flag.go:748"},
+		"flag.BoolVar": {Sig: "func BoolVar(p *bool, name string, value bool, usage string)", Doc: "BoolVar defines a bool flag with specified name, default value, and usage string.", Pos: "flag.go:754"},
+		"flag.FlagSet.Bool": {Sig: "func (f *FlagSet) Bool(name string, value bool, usage string) *bool", Doc: "Bool defines a bool flag with specified name, default value, and usage string.", Pos: "flag.go:760"},
+		"flag.Bool": {Sig: "func Bool(name string, value bool, usage string) *bool", Doc: "Bool defines a bool flag with specified name, default value, and usage string.", Pos: "flag.go:768"},
+		"flag.FlagSet.IntVar": {Sig: "func (f *FlagSet) IntVar(p *int, name string, value int, usage string)", Doc: "IntVar defines an int flag with specified name, default value, and usage string.", Pos: "flag.go:774"},
+		"flag.IntVar": {Sig: "func IntVar(p *int, name string, value int, usage string)", Doc: "IntVar defines an int flag with specified name, default value, and usage string.", Pos: "flag.go:780"},
+		"flag.FlagSet.Int": {Sig: "func (f *FlagSet) Int(name string, value int, usage string) *int", Doc: "Int defines an int flag with specified name, default value, and usage string.", Pos: "flag.go:786"},
+		"flag.Int": {Sig: "func Int(name string, value int, usage string) *int", Doc: "Int defines an int flag with specified name, default value, and usage string.", Pos: "flag.go:794"},
+		"flag.FlagSet.Int64Var": {Sig: "func (f *FlagSet) Int64Var(p *int64, name string, value int64, usage string)", Doc: "Int64Var defines an int64 flag with specified name, default value, and usage string.", Pos: "flag.go:800"},
+		"flag.Int64Var": {Sig: "func Int64Var(p *int64, name string, value int64, usage string)", Doc: "Int64Var defines an int64 flag with specified name, default value, and usage string.", Pos: "flag.go:806"},
+		"flag.FlagSet.Int64": {Sig: "func (f *FlagSet) Int64(name string, value int64, usage string) *int64", Doc: "Int64 defines an int64 flag with specified name, default value, and usage string.", Pos: "flag.go:812"},
+		"flag.Int64": {Sig: "func Int64(name string, value int64, usage string) *int64", Doc: "Int64 defines an int64 flag with specified name, default value, and usage string.", Pos: "flag.go:820"},
+		"flag.FlagSet.UintVar": {Sig: "func (f *FlagSet) UintVar(p *uint, name string, value uint, usage string)", Doc: "UintVar defines a uint flag with specified name, default value, and usage string.", Pos: "flag.go:826"},
+		"flag.UintVar": {Sig: "func UintVar(p *uint, name string, value uint, usage string)", Doc: "UintVar defines a uint flag with specified name, default value, and usage string.", Pos: "flag.go:832"},
+		"flag.FlagSet.Uint": {Sig: "func (f *FlagSet) Uint(name string, value uint, usage string) *uint", Doc: "Uint defines a uint flag with specified name, default value, and usage string.", Pos: "flag.go:838"},
+		"flag.Uint": {Sig: "func Uint(name string, value uint, usage string) *uint", Doc: "Uint defines a uint flag with specified name, default value, and usage string.", Pos: "flag.go:846"},
+		"flag.FlagSet.Uint64Var": {Sig: "func (f *FlagSet) Uint64Var(p *uint64, name string, value uint64, usage string)", Doc: "Uint64Var defines a uint64 flag with specified name, default value, and usage string.", Pos: "flag.go:852"},
+		"flag.Uint64Var": {Sig: "func Uint64Var(p *uint64, name string, value uint64, usage string)", Doc: "Uint64Var defines a uint64 flag with specified name, default value, and usage string.", Pos: "flag.go:858"},
+		"flag.FlagSet.Uint64": {Sig: "func (f *FlagSet) Uint64(name string, value uint64, usage string) *uint64", Doc: "Uint64 defines a uint64 flag with specified name, default value, and usage string.", Pos: "flag.go:864"},
+		"flag.Uint64": {Sig: "func Uint64(name string, value uint64, usage string) *uint64", Doc: "Uint64 defines a uint64 flag with specified name, default value, and usage string.", Pos: "flag.go:872"},
+		"flag.FlagSet.StringVar": {Sig: "func (f *FlagSet) StringVar(p *string, name string, value string, usage string)", Doc: "StringVar defines a string flag with specified name, default value, and usage string.", Pos: "flag.go:878"},
+		"flag.StringVar": {Sig: "func StringVar(p *string, name string, value string, usage string)", Doc: "StringVar defines a string flag with specified name, default value, and usage string.", Pos: "flag.go:884"},
+		"flag.FlagSet.String": {Sig: "func (f *FlagSet) String(name string, value string, usage string) *string", Doc: "String defines a string flag with specified name, default value, and usage string.", Pos: "flag.go:890"},
+		"flag.String": {Sig: "func String(name string, value string, usage string) *string", Doc: "String defines a string flag with specified name, default value, and usage string.", Pos: "flag.go:898"},
+		"flag.FlagSet.Float64Var": {Sig: "func (f *FlagSet) Float64Var(p *float64, name string, value float64, usage string)", Doc: "Float64Var defines a float64 flag with specified name, default value, and usage string.", Pos: "flag.go:904"},
+		"flag.Float64Var": {Sig: "func Float64Var(p *float64, name string, value float64, usage string)", Doc: "Float64Var defines a float64 flag with specified name, default value, and usage string.", Pos: "flag.go:910"},
+		"flag.FlagSet.Float64": {Sig: "func (f *FlagSet) Float64(name string, value float64, usage string) *float64", Doc: "Float64 defines a float64 flag with specified name, default value, and usage string.", Pos: "flag.go:916"},
+		"flag.Float64": {Sig: "func Float64(name string, value float64, usage string) *float64", Doc: "Float64 defines a float64 flag with specified name, default value, and usage string.", Pos: "flag.go:924"},
+		"flag.FlagSet.DurationVar": {Sig: "func (f *FlagSet) DurationVar(p *time.Duration, name string, value time.Duration, usage string)", Doc: "DurationVar defines a time.Duration flag with specified name, default value, and usage string.", Pos: "flag.go:931"},
+		"flag.DurationVar": {Sig: "func DurationVar(p *time.Duration, name string, value time.Duration, usage string)", Doc: "DurationVar defines a time.Duration flag with specified name, default value, and usage string.", Pos: "flag.go:938"},
+		"flag.FlagSet.Duration": {Sig: "func (f *FlagSet) Duration(name string, value time.Duration, usage string) *time.Duration", Doc: "Duration defines a time.Duration flag with specified name, default value, and usage string.", Pos: "flag.go:945"},
+		"flag.Duration": {Sig: "func Duration(name string, value time.Duration, usage string) *time.Duration", Doc: "Duration defines a time.Duration flag with specified name, default value, and usage string.", Pos: "flag.go:954"},
+		"flag.FlagSet.TextVar": {Sig: "func (f *FlagSet) TextVar(p encoding.TextUnmarshaler, name string, value encoding.TextMarshaler, usage string)", Doc: "TextVar defines a flag with a specified name, default value, and usage string.", Pos: "flag.go:963"},
+		"flag.TextVar": {Sig: "func TextVar(p encoding.TextUnmarshaler, name string, value encoding.TextMarshaler, usage string)", Doc: "TextVar defines a flag with a specified name, default value, and usage string.", Pos: "flag.go:972"},
+		"flag.FlagSet.Func": {Sig: "func (f *FlagSet) Func(name, usage string, fn func(string) error)", Doc: "Func defines a flag with the specified name and usage string.", Pos: "flag.go:979"},
+		"flag.Func": {Sig: "func Func(name, usage string, fn func(string) error)", Doc: "Func defines a flag with the specified name and usage string.", Pos: "flag.go:986"},
+		"flag.FlagSet.BoolFunc": {Sig: "func (f *FlagSet) BoolFunc(name, usage string, fn func(string) error)", Doc: "BoolFunc defines a flag with the specified name and usage string without requiring values.", Pos: "flag.go:993"},
+		"flag.BoolFunc": {Sig: "func BoolFunc(name, usage string, fn func(string) error)", Doc: "BoolFunc defines a flag with the specified name and usage string without requiring values.", Pos: "flag.go:1000"},
+		"flag.FlagSet.Var": {Sig: "func (f *FlagSet) Var(value Value, name string, usage string)", Doc: "Var defines a flag with the specified name and usage string.", Pos: "flag.go:1010"},
+		"flag.Var": {Sig: "func Var(value Value, name string, usage string)", Doc: "Var defines a flag with the specified name and usage string.", Pos: "flag.go:1045"},
+		"flag.FlagSet.Parse": {Sig: "func (f *FlagSet) Parse(arguments []string) error", Doc: "Parse parses flag definitions from the argument list, which should not include the command name.", Pos: "flag.go:1153"},
+		"flag.FlagSet.Parsed": {Sig: "func (f *FlagSet) Parsed() bool", Doc: "Parsed reports whether f.Parse has been called.", Pos: "flag.go:1180"},
+		"flag.Parse": {Sig: "func Parse()", Doc: "Parse parses the command-line flags from os.Args[1:].", Pos: "flag.go:1186"},
+		"flag.Parsed": {Sig: "func Parsed() bool", Doc: "Parsed reports whether the command-line flags have been parsed.", Pos: "flag.go:1192"},
+		"flag.CommandLine": {Sig: "var CommandLine *FlagSet", Doc: "CommandLine is the default set of command-line flags, parsed from os.Args.", Pos: "flag.go:1199"},
+		"flag.NewFlagSet": {Sig: "func NewFlagSet(name string, errorHandling ErrorHandling) *FlagSet", Doc: "NewFlagSet returns a new, empty flag set with the specified name and error handling property.", Pos: "flag.go:1223"},
+		"flag.FlagSet.Init": {Sig: "func (f *FlagSet) Init(name string, errorHandling ErrorHandling)", Doc: "Init sets the name and error handling property for a flag set.", Pos: "flag.go:1235"},
+		"fmt": {Doc: "Package fmt implements formatted I/O with functions analogous to C's printf and scanf."},
+		"fmt.Errorf": {Sig: "func Errorf(format string, a ...any) (err error)", Doc: "Errorf formats according to a format specifier and returns the string as a value that satisfies error.", Pos: "errors.go:23"},
+		"fmt.State": {Sig: "type State interface", Doc: "State represents the printer state passed to custom formatters.", Pos: "print.go:39"},
+		"fmt.Formatter": {Sig: "type Formatter interface", Doc: "Formatter is implemented by any value that has a Format method.", Pos: "print.go:54"},
+		"fmt.Stringer": {Sig: "type Stringer interface", Doc: "Stringer is implemented by any value that has a String method, which defines the “native” format for that value.", Pos: "print.go:63"},
+		"fmt.GoStringer": {Sig: "type GoStringer interface", Doc: "GoStringer is implemented by any value that has a GoString method, which defines the Go syntax for that value.", Pos: "print.go:71"},
+		"fmt.FormatString": {Sig: "func FormatString(state State, verb rune) string", Doc: "FormatString returns a string representing the fully qualified formatting directive captured by the [State], followed by the argument verb.", Pos: "print.go:81"},
+		"fmt.Fprintf": {Sig: "func Fprintf(w io.Writer, format string, a ...any) (n int, err error)", Doc: "Fprintf formats according to a format specifier and writes to w.", Pos: "print.go:214"},
+		"fmt.Printf": {Sig: "func Printf(format string, a ...any) (n int, err error)", Doc: "Printf formats according to a format specifier and writes to standard output.", Pos: "print.go:224"},
+		"fmt.Sprintf": {Sig: "func Sprintf(format string, a ...any) string", Doc: "Sprintf formats according to a format specifier and returns the resulting string.", Pos: "print.go:229"},
+		"fmt.Appendf": {Sig: "func Appendf(b []byte, format string, a ...any) []byte", Doc: "Appendf formats according to a format specifier, appends the result to the byte slice, and returns the updated slice.", Pos: "print.go:239"},
+		"fmt.Fprint": {Sig: "func Fprint(w io.Writer, a ...any) (n int, err error)", Doc: "Fprint formats using the default formats for its operands and writes to w.", Pos: "print.go:252"},
+		"fmt.Print": {Sig: "func Print(a ...any) (n int, err error)", Doc: "Print formats using the default formats for its operands and writes to standard output.", Pos: "print.go:263"},
+		"fmt.Sprint": {Sig: "func Sprint(a ...any) string", Doc: "Sprint formats using the default formats for its operands and returns the resulting string.", Pos: "print.go:269"},
+		"fmt.Append": {Sig: "func Append(b []byte, a ...any) []byte", Doc: "Append formats using the default formats for its operands, appends the result to the byte slice, and returns the updated slice.", Pos: "print.go:280"},
+		"fmt.Fprintln": {Sig: "func Fprintln(w io.Writer, a ...any) (n int, err error)", Doc: "Fprintln formats using the default formats for its operands and writes to w.", Pos: "print.go:295"},
+		"fmt.Println": {Sig: "func Println(a ...any) (n int, err error)", Doc: "Println formats using the default formats for its operands and writes to standard output.", Pos: "print.go:306"},
+		"fmt.Sprintln": {Sig: "func Sprintln(a ...any) string", Doc: "Sprintln formats using the default formats for its operands and returns the resulting string.", Pos: "print.go:312"},
+		"fmt.Appendln": {Sig: "func Appendln(b []byte, a ...any) []byte", Doc: "Appendln formats using the default formats for its operands, appends the result to the byte slice, and returns the updated slice.", Pos: "print.go:323"},
+		"fmt.ScanState": {Sig: "type ScanState interface", Doc: "ScanState represents the scanner state passed to custom scanners.", Pos: "scan.go:21"},
+		"fmt.Scanner": {Sig: "type Scanner interface", Doc: "Scanner is implemented by any value that has a Scan method, which scans the input for the representation of a value and stores the result in the receiver, which must be a pointer to be useful.", Pos: "scan.go:55"},
+		"fmt.Scan": {Sig: "func Scan(a ...any) (n int, err error)", Doc: "Scan scans text read from standard input, storing successive space-separated values into successive arguments.", Pos: "scan.go:63"},
+		"fmt.Scanln": {Sig: "func Scanln(a ...any) (n int, err error)", Doc: "Scanln is similar to [Scan], but stops scanning at a newline and after the final item there must be a newline or EOF.", Pos: "scan.go:69"},
+		"fmt.Scanf": {Sig: "func Scanf(format string, a ...any) (n int, err error)", Doc: "Scanf scans text read from standard input, storing successive space-separated values into successive arguments as determined by the format.", Pos: "scan.go:80"},
+		"fmt.Sscan": {Sig: "func Sscan(str string, a ...any) (n int, err error)", Doc: "Sscan scans the argument string, storing successive space-separated values into successive arguments.", Pos: "scan.go:99"},
+		"fmt.Sscanln": {Sig: "func Sscanln(str string, a ...any) (n int, err error)", Doc: "Sscanln is similar to [Sscan], but stops scanning at a newline and after the final item there must be a newline or EOF.", Pos: "scan.go:105"},
+		"fmt.Sscanf": {Sig: "func Sscanf(str string, format string, a ...any) (n int, err error)", Doc: "Sscanf scans the argument string, storing successive space-separated values into successive arguments as determined by the format.", Pos: "scan.go:113"},
+		"fmt.Fscan": {Sig: "func Fscan(r io.Reader, a ...any) (n int, err error)", Doc: "Fscan scans text read from r, storing successive space-separated values into successive arguments.", Pos: "scan.go:121"},
+		"fmt.Fscanln": {Sig: "func Fscanln(r io.Reader, a ...any) (n int, err error)", Doc: "Fscanln is similar to [Fscan], but stops scanning at a newline and after the final item there must be a newline or EOF.", Pos: "scan.go:130"},
+		"fmt.Fscanf": {Sig: "func Fscanf(r io.Reader, format string, a ...any) (n int, err error)", Doc: "Fscanf scans text read from r, storing successive space-separated values into successive arguments as determined by the format.", Pos: "scan.go:141"},
+		"github.com/mgutz/ansi": {Doc: "Package ansi is a small, fast library to create ANSI colored strings and codes."},
+		"github.com/mgutz/ansi.Reset": {Sig: "const Reset", Doc: "Reset is the ANSI reset escape sequence", Pos: "ansi.go:34"},
+		"github.com/mgutz/ansi.DefaultBG": {Sig: "const DefaultBG", Doc: "DefaultBG is the default background", Pos: "ansi.go:36"},
+		"github.com/mgutz/ansi.DefaultFG": {Sig: "const DefaultFG", Doc: "DefaultFG is the default foreground", Pos: "ansi.go:38"},
+		"github.com/mgutz/ansi.Black": {Sig: "var Black string", Doc: "Black FG", Pos: "ansi.go:42"},
+		"github.com/mgutz/ansi.Red": {Sig: "var Red string", Doc: "Red FG", Pos: "ansi.go:45"},
+		"github.com/mgutz/ansi.Green": {Sig: "var Green string", Doc: "Green FG", Pos: "ansi.go:48"},
+		"github.com/mgutz/ansi.Yellow": {Sig: "var Yellow string", Doc: "Yellow FG", Pos: "ansi.go:51"},
+		"github.com/mgutz/ansi.Blue": {Sig: "var Blue string", Doc: "Blue FG", Pos: "ansi.go:54"},
+		"github.com/mgutz/ansi.Magenta": {Sig: "var Magenta string", Doc: "Magenta FG", Pos: "ansi.go:57"},
+		"github.com/mgutz/ansi.Cyan": {Sig: "var Cyan string", Doc: "Cyan FG", Pos: "ansi.go:60"},
+		"github.com/mgutz/ansi.White": {Sig: "var White string", Doc: "White FG", Pos: "ansi.go:63"},
+		"github.com/mgutz/ansi.LightBlack": {Sig: "var LightBlack string", Doc: "LightBlack FG", Pos: "ansi.go:66"},
+		"github.com/mgutz/ansi.LightRed": {Sig: "var LightRed string", Doc: "LightRed FG", Pos: "ansi.go:69"},
+		"github.com/mgutz/ansi.LightGreen": {Sig: "var LightGreen string", Doc: "LightGreen FG", Pos: "ansi.go:72"},
+		"github.com/mgutz/ansi.LightYellow": {Sig: "var LightYellow string", Doc: "LightYellow FG", Pos: "ansi.go:75"},
+		"github.com/mgutz/ansi.LightBlue": {Sig: "var LightBlue string", Doc: "LightBlue FG", Pos: "ansi.go:78"},
+		"github.com/mgutz/ansi.LightMagenta": {Sig: "var LightMagenta string", Doc: "LightMagenta FG", Pos: "ansi.go:81"},
+		"github.com/mgutz/ansi.LightCyan": {Sig: "var LightCyan string", Doc: "LightCyan FG", Pos: "ansi.go:84"},
+		"github.com/mgutz/ansi.LightWhite": {Sig: "var LightWhite string", Doc: "LightWhite FG", Pos: "ansi.go:87"},
+		"github.com/mgutz/ansi.Colors": {Sig: "var Colors", Doc: "Colors maps common color names to their ANSI color code.", Pos: "ansi.go:92"},
+		"github.com/mgutz/ansi.ColorCode": {Sig: "func ColorCode(style string) string", Doc: "ColorCode returns the ANSI color color code for style.", Pos: "ansi.go:129"},
+		"github.com/mgutz/ansi.Color": {Sig: "func Color(s, style string) string", Doc: "Color colors a string based on the ANSI color code for style.", Pos: "ansi.go:217"},
+		"github.com/mgutz/ansi.ColorFunc": {Sig: "func ColorFunc(style string) func(string) string", Doc: "ColorFunc creates a closure to avoid computation ANSI color code.", Pos: "ansi.go:228"},
+		"github.com/mgutz/ansi.DisableColors": {Sig: "func DisableColors(disable bool)", Doc: "DisableColors disables ANSI color codes.", Pos: "ansi.go:248"},
+		"go/ast": {Doc: "Package ast declares the types used to represent syntax trees for Go packages."},
+		"go/ast.Node": {Sig: "type Node interface", Doc: "All node types implement the Node interface.", Pos: "ast.go:36"},
+		"go/ast.Expr": {Sig: "type Expr interface", Doc: "All expression nodes implement the Expr interface.", Pos: "ast.go:42"},
+		"go/ast.Stmt": {Sig: "type Stmt interface", Doc: "All statement nodes implement the Stmt interface.", Pos: "ast.go:48"},
+		"go/ast.Decl": {Sig: "type Decl interface", Doc: "All declaration nodes implement the Decl interface.", Pos: "ast.go:54"},
+		"go/ast.Comment": {Sig: "type Comment struct", Doc: "A Comment node represents a single //-style or /*-style comment.", Pos: "ast.go:68"},
+		"go/ast.Comment.Pos": {Sig: "func (c *Comment) Pos() token.Pos", Doc: "", Pos: "ast.go:73"},
+		"go/ast.Comment.End": {Sig: "func (c *Comment) End() token.Pos", Doc: "", Pos: "ast.go:74"},
+		"go/ast.CommentGroup": {Sig: "type CommentGroup struct", Doc: "A CommentGroup represents a sequence of comments with no other tokens and no empty lines between.", Pos: "ast.go:78"},
+		"go/ast.CommentGroup.Pos": {Sig: "func (g *CommentGroup) Pos() token.Pos", Doc: "", Pos: "ast.go:82"},
+		"go/ast.CommentGroup.End": {Sig: "func (g *CommentGroup) End() token.Pos", Doc: "", Pos: "ast.go:83"},
+		"go/ast.CommentGroup.Text": {Sig: "func (g *CommentGroup) Text() string", Doc: "Text returns the text of the comment.", Pos: "ast.go:101"},
+		"go/ast.Field": {Sig: "type Field struct", Doc: "A Field represents a Field declaration list in a struct type, a method list in an interface type, or a parameter/result declaration in a signature.", Pos: "ast.go:201"},
+		"go/ast.Field.Pos": {Sig: "func (f *Field) Pos() token.Pos", Doc: "", Pos: "ast.go:209"},
+		"go/ast.Field.End": {Sig: "func (f *Field) End() token.Pos", Doc: "", Pos: "ast.go:219"},
+		"go/ast.FieldList": {Sig: "type FieldList struct", Doc: "A FieldList represents a list of Fields, enclosed by parentheses, curly braces, or square brackets.", Pos: "ast.go:234"},
+		"go/ast.FieldList.Pos": {Sig: "func (f *FieldList) Pos() token.Pos", Doc: "", Pos: "ast.go:240"},
+		"go/ast.FieldList.End": {Sig: "func (f *FieldList) End() token.Pos", Doc: "", Pos: "ast.go:252"},
+		"go/ast.FieldList.NumFields": {Sig: "func (f *FieldList) NumFields() int", Doc: "NumFields returns the number of parameters or struct fields represented by a [FieldList].", Pos: "ast.go:265"},
+		"go/ast.BadExpr": {Sig: "type BadExpr struct", Doc: "A BadExpr node is a placeholder for an expression containing syntax errors for which a correct expression node cannot be created.", Pos: "ast.go:286"},
+		"go/ast.Ident": {Sig: "type Ident struct", Doc: "An Ident node represents an identifier.", Pos: "ast.go:291"},
+		"go/ast.Ellipsis": {Sig: "type Ellipsis struct", Doc: "An Ellipsis node stands for the \"...\" type in a parameter list or the \"...\" length in an array type.", Pos: "ast.go:300"},
+		"go/ast.BasicLit": {Sig: "type BasicLit struct", Doc: "A BasicLit node represents a literal of basic type.", Pos: "ast.go:316"},
+		"go/ast.FuncLit": {Sig: "type FuncLit struct", Doc: "A FuncLit node represents a function literal.", Pos: "ast.go:324"},
+		"go/ast.CompositeLit": {Sig: "type CompositeLit struct", Doc: "A CompositeLit node represents a composite literal.", Pos: "ast.go:330"},
+		"go/ast.ParenExpr": {Sig: "type ParenExpr struct", Doc: "A ParenExpr node represents a parenthesized expression.", Pos: "ast.go:339"},
+		"go/ast.SelectorExpr": {Sig: "type SelectorExpr struct", Doc: "A SelectorExpr node represents an expression followed by a selector.", Pos: "ast.go:346"},
+		"go/ast.IndexExpr": {Sig: "type IndexExpr struct", Doc: "An IndexExpr node represents an expression followed by an index.", Pos: "ast.go:352"},
+		"go/ast.IndexListExpr": {Sig: "type IndexListExpr struct", Doc: "An IndexListExpr node represents an expression followed by multiple indices.", Pos: "ast.go:361"},
+		"go/ast.SliceExpr": {Sig: "type SliceExpr struct", Doc: "A SliceExpr node represents an expression followed by slice indices.", Pos: "ast.go:369"},
+		"go/ast.TypeAssertExpr": {Sig: "type TypeAssertExpr struct", Doc: "A TypeAssertExpr node represents an expression followed by a type assertion.", Pos: "ast.go:382"},
+		"go/ast.CallExpr": {Sig: "type CallExpr struct", Doc: "A CallExpr node represents an expression followed by an argument list.", Pos: "ast.go:390"},
+		"go/ast.StarExpr": {Sig: "type StarExpr struct", Doc: "A StarExpr node represents an expression of the form \"*\" Expression.", Pos: "ast.go:401"},
+		"go/ast.UnaryExpr": {Sig: "type UnaryExpr struct", Doc: "A UnaryExpr node represents a unary expression.", Pos: "ast.go:409"},
+		"go/ast.BinaryExpr": {Sig: "type BinaryExpr struct", Doc: "A BinaryExpr node represents a binary expression.", Pos: "ast.go:416"},
+		"go/ast.KeyValueExpr": {Sig: "type KeyValueExpr struct", Doc: "A KeyValueExpr node represents (key : value) pairs in composite literals.", Pos: "ast.go:426"},
+		"go/ast.ChanDir": {Sig: "type ChanDir int", Doc: "The direction of a channel type is indicated by a bit mask including one or both of the following constants.", Pos: "ast.go:435"},
+		"go/ast.SEND": {Sig: "const SEND ChanDir", Doc: "", Pos: "ast.go:438"},
+		"go/ast.RECV": {Sig: "const RECV", Doc: "", Pos: "ast.go:439"},
+		"go/ast.ArrayType": {Sig: "type ArrayType struct", Doc: "An ArrayType node represents an array or slice type.", Pos: "ast.go:447"},
+		"go/ast.StructType": {Sig: "type StructType struct", Doc: "A StructType node represents a struct type.", Pos: "ast.go:454"},
+		"go/ast.FuncType": {Sig: "type FuncType struct", Doc: "A FuncType node represents a function type.", Pos: "ast.go:463"},
+		"go/ast.InterfaceType": {Sig: "type InterfaceType struct", Doc: "An InterfaceType node represents an interface type.", Pos: "ast.go:471"},
+		"go/ast.MapType": {Sig: "type MapType struct", Doc: "A MapType node represents a map type.", Pos: "ast.go:478"},
+		"go/ast.ChanType": {Sig: "type ChanType struct", Doc: "A ChanType node represents a channel type.", Pos: "ast.go:485"},
+		"go/ast.BadExpr.Pos": {Sig: "func (x *BadExpr) Pos() token.Pos", Doc: "", Pos: "ast.go:495"},
+		"go/ast.Ident.Pos": {Sig: "func (x *Ident) Pos() token.Pos", Doc: "", Pos: "ast.go:496"},
+		"go/ast.Ellipsis.Pos": {Sig: "func (x *Ellipsis) Pos() token.Pos", Doc: "", Pos: "ast.go:497"},
+		"go/ast.BasicLit.Pos": {Sig: "func (x *BasicLit) Pos() token.Pos", Doc: "", Pos: "ast.go:498"},
+		"go/ast.FuncLit.Pos": {Sig: "func (x *FuncLit) Pos() token.Pos", Doc: "", Pos: "ast.go:499"},
+		"go/ast.CompositeLit.Pos": {Sig: "func (x *CompositeLit) Pos() token.Pos", Doc: "", Pos: "ast.go:500"},
+		"go/ast.ParenExpr.Pos": {Sig: "func (x *ParenExpr) Pos() token.Pos", Doc: "", Pos: "ast.go:506"},
+		"go/ast.SelectorExpr.Pos": {Sig: "func (x *SelectorExpr) Pos() token.Pos", Doc: "", Pos: "ast.go:507"},
+		"go/ast.IndexExpr.Pos": {Sig: "func (x *IndexExpr) Pos() token.Pos", Doc: "", Pos: "ast.go:508"},
+		"go/ast.IndexListExpr.Pos": {Sig: "func (x *IndexListExpr) Pos() token.Pos", Doc: "", Pos: "ast.go:509"},
+		"go/ast.SliceExpr.Pos": {Sig: "func (x *SliceExpr) Pos() token.Pos", Doc: "", Pos: "ast.go:510"},
+		"go/ast.TypeAssertExpr.Pos": {Sig: "func (x *TypeAssertExpr) Pos() token.Pos", Doc: "", Pos: "ast.go:511"},
+		"go/ast.CallExpr.Pos": {Sig: "func (x *CallExpr) Pos() token.Pos", Doc: "", Pos: "ast.go:512"},
+		"go/ast.StarExpr.Pos": {Sig: "func (x *StarExpr) Pos() token.Pos", Doc: "", Pos: "ast.go:513"},
+		"go/ast.UnaryExpr.Pos": {Sig: "func (x *UnaryExpr) Pos() token.Pos", Doc: "", Pos: "ast.go:514"},
+		"go/ast.BinaryExpr.Pos": {Sig: "func (x *BinaryExpr) Pos() token.Pos", Doc: "", Pos: "ast.go:515"},
+		"go/ast.KeyValueExpr.Pos": {Sig: "func (x *KeyValueExpr) Pos() token.Pos", Doc: "", Pos: "ast.go:516"},
+		"go/ast.ArrayType.Pos": {Sig: "func (x *ArrayType) Pos() token.Pos", Doc: "", Pos: "ast.go:517"},
+		"go/ast.StructType.Pos": {Sig: "func (x *StructType) Pos() token.Pos", Doc: "", Pos: "ast.go:518"},
+		"go/ast.FuncType.Pos": {Sig: "func (x *FuncType) Pos() token.Pos", Doc: "", Pos: "ast.go:519"},
+		"go/ast.InterfaceType.Pos": {Sig: "func (x *InterfaceType) Pos() token.Pos", Doc: "", Pos: "ast.go:525"},
+		"go/ast.MapType.Pos": {Sig: "func (x *MapType) Pos() token.Pos", Doc: "", Pos: "ast.go:526"},
+		"go/ast.ChanType.Pos": {Sig: "func (x *ChanType) Pos() token.Pos", Doc: "", Pos: "ast.go:527"},
+		"go/ast.BadExpr.End": {Sig: "func (x *BadExpr) End() token.Pos", Doc: "", Pos: "ast.go:529"},
+		"go/ast.Ident.End": {Sig: "func (x *Ident) End() token.Pos", Doc: "", Pos: "ast.go:530"},
+		"go/ast.Ellipsis.End": {Sig: "func (x *Ellipsis) End() token.Pos", Doc: "", Pos: "ast.go:531"},
+		"go/ast.BasicLit.End": {Sig: "func (x *BasicLit) End() token.Pos", Doc: "", Pos: "ast.go:537"},
+		"go/ast.FuncLit.End": {Sig: "func (x *FuncLit) End() token.Pos", Doc: "", Pos: "ast.go:546"},
+		"go/ast.CompositeLit.End": {Sig: "func (x *CompositeLit) End() token.Pos", Doc: "", Pos: "ast.go:547"},
+		"go/ast.ParenExpr.End": {Sig: "func (x *ParenExpr) End() token.Pos", Doc: "", Pos: "ast.go:548"},
+		"go/ast.SelectorExpr.End": {Sig: "func (x *SelectorExpr) End() token.Pos", Doc: "", Pos: "ast.go:549"},
+		"go/ast.IndexExpr.End": {Sig: "func (x *IndexExpr) End() token.Pos", Doc: "", Pos: "ast.go:550"},
+		"go/ast.IndexListExpr.End": {Sig: "func (x *IndexListExpr) End() token.Pos", Doc: "", Pos: "ast.go:551"},
+		"go/ast.SliceExpr.End": {Sig: "func (x *SliceExpr) End() token.Pos", Doc: "", Pos: "ast.go:552"},
+		"go/ast.TypeAssertExpr.End": {Sig: "func (x *TypeAssertExpr) End() token.Pos", Doc: "", Pos: "ast.go:553"},
+		"go/ast.CallExpr.End": {Sig: "func (x *CallExpr) End() token.Pos", Doc: "", Pos: "ast.go:554"},
+		"go/ast.StarExpr.End": {Sig: "func (x *StarExpr) End() token.Pos", Doc: "", Pos: "ast.go:555"},
+		"go/ast.UnaryExpr.End": {Sig: "func (x *UnaryExpr) End() token.Pos", Doc: "", Pos: "ast.go:556"},
+		"go/ast.BinaryExpr.End": {Sig: "func (x *BinaryExpr) End() token.Pos", Doc: "", Pos: "ast.go:557"},
+		"go/ast.KeyValueExpr.End": {Sig: "func (x *KeyValueExpr) End() token.Pos", Doc: "", Pos: "ast.go:558"},
+		"go/ast.ArrayType.End": {Sig: "func (x *ArrayType) End() token.Pos", Doc: "", Pos: "ast.go:559"},
+		"go/ast.StructType.End": {Sig: "func (x *StructType) End() token.Pos", Doc: "", Pos: "ast.go:560"},
+		"go/ast.FuncType.End": {Sig: "func (x *FuncType) End() token.Pos", Doc: "", Pos: "ast.go:561"},
+		"go/ast.InterfaceType.End": {Sig: "func (x *InterfaceType) End() token.Pos", Doc: "", Pos: "ast.go:567"},
+		"go/ast.MapType.End": {Sig: "func (x *MapType) End() token.Pos", Doc: "", Pos: "ast.go:568"},
+		"go/ast.ChanType.End": {Sig: "func (x *ChanType) End() token.Pos", Doc: "", Pos: "ast.go:569"},
+		"go/ast.NewIdent": {Sig: "func NewIdent(name string) *Ident", Doc: "NewIdent creates a new [Ident] without position.", Pos: "ast.go:603"},
+		"go/ast.IsExported": {Sig: "func IsExported(name string) bool", Doc: "IsExported reports whether name starts with an upper-case letter.", Pos: "ast.go:606"},
+		"go/ast.Ident.IsExported": {Sig: "func (id *Ident) IsExported() bool", Doc: "IsExported reports whether id starts with an upper-case letter.", Pos: "ast.go:609"},
+		"go/ast.Ident.String": {Sig: "func (id *Ident) String() string", Doc: "", Pos: "ast.go:611"},
+		"go/ast.BadStmt": {Sig: "type BadStmt struct", Doc: "A BadStmt node is a placeholder for statements containing syntax errors for which no correct statement nodes can be created.", Pos: "ast.go:628"},
+		"go/ast.DeclStmt": {Sig: "type DeclStmt struct", Doc: "A DeclStmt node represents a declaration in a statement list.", Pos: "ast.go:633"},
+		"go/ast.EmptyStmt": {Sig: "type EmptyStmt struct", Doc: "An EmptyStmt node represents an empty statement.", Pos: "ast.go:641"},
+		"go/ast.LabeledStmt": {Sig: "type LabeledStmt struct", Doc: "A LabeledStmt node represents a labeled statement.", Pos: "ast.go:647"},
+		"go/ast.ExprStmt": {Sig: "type ExprStmt struct", Doc: "An ExprStmt node represents a (stand-alone) expression in a statement list.", Pos: "ast.go:656"},
+		"go/ast.SendStmt": {Sig: "type SendStmt struct", Doc: "A SendStmt node represents a send statement.", Pos: "ast.go:661"},
+		"go/ast.IncDecStmt": {Sig: "type IncDecStmt struct", Doc: "An IncDecStmt node represents an increment or decrement statement.", Pos: "ast.go:668"},
+		"go/ast.AssignStmt": {Sig: "type AssignStmt struct", Doc: "An AssignStmt node represents an assignment or a short variable declaration.", Pos: "ast.go:677"},
+		"go/ast.GoStmt": {Sig: "type GoStmt struct", Doc: "A GoStmt node represents a go statement.", Pos: "ast.go:685"},
+		"go/ast.DeferStmt": {Sig: "type DeferStmt struct", Doc: "A DeferStmt node represents a defer statement.", Pos: "ast.go:691"},
+		"go/ast.ReturnStmt": {Sig: "type ReturnStmt struct", Doc: "A ReturnStmt node represents a return statement.", Pos: "ast.go:697"},
+		"go/ast.BranchStmt": {Sig: "type BranchStmt struct", Doc: "A BranchStmt node represents a break, continue, goto, or fallthrough statement.", Pos: "ast.go:705"},
+		"go/ast.BlockStmt": {Sig: "type BlockStmt struct", Doc: "A BlockStmt node represents a braced statement list.", Pos: "ast.go:712"},
+		"go/ast.IfStmt": {Sig: "type IfStmt struct", Doc: "An IfStmt node represents an if statement.", Pos: "ast.go:719"},
+		"go/ast.CaseClause": {Sig: "type CaseClause struct", Doc: "A CaseClause represents a case of an expression or type switch statement.", Pos: "ast.go:728"},
+		"go/ast.SwitchStmt": {Sig: "type SwitchStmt struct", Doc: "A SwitchStmt node represents an expression switch statement.", Pos: "ast.go:736"},
+		"go/ast.TypeSwitchStmt": {Sig: "type TypeSwitchStmt struct", Doc: "A TypeSwitchStmt node represents a type switch statement.", Pos: "ast.go:744"},
+		"go/ast.CommClause": {Sig: "type CommClause struct", Doc: "A CommClause node represents a case of a select statement.", Pos: "ast.go:752"},
+		"go/ast.SelectStmt": {Sig: "type SelectStmt struct", Doc: "A SelectStmt node represents a select statement.", Pos: "ast.go:760"},
+		"go/ast.ForStmt": {Sig: "type ForStmt struct", Doc: "A ForStmt represents a for statement.", Pos: "ast.go:766"},
+		"go/ast.RangeStmt": {Sig: "type RangeStmt struct", Doc: "A RangeStmt represents a for statement with a range clause.", Pos: "ast.go:775"},
+		"go/ast.BadStmt.Pos": {Sig: "func (s *BadStmt) Pos() token.Pos", Doc: "", Pos: "ast.go:788"},
+		"go/ast.DeclStmt.Pos": {Sig: "func (s *DeclStmt) Pos() token.Pos", Doc: "", Pos: "ast.go:789"},
+		"go/ast.EmptyStmt.Pos": {Sig: "func (s *EmptyStmt) Pos() token.Pos", Doc: "", Pos: "ast.go:790"},
+		"go/ast.LabeledStmt.Pos": {Sig: "func (s *LabeledStmt) Pos() token.Pos", Doc: "", Pos: "ast.go:791"},
+		"go/ast.ExprStmt.Pos": {Sig: "func (s *ExprStmt) Pos() token.Pos", Doc: "", Pos: "ast.go:792"},
+		"go/ast.SendStmt.Pos": {Sig: "func (s *SendStmt) Pos() token.Pos", Doc: "", Pos: "ast.go:793"},
+		"go/ast.IncDecStmt.Pos": {Sig: "func (s *IncDecStmt) Pos() token.Pos", Doc: "", Pos: "ast.go:794"},
+		"go/ast.AssignStmt.Pos": {Sig: "func (s *AssignStmt) Pos() token.Pos", Doc: "", Pos: "ast.go:795"},
+		"go/ast.GoStmt.Pos": {Sig: "func (s *GoStmt) Pos() token.Pos", Doc: "", Pos: "ast.go:796"},
+		"go/ast.DeferStmt.Pos": {Sig: "func (s *DeferStmt) Pos() token.Pos", Doc: "", Pos: "ast.go:797"},
+		"go/ast.ReturnStmt.Pos": {Sig: "func (s *ReturnStmt) Pos() token.Pos", Doc: "", Pos: "ast.go:798"},
+		"go/ast.BranchStmt.Pos": {Sig: "func (s *BranchStmt) Pos() token.Pos", Doc: "", Pos: "ast.go:799"},
+		"go/ast.BlockStmt.Pos": {Sig: "func (s *BlockStmt) Pos() token.Pos", Doc: "", Pos: "ast.go:800"},
+		"go/ast.IfStmt.Pos": {Sig: "func (s *IfStmt) Pos() token.Pos", Doc: "", Pos: "ast.go:801"},
+		"go/ast.CaseClause.Pos": {Sig: "func (s *CaseClause) Pos() token.Pos", Doc: "", Pos: "ast.go:802"},
+		"go/ast.SwitchStmt.Pos": {Sig: "func (s *SwitchStmt) Pos() token.Pos", Doc: "", Pos: "ast.go:803"},
+		"go/ast.TypeSwitchStmt.Pos": {Sig: "func (s *TypeSwitchStmt) Pos() token.Pos", Doc: "", Pos: "ast.go:804"},
+		"go/ast.CommClause.Pos": {Sig: "func (s *CommClause) Pos() token.Pos", Doc: "", Pos: "ast.go:805"},
+		"go/ast.SelectStmt.Pos": {Sig: "func (s *SelectStmt) Pos() token.Pos", Doc: "", Pos: "ast.go:806"},
+		"go/ast.ForStmt.Pos": {Sig: "func (s *ForStmt) Pos() token.Pos", Doc: "", Pos: "ast.go:807"},
+		"go/ast.RangeStmt.Pos": {Sig: "func (s *RangeStmt) Pos() token.Pos", Doc: "", Pos: "ast.go:808"},
+		"go/ast.BadStmt.End": {Sig: "func (s *BadStmt) End() token.Pos", Doc: "", Pos: "ast.go:810"},
+		"go/ast.DeclStmt.End": {Sig: "func (s *DeclStmt) End() token.Pos", Doc: "", Pos: "ast.go:811"},
+		"go/ast.EmptyStmt.End": {Sig: "func (s *EmptyStmt) End() token.Pos", Doc: "", Pos: "ast.go:812"},
+		"go/ast.LabeledStmt.End": {Sig: "func (s *LabeledStmt) End() token.Pos", Doc: "", Pos: "ast.go:818"},
+		"go/ast.ExprStmt.End": {Sig: "func (s *ExprStmt) End() token.Pos", Doc: "", Pos: "ast.go:819"},
+		"go/ast.SendStmt.End": {Sig: "func (s *SendStmt) End() token.Pos", Doc: "", Pos: "ast.go:820"},
+		"go/ast.IncDecStmt.End": {Sig: "func (s *IncDecStmt) End() token.Pos", Doc: "", Pos: "ast.go:821"},
+		"go/ast.AssignStmt.End": {Sig: "func (s *AssignStmt) End() token.Pos", Doc: "", Pos: "ast.go:824"},
+		"go/ast.GoStmt.End": {Sig: "func (s *GoStmt) End() token.Pos", Doc: "", Pos: "ast.go:825"},
+		"go/ast.DeferStmt.End": {Sig: "func (s *DeferStmt) End() token.Pos", Doc: "", Pos: "ast.go:826"},
+		"go/ast.ReturnStmt.End": {Sig: "func (s *ReturnStmt) End() token.Pos", Doc: "", Pos: "ast.go:827"},
+		"go/ast.BranchStmt.End": {Sig: "func (s *BranchStmt) End() token.Pos", Doc: "", Pos: "ast.go:833"},
+		"go/ast.BlockStmt.End": {Sig: "func (s *BlockStmt) End() token.Pos", Doc: "", Pos: "ast.go:839"},
+		"go/ast.IfStmt.End": {Sig: "func (s *IfStmt) End() token.Pos", Doc: "", Pos: "ast.go:848"},
+		"go/ast.CaseClause.End": {Sig: "func (s *CaseClause) End() token.Pos", Doc: "", Pos: "ast.go:854"},
+		"go/ast.SwitchStmt.End": {Sig: "func (s *SwitchStmt) End() token.Pos", Doc: "", Pos: "ast.go:860"},
+		"go/ast.TypeSwitchStmt.End": {Sig: "func (s *TypeSwitchStmt) End() token.Pos", Doc: "", Pos: "ast.go:861"},
+		"go/ast.CommClause.End": {Sig: "func (s *CommClause) End() token.Pos", Doc: "", Pos: "ast.go:862"},
+		"go/ast.SelectStmt.End": {Sig: "func (s *SelectStmt) End() token.Pos", Doc: "", Pos: "ast.go:868"},
+		"go/ast.ForStmt.End": {Sig: "func (s *ForStmt) End() token.Pos", Doc: "", Pos: "ast.go:869"},
+		"go/ast.RangeStmt.End": {Sig: "func (s *RangeStmt) End() token.Pos", Doc: "", Pos: "ast.go:870"},
+		"go/ast.Spec": {Sig: "type Spec interface", Doc: "The Spec type stands for any of *ImportSpec, *ValueSpec, and *TypeSpec.", Pos: "ast.go:903"},
+		"go/ast.ImportSpec": {Sig: "type ImportSpec struct", Doc: "An ImportSpec node represents a single package import.", Pos: "ast.go:909"},
+		"go/ast.ValueSpec": {Sig: "type ValueSpec struct", Doc: "A ValueSpec node represents a constant or variable declaration (ConstSpec or VarSpec production).", Pos: "ast.go:920"},
+		"go/ast.TypeSpec": {Sig: "type TypeSpec struct", Doc: "A TypeSpec node represents a type declaration (TypeSpec production).", Pos: "ast.go:929"},
+		"go/ast.ImportSpec.Pos": {Sig: "func (s *ImportSpec) Pos() token.Pos", Doc: "", Pos: "ast.go:941"},
+		"go/ast.ValueSpec.Pos": {Sig: "func (s *ValueSpec) Pos() token.Pos", Doc: "", Pos: "ast.go:947"},
+		"go/ast.TypeSpec.Pos": {Sig: "func (s *TypeSpec) Pos() token.Pos", Doc: "", Pos: "ast.go:948"},
+		"go/ast.ImportSpec.End": {Sig: "func (s *ImportSpec) End() token.Pos", Doc: "", Pos: "ast.go:950"},
+		"go/ast.ValueSpec.End": {Sig: "func (s *ValueSpec) End() token.Pos", Doc: "", Pos: "ast.go:957"},
+		"go/ast.TypeSpec.End": {Sig: "func (s *TypeSpec) End() token.Pos", Doc: "", Pos: "ast.go:966"},
+		"go/ast.BadDecl": {Sig: "type BadDecl struct", Doc: "A BadDecl node is a placeholder for a declaration containing syntax errors for which a correct declaration node cannot be created.", Pos: "ast.go:980"},
+		"go/ast.GenDecl": {Sig: "type GenDecl struct", Doc: "A GenDecl node (generic declaration node) represents an import, constant, type or variable declaration.", Pos: "ast.go:995"},
+		"go/ast.FuncDecl": {Sig: "type FuncDecl struct", Doc: "A FuncDecl node represents a function declaration.", Pos: "ast.go:1005"},
+		"go/ast.BadDecl.Pos": {Sig: "func (d *BadDecl) Pos() token.Pos", Doc: "", Pos: "ast.go:1016"},
+		"go/ast.GenDecl.Pos": {Sig: "func (d *GenDecl) Pos() token.Pos", Doc: "", Pos: "ast.go:1017"},
+		"go/ast.FuncDecl.Pos": {Sig: "func (d *FuncDecl) Pos() token.Pos", Doc: "", Pos: "ast.go:1018"},
+		"go/ast.BadDecl.End": {Sig: "func (d *BadDecl) End() token.Pos", Doc: "", Pos: "ast.go:1020"},
+		"go/ast.GenDecl.End": {Sig: "func (d *GenDecl) End() token.Pos", Doc: "", Pos: "ast.go:1021"},
+		"go/ast.FuncDecl.End": {Sig: "func (d *FuncDecl) End() token.Pos", Doc: "", Pos: "ast.go:1027"},
+		"go/ast.File": {Sig: "type File struct", Doc: "A File node represents a Go source file.", Pos: "ast.go:1064"},
+		"go/ast.File.Pos": {Sig: "func (f *File) Pos() token.Pos", Doc: "Pos returns the position of the package declaration.", Pos: "ast.go:1082"},
+		"go/ast.File.End": {Sig: "func (f *File) End() token.Pos", Doc: "End returns the end of the last declaration in the file.", Pos: "ast.go:1088"},
+		"go/ast.Package": {Sig: "type Package struct", Doc: "A Package node represents a set of source files collectively building a Go package.", Pos: "ast.go:1099"},
+		"go/ast.Package.Pos": {Sig: "func (p *Package) Pos() token.Pos", Doc: "", Pos: "ast.go:1106"},
+		"go/ast.Package.End": {Sig: "func (p *Package) End() token.Pos", Doc: "", Pos: "ast.go:1107"},
+		"go/ast.IsGenerated": {Sig: "func IsGenerated(file *File) bool", Doc: "IsGenerated reports whether the file was generated by a program, not handwritten, by detecting the special comment described at https://go.dev/s/generatedcode.", Pos: "ast.go:1119"},
+		"go/ast.Unparen": {Sig: "func Unparen(e Expr) Expr", Doc: "Unparen returns the expression with any enclosing parentheses removed.", Pos: "ast.go:1147"},
+		"go/ast.CommentMap": {Sig: "type CommentMap map[Node][]*CommentGroup", Doc: "A CommentMap maps an AST node to a list of comment groups associated with it.", Pos: "commentmap.go:26"},
+		"go/ast.NewCommentMap": {Sig: "func NewCommentMap(fset *token.FileSet, node Node, comments []*CommentGroup) CommentMap", Doc: "NewCommentMap creates a new comment map by associating comment groups of the comments list with the nodes of the AST specified by node.", Pos: "commentmap.go:127"},
+		"go/ast.CommentMap.Update": {Sig: "func (cmap CommentMap) Update(old, new Node) Node", Doc: "Update replaces an old node in the comment map with the new node and returns the new node.", Pos: "commentmap.go:229"},
+		"go/ast.CommentMap.Filter": {Sig: "func (cmap CommentMap) Filter(node Node) CommentMap", Doc: "Filter returns a new comment map consisting of only those entries of cmap for which a corresponding node exists in the AST specified by node.", Pos: "commentmap.go:240"},
+		"go/ast.CommentMap.Comments": {Sig: "func (cmap CommentMap) Comments() []*CommentGroup", Doc: "Comments returns the list of comment groups in the comment map.", Pos: "commentmap.go:253"},
+		"go/ast.CommentMap.String": {Sig: "func (cmap CommentMap) String() string", Doc: "", Pos: "commentmap.go:298"},
+		"go/ast.Directive": {Sig: "type Directive struct", Doc: "A Directive is a comment of this form:", Pos: "directive.go:32"},
+		"go/ast.ParseDirective": {Sig: "func ParseDirective(pos token.Pos, c string) (Directive, bool)", Doc: "ParseDirective parses a single comment line for a directive comment.", Pos: "directive.go:56"},
+		"go/ast.Directive.Pos": {Sig: "func (d *Directive) Pos() token.Pos", Doc: "", Pos: "directive.go:98"},
+		"go/ast.Directive.End": {Sig: "func (d *Directive) End() token.Pos", Doc: "", Pos: "directive.go:99"},
+		"go/ast.DirectiveArg": {Sig: "type DirectiveArg struct", Doc: "A DirectiveArg is an argument to a directive comment.", Pos: "directive.go:102"},
+		"go/ast.Directive.ParseArgs": {Sig: "func (d *Directive) ParseArgs() ([]DirectiveArg, error)", Doc: "ParseArgs parses a [Directive]'s arguments using the standard convention, which is a sequence of tokens, where each token may be a bare word, or a double quoted Go string, or a back quoted raw Go string.", Pos: "directive.go:116"},
+		"go/ast.FileExports": {Sig: "func FileExports(src *File) bool", Doc: "FileExports trims the AST for a Go source file in place such that only exported nodes remain: all top-level identifiers which are not exported and their associated information (such as type, initial value, or function body) are removed.", Pos: "filter.go:27"},
+		"go/ast.PackageExports": {Sig: "func PackageExports(pkg *Package) bool", Doc: "PackageExports trims the AST for a Go package in place such that only exported nodes remain.", Pos: "filter.go:40"},
+		"go/ast.Filter": {Sig: "type Filter func(string) bool", Doc: "", Pos: "filter.go:47"},
+		"go/ast.FilterDecl": {Sig: "func FilterDecl(decl Decl, f Filter) bool", Doc: "FilterDecl trims the AST for a Go declaration in place by removing all names (including struct field and interface method names, but not from parameter lists) that don't pass through the filter f.", Pos: "filter.go:232"},
+		"go/ast.FilterFile": {Sig: "func FilterFile(src *File, f Filter) bool", Doc: "FilterFile trims the AST for a Go file in place by removing all names from top-level declarations (including struct field and interface method names, but not from parameter lists) that don't pass through the filter f.", Pos: "filter.go:256"},
+		"go/ast.FilterPackage": {Sig: "func FilterPackage(pkg *Package, f Filter) bool", Doc: "FilterPackage trims the AST for a Go package in place by removing all names from top-level declarations (including struct field and interface method names, but not from parameter lists) that don't pass through the filter f.", Pos: "filter.go:285"},
+		"go/ast.MergeMode": {Sig: "type MergeMode uint", Doc: "The MergeMode flags control the behavior of [MergePackageFiles].", Pos: "filter.go:306"},
+		"go/ast.FilterFuncDuplicates": {Sig: "const FilterFuncDuplicates MergeMode", Doc: "If set, duplicate function declarations are excluded.", Pos: "filter.go:312"},
+		"go/ast.FilterUnassociatedComments": {Sig: "const FilterUnassociatedComments", Doc: "If set, comments that are not associated with a specific AST node (as Doc or Comment) are excluded.", Pos: "filter.go:315"},
+		"go/ast.FilterImportDuplicates": {Sig: "const FilterImportDuplicates", Doc: "If set, duplicate import declarations are excluded.", Pos: "filter.go:317"},
+		"go/ast.MergePackageFiles": {Sig: "func MergePackageFiles(pkg *Package, mode MergeMode) *File", Doc: "MergePackageFiles creates a file AST by merging the ASTs of the files belonging to a package.", Pos: "filter.go:349"},
+		"go/ast.SortImports": {Sig: "func SortImports(fset *token.FileSet, f *File)", Doc: "SortImports sorts runs of consecutive import lines in import blocks in f.", Pos: "import.go:16"},
+		"go/ast.FieldFilter": {Sig: "type FieldFilter func(name string, value reflect.Value) bool", Doc: "A FieldFilter may be provided to [Fprint] to control the output.", Pos: "print.go:18"},
+		"go/ast.NotNilFilter": {Sig: "func NotNilFilter(_ string, v reflect.Value) bool", Doc: "NotNilFilter is a [FieldFilter] that returns true for field values that are not nil; it returns false otherwise.", Pos: "print.go:22"},
+		"go/ast.Fprint": {Sig: "func Fprint(w io.Writer, fset *token.FileSet, x any, f FieldFilter) error", Doc: "Fprint prints the (sub-)tree starting at AST node x to w.", Pos: "print.go:39"},
+		"go/ast.Print": {Sig: "func Print(fset *token.FileSet, x any) error", Doc: "Print prints x to standard output, skipping nil fields.", Pos: "print.go:73"},
+		"go/ast.Importer": {Sig: "type Importer func(imports map[string]*Object, path string) (pkg *Object, err error)", Doc: "An Importer resolves import paths to package Objects.", Pos: "resolve.go:65"},
+		"go/ast.NewPackage": {Sig: "func NewPackage(fset *token.FileSet, files map[string]*File, importer Importer, universe *Scope) (*Package, error)", Doc: "NewPackage creates a new [Package] node from a set of [File] nodes.", Pos: "resolve.go:77"},
+		"go/ast.Scope": {Sig: "type Scope struct", Doc: "A Scope maintains the set of named language entities declared in the scope and a link to the immediately surrounding (outer) scope.", Pos: "scope.go:20"},
+		"go/ast.NewScope": {Sig: "func NewScope(outer *Scope) *Scope", Doc: "NewScope creates a new scope nested in the outer scope.", Pos: "scope.go:26"},
+		"go/ast.Scope.Lookup": {Sig: "func (s *Scope) Lookup(name string) *Object", Doc: "Lookup returns the object with the given name if it is found in scope s, otherwise it returns nil.", Pos: "scope.go:34"},
+		"go/ast.Scope.Insert": {Sig: "func (s *Scope) Insert(obj *Object) (alt *Object)", Doc: "Insert attempts to insert a named object obj into the scope s.", Pos: "scope.go:42"},
+		"go/ast.Scope.String": {Sig: "func (s *Scope) String() string", Doc: "Debugging support", Pos: "scope.go:50"},
+		"go/ast.Object": {Sig: "type Object struct", Doc: "An Object describes a named language entity such as a package, constant, type, variable, function (incl.", Pos: "scope.go:87"},
+		"go/ast.NewObj": {Sig: "func NewObj(kind ObjKind, name string) *Object", Doc: "NewObj creates a new object of a given kind and name.", Pos: "scope.go:96"},
+		"go/ast.Object.Pos": {Sig: "func (obj *Object) Pos() token.Pos", Doc: "Pos computes the source position of the declaration of an object name.", Pos: "scope.go:103"},
+		"go/ast.ObjKind": {Sig: "type ObjKind int", Doc: "ObjKind describes what an [Object] represents.", Pos: "scope.go:148"},
+		"go/ast.Bad": {Sig: "const Bad ObjKind", Doc: "The list of possible [Object] kinds.", Pos: "scope.go:152"},
+		"go/ast.Pkg": {Sig: "const Pkg", Doc: "The list of possible [Object] kinds.", Pos: "scope.go:153"},
+		"go/ast.Con": {Sig: "const Con", Doc: "The list of possible [Object] kinds.", Pos: "scope.go:154"},
+		"go/ast.Typ": {Sig: "const Typ", Doc: "The list of possible [Object] kinds.", Pos: "scope.go:155"},
+		"go/ast.Var": {Sig: "const Var", Doc: "The list of possible [Object] kinds.", Pos: "scope.go:156"},
+		"go/ast.Fun": {Sig: "const Fun", Doc: "The list of possible [Object] kinds.", Pos: "scope.go:157"},
+		"go/ast.Lbl": {Sig: "const Lbl", Doc: "The list of possible [Object] kinds.", Pos: "scope.go:158"},
+		"go/ast.ObjKind.String": {Sig: "func (kind ObjKind) String() string", Doc: "", Pos: "scope.go:171"},
+		"go/ast.Visitor": {Sig: "type Visitor interface", Doc: "A Visitor's Visit method is invoked for each node encountered by [Walk].", Pos: "walk.go:15"},
+		"go/ast.Walk": {Sig: "func Walk(v Visitor, node Node)", Doc: "Walk traverses an AST in depth-first order: It starts by calling v.Visit(node); node must not be nil.", Pos: "walk.go:33"},
+		"go/ast.Inspect": {Sig: "func Inspect(node Node, f func(Node) bool)", Doc: "Inspect traverses an AST in depth-first order: It starts by calling f(node); node must not be nil.", Pos: "walk.go:376"},
+		"go/ast.Preorder": {Sig: "func Preorder(root Node) iter.Seq[Node]", Doc: "Preorder returns an iterator over all the nodes of the syntax tree beneath (and including) the specified root, in depth-first preorder.", Pos: "walk.go:386"},
+		"go/ast.PreorderStack": {Sig: "func PreorderStack(root Node, stack []Node, f func(n Node, stack []Node) bool)", Doc: "PreorderStack traverses the tree rooted at root, calling f before visiting each node.", Pos: "walk.go:411"},
+		"go/parser": {Doc: "Package parser implements a parser for Go source files."},
+		"go/parser.Mode": {Sig: "type Mode uint", Doc: "A Mode value is a set of flags (or 0).", Pos: "interface.go:47"},
+		"go/parser.PackageClauseOnly": {Sig: "const PackageClauseOnly Mode", Doc: "", Pos: "interface.go:50"},
+		"go/parser.ImportsOnly": {Sig: "const ImportsOnly", Doc: "", Pos: "interface.go:51"},
+		"go/parser.ParseComments": {Sig: "const ParseComments", Doc: "", Pos: "interface.go:52"},
+		"go/parser.Trace": {Sig: "const Trace", Doc: "", Pos: "interface.go:53"},
+		"go/parser.DeclarationErrors": {Sig: "const DeclarationErrors", Doc: "", Pos: "interface.go:54"},
+		"go/parser.SpuriousErrors": {Sig: "const SpuriousErrors", Doc: "", Pos: "interface.go:55"},
+		"go/parser.SkipObjectResolution": {Sig: "const SkipObjectResolution", Doc: "", Pos: "interface.go:56"},
+		"go/parser.AllErrors": {Sig: "const AllErrors", Doc: "", Pos: "interface.go:57"},
+		"go/parser.ParseFile": {Sig: "func ParseFile(fset *token.FileSet, filename string, src any, mode Mode) (f *ast.File, err error)", Doc: "ParseFile parses the source code of a single Go source file and returns the corresponding [ast.File] node.", Pos: "interface.go:84"},
+		"go/parser.ParseDir": {Sig: "func ParseDir(fset *token.FileSet, path string, filter func(fs.FileInfo) bool, mode Mode) (pkgs map[string]*ast.Package, first error)", Doc: "ParseDir calls [ParseFile] for all files with names ending in \".go\" in the directory specified by path and returns a map of package name -> package AST with all the packages found.", Pos: "interface.go:153"},
+		"go/parser.ParseExprFrom": {Sig: "func ParseExprFrom(fset *token.FileSet, filename string, src any, mode Mode) (expr ast.Expr, err error)", Doc: "ParseExprFrom is a convenience function for parsing an expression.", Pos: "interface.go:203"},
+		"go/parser.ParseExpr": {Sig: "func ParseExpr(x string) (ast.Expr, error)", Doc: "ParseExpr is a convenience function for obtaining the AST of an expression x.", Pos: "interface.go:251"},
+		"go/scanner": {Doc: "Package scanner implements a scanner for Go source text."},
+		"go/scanner.Error": {Sig: "type Error struct", Doc: "In an [ErrorList], an error is represented by an *Error.", Pos: "errors.go:18"},
+		"go/scanner.Error.Error": {Sig: "func (e Error) Error() string", Doc: "Error implements the error interface.", Pos: "errors.go:26"},
+		"go/scanner.ErrorList": {Sig: "type ErrorList []*Error", Doc: "ErrorList is a list of *Errors.", Pos: "errors.go:37"},
+		"go/scanner.ErrorList.Add": {Sig: "func (p *ErrorList) Add(pos token.Position, msg string)", Doc: "Add adds an [Error] with given position and error message to an [ErrorList].", Pos: "errors.go:40"},
+		"go/scanner.ErrorList.Reset": {Sig: "func (p *ErrorList) Reset()", Doc: "Reset resets an [ErrorList] to no errors.", Pos: "errors.go:45"},
+		"go/scanner.ErrorList.Len": {Sig: "func (p ErrorList) Len() int", Doc: "[ErrorList] implements the sort Interface.", Pos: "errors.go:48"},
+		"go/scanner.ErrorList.Swap": {Sig: "func (p ErrorList) Swap(i, j int)", Doc: "", Pos: "errors.go:49"},
+		"go/scanner.ErrorList.Less": {Sig: "func (p ErrorList) Less(i, j int) bool", Doc: "", Pos: "errors.go:51"},
+		"go/scanner.ErrorList.Sort": {Sig: "func (p ErrorList) Sort()", Doc: "Sort sorts an [ErrorList].", Pos: "errors.go:72"},
+		"go/scanner.ErrorList.RemoveMultiples": {Sig: "func (p *ErrorList) RemoveMultiples()", Doc: "RemoveMultiples sorts an [ErrorList] and removes all but the first error per line.", Pos: "errors.go:77"},
+		"go/scanner.ErrorList.Error": {Sig: "func (p ErrorList) Error() string", Doc: "An [ErrorList] implements the error interface.", Pos: "errors.go:92"},
+		"go/scanner.ErrorList.Err": {Sig: "func (p ErrorList) Err() error", Doc: "Err returns an error equivalent to this error list.", Pos: "errors.go:104"},
+		"go/scanner.PrintError": {Sig: "func PrintError(w io.Writer, err error)", Doc: "PrintError is a utility function that prints a list of errors to w, one error per line, if the err parameter is an [ErrorList].", Pos: "errors.go:114"},
+		"go/scanner.ErrorHandler": {Sig: "type ErrorHandler func(pos token.Position, msg string)", Doc: "An ErrorHandler may be provided to [Scanner.Init].", Pos: "scanner.go:24"},
+		"go/scanner.Scanner": {Sig: "type Scanner struct", Doc: "A Scanner holds the scanner's internal state while processing a given text.", Pos: "scanner.go:29"},
+		"go/scanner.Mode": {Sig: "type Mode uint", Doc: "A Mode value is a set of flags (or 0).", Pos: "scanner.go:115"},
+		"go/scanner.ScanComments": {Sig: "const ScanComments Mode", Doc: "", Pos: "scanner.go:118"},
+		"go/scanner.Scanner.Init": {Sig: "func (s *Scanner) Init(file *token.File, src []byte, err ErrorHandler, mode Mode)", Doc: "Init prepares the scanner s to tokenize the text src by setting the scanner at the beginning of src.", Pos: "scanner.go:136"},
+		"go/scanner.Scanner.End": {Sig: "func (s *Scanner) End() token.Pos", Doc: "End returns the position immediately after the last scanned token.", Pos: "scanner.go:777"},
+		"go/scanner.Scanner.Scan": {Sig: "func (s *Scanner) Scan() (pos token.Pos, tok token.Token, lit string)", Doc: "Scan scans the next token and returns the token position, the token, and its literal string if applicable.", Pos: "scanner.go:822"},
+		"go/token": {Doc: "Package token defines constants representing the lexical tokens of the Go programming language and basic operations on tokens (printing, predicates)."},
+		"go/token.Position": {Sig: "type Position struct", Doc: "Position describes an arbitrary source position including the file, line, and column location.", Pos: "position.go:26"},
+		"go/token.Position.IsValid": {Sig: "func (pos *Position) IsValid() bool", Doc: "IsValid reports whether the position is valid.", Pos: "position.go:34"},
+		"go/token.Position.String": {Sig: "func (pos Position) String() string", Doc: "String returns a string in one of several forms:", Pos: "position.go:44"},
+		"go/token.Pos": {Sig: "type Pos int", Doc: "Pos is a compact encoding of a source position within a file set.", Pos: "position.go:83"},
+		"go/token.NoPos": {Sig: "const NoPos Pos", Doc: "The zero value for [Pos] is NoPos; there is no file and line information associated with it, and NoPos.IsValid() is false.", Pos: "position.go:89"},
+		"go/token.Pos.IsValid": {Sig: "func (p Pos) IsValid() bool", Doc: "IsValid reports whether the position is valid.", Pos: "position.go:92"},
+		"go/token.File": {Sig: "type File struct", Doc: "A File is a handle for a file belonging to a [FileSet].", Pos: "position.go:104"},
+		"go/token.File.String": {Sig: "func (f *File) String() string", Doc: "String returns a brief description of the File.", Pos: "position.go:116"},
+		"go/token.File.Name": {Sig: "func (f *File) Name() string", Doc: "Name returns the file name of file f as registered with AddFile.", Pos: "position.go:121"},
+		"go/token.File.Base": {Sig: "func (f *File) Base() int", Doc: "Base returns the base offset of file f as registered with AddFile.", Pos: "position.go:126"},
+		"go/token.File.Size": {Sig: "func (f *File) Size() int", Doc: "Size returns the size of file f as registered with AddFile.", Pos: "position.go:131"},
+		"go/token.File.End": {Sig: "func (f *File) End() Pos", Doc: "End returns the end position of file f as registered with AddFile.", Pos: "position.go:136"},
+		"go/token.File.LineCount": {Sig: "func (f *File) LineCount() int", Doc: "LineCount returns the number of lines in file f.", Pos: "position.go:141"},
+		"go/token.File.AddLine": {Sig: "func (f *File) AddLine(offset int)", Doc: "AddLine adds the line offset for a new line.", Pos: "position.go:151"},
+		"go/token.File.MergeLine": {Sig: "func (f *File) MergeLine(line int)", Doc: "MergeLine merges a line with the following line.", Pos: "position.go:163"},
+		"go/token.File.Lines": {Sig: "func (f *File) Lines() []int", Doc: "Lines returns the effective line offset table of the form described by [File.SetLines].", Pos: "position.go:183"},
+		"go/token.File.SetLines": {Sig: "func (f *File) SetLines(lines []int) bool", Doc: "SetLines sets the line offsets for a file and reports whether it succeeded.", Pos: "position.go:198"},
+		"go/token.File.SetLinesForContent": {Sig: "func (f *File) SetLinesForContent(content []byte)", Doc: "SetLinesForContent sets the line offsets for the given file content.", Pos: "position.go:216"},
+		"go/token.File.LineStart": {Sig: "func (f *File) LineStart(line int) Pos", Doc: "LineStart returns the [Pos] value of the start of the specified line.", Pos: "position.go:238"},
+		"go/token.File.AddLineInfo": {Sig: "func (f *File) AddLineInfo(offset int, filename string, line int)", Doc: "AddLineInfo is like [File.AddLineColumnInfo] with a column = 1 argument.", Pos: "position.go:262"},
+		"go/token.File.AddLineColumnInfo": {Sig: "func (f *File) AddLineColumnInfo(offset int, filename string, line, column int)", Doc: "AddLineColumnInfo adds alternative file, line, and column number information for a given file offset.", Pos: "position.go:274"},
+		"go/token.File.Pos": {Sig: "func (f *File) Pos(offset int) Pos", Doc: "Pos returns the Pos value for the given file offset.", Pos: "position.go:301"},
+		"go/token.File.Offset": {Sig: "func (f *File) Offset(p Pos) int", Doc: "Offset returns the offset for the given file position p.", Pos: "position.go:314"},
+		"go/token.File.Line": {Sig: "func (f *File) Line(p Pos) int", Doc: "Line returns the line number for the given file position p; p must be a [Pos] value in that file or [NoPos].", Pos: "position.go:320"},
+		"go/token.File.PositionFor": {Sig: "func (f *File) PositionFor(p Pos, adjusted bool) (pos Position)", Doc: "PositionFor returns the Position value for the given file position p.", Pos: "position.go:386"},
+		"go/token.File.Position": {Sig: "func (f *File) Position(p Pos) (pos Position)", Doc: "Position returns the Position value for the given file position p.", Pos: "position.go:396"},
+		"go/token.FileSet": {Sig: "type FileSet struct", Doc: "A FileSet represents a set of source files.", Pos: "position.go:425"},
+		"go/token.NewFileSet": {Sig: "func NewFileSet() *FileSet", Doc: "NewFileSet creates a new file set.", Pos: "position.go:433"},
+		"go/token.FileSet.Base": {Sig: "func (s *FileSet) Base() int", Doc: "Base returns the minimum base offset that must be provided to [FileSet.AddFile] when adding the next file.", Pos: "position.go:441"},
+		"go/token.FileSet.AddFile": {Sig: "func (s *FileSet) AddFile(filename string, base, size int) *File", Doc: "AddFile adds a new file with a given filename, base offset, and file size to the file set s and returns the file.", Pos: "position.go:463"},
+		"go/token.FileSet.AddExistingFiles": {Sig: "func (s *FileSet) AddExistingFiles(files ...*File)", Doc: "AddExistingFiles adds the specified files to the FileSet if they are not already present.", Pos: "position.go:495"},
+		"go/token.FileSet.RemoveFile": {Sig: "func (s *FileSet) RemoveFile(file *File)", Doc: "RemoveFile removes a file from the [FileSet] so that subsequent queries for its [Pos] interval yield a negative result.", Pos: "position.go:529"},
+		"go/token.FileSet.Iterate": {Sig: "func (s *FileSet) Iterate(yield func(*File) bool)", Doc: "Iterate calls yield for the files in the file set in ascending Base order until yield returns false.", Pos: "position.go:543"},
+		"go/token.FileSet.File": {Sig: "func (s *FileSet) File(p Pos) (f *File)", Doc: "File returns the file that contains the position p.", Pos: "position.go:579"},
+		"go/token.FileSet.PositionFor": {Sig: "func (s *FileSet) PositionFor(p Pos, adjusted bool) (pos Position)", Doc: "PositionFor converts a [Pos] p in the fileset into a [Position] value.", Pos: "position.go:590"},
+		"go/token.FileSet.Position": {Sig: "func (s *FileSet) Position(p Pos) (pos Position)", Doc: "Position converts a [Pos] p in the fileset into a Position value.", Pos: "position.go:601"},
+		"go/token.FileSet.Read": {Sig: "func (s *FileSet) Read(decode func(any) error) error", Doc: "Read calls decode to deserialize a file set into s; s must not be nil.", Pos: "serialize.go:24"},
+		"go/token.FileSet.Write": {Sig: "func (s *FileSet) Write(encode func(any) error) error", Doc: "Write calls encode to serialize the file set s.", Pos: "serialize.go:48"},
+		"go/token.Token": {Sig: "type Token int", Doc: "Token is the set of lexical tokens of the Go programming language.", Pos: "token.go:16"},
+		"go/token.ILLEGAL": {Sig: "const ILLEGAL Token", Doc: "Special tokens", Pos: "token.go:21"},
+		"go/token.EOF": {Sig: "const EOF", Doc: "The list of tokens.", Pos: "token.go:22"},
+		"go/token.COMMENT": {Sig: "const COMMENT", Doc: "The list of tokens.", Pos: "token.go:23"},
+		"go/token.IDENT": {Sig: "const IDENT", Doc: "Identifiers and basic type literals (these tokens stand for classes of literals)", Pos: "token.go:28"},
+		"go/token.INT": {Sig: "const INT", Doc: "The list of tokens.", Pos: "token.go:29"},
+		"go/token.FLOAT": {Sig: "const FLOAT", Doc: "The list of tokens.", Pos: "token.go:30"},
+		"go/token.IMAG": {Sig: "const IMAG", Doc: "The list of tokens.", Pos: "token.go:31"},
+		"go/token.CHAR": {Sig: "const CHAR", Doc: "The list of tokens.", Pos: "token.go:32"},
+		"go/token.STRING": {Sig: "const STRING", Doc: "The list of tokens.", Pos: "token.go:33"},
+		"go/token.ADD": {Sig: "const ADD", Doc: "Operators and delimiters", Pos: "token.go:38"},
+		"go/token.SUB": {Sig: "const SUB", Doc: "The list of tokens.", Pos: "token.go:39"},
+		"go/token.MUL": {Sig: "const MUL", Doc: "The list of tokens.", Pos: "token.go:40"},
+		"go/token.QUO": {Sig: "const QUO", Doc: "The list of tokens.", Pos: "token.go:41"},
+		"go/token.REM": {Sig: "const REM", Doc: "The list of tokens.", Pos: "token.go:42"},
+		"go/token.AND": {Sig: "const AND", Doc: "The list of tokens.", Pos: "token.go:44"},
+		"go/token.OR": {Sig: "const OR", Doc: "The list of tokens.", Pos: "token.go:45"},
+		"go/token.XOR": {Sig: "const XOR", Doc: "The list of tokens.", Pos: "token.go:46"},
+		"go/token.SHL": {Sig: "const SHL", Doc: "The list of tokens.", Pos: "token.go:47"},
+		"go/token.SHR": {Sig: "const SHR", Doc: "The list of tokens.", Pos: "token.go:48"},
+		"go/token.AND_NOT": {Sig: "const AND_NOT", Doc: "The list of tokens.", Pos: "token.go:49"},
+		"go/token.ADD_ASSIGN": {Sig: "const ADD_ASSIGN", Doc: "The list of tokens.", Pos: "token.go:51"},
+		"go/token.SUB_ASSIGN": {Sig: "const SUB_ASSIGN", Doc: "The list of tokens.", Pos: "token.go:52"},
+		"go/token.MUL_ASSIGN": {Sig: "const MUL_ASSIGN", Doc: "The list of tokens.", Pos: "token.go:53"},
+		"go/token.QUO_ASSIGN": {Sig: "const QUO_ASSIGN", Doc: "The list of tokens.", Pos: "token.go:54"},
+		"go/token.REM_ASSIGN": {Sig: "const REM_ASSIGN", Doc: "The list of tokens.", Pos: "token.go:55"},
+		"go/token.AND_ASSIGN": {Sig: "const AND_ASSIGN", Doc: "The list of tokens.", Pos: "token.go:57"},
+		"go/token.OR_ASSIGN": {Sig: "const OR_ASSIGN", Doc: "The list of tokens.", Pos: "token.go:58"},
+		"go/token.XOR_ASSIGN": {Sig: "const XOR_ASSIGN", Doc: "The list of tokens.", Pos: "token.go:59"},
+		"go/token.SHL_ASSIGN": {Sig: "const SHL_ASSIGN", Doc: "The list of tokens.", Pos: "token.go:60"},
+		"go/token.SHR_ASSIGN": {Sig: "const SHR_ASSIGN", Doc: "The list of tokens.", Pos: "token.go:61"},
+		"go/token.AND_NOT_ASSIGN": {Sig: "const AND_NOT_ASSIGN", Doc: "The list of tokens.", Pos: "token.go:62"},
+		"go/token.LAND": {Sig: "const LAND", Doc: "The list of tokens.", Pos: "token.go:64"},
+		"go/token.LOR": {Sig: "const LOR", Doc: "The list of tokens.", Pos: "token.go:65"},
+		"go/token.ARROW": {Sig: "const ARROW", Doc: "The list of tokens.", Pos: "token.go:66"},
+		"go/token.INC": {Sig: "const INC", Doc: "The list of tokens.", Pos: "token.go:67"},
+		"go/token.DEC": {Sig: "const DEC", Doc: "The list of tokens.", Pos: "token.go:68"},
+		"go/token.EQL": {Sig: "const EQL", Doc: "The list of tokens.", Pos: "token.go:70"},
+		"go/token.LSS": {Sig: "const LSS", Doc: "The list of tokens.", Pos: "token.go:71"},
+		"go/token.GTR": {Sig: "const GTR", Doc: "The list of tokens.", Pos: "token.go:72"},
+		"go/token.ASSIGN": {Sig: "const ASSIGN", Doc: "The list of tokens.", Pos: "token.go:73"},
+		"go/token.NOT": {Sig: "const NOT", Doc: "The list of tokens.", Pos: "token.go:74"},
+		"go/token.NEQ": {Sig: "const NEQ", Doc: "The list of tokens.", Pos: "token.go:76"},
+		"go/token.LEQ": {Sig: "const LEQ", Doc: "The list of tokens.", Pos: "token.go:77"},
+		"go/token.GEQ": {Sig: "const GEQ", Doc: "The list of tokens.", Pos: "token.go:78"},
+		"go/token.DEFINE": {Sig: "const DEFINE", Doc: "The list of tokens.", Pos: "token.go:79"},
+		"go/token.ELLIPSIS": {Sig: "const ELLIPSIS", Doc: "The list of tokens.", Pos: "token.go:80"},
+		"go/token.LPAREN": {Sig: "const LPAREN", Doc: "The list of tokens.", Pos: "token.go:82"},
+		"go/token.LBRACK": {Sig: "const LBRACK", Doc: "The list of tokens.", Pos: "token.go:83"},
+		"go/token.LBRACE": {Sig: "const LBRACE", Doc: "The list of tokens.", Pos: "token.go:84"},
+		"go/token.COMMA": {Sig: "const COMMA", Doc: "The list of tokens.", Pos: "token.go:85"},
+		"go/token.PERIOD": {Sig: "const PERIOD", Doc: "The list of tokens.", Pos: "token.go:86"},
+		"go/token.RPAREN": {Sig: "const RPAREN", Doc: "The list of tokens.", Pos: "token.go:88"},
+		"go/token.RBRACK": {Sig: "const RBRACK", Doc: "The list of tokens.", Pos: "token.go:89"},
+		"go/token.RBRACE": {Sig: "const RBRACE", Doc: "The list of tokens.", Pos: "token.go:90"},
+		"go/token.SEMICOLON": {Sig: "const SEMICOLON", Doc: "The list of tokens.", Pos: "token.go:91"},
+		"go/token.COLON": {Sig: "const COLON", Doc: "The list of tokens.", Pos: "token.go:92"},
+		"go/token.BREAK": {Sig: "const BREAK", Doc: "Keywords", Pos: "token.go:97"},
+		"go/token.CASE": {Sig: "const CASE", Doc: "The list of tokens.", Pos: "token.go:98"},
+		"go/token.CHAN": {Sig: "const CHAN", Doc: "The list of tokens.", Pos: "token.go:99"},
+		"go/token.CONST": {Sig: "const CONST", Doc: "The list of tokens.", Pos: "token.go:100"},
+		"go/token.CONTINUE": {Sig: "const CONTINUE", Doc: "The list of tokens.", Pos: "token.go:101"},
+		"go/token.DEFAULT": {Sig: "const DEFAULT", Doc: "The list of tokens.", Pos: "token.go:103"},
+		"go/token.DEFER": {Sig: "const DEFER", Doc: "The list of tokens.", Pos: "token.go:104"},
+		"go/token.ELSE": {Sig: "const ELSE", Doc: "The list of tokens.", Pos: "token.go:105"},
+		"go/token.FALLTHROUGH": {Sig: "const FALLTHROUGH", Doc: "The list of tokens.", Pos: "token.go:106"},
+		"go/token.FOR": {Sig: "const FOR", Doc: "The list of tokens.", Pos: "token.go:107"},
+		"go/token.FUNC": {Sig: "const FUNC", Doc: "The list of tokens.", Pos: "token.go:109"},
+		"go/token.GO": {Sig: "const GO", Doc: "The list of tokens.", Pos: "token.go:110"},
+		"go/token.GOTO": {Sig: "const GOTO", Doc: "The list of tokens.", Pos: "token.go:111"},
+		"go/token.IF": {Sig: "const IF", Doc: "The list of tokens.", Pos: "token.go:112"},
+		"go/token.IMPORT": {Sig: "const IMPORT", Doc: "The list of tokens.", Pos: "token.go:113"},
+		"go/token.INTERFACE": {Sig: "const INTERFACE", Doc: "The list of tokens.", Pos: "token.go:115"},
+		"go/token.MAP": {Sig: "const MAP", Doc: "The list of tokens.", Pos: "token.go:116"},
+		"go/token.PACKAGE": {Sig: "const PACKAGE", Doc: "The list of tokens.", Pos: "token.go:117"},
+		"go/token.RANGE": {Sig: "const RANGE", Doc: "The list of tokens.", Pos: "token.go:118"},
+		"go/token.RETURN": {Sig: "const RETURN", Doc: "The list of tokens.", Pos: "token.go:119"},
+		"go/token.SELECT": {Sig: "const SELECT", Doc: "The list of tokens.", Pos: "token.go:121"},
+		"go/token.STRUCT": {Sig: "const STRUCT", Doc: "The list of tokens.", Pos: "token.go:122"},
+		"go/token.SWITCH": {Sig: "const SWITCH", Doc: "The list of tokens.", Pos: "token.go:123"},
+		"go/token.TYPE": {Sig: "const TYPE", Doc: "The list of tokens.", Pos: "token.go:124"},
+		"go/token.VAR": {Sig: "const VAR", Doc: "The list of tokens.", Pos: "token.go:125"},
+		"go/token.TILDE": {Sig: "const TILDE", Doc: "additional tokens, handled in an ad-hoc manner", Pos: "token.go:130"},
+		"go/token.Token.String": {Sig: "func (tok Token) String() string", Doc: "String returns the string corresponding to the token tok.", Pos: "token.go:241"},
+		"go/token.LowestPrec": {Sig: "const LowestPrec", Doc: "A set of constants for precedence-based expression parsing.", Pos: "token.go:258"},
+		"go/token.UnaryPrec": {Sig: "const UnaryPrec", Doc: "A set of constants for precedence-based expression parsing.", Pos: "token.go:259"},
+		"go/token.HighestPrec": {Sig: "const HighestPrec", Doc: "A set of constants for precedence-based expression parsing.", Pos: "token.go:260"},
+		"go/token.Token.Precedence": {Sig: "func (op Token) Precedence() int", Doc: "Precedence returns the operator precedence of the binary operator op.", Pos: "token.go:266"},
+		"go/token.Lookup": {Sig: "func Lookup(ident string) Token", Doc: "Lookup maps an identifier to its keyword token or [IDENT] (if not a keyword).", Pos: "token.go:292"},
+		"go/token.Token.IsLiteral": {Sig: "func (tok Token) IsLiteral() bool", Doc: "IsLiteral returns true for tokens corresponding to identifiers and basic type literals; it returns false otherwise.", Pos: "token.go:303"},
+		"go/token.Token.IsOperator": {Sig: "func (tok Token) IsOperator() bool", Doc: "IsOperator returns true for tokens corresponding to operators and delimiters; it returns false otherwise.", Pos: "token.go:307"},
+		"go/token.Token.IsKeyword": {Sig: "func (tok Token) IsKeyword() bool", Doc: "IsKeyword returns true for tokens corresponding to keywords; it returns false otherwise.", Pos: "token.go:313"},
+		"go/token.IsExported": {Sig: "func IsExported(name string) bool", Doc: "IsExported reports whether name starts with an upper-case letter.", Pos: "token.go:316"},
+		"go/token.IsKeyword": {Sig: "func IsKeyword(name string) bool", Doc: "IsKeyword reports whether name is a Go keyword, such as \"func\" or \"return\".", Pos: "token.go:322"},
+		"go/token.IsIdentifier": {Sig: "func IsIdentifier(name string) bool", Doc: "IsIdentifier reports whether name is a Go identifier, that is, a non-empty string made up of letters, digits, and underscores, where the first character is not a digit.", Pos: "token.go:331"},
+		"io": {Doc: "Package io provides basic interfaces to I/O primitives."},
+		"io.SeekStart": {Sig: "const SeekStart", Doc: "Seek whence values.", Pos: "io.go:22"},
+		"io.SeekCurrent": {Sig: "const SeekCurrent", Doc: "Seek whence values.", Pos: "io.go:23"},
+		"io.SeekEnd": {Sig: "const SeekEnd", Doc: "Seek whence values.", Pos: "io.go:24"},
+		"io.ErrShortWrite": {Sig: "var ErrShortWrite", Doc: "ErrShortWrite means that a write accepted fewer bytes than requested but failed to return an explicit error.", Pos: "io.go:29"},
+		"io.ErrShortBuffer": {Sig: "var ErrShortBuffer", Doc: "ErrShortBuffer means that a read required a longer buffer than was provided.", Pos: "io.go:35"},
+		"io.EOF": {Sig: "var EOF", Doc: "EOF is the error returned by Read when no more input is available.", Pos: "io.go:44"},
+		"io.ErrUnexpectedEOF": {Sig: "var ErrUnexpectedEOF", Doc: "ErrUnexpectedEOF means that EOF was encountered in the middle of reading a fixed-size block or data structure.", Pos: "io.go:48"},
+		"io.ErrNoProgress": {Sig: "var ErrNoProgress", Doc: "ErrNoProgress is returned by some clients of a [Reader] when many calls to Read have failed to return any data or error, usually the sign of a broken [Reader] implementation.", Pos: "io.go:53"},
+		"io.Reader": {Sig: "type Reader interface", Doc: "Reader is the interface that wraps the basic Read method.", Pos: "io.go:86"},
+		"io.Writer": {Sig: "type Writer interface", Doc: "Writer is the interface that wraps the basic Write method.", Pos: "io.go:99"},
+		"io.Closer": {Sig: "type Closer interface", Doc: "Closer is the interface that wraps the basic Close method.", Pos: "io.go:107"},
+		"io.Seeker": {Sig: "type Seeker interface", Doc: "Seeker is the interface that wraps the basic Seek method.", Pos: "io.go:126"},
+		"io.ReadWriter": {Sig: "type ReadWriter interface", Doc: "ReadWriter is the interface that groups the basic Read and Write methods.", Pos: "io.go:131"},
+		"io.ReadCloser": {Sig: "type ReadCloser interface", Doc: "ReadCloser is the interface that groups the basic Read and Close methods.", Pos: "io.go:137"},
+		"io.WriteCloser": {Sig: "type WriteCloser interface", Doc: "WriteCloser is the interface that groups the basic Write and Close methods.", Pos: "io.go:143"},
+		"io.ReadWriteCloser": {Sig: "type ReadWriteCloser interface", Doc: "ReadWriteCloser is the interface that groups the basic Read, Write and Close methods.", Pos: "io.go:149"},
+		"io.ReadSeeker": {Sig: "type ReadSeeker interface", Doc: "ReadSeeker is the interface that groups the basic Read and Seek methods.", Pos: "io.go:156"},
+		"io.ReadSeekCloser": {Sig: "type ReadSeekCloser interface", Doc: "ReadSeekCloser is the interface that groups the basic Read, Seek and Close methods.", Pos: "io.go:163"},
+		"io.WriteSeeker": {Sig: "type WriteSeeker interface", Doc: "WriteSeeker is the interface that groups the basic Write and Seek methods.", Pos: "io.go:170"},
+		"io.ReadWriteSeeker": {Sig: "type ReadWriteSeeker interface", Doc: "ReadWriteSeeker is the interface that groups the basic Read, Write and Seek methods.", Pos: "io.go:176"},
+		"io.ReaderFrom": {Sig: "type ReaderFrom interface", Doc: "ReaderFrom is the interface that wraps the ReadFrom method.", Pos: "io.go:189"},
+		"io.WriterTo": {Sig: "type WriterTo interface", Doc: "WriterTo is the interface that wraps the WriteTo method.", Pos: "io.go:200"},
+		"io.ReaderAt": {Sig: "type ReaderAt interface", Doc: "ReaderAt is the interface that wraps the basic ReadAt method.", Pos: "io.go:230"},
+		"io.WriterAt": {Sig: "type WriterAt interface", Doc: "WriterAt is the interface that wraps the basic WriteAt method.", Pos: "io.go:249"},
+		"io.ByteReader": {Sig: "type ByteReader interface", Doc: "ByteReader is the interface that wraps the ReadByte method.", Pos: "io.go:262"},
+		"io.ByteScanner": {Sig: "type ByteScanner interface", Doc: "ByteScanner is the interface that adds the UnreadByte method to the basic ReadByte method.", Pos: "io.go:274"},
+		"io.ByteWriter": {Sig: "type ByteWriter interface", Doc: "ByteWriter is the interface that wraps the WriteByte method.", Pos: "io.go:280"},
+		"io.RuneReader": {Sig: "type RuneReader interface", Doc: "RuneReader is the interface that wraps the ReadRune method.", Pos: "io.go:289"},
+		"io.RuneScanner": {Sig: "type RuneScanner interface", Doc: "RuneScanner is the interface that adds the UnreadRune method to the basic ReadRune method.", Pos: "io.go:301"},
+		"io.StringWriter": {Sig: "type StringWriter interface", Doc: "StringWriter is the interface that wraps the WriteString method.", Pos: "io.go:307"},
+		"io.WriteString": {Sig: "func WriteString(w Writer, s string) (n int, err error)", Doc: "WriteString writes the contents of the string s to w, which accepts a slice of bytes.", Pos: "io.go:314"},
+		"io.ReadAtLeast": {Sig: "func ReadAtLeast(r Reader, buf []byte, min int) (n int, err error)", Doc: "ReadAtLeast reads from r into buf until it has read at least min bytes.", Pos: "io.go:329"},
+		"io.ReadFull": {Sig: "func ReadFull(r Reader, buf []byte) (n int, err error)", Doc: "ReadFull reads exactly len(buf) bytes from r into buf.", Pos: "io.go:353"},
+		"io.CopyN": {Sig: "func CopyN(dst Writer, src Reader, n int64) (written int64, err error)", Doc: "CopyN copies n bytes (or until an error) from src to dst.", Pos: "io.go:363"},
+		"io.Copy": {Sig: "func Copy(dst Writer, src Reader) (written int64, err error)", Doc: "Copy copies from src to dst until either EOF is reached on src or an error occurs.", Pos: "io.go:387"},
+		"io.CopyBuffer": {Sig: "func CopyBuffer(dst Writer, src Reader, buf []byte) (written int64, err error)", Doc: "CopyBuffer is identical to Copy except that it stages through the provided buffer (if one is required) rather than allocating a temporary one.", Pos: "io.go:398"},
+		"io.LimitReader": {Sig: "func LimitReader(r Reader, n int64) Reader", Doc: "LimitReader returns a Reader that reads from r but stops with EOF after n bytes.", Pos: "io.go:461"},
+		"io.LimitedReader": {Sig: "type LimitedReader struct", Doc: "A LimitedReader reads from R but limits the amount of data returned to just N bytes.", Pos: "io.go:467"},
+		"io.LimitedReader.Read": {Sig: "func (l *LimitedReader) Read(p []byte) (n int, err error)", Doc: "", Pos: "io.go:472"},
+		"io.NewSectionReader": {Sig: "func NewSectionReader(r ReaderAt, off int64, n int64) *SectionReader", Doc: "NewSectionReader returns a [SectionReader] that reads from r starting at offset off and stops with EOF after n bytes.", Pos: "io.go:486"},
+		"io.SectionReader": {Sig: "type SectionReader struct", Doc: "SectionReader implements Read, Seek, and ReadAt on a section of an underlying [ReaderAt].", Pos: "io.go:501"},
+		"io.SectionReader.Read": {Sig: "func (s *SectionReader) Read(p []byte) (n int, err error)", Doc: "", Pos: "io.go:509"},
+		"io.SectionReader.Seek": {Sig: "func (s *SectionReader) Seek(offset int64, whence int) (int64, error)", Doc: "", Pos: "io.go:524"},
+		"io.SectionReader.ReadAt": {Sig: "func (s *SectionReader) ReadAt(p []byte, off int64) (n int, err error)", Doc: "", Pos: "io.go:542"},
+		"io.SectionReader.Size": {Sig: "func (s *SectionReader) Size() int64", Doc: "Size returns the size of the section in bytes.", Pos: "io.go:559"},
+		"io.SectionReader.Outer": {Sig: "func (s *SectionReader) Outer() (r ReaderAt, off int64, n int64)", Doc: "Outer returns the underlying [ReaderAt] and offsets for the section.", Pos: "io.go:565"},
+		"io.OffsetWriter": {Sig: "type OffsetWriter struct", Doc: "An OffsetWriter maps writes at offset base to offset base+off in the underlying writer.", Pos: "io.go:570"},
+		"io.NewOffsetWriter": {Sig: "func NewOffsetWriter(w WriterAt, off int64) *OffsetWriter", Doc: "NewOffsetWriter returns an [OffsetWriter] that writes to w starting at offset off.", Pos: "io.go:578"},
+		"io.OffsetWriter.Write": {Sig: "func (o *OffsetWriter) Write(p []byte) (n int, err error)", Doc: "", Pos: "io.go:582"},
+		"io.OffsetWriter.WriteAt": {Sig: "func (o *OffsetWriter) WriteAt(p []byte, off int64) (n int, err error)", Doc: "", Pos: "io.go:588"},
+		"io.OffsetWriter.Seek": {Sig: "func (o *OffsetWriter) Seek(offset int64, whence int) (int64, error)", Doc: "", Pos: "io.go:597"},
+		"io.TeeReader": {Sig: "func TeeReader(r Reader, w Writer) Reader", Doc: "TeeReader returns a [Reader] that writes to w what it reads from r.", Pos: "io.go:618"},
+		"io.Discard": {Sig: "var Discard Writer", Doc: "Discard is a [Writer] on which all Write calls succeed without doing anything.", Pos: "io.go:639"},
+		"io.NopCloser": {Sig: "func NopCloser(r Reader) ReadCloser", Doc: "NopCloser returns a [ReadCloser] with a no-op Close method wrapping the provided [Reader] r.", Pos: "io.go:682"},
+		"io.ReadAll": {Sig: "func ReadAll(r Reader) ([]byte, error)", Doc: "ReadAll reads from r until an error or EOF and returns the data it read.", Pos: "io.go:709"},
+		"io.MultiReader": {Sig: "func MultiReader(readers ...Reader) Reader", Doc: "MultiReader returns a Reader that's the logical concatenation of the provided input readers.", Pos: "multi.go:73"},
+		"io.MultiWriter": {Sig: "func MultiWriter(writers ...Writer) Writer", Doc: "MultiWriter creates a writer that duplicates its writes to all the provided writers, similar to the Unix tee(1) command.", Pos: "multi.go:127"},
+		"io.ErrClosedPipe": {Sig: "var ErrClosedPipe", Doc: "ErrClosedPipe is the error used for read or write operations on a closed pipe.", Pos: "pipe.go:36"},
+		"io.PipeReader": {Sig: "type PipeReader struct", Doc: "A PipeReader is the read half of a pipe.", Pos: "pipe.go:126"},
+		"io.PipeReader.Read": {Sig: "func (r *PipeReader) Read(data []byte) (n int, err error)", Doc: "Read implements the standard Read interface: it reads data from the pipe, blocking until a writer arrives or the write end is closed.", Pos: "pipe.go:133"},
+		"io.PipeReader.Close": {Sig: "func (r *PipeReader) Close() error", Doc: "Close closes the reader; subsequent writes to the write half of the pipe will return the error [ErrClosedPipe].", Pos: "pipe.go:139"},
+		"io.PipeReader.CloseWithError": {Sig: "func (r *PipeReader) CloseWithError(err error) error", Doc: "CloseWithError closes the reader; subsequent writes to the write half of the pipe will return the error err.", Pos: "pipe.go:148"},
+		"io.PipeWriter": {Sig: "type PipeWriter struct", Doc: "A PipeWriter is the write half of a pipe.", Pos: "pipe.go:153"},
+		"io.PipeWriter.Write": {Sig: "func (w *PipeWriter) Write(data []byte) (n int, err error)", Doc: "Write implements the standard Write interface: it writes data to the pipe, blocking until one or more readers have consumed all the data or the read end is closed.", Pos: "pipe.go:160"},
+		"io.PipeWriter.Close": {Sig: "func (w *PipeWriter) Close() error", Doc: "Close closes the writer; subsequent reads from the read half of the pipe will return no bytes and EOF.", Pos: "pipe.go:166"},
+		"io.PipeWriter.CloseWithError": {Sig: "func (w *PipeWriter) CloseWithError(err error) error", Doc: "CloseWithError closes the writer; subsequent reads from the read half of the pipe will return no bytes and the error err, or EOF if err is nil.", Pos: "pipe.go:176"},
+		"io.Pipe": {Sig: "func Pipe() (*PipeReader, *PipeWriter)", Doc: "Pipe creates a synchronous in-memory pipe.", Pos: "pipe.go:195"},
+		"io/ioutil": {Doc: "Package ioutil implements some I/O utility functions."},
+		"io/ioutil.ReadAll": {Sig: "func ReadAll(r io.Reader) ([]byte, error)", Doc: "ReadAll reads from r until an error or EOF and returns the data it read.", Pos: "ioutil.go:29"},
+		"io/ioutil.ReadFile": {Sig: "func ReadFile(filename string) ([]byte, error)", Doc: "ReadFile reads the file named by filename and returns the contents.", Pos: "ioutil.go:41"},
+		"io/ioutil.WriteFile": {Sig: "func WriteFile(filename string, data []byte, perm fs.FileMode) error", Doc: "WriteFile writes data to a file named by filename.", Pos: "ioutil.go:52"},
+		"io/ioutil.ReadDir": {Sig: "func ReadDir(dirname string) ([]fs.FileInfo, error)", Doc: "ReadDir reads the directory named by dirname and returns a list of fs.FileInfo for the directory's contents, sorted by filename.", Pos: "ioutil.go:76"},
+		"io/ioutil.NopCloser": {Sig: "func NopCloser(r io.Reader) io.ReadCloser", Doc: "NopCloser returns a ReadCloser with a no-op Close method wrapping the provided Reader r.", Pos: "ioutil.go:98"},
+		"io/ioutil.Discard": {Sig: "var Discard io.Writer", Doc: "Discard is an io.Writer on which all Write calls succeed without doing anything.", Pos: "ioutil.go:106"},
+		"io/ioutil.TempFile": {Sig: "func TempFile(dir, pattern string) (f *os.File, err error)", Doc: "TempFile creates a new temporary file in the directory dir, opens the file for reading and writing, and returns the resulting *os.File.", Pos: "tempfile.go:26"},
+		"io/ioutil.TempDir": {Sig: "func TempDir(dir, pattern string) (name string, err error)", Doc: "TempDir creates a new temporary directory in the directory dir.", Pos: "tempfile.go:43"},
+		"log": {Doc: "Package log implements a simple logging package."},
+		"log.Ldate": {Sig: "const Ldate", Doc: "These flags define which text to prefix to each log entry generated by the [Logger].", Pos: "log.go:43"},
+		"log.Ltime": {Sig: "const Ltime", Doc: "These flags define which text to prefix to each log entry generated by the [Logger].", Pos: "log.go:44"},
+		"log.Lmicroseconds": {Sig: "const Lmicroseconds", Doc: "These flags define which text to prefix to each log entry generated by the [Logger].", Pos: "log.go:45"},
+		"log.Llongfile": {Sig: "const Llongfile", Doc: "These flags define which text to prefix to each log entry generated by the [Logger].", Pos: "log.go:46"},
+		"log.Lshortfile": {Sig: "const Lshortfile", Doc: "These flags define which text to prefix to each log entry generated by the [Logger].", Pos: "log.go:47"},
+		"log.LUTC": {Sig: "const LUTC", Doc: "These flags define which text to prefix to each log entry generated by the [Logger].", Pos: "log.go:48"},
+		"log.Lmsgprefix": {Sig: "const Lmsgprefix", Doc: "These flags define which text to prefix to each log entry generated by the [Logger].", Pos: "log.go:49"},
+		"log.LstdFlags": {Sig: "const LstdFlags", Doc: "These flags define which text to prefix to each log entry generated by the [Logger].", Pos: "log.go:50"},
+		"log.Logger": {Sig: "type Logger struct", Doc: "A Logger represents an active logging object that generates lines of output to an io.Writer.", Pos: "log.go:57"},
+		"log.New": {Sig: "func New(out io.Writer, prefix string, flag int) *Logger", Doc: "New creates a new [Logger].", Pos: "log.go:71"},
+		"log.Logger.SetOutput": {Sig: "func (l *Logger) SetOutput(w io.Writer)", Doc: "SetOutput sets the output destination for the logger.", Pos: "log.go:80"},
+		"log.Default": {Sig: "func Default() *Logger", Doc: "Default returns the standard logger used by the package-level output functions.", Pos: "log.go:90"},
+		"log.Logger.Output": {Sig: "func (l *Logger) Output(calldepth int, s string) error", Doc: "Output writes the output for a logging event.", Pos: "log.go:193"},
+		"log.Logger.Print": {Sig: "func (l *Logger) Print(v ...any)", Doc: "Print calls l.Output to print to the logger.", Pos: "log.go:258"},
+		"log.Logger.Printf": {Sig: "func (l *Logger) Printf(format string, v ...any)", Doc: "Printf calls l.Output to print to the logger.", Pos: "log.go:266"},
+		"log.Logger.Println": {Sig: "func (l *Logger) Println(v ...any)", Doc: "Println calls l.Output to print to the logger.", Pos: "log.go:274"},
+		"log.Logger.Fatal": {Sig: "func (l *Logger) Fatal(v ...any)", Doc: "Fatal is equivalent to l.Print() followed by a call to os.Exit(1).", Pos: "log.go:281"},
+		"log.Logger.Fatalf": {Sig: "func (l *Logger) Fatalf(format string, v ...any)", Doc: "Fatalf is equivalent to l.Printf() followed by a call to os.Exit(1).", Pos: "log.go:289"},
+		"log.Logger.Fatalln": {Sig: "func (l *Logger) Fatalln(v ...any)", Doc: "Fatalln is equivalent to l.Println() followed by a call to os.Exit(1).", Pos: "log.go:297"},
+		"log.Logger.Panic": {Sig: "func (l *Logger) Panic(v ...any)", Doc: "Panic is equivalent to l.Print() followed by a call to panic().", Pos: "log.go:305"},
+		"log.Logger.Panicf": {Sig: "func (l *Logger) Panicf(format string, v ...any)", Doc: "Panicf is equivalent to l.Printf() followed by a call to panic().", Pos: "log.go:314"},
+		"log.Logger.Panicln": {Sig: "func (l *Logger) Panicln(v ...any)", Doc: "Panicln is equivalent to l.Println() followed by a call to panic().", Pos: "log.go:323"},
+		"log.Logger.Flags": {Sig: "func (l *Logger) Flags() int", Doc: "Flags returns the output flags for the logger.", Pos: "log.go:333"},
+		"log.Logger.SetFlags": {Sig: "func (l *Logger) SetFlags(flag int)", Doc: "SetFlags sets the output flags for the logger.", Pos: "log.go:339"},
+		"log.Logger.Prefix": {Sig: "func (l *Logger) Prefix() string", Doc: "Prefix returns the output prefix for the logger.", Pos: "log.go:344"},
+		"log.Logger.SetPrefix": {Sig: "func (l *Logger) SetPrefix(prefix string)", Doc: "SetPrefix sets the output prefix for the logger.", Pos: "log.go:352"},
+		"log.Logger.Writer": {Sig: "func (l *Logger) Writer() io.Writer", Doc: "Writer returns the output destination for the logger.", Pos: "log.go:357"},
+		"log.SetOutput": {Sig: "func SetOutput(w io.Writer)", Doc: "SetOutput sets the output destination for the standard logger.", Pos: "log.go:364"},
+		"log.Flags": {Sig: "func Flags() int", Doc: "Flags returns the output flags for the standard logger.", Pos: "log.go:370"},
+		"log.SetFlags": {Sig: "func SetFlags(flag int)", Doc: "SetFlags sets the output flags for the standard logger.", Pos: "log.go:376"},
+		"log.Prefix": {Sig: "func Prefix() string", Doc: "Prefix returns the output prefix for the standard logger.", Pos: "log.go:381"},
+		"log.SetPrefix": {Sig: "func SetPrefix(prefix string)", Doc: "SetPrefix sets the output prefix for the standard logger.", Pos: "log.go:386"},
+		"log.Writer": {Sig: "func Writer() io.Writer", Doc: "Writer returns the output destination for the standard logger.", Pos: "log.go:391"},
+		"log.Print": {Sig: "func Print(v ...any)", Doc: "Print calls Output to print to the standard logger.", Pos: "log.go:399"},
+		"log.Printf": {Sig: "func Printf(format string, v ...any)", Doc: "Printf calls Output to print to the standard logger.", Pos: "log.go:407"},
+		"log.Println": {Sig: "func Println(v ...any)", Doc: "Println calls Output to print to the standard logger.", Pos: "log.go:415"},
+		"log.Fatal": {Sig: "func Fatal(v ...any)", Doc: "Fatal is equivalent to [Print] followed by a call to os.Exit(1).", Pos: "log.go:422"},
+		"log.Fatalf": {Sig: "func Fatalf(format string, v ...any)", Doc: "Fatalf is equivalent to [Printf] followed by a call to os.Exit(1).", Pos: "log.go:430"},
+		"log.Fatalln": {Sig: "func Fatalln(v ...any)", Doc: "Fatalln is equivalent to [Println] followed by a call to os.Exit(1).", Pos: "log.go:438"},
+		"log.Panic": {Sig: "func Panic(v ...any)", Doc: "Panic is equivalent to [Print] followed by a call to panic().", Pos: "log.go:446"},
+		"log.Panicf": {Sig: "func Panicf(format string, v ...any)", Doc: "Panicf is equivalent to [Printf] followed by a call to panic().", Pos: "log.go:455"},
+		"log.Panicln": {Sig: "func Panicln(v ...any)", Doc: "Panicln is equivalent to [Println] followed by a call to panic().", Pos: "log.go:464"},
+		"log.Output": {Sig: "func Output(calldepth int, s string) error", Doc: "Output writes the output for a logging event.", Pos: "log.go:479"},
+		"math": {Doc: "Package math provides basic constants and mathematical functions."},
+		"math.Abs": {Sig: "func Abs(x float64) float64", Doc: "Abs returns the absolute value of x.", Pos: "abs.go:13"},
+		"math.Acosh": {Sig: "func Acosh(x float64) float64", Doc: "Acosh returns the inverse hyperbolic cosine of x.", Pos: "acosh.go:43"},
+		"math.Asin": {Sig: "func Asin(x float64) float64", Doc: "Asin returns the arcsine, in radians, of x.", Pos: "asin.go:20"},
+		"math.Acos": {Sig: "func Acos(x float64) float64", Doc: "Acos returns the arccosine, in radians, of x.", Pos: "asin.go:58"},
+		"math.Asinh": {Sig: "func Asinh(x float64) float64", Doc: "Asinh returns the inverse hyperbolic sine of x.", Pos: "asinh.go:40"},
+		"math.Atan": {Sig: "func Atan(x float64) float64", Doc: "Atan returns the arctangent, in radians, of x.", Pos: "atan.go:96"},
+		"math.Atan2": {Sig: "func Atan2(y, x float64) float64", Doc: "Atan2 returns the arc tangent of y/x, using the signs of the two to determine the quadrant of the return value.", Pos: "atan2.go:30"},
+		"math.Atanh": {Sig: "func Atanh(x float64) float64", Doc: "Atanh returns the inverse hyperbolic tangent of x.", Pos: "atanh.go:48"},
+		"math.Inf": {Sig: "func Inf(sign int) float64", Doc: "Inf returns positive infinity if sign >= 0, negative infinity if sign < 0.", Pos: "bits.go:20"},
+		"math.NaN": {Sig: "func NaN() float64", Doc: "NaN returns an IEEE 754 “not-a-number” value.", Pos: "bits.go:31"},
+		"math.IsNaN": {Sig: "func IsNaN(f float64) (is bool)", Doc: "IsNaN reports whether f is an IEEE 754 “not-a-number” value.", Pos: "bits.go:34"},
+		"math.IsInf": {Sig: "func IsInf(f float64, sign int) bool", Doc: "IsInf reports whether f is an infinity, according to sign.", Pos: "bits.go:46"},
+		"math.Cbrt": {Sig: "func Cbrt(x float64) float64", Doc: "Cbrt returns the cube root of x.", Pos: "cbrt.go:26"},
+		"math.E": {Sig: "const E", Doc: "Mathematical constants.", Pos: "const.go:12"},
+		"math.Pi": {Sig: "const Pi", Doc: "Mathematical constants.", Pos: "const.go:13"},
+		"math.Phi": {Sig: "const Phi", Doc: "Mathematical constants.", Pos: "const.go:14"},
+		"math.Sqrt2": {Sig: "const Sqrt2", Doc: "Mathematical constants.", Pos: "const.go:16"},
+		"math.SqrtE": {Sig: "const SqrtE", Doc: "Mathematical constants.", Pos: "const.go:17"},
+		"math.SqrtPi": {Sig: "const SqrtPi", Doc: "Mathematical constants.", Pos: "const.go:18"},
+		"math.SqrtPhi": {Sig: "const SqrtPhi", Doc: "Mathematical constants.", Pos: "const.go:19"},
+		"math.Ln2": {Sig: "const Ln2", Doc: "Mathematical constants.", Pos: "const.go:21"},
+		"math.Log2E": {Sig: "const Log2E", Doc: "Mathematical constants.", Pos: "const.go:22"},
+		"math.Ln10": {Sig: "const Ln10", Doc: "Mathematical constants.", Pos: "const.go:23"},
+		"math.Log10E": {Sig: "const Log10E", Doc: "Mathematical constants.", Pos: "const.go:24"},
+		"math.MaxFloat32": {Sig: "const MaxFloat32", Doc: "Floating-point limit values.", Pos: "const.go:31"},
+		"math.SmallestNonzeroFloat32": {Sig: "const SmallestNonzeroFloat32", Doc: "Floating-point limit values.", Pos: "const.go:32"},
+		"math.MaxFloat64": {Sig: "const MaxFloat64", Doc: "Floating-point limit values.", Pos: "const.go:34"},
+		"math.SmallestNonzeroFloat64": {Sig: "const SmallestNonzeroFloat64", Doc: "Floating-point limit values.", Pos: "const.go:35"},
+		"math.MaxInt": {Sig: "const MaxInt", Doc: "Integer limit values.", Pos: "const.go:42"},
+		"math.MinInt": {Sig: "const MinInt", Doc: "Integer limit values.", Pos: "const.go:43"},
+		"math.MaxInt8": {Sig: "const MaxInt8", Doc: "Integer limit values.", Pos: "const.go:44"},
+		"math.MinInt8": {Sig: "const MinInt8", Doc: "Integer limit values.", Pos: "const.go:45"},
+		"math.MaxInt16": {Sig: "const MaxInt16", Doc: "Integer limit values.", Pos: "const.go:46"},
+		"math.MinInt16": {Sig: "const MinInt16", Doc: "Integer limit values.", Pos: "const.go:47"},
+		"math.MaxInt32": {Sig: "const MaxInt32", Doc: "Integer limit values.", Pos: "const.go:48"},
+		"math.MinInt32": {Sig: "const MinInt32", Doc: "Integer limit values.", Pos: "const.go:49"},
+		"math.MaxInt64": {Sig: "const MaxInt64", Doc: "Integer limit values.", Pos: "const.go:50"},
+		"math.MinInt64": {Sig: "const MinInt64", Doc: "Integer limit values.", Pos: "const.go:51"},
+		"math.MaxUint": {Sig: "const MaxUint", Doc: "Integer limit values.", Pos: "const.go:52"},
+		"math.MaxUint8": {Sig: "const MaxUint8", Doc: "Integer limit values.", Pos: "const.go:53"},
+		"math.MaxUint16": {Sig: "const MaxUint16", Doc: "Integer limit values.", Pos: "const.go:54"},
+		"math.MaxUint32": {Sig: "const MaxUint32", Doc: "Integer limit values.", Pos: "const.go:55"},
+		"math.MaxUint64": {Sig: "const MaxUint64", Doc: "Integer limit values.", Pos: "const.go:56"},
+		"math.Copysign": {Sig: "func Copysign(f, sign float64) float64", Doc: "Copysign returns a value with the magnitude of f and the sign of sign.", Pos: "copysign.go:9"},
+		"math.Dim": {Sig: "func Dim(x, y float64) float64", Doc: "Dim returns the maximum of x-y or 0.", Pos: "dim.go:14"},
+		"math.Max": {Sig: "func Max(x, y float64) float64", Doc: "Max returns the larger of x or y.", Pos: "dim.go:40"},
+		"math.Min": {Sig: "func Min(x, y float64) float64", Doc: "Min returns the smaller of x or y.", Pos: "dim.go:76"},
+		"math.Erf": {Sig: "func Erf(x float64) float64", Doc: "Erf returns the error function of x.", Pos: "erf.go:189"},
+		"math.Erfc": {Sig: "func Erfc(x float64) float64", Doc: "Erfc returns the complementary error function of x.", Pos: "erf.go:274"},
+		"math.Erfinv": {Sig: "func Erfinv(x float64) float64", Doc: "Erfinv returns the inverse error function of x.", Pos: "erfinv.go:77"},
+		"math.Erfcinv": {Sig: "func Erfcinv(x float64) float64", Doc: "Erfcinv returns the inverse of [Erfc](x).", Pos: "erfinv.go:127"},
+		"math.Exp": {Sig: "func Exp(x float64) float64", Doc: "Exp returns e**x, the base-e exponential of x.", Pos: "exp.go:16"},
+		"math.Exp2": {Sig: "func Exp2(x float64) float64", Doc: "Exp2 returns 2**x, the base-2 exponential of x.", Pos: "exp.go:140"},
+		"math.Expm1": {Sig: "func Expm1(x float64) float64", Doc: "Expm1 returns e**x - 1, the base-e exponential of x minus 1.", Pos: "expm1.go:126"},
+		"math.Floor": {Sig: "func Floor(x float64) float64", Doc: "Floor returns the greatest integer value less than or equal to x.", Pos: "floor.go:14"},
+		"math.Ceil": {Sig: "func Ceil(x float64) float64", Doc: "Ceil returns the least integer value greater than or equal to x.", Pos: "floor.go:43"},
+		"math.Trunc": {Sig: "func Trunc(x float64) float64", Doc: "Trunc returns the integer value of x.", Pos: "floor.go:61"},
+		"math.Round": {Sig: "func Round(x float64) float64", Doc: "Round returns the nearest integer, rounding half away from zero.", Pos: "floor.go:90"},
+		"math.RoundToEven": {Sig: "func RoundToEven(x float64) float64", Doc: "RoundToEven returns the nearest integer, rounding ties to even.", Pos: "floor.go:128"},
+		"math.FMA": {Sig: "func FMA(x, y, z float64) float64", Doc: "FMA returns x * y + z, computed with only one rounding.", Pos: "fma.go:95"},
+		"math.Frexp": {Sig: "func Frexp(f float64) (frac float64, exp int)", Doc: "Frexp breaks f into a normalized fraction and an integral power of two.", Pos: "frexp.go:17"},
+		"math.Gamma": {Sig: "func Gamma(x float64) float64", Doc: "Gamma returns the Gamma function of x.", Pos: "gamma.go:131"},
+		"math.Hypot": {Sig: "func Hypot(p, q float64) float64", Doc: "Hypot returns [Sqrt](p*p + q*q), taking care to avoid unnecessary overflow and underflow.", Pos: "hypot.go:20"},
+		"math.J0": {Sig: "func J0(x float64) float64", Doc: "J0 returns the order-zero Bessel function of the first kind.", Pos: "j0.go:77"},
+		"math.Y0": {Sig: "func Y0(x float64) float64", Doc: "Y0 returns the order-zero Bessel function of the second kind.", Pos: "j0.go:156"},
+		"math.J1": {Sig: "func J1(x float64) float64", Doc: "J1 returns the order-one Bessel function of the first kind.", Pos: "j1.go:75"},
+		"math.Y1": {Sig: "func Y1(x float64) float64", Doc: "Y1 returns the order-one Bessel function of the second kind.", Pos: "j1.go:156"},
+		"math.Jn": {Sig: "func Jn(n int, x float64) float64", Doc: "Jn returns the order-n Bessel function of the first kind.", Pos: "jn.go:54"},
+		"math.Yn": {Sig: "func Yn(n int, x float64) float64", Doc: "Yn returns the order-n Bessel function of the second kind.", Pos: "jn.go:235"},
+		"math.Ldexp": {Sig: "func Ldexp(frac float64, exp int) float64", Doc: "Ldexp is the inverse of [Frexp].", Pos: "ldexp.go:15"},
+		"math.Lgamma": {Sig: "func Lgamma(x float64) (lgamma float64, sign int)", Doc: "Lgamma returns the natural logarithm and sign (-1 or +1) of [Gamma](x).", Pos: "lgamma.go:175"},
+		"math.Log": {Sig: "func Log(x float64) float64", Doc: "Log returns the natural logarithm of x.", Pos: "log.go:81"},
+		"math.Log10": {Sig: "func Log10(x float64) float64", Doc: "Log10 returns the decimal logarithm of x.", Pos: "log10.go:9"},
+		"math.Log2": {Sig: "func Log2(x float64) float64", Doc: "Log2 returns the binary logarithm of x.", Pos: "log10.go:22"},
+		"math.Log1p": {Sig: "func Log1p(x float64) float64", Doc: "Log1p returns the natural logarithm of 1 plus its argument x.", Pos: "log1p.go:96"},
+		"math.Logb": {Sig: "func Logb(x float64) float64", Doc: "Logb returns the binary exponent of x.", Pos: "logb.go:14"},
+		"math.Ilogb": {Sig: "func Ilogb(x float64) int", Doc: "Ilogb returns the binary exponent of x as an integer.", Pos: "logb.go:34"},
+		"math.Mod": {Sig: "func Mod(x, y float64) float64", Doc: "Mod returns the floating-point remainder of x/y.", Pos: "mod.go:22"},
+		"math.Modf": {Sig: "func Modf(f float64) (integer float64, fractional float64)", Doc: "Modf returns integer and fractional floating-point numbers that sum to f.", Pos: "modf.go:14"},
+		"math.Nextafter32": {Sig: "func Nextafter32(x, y float32) (r float32)", Doc: "Nextafter32 returns the next representable float32 value after x towards y.", Pos: "nextafter.go:15"},
+		"math.Nextafter": {Sig: "func Nextafter(x, y float64) (r float64)", Doc: "Nextafter returns the next representable float64 value after x towards y.", Pos: "nextafter.go:39"},
+		"math.Pow": {Sig: "func Pow(x, y float64) float64", Doc: "Pow returns x**y, the base-x exponential of y.", Pos: "pow.go:48"},
+		"math.Pow10": {Sig: "func Pow10(n int) float64", Doc: "Pow10 returns 10**n, the base-10 exponential of n.", Pos: "pow10.go:31"},
+		"math.Remainder": {Sig: "func Remainder(x, y float64) float64", Doc: "Remainder returns the IEEE 754 floating-point remainder of x/y.", Pos: "remainder.go:38"},
+		"math.Signbit": {Sig: "func Signbit(x float64) bool", Doc: "Signbit reports whether x is negative or negative zero.", Pos: "signbit.go:8"},
+		"math.Cos": {Sig: "func Cos(x float64) float64", Doc: "Cos returns the cosine of the radian argument x.", Pos: "sin.go:118"},
+		"math.Sin": {Sig: "func Sin(x float64) float64", Doc: "Sin returns the sine of the radian argument x.", Pos: "sin.go:185"},
+		"math.Sincos": {Sig: "func Sincos(x float64) (sin, cos float64)", Doc: "Sincos returns Sin(x), Cos(x).", Pos: "sincos.go:16"},
+		"math.Sinh": {Sig: "func Sinh(x float64) float64", Doc: "Sinh returns the hyperbolic sine of x.", Pos: "sinh.go:26"},
+		"math.Cosh": {Sig: "func Cosh(x float64) float64", Doc: "Cosh returns the hyperbolic cosine of x.", Pos: "sinh.go:79"},
+		"math.Sqrt": {Sig: "func Sqrt(x float64) float64", Doc: "Sqrt returns the square root of x.", Pos: "sqrt.go:93"},
+		"math.Tan": {Sig: "func Tan(x float64) float64", Doc: "Tan returns the tangent of the radian argument x.", Pos: "tan.go:83"},
+		"math.Tanh": {Sig: "func Tanh(x float64) float64", Doc: "Tanh returns the hyperbolic tangent of x.", Pos: "tanh.go:75"},
+		"math.Float32bits": {Sig: "func Float32bits(f float32) uint32", Doc: "Float32bits returns the IEEE 754 binary representation of f, with the sign bit of f and the result in the same bit position.", Pos: "unsafe.go:24"},
+		"math.Float32frombits": {Sig: "func Float32frombits(b uint32) float32", Doc: "Float32frombits returns the floating-point number corresponding to the IEEE 754 binary representation b, with the sign bit of b and the result in the same bit position.", Pos: "unsafe.go:30"},
+		"math.Float64bits": {Sig: "func Float64bits(f float64) uint64", Doc: "Float64bits returns the IEEE 754 binary representation of f, with the sign bit of f and the result in the same bit position, and Float64bits(Float64frombits(x)) == x.", Pos: "unsafe.go:35"},
+		"math.Float64frombits": {Sig: "func Float64frombits(b uint64) float64", Doc: "Float64frombits returns the floating-point number corresponding to the IEEE 754 binary representation b, with the sign bit of b and the result in the same bit position.", Pos: "unsafe.go:41"},
+		"math/big": {Doc: "Package big implements arbitrary-precision arithmetic (big numbers)."},
+		"math/big.Accuracy.String": {Sig: "func (i Accuracy) String() string", Doc: "", Pos: "accuracy_string.go:20"},
+		"math/big.Word": {Sig: "type Word uint", Doc: "A Word represents a single digit of a multi-precision unsigned integer.", Pos: "arith.go:19"},
+		"math/big.Float": {Sig: "type Float struct", Doc: "A nonzero finite Float represents a multi-precision floating point number", Pos: "float.go:65"},
+		"math/big.ErrNaN": {Sig: "type ErrNaN struct", Doc: "An ErrNaN panic is raised by a [Float] operation that would lead to a NaN under IEEE 754 rules.", Pos: "float.go:77"},
+		"math/big.ErrNaN.Error": {Sig: "func (err ErrNaN) Error() string", Doc: "", Pos: "float.go:83"},
+		"math/big.NewFloat": {Sig: "func NewFloat(x float64) *Float", Doc: "NewFloat allocates and returns a new [Float] set to x, with precision 53 and rounding mode [ToNearestEven].", Pos: "float.go:90"},
+		"math/big.MaxExp": {Sig: "const MaxExp", Doc: "Exponent and precision limits.", Pos: "float.go:99"},
+		"math/big.MinExp": {Sig: "const MinExp", Doc: "Exponent and precision limits.", Pos: "float.go:100"},
+		"math/big.MaxPrec": {Sig: "const MaxPrec", Doc: "Exponent and precision limits.", Pos: "float.go:101"},
+		"math/big.RoundingMode": {Sig: "type RoundingMode byte", Doc: "RoundingMode determines how a [Float] value is rounded to the desired precision.", Pos: "float.go:134"},
+		"math/big.ToNearestEven": {Sig: "const ToNearestEven RoundingMode", Doc: "These constants define supported rounding modes.", Pos: "float.go:138"},
+		"math/big.ToNearestAway": {Sig: "const ToNearestAway", Doc: "These constants define supported rounding modes.", Pos: "float.go:139"},
+		"math/big.ToZero": {Sig: "const ToZero", Doc: "These constants define supported rounding modes.", Pos: "float.go:140"},
+		"math/big.AwayFromZero": {Sig: "const AwayFromZero", Doc: "These constants define supported rounding modes.", Pos: "float.go:141"},
+		"math/big.ToNegativeInf": {Sig: "const ToNegativeInf", Doc: "These constants define supported rounding modes.", Pos: "float.go:142"},
+		"math/big.ToPositiveInf": {Sig: "const ToPositiveInf", Doc: "These constants define supported rounding modes.", Pos: "float.go:143"},
+		"math/big.Accuracy": {Sig: "type Accuracy int8", Doc: "Accuracy describes the rounding error produced by the most recent operation that generated a [Float] value, relative to the exact value.", Pos: "float.go:150"},
+		"math/big.Below": {Sig: "const Below Accuracy", Doc: "Constants describing the [Accuracy] of a [Float].", Pos: "float.go:154"},
+		"math/big.Exact": {Sig: "const Exact Accuracy", Doc: "Constants describing the [Accuracy] of a [Float].", Pos: "float.go:155"},
+		"math/big.Above": {Sig: "const Above Accuracy", Doc: "Constants describing the [Accuracy] of a [Float].", Pos: "float.go:156"},
+		"math/big.Float.SetPrec": {Sig: "func (z *Float) SetPrec(prec uint) *Float", Doc: "SetPrec sets z's precision to prec and returns the (possibly) rounded value of z.", Pos: "float.go:166"},
+		"math/big.Float.SetMode": {Sig: "func (z *Float) SetMode(mode RoundingMode) *Float", Doc: "SetMode sets z's rounding mode to mode and returns an exact z.", Pos: "float.go:202"},
+		"math/big.Float.Prec": {Sig: "func (x *Float) Prec() uint", Doc: "Prec returns the mantissa precision of x in bits.", Pos: "float.go:210"},
+		"math/big.Float.MinPrec": {Sig: "func (x *Float) MinPrec() uint", Doc: "MinPrec returns the minimum precision required to represent x exactly (i.e., the smallest prec before x.SetPrec(prec) would start rounding x).", Pos: "float.go:217"},
+		"math/big.Float.Mode": {Sig: "func (x *Float) Mode() RoundingMode", Doc: "Mode returns the rounding mode of x.", Pos: "float.go:225"},
+		"math/big.Float.Acc": {Sig: "func (x *Float) Acc() Accuracy", Doc: "Acc returns the accuracy of x produced by the most recent operation, unless explicitly documented otherwise by that operation.", Pos: "float.go:232"},
+		"math/big.Float.Sign": {Sig: "func (x *Float) Sign() int", Doc: "Sign returns:", Pos: "float.go:240"},
+		"math/big.Float.MantExp": {Sig: "func (x *Float) MantExp(mant *Float) (exp int)", Doc: "MantExp breaks x into its mantissa and exponent components and returns the exponent.", Pos: "float.go:268"},
+		"math/big.Float.SetMantExp": {Sig: "func (z *Float) SetMantExp(mant *Float, exp int) *Float", Doc: "SetMantExp sets z to mant × 2**exp and returns z.", Pos: "float.go:321"},
+		"math/big.Float.Signbit": {Sig: "func (x *Float) Signbit() bool", Doc: "Signbit reports whether x is negative or negative zero.", Pos: "float.go:336"},
+		"math/big.Float.IsInf": {Sig: "func (x *Float) IsInf() bool", Doc: "IsInf reports whether x is +Inf or -Inf.", Pos: "float.go:341"},
+		"math/big.Float.IsInt": {Sig: "func (x *Float) IsInt() bool", Doc: "IsInt reports whether x is an integer.", Pos: "float.go:347"},
+		"math/big.Float.SetUint64": {Sig: "func (z *Float) SetUint64(x uint64) *Float", Doc: "SetUint64 sets z to the (possibly rounded) value of x and returns z.", Pos: "float.go:533"},
+		"math/big.Float.SetInt64": {Sig: "func (z *Float) SetInt64(x int64) *Float", Doc: "SetInt64 sets z to the (possibly rounded) value of x and returns z.", Pos: "float.go:540"},
+		"math/big.Float.SetFloat64": {Sig: "func (z *Float) SetFloat64(x float64) *Float", Doc: "SetFloat64 sets z to the (possibly rounded) value of x and returns z.", Pos: "float.go:553"},
+		"math/big.Float.SetInt": {Sig: "func (z *Float) SetInt(x *Int) *Float", Doc: "SetInt sets z to the (possibly rounded) value of x and returns z.", Pos: "float.go:601"},
+		"math/big.Float.SetRat": {Sig: "func (z *Float) SetRat(x *Rat) *Float", Doc: "SetRat sets z to the (possibly rounded) value of x and returns z.", Pos: "float.go:625"},
+		"math/big.Float.SetInf": {Sig: "func (z *Float) SetInf(signbit bool) *Float", Doc: "SetInf sets z to the infinite Float -Inf if signbit is set, or +Inf if signbit is not set, and returns z.", Pos: "float.go:642"},
+		"math/big.Float.Set": {Sig: "func (z *Float) Set(x *Float) *Float", Doc: "Set sets z to the (possibly rounded) value of x and returns z.", Pos: "float.go:655"},
+		"math/big.Float.Copy": {Sig: "func (z *Float) Copy(x *Float) *Float", Doc: "Copy sets z to x, with the same precision, rounding mode, and accuracy as x.", Pos: "float.go:678"},
+		"math/big.Float.Uint64": {Sig: "func (x *Float) Uint64() (uint64, Accuracy)", Doc: "Uint64 returns the unsigned integer resulting from truncating x towards zero.", Pos: "float.go:741"},
+		"math/big.Float.Int64": {Sig: "func (x *Float) Int64() (int64, Accuracy)", Doc: "Int64 returns the integer resulting from truncating x towards zero.", Pos: "float.go:786"},
+		"math/big.Float.Float32": {Sig: "func (x *Float) Float32() (float32, Accuracy)", Doc: "Float32 returns the float32 value nearest to x.", Pos: "float.go:841"},
+		"math/big.Float.Float64": {Sig: "func (x *Float) Float64() (float64, Accuracy)", Doc: "Float64 returns the float64 value nearest to x.", Pos: "float.go:961"},
+		"math/big.Float.Int": {Sig: "func (x *Float) Int(z *Int) (*Int, Accuracy)", Doc: "Int returns the result of truncating x towards zero; or nil if x is an infinity.", Pos: "float.go:1082"},
+		"math/big.Float.Rat": {Sig: "func (x *Float) Rat(z *Rat) (*Rat, Accuracy)", Doc: "Rat returns the rational number corresponding to x; or nil if x is an infinity.", Pos: "float.go:1138"},
+		"math/big.Float.Abs": {Sig: "func (z *Float) Abs(x *Float) *Float", Doc: "Abs sets z to the (possibly rounded) value |x| (the absolute value of x) and returns z.", Pos: "float.go:1182"},
+		"math/big.Float.Neg": {Sig: "func (z *Float) Neg(x *Float) *Float", Doc: "Neg sets z to the (possibly rounded) value of x with its sign negated, and returns z.", Pos: "float.go:1190"},
+		"math/big.Float.Add": {Sig: "func (z *Float) Add(x, y *Float) *Float", Doc: "Add sets z to the rounded sum x+y and returns z.", Pos: "float.go:1451"},
+		"math/big.Float.Sub": {Sig: "func (z *Float) Sub(x, y *Float) *Float", Doc: "Sub sets z to the rounded difference x-y and returns z.", Pos: "float.go:1525"},
+		"math/big.Float.Mul": {Sig: "func (z *Float) Mul(x, y *Float) *Float", Doc: "Mul sets z to the rounded product x*y and returns z.", Pos: "float.go:1592"},
+		"math/big.Float.Quo": {Sig: "func (z *Float) Quo(x, y *Float) *Float", Doc: "Quo sets z to the rounded quotient x/y and returns z.", Pos: "float.go:1637"},
+		"math/big.Float.Cmp": {Sig: "func (x *Float) Cmp(y *Float) int", Doc: "Cmp compares x and y and returns:", Pos: "float.go:1682"},
+		"math/big.Float.SetString": {Sig: "func (z *Float) SetString(s string) (*Float, bool)", Doc: "SetString sets z to the value of s and returns z and a boolean indicating success.", Pos: "floatconv.go:22"},
+		"math/big.Float.Parse": {Sig: "func (z *Float) Parse(s string, base int) (f *Float, b int, err error)", Doc: "Parse parses s which must contain a text representation of a floating- point number with a mantissa in the given conversion base (the exponent is always a decimal number), or a string representing an infinite value.", Pos: "floatconv.go:259"},
+		"math/big.ParseFloat": {Sig: "func ParseFloat(s string, base int, prec uint, mode RoundingMode) (f *Float, b int, err error)", Doc: "ParseFloat is like f.Parse(s, base) with f set to the given precision and rounding mode.", Pos: "floatconv.go:287"},
+		"math/big.Float.Scan": {Sig: "func (z *Float) Scan(s fmt.ScanState, ch rune) error", Doc: "Scan is a support routine for fmt.Scanner; it sets z to the value of the scanned number.", Pos: "floatconv.go:298"},
+		"math/big.Float.GobEncode": {Sig: "func (x *Float) GobEncode() ([]byte, error)", Doc: "GobEncode implements the encoding/gob.GobEncoder interface.", Pos: "floatmarsh.go:21"},
+		"math/big.Float.GobDecode": {Sig: "func (z *Float) GobDecode(buf []byte) error", Doc: "GobDecode implements the encoding/gob.GobDecoder interface.", Pos: "floatmarsh.go:65"},
+		"math/big.Float.AppendText": {Sig: "func (x *Float) AppendText(b []byte) ([]byte, error)", Doc: "AppendText implements the encoding.TextAppender interface.", Pos: "floatmarsh.go:112"},
+		"math/big.Float.MarshalText": {Sig: "func (x *Float) MarshalText() (text []byte, err error)", Doc: "MarshalText implements the encoding.TextMarshaler interface.", Pos: "floatmarsh.go:122"},
+		"math/big.Float.UnmarshalText": {Sig: "func (z *Float) UnmarshalText(text []byte) error", Doc: "UnmarshalText implements the encoding.TextUnmarshaler interface.", Pos: "floatmarsh.go:130"},
+		"math/big.Float.Text": {Sig: "func (x *Float) Text(format byte, prec int) string", Doc: "Text converts the floating-point number x to a string according to the given format and precision prec.", Pos: "ftoa.go:51"},
+		"math/big.Float.String": {Sig: "func (x *Float) String() string", Doc: "String formats x like x.Text('g', 10).", Pos: "ftoa.go:61"},
+		"math/big.Float.Append": {Sig: "func (x *Float) Append(buf []byte, fmt byte, prec int) []byte", Doc: "Append appends to buf the string form of the floating-point number x, as generated by x.Text, and returns the extended buffer.", Pos: "ftoa.go:67"},
+		"math/big.Float.Format": {Sig: "func (x *Float) Format(s fmt.State, format rune)", Doc: "Format implements fmt.Formatter.", Pos: "ftoa.go:464"},
+		"math/big.Int": {Sig: "type Int struct", Doc: "An Int represents a signed multi-precision integer.", Pos: "int.go:33"},
+		"math/big.Int.Sign": {Sig: "func (x *Int) Sign() int", Doc: "Sign returns:", Pos: "int.go:44"},
+		"math/big.Int.SetInt64": {Sig: "func (z *Int) SetInt64(x int64) *Int", Doc: "SetInt64 sets z to x and returns z.", Pos: "int.go:58"},
+		"math/big.Int.SetUint64": {Sig: "func (z *Int) SetUint64(x uint64) *Int", Doc: "SetUint64 sets z to x and returns z.", Pos: "int.go:70"},
+		"math/big.NewInt": {Sig: "func NewInt(x int64) *Int", Doc: "NewInt allocates and returns a new [Int] set to x.", Pos: "int.go:77"},
+		"math/big.Int.Set": {Sig: "func (z *Int) Set(x *Int) *Int", Doc: "Set sets z to x and returns z.", Pos: "int.go:95"},
+		"math/big.Int.Bits": {Sig: "func (x *Int) Bits() []Word", Doc: "Bits provides raw (unchecked but fast) access to x by returning its absolute value as a little-endian [Word] slice.", Pos: "int.go:108"},
+		"math/big.Int.SetBits": {Sig: "func (z *Int) SetBits(abs []Word) *Int", Doc: "SetBits provides raw (unchecked but fast) access to z by setting its value to abs, interpreted as a little-endian [Word] slice, and returning z.", Pos: "int.go:120"},
+		"math/big.Int.Abs": {Sig: "func (z *Int) Abs(x *Int) *Int", Doc: "Abs sets z to |x| (the absolute value of x) and returns z.", Pos: "int.go:127"},
+		"math/big.Int.Neg": {Sig: "func (z *Int) Neg(x *Int) *Int", Doc: "Neg sets z to -x and returns z.", Pos: "int.go:134"},
+		"math/big.Int.Add": {Sig: "func (z *Int) Add(x, y *Int) *Int", Doc: "Add sets z to the sum x+y and returns z.", Pos: "int.go:141"},
+		"math/big.Int.Sub": {Sig: "func (z *Int) Sub(x, y *Int) *Int", Doc: "Sub sets z to the difference x-y and returns z.", Pos: "int.go:162"},
+		"math/big.Int.Mul": {Sig: "func (z *Int) Mul(x, y *Int) *Int", Doc: "Mul sets z to the product x*y and returns z.", Pos: "int.go:183"},
+		"math/big.Int.MulRange": {Sig: "func (z *Int) MulRange(a, b int64) *Int", Doc: "MulRange sets z to the product of all integers in the range [a, b] inclusively and returns z.", Pos: "int.go:208"},
+		"math/big.Int.Binomial": {Sig: "func (z *Int) Binomial(n, k int64) *Int", Doc: "Binomial sets z to the binomial coefficient C(n, k) and returns z.", Pos: "int.go:229"},
+		"math/big.Int.Quo": {Sig: "func (z *Int) Quo(x, y *Int) *Int", Doc: "Quo sets z to the quotient x/y for y != 0 and returns z.", Pos: "int.go:273"},
+		"math/big.Int.Rem": {Sig: "func (z *Int) Rem(x, y *Int) *Int", Doc: "Rem sets z to the remainder x%y for y != 0 and returns z.", Pos: "int.go:282"},
+		"math/big.Int.QuoRem": {Sig: "func (z *Int) QuoRem(x, y, r *Int) (*Int, *Int)", Doc: "QuoRem sets z to the quotient x/y and r to the remainder x%y and returns the pair (z, r) for y != 0.", Pos: "int.go:299"},
+		"math/big.Int.Div": {Sig: "func (z *Int) Div(x, y *Int) *Int", Doc: "Div sets z to the quotient x/y for y != 0 and returns z.", Pos: "int.go:308"},
+		"math/big.Int.Mod": {Sig: "func (z *Int) Mod(x, y *Int) *Int", Doc: "Mod sets z to the modulus x%y for y != 0 and returns z.", Pos: "int.go:325"},
+		"math/big.Int.DivMod": {Sig: "func (z *Int) DivMod(x, y, m *Int) (*Int, *Int)", Doc: "DivMod sets z to the quotient x div y and m to the modulus x mod y and returns the pair (z, m) for y != 0.", Pos: "int.go:356"},
+		"math/big.Trunc": {Sig: "const Trunc", Doc: "Rounding modes that determine how the integer quotient is adjusted in an integer division.", Pos: "int.go:377"},
+		"math/big.Floor": {Sig: "const Floor", Doc: "Rounding modes that determine how the integer quotient is adjusted in an integer division.", Pos: "int.go:378"},
+		"math/big.Round": {Sig: "const Round", Doc: "Rounding modes that determine how the integer quotient is adjusted in an integer division.", Pos: "int.go:379"},
+		"math/big.Ceil": {Sig: "const Ceil", Doc: "Rounding modes that determine how the integer quotient is adjusted in an integer division.", Pos: "int.go:380"},
+		"math/big.Int.Divide": {Sig: "func (z *Int) Divide(x, y, r *Int, mode RoundingMode) (*Int, *Int)", Doc: "Divide computes the integer quotient q and remainder r such that", Pos: "int.go:393"},
+		"math/big.Int.Cmp": {Sig: "func (x *Int) Cmp(y *Int) (r int)", Doc: "Cmp compares x and y and returns:", Pos: "int.go:461"},
+		"math/big.Int.CmpAbs": {Sig: "func (x *Int) CmpAbs(y *Int) int", Doc: "CmpAbs compares the absolute values of x and y and returns:", Pos: "int.go:486"},
+		"math/big.Int.Int64": {Sig: "func (x *Int) Int64() int64", Doc: "Int64 returns the int64 representation of x.", Pos: "int.go:512"},
+		"math/big.Int.Uint64": {Sig: "func (x *Int) Uint64() uint64", Doc: "Uint64 returns the uint64 representation of x.", Pos: "int.go:522"},
+		"math/big.Int.IsInt64": {Sig: "func (x *Int) IsInt64() bool", Doc: "IsInt64 reports whether x can be represented as an int64.", Pos: "int.go:527"},
+		"math/big.Int.IsUint64": {Sig: "func (x *Int) IsUint64() bool", Doc: "IsUint64 reports whether x can be represented as a uint64.", Pos: "int.go:536"},
+		"math/big.Int.Float64": {Sig: "func (x *Int) Float64() (float64, Accuracy)", Doc: "Float64 returns the float64 value nearest x, and an indication of any rounding that occurred.", Pos: "int.go:542"},
+		"math/big.Int.SetString": {Sig: "func (z *Int) SetString(s string, base int) (*Int, bool)", Doc: "SetString sets z to the value of s, interpreted in the given base, and returns z and a boolean indicating success.", Pos: "int.go:582"},
+		"math/big.Int.SetBytes": {Sig: "func (z *Int) SetBytes(buf []byte) *Int", Doc: "SetBytes interprets buf as the bytes of a big-endian unsigned integer, sets z to that value, and returns z.", Pos: "int.go:601"},
+		"math/big.Int.Bytes": {Sig: "func (x *Int) Bytes() []byte", Doc: "Bytes returns the absolute value of x as a big-endian byte slice.", Pos: "int.go:610"},
+		"math/big.Int.FillBytes": {Sig: "func (x *Int) FillBytes(buf []byte) []byte", Doc: "FillBytes sets buf to the absolute value of x, storing it as a zero-extended big-endian byte slice, and returns buf.", Pos: "int.go:622"},
+		"math/big.Int.BitLen": {Sig: "func (x *Int) BitLen() int", Doc: "BitLen returns the length of the absolute value of x in bits.", Pos: "int.go:631"},
+		"math/big.Int.TrailingZeroBits": {Sig: "func (x *Int) TrailingZeroBits() uint", Doc: "TrailingZeroBits returns the number of consecutive least significant zero bits of |x|.", Pos: "int.go:640"},
+		"math/big.Int.Exp": {Sig: "func (z *Int) Exp(x, y, m *Int) *Int", Doc: "Exp sets z = x**y mod |m| (i.e.", Pos: "int.go:650"},
+		"math/big.Int.GCD": {Sig: "func (z *Int) GCD(x, y, a, b *Int) *Int", Doc: "GCD sets z to the greatest common divisor of a and b and returns z.", Pos: "int.go:704"},
+		"math/big.Int.Rand": {Sig: "func (z *Int) Rand(rnd *rand.Rand, n *Int) *Int", Doc: "Rand sets z to a pseudo-random number in [0, n) and returns z.", Pos: "int.go:957"},
+		"math/big.Int.ModInverse": {Sig: "func (z *Int) ModInverse(g, n *Int) *Int", Doc: "ModInverse sets z to the multiplicative inverse of g in the ring ℤ/nℤ and returns z.", Pos: "int.go:973"},
+		"math/big.Jacobi": {Sig: "func Jacobi(x, y *Int) int", Doc: "Jacobi returns the Jacobi symbol (x/y), either +1, -1, or 0.", Pos: "int.go:1008"},
+		"math/big.Int.ModSqrt": {Sig: "func (z *Int) ModSqrt(x, p *Int) *Int", Doc: "ModSqrt sets z to a square root of x mod p if such a square root exists, and returns z.", Pos: "int.go:1155"},
+		"math/big.Int.Lsh": {Sig: "func (z *Int) Lsh(x *Int, n uint) *Int", Doc: "Lsh sets z = x << n and returns z.", Pos: "int.go:1182"},
+		"math/big.Int.Rsh": {Sig: "func (z *Int) Rsh(x *Int, n uint) *Int", Doc: "Rsh sets z = x >> n and returns z.", Pos: "int.go:1189"},
+		"math/big.Int.Bit": {Sig: "func (x *Int) Bit(i int) uint", Doc: "Bit returns the value of the i'th bit of x.", Pos: "int.go:1206"},
+		"math/big.Int.SetBit": {Sig: "func (z *Int) SetBit(x *Int, i int, b uint) *Int", Doc: "SetBit sets z to x, with x's i'th bit set to b (0 or 1).", Pos: "int.go:1230"},
+		"math/big.Int.And": {Sig: "func (z *Int) And(x, y *Int) *Int", Doc: "And sets z = x & y and returns z.", Pos: "int.go:1247"},
+		"math/big.Int.AndNot": {Sig: "func (z *Int) AndNot(x, y *Int) *Int", Doc: "AndNot sets z = x &^ y and returns z.", Pos: "int.go:1277"},
+		"math/big.Int.Or": {Sig: "func (z *Int) Or(x, y *Int) *Int", Doc: "Or sets z = x | y and returns z.", Pos: "int.go:1310"},
+		"math/big.Int.Xor": {Sig: "func (z *Int) Xor(x, y *Int) *Int", Doc: "Xor sets z = x ^ y and returns z.", Pos: "int.go:1340"},
+		"math/big.Int.Not": {Sig: "func (z *Int) Not(x *Int) *Int", Doc: "Not sets z = ^x and returns z.", Pos: "int.go:1370"},
+		"math/big.Int.Sqrt": {Sig: "func (z *Int) Sqrt(x *Int) *Int", Doc: "Sqrt sets z to ⌊√x⌋, the largest integer such that z² ≤ x, and returns z.", Pos: "int.go:1386"},
+		"math/big.Int.Text": {Sig: "func (x *Int) Text(base int) string", Doc: "Text returns the string representation of x in the given base.", Pos: "intconv.go:21"},
+		"math/big.Int.Append": {Sig: "func (x *Int) Append(buf []byte, base int) []byte", Doc: "Append appends the string representation of x, as generated by x.Text(base), to buf and returns the extended buffer.", Pos: "intconv.go:30"},
+		"math/big.Int.String": {Sig: "func (x *Int) String() string", Doc: "String returns the decimal representation of x as generated by x.Text(10).", Pos: "intconv.go:39"},
+		"math/big.Int.Format": {Sig: "func (x *Int) Format(s fmt.State, ch rune)", Doc: "Format implements fmt.Formatter.", Pos: "intconv.go:81"},
+		"math/big.Int.Scan": {Sig: "func (z *Int) Scan(s fmt.ScanState, ch rune) error", Doc: "Scan is a support routine for fmt.Scanner; it sets z to the value of the scanned number.", Pos: "intconv.go:251"},
+		"math/big.Int.GobEncode": {Sig: "func (x *Int) GobEncode() ([]byte, error)", Doc: "GobEncode implements the encoding/gob.GobEncoder interface.", Pos: "intmarsh.go:18"},
+		"math/big.Int.GobDecode": {Sig: "func (z *Int) GobDecode(buf []byte) error", Doc: "GobDecode implements the encoding/gob.GobDecoder interface.", Pos: "intmarsh.go:33"},
+		"math/big.Int.AppendText": {Sig: "func (x *Int) AppendText(b []byte) (text []byte, err error)", Doc: "AppendText implements the encoding.TextAppender interface.", Pos: "intmarsh.go:49"},
+		"math/big.Int.MarshalText": {Sig: "func (x *Int) MarshalText() (text []byte, err error)", Doc: "MarshalText implements the encoding.TextMarshaler interface.", Pos: "intmarsh.go:54"},
+		"math/big.Int.UnmarshalText": {Sig: "func (z *Int) UnmarshalText(text []byte) error", Doc: "UnmarshalText implements the encoding.TextUnmarshaler interface.", Pos: "intmarsh.go:59"},
+		"math/big.Int.MarshalJSON": {Sig: "func (x *Int) MarshalJSON() ([]byte, error)", Doc: "MarshalJSON implements the encoding/json.Marshaler interface.", Pos: "intmarsh.go:71"},
+		"math/big.Int.UnmarshalJSON": {Sig: "func (z *Int) UnmarshalJSON(text []byte) error", Doc: "UnmarshalJSON implements the encoding/json.Unmarshaler interface.", Pos: "intmarsh.go:79"},
+		"math/big.MaxBase": {Sig: "const MaxBase", Doc: "MaxBase is the largest number base accepted for string conversions.", Pos: "natconv.go:25"},
+		"math/big.Int.ProbablyPrime": {Sig: "func (x *Int) ProbablyPrime(n int) bool", Doc: "ProbablyPrime reports whether x is probably prime, applying the Miller-Rabin test with n pseudorandomly chosen bases as well as a Baillie-PSW test.", Pos: "prime.go:26"},
+		"math/big.Rat": {Sig: "type Rat struct", Doc: "A Rat represents a quotient a/b of arbitrary precision.", Pos: "rat.go:23"},
+		"math/big.NewRat": {Sig: "func NewRat(a, b int64) *Rat", Doc: "NewRat creates a new [Rat] with numerator a and denominator b.", Pos: "rat.go:33"},
+		"math/big.Rat.SetFloat64": {Sig: "func (z *Rat) SetFloat64(f float64) *Rat", Doc: "SetFloat64 sets z to exactly f and returns z.", Pos: "rat.go:39"},
+		"math/big.Rat.Float32": {Sig: "func (x *Rat) Float32() (f float32, exact bool)", Doc: "Float32 returns the nearest float32 value for x and a bool indicating whether f represents x exactly.", Pos: "rat.go:273"},
+		"math/big.Rat.Float64": {Sig: "func (x *Rat) Float64() (f float64, exact bool)", Doc: "Float64 returns the nearest float64 value for x and a bool indicating whether f represents x exactly.", Pos: "rat.go:291"},
+		"math/big.Rat.SetFrac": {Sig: "func (z *Rat) SetFrac(a, b *Int) *Rat", Doc: "SetFrac sets z to a/b and returns z.", Pos: "rat.go:307"},
+		"math/big.Rat.SetFrac64": {Sig: "func (z *Rat) SetFrac64(a, b int64) *Rat", Doc: "SetFrac64 sets z to a/b and returns z.", Pos: "rat.go:323"},
+		"math/big.Rat.SetInt": {Sig: "func (z *Rat) SetInt(x *Int) *Rat", Doc: "SetInt sets z to x (by making a copy of x) and returns z.", Pos: "rat.go:337"},
+		"math/big.Rat.SetInt64": {Sig: "func (z *Rat) SetInt64(x int64) *Rat", Doc: "SetInt64 sets z to x and returns z.", Pos: "rat.go:344"},
+		"math/big.Rat.SetUint64": {Sig: "func (z *Rat) SetUint64(x uint64) *Rat", Doc: "SetUint64 sets z to x and returns z.", Pos: "rat.go:351"},
+		"math/big.Rat.Set": {Sig: "func (z *Rat) Set(x *Rat) *Rat", Doc: "Set sets z to x (by making a copy of x) and returns z.", Pos: "rat.go:358"},
+		"math/big.Rat.Abs": {Sig: "func (z *Rat) Abs(x *Rat) *Rat", Doc: "Abs sets z to |x| (the absolute value of x) and returns z.", Pos: "rat.go:370"},
+		"math/big.Rat.Neg": {Sig: "func (z *Rat) Neg(x *Rat) *Rat", Doc: "Neg sets z to -x and returns z.", Pos: "rat.go:377"},
+		"math/big.Rat.Inv": {Sig: "func (z *Rat) Inv(x *Rat) *Rat", Doc: "Inv sets z to 1/x and returns z.", Pos: "rat.go:385"},
+		"math/big.Rat.Sign": {Sig: "func (x *Rat) Sign() int", Doc: "Sign returns:", Pos: "rat.go:398"},
+		"math/big.Rat.IsInt": {Sig: "func (x *Rat) IsInt() bool", Doc: "IsInt reports whether the denominator of x is 1.", Pos: "rat.go:403"},
+		"math/big.Rat.Num": {Sig: "func (x *Rat) Num() *Int", Doc: "Num returns the numerator of x; it may be <= 0.", Pos: "rat.go:411"},
+		"math/big.Rat.Denom": {Sig: "func (x *Rat) Denom() *Int", Doc: "Denom returns the denominator of x; it is always > 0.", Pos: "rat.go:422"},
+		"math/big.Rat.Cmp": {Sig: "func (x *Rat) Cmp(y *Rat) int", Doc: "Cmp compares x and y and returns:", Pos: "rat.go:488"},
+		"math/big.Rat.Add": {Sig: "func (z *Rat) Add(x, y *Rat) *Rat", Doc: "Add sets z to the sum x+y and returns z.", Pos: "rat.go:498"},
+		"math/big.Rat.Sub": {Sig: "func (z *Rat) Sub(x, y *Rat) *Rat", Doc: "Sub sets z to the difference x-y and returns z.", Pos: "rat.go:511"},
+		"math/big.Rat.Mul": {Sig: "func (z *Rat) Mul(x, y *Rat) *Rat", Doc: "Mul sets z to the product x*y and returns z.", Pos: "rat.go:524"},
+		"math/big.Rat.Quo": {Sig: "func (z *Rat) Quo(x, y *Rat) *Rat", Doc: "Quo sets z to the quotient x/y and returns z.", Pos: "rat.go:547"},
+		"math/big.Rat.Scan": {Sig: "func (z *Rat) Scan(s fmt.ScanState, ch rune) error", Doc: "Scan is a support routine for fmt.Scanner.", Pos: "ratconv.go:26"},
+		"math/big.Rat.SetString": {Sig: "func (z *Rat) SetString(s string) (*Rat, bool)", Doc: "SetString sets z to the value of s and returns z and a boolean indicating success.", Pos: "ratconv.go:58"},
+		"math/big.Rat.String": {Sig: "func (x *Rat) String() string", Doc: "String returns a string representation of x in the form \"a/b\" (even if b == 1).", Pos: "ratconv.go:304"},
+		"math/big.Rat.RatString": {Sig: "func (x *Rat) RatString() string", Doc: "RatString returns a string representation of x in the form \"a/b\" if b != 1, and in the form \"a\" if b == 1.", Pos: "ratconv.go:324"},
+		"math/big.Rat.FloatString": {Sig: "func (x *Rat) FloatString(prec int) string", Doc: "FloatString returns a string representation of x in decimal form with prec digits of precision after the radix point.", Pos: "ratconv.go:334"},
+		"math/big.Rat.FloatPrec": {Sig: "func (x *Rat) FloatPrec() (n int, exact bool)", Doc: "FloatPrec returns the number n of non-repeating digits immediately following the decimal point of the decimal representation of x.", Pos: "ratconv.go:405"},
+		"math/big.Rat.GobEncode": {Sig: "func (x *Rat) GobEncode() ([]byte, error)", Doc: "GobEncode implements the encoding/gob.GobEncoder interface.", Pos: "ratmarsh.go:20"},
+		"math/big.Rat.GobDecode": {Sig: "func (z *Rat) GobDecode(buf []byte) error", Doc: "GobDecode implements the encoding/gob.GobDecoder interface.", Pos: "ratmarsh.go:43"},
+		"math/big.Rat.AppendText": {Sig: "func (x *Rat) AppendText(b []byte) ([]byte, error)", Doc: "AppendText implements the encoding.TextAppender interface.", Pos: "ratmarsh.go:72"},
+		"math/big.Rat.MarshalText": {Sig: "func (x *Rat) MarshalText() (text []byte, err error)", Doc: "MarshalText implements the encoding.TextMarshaler interface.", Pos: "ratmarsh.go:80"},
+		"math/big.Rat.UnmarshalText": {Sig: "func (z *Rat) UnmarshalText(text []byte) error", Doc: "UnmarshalText implements the encoding.TextUnmarshaler interface.", Pos: "ratmarsh.go:85"},
+		"math/big.RoundingMode.String": {Sig: "func (i RoundingMode) String() string", Doc: "", Pos: "roundingmode_string.go:23"},
+		"math/big.Float.Sqrt": {Sig: "func (z *Float) Sqrt(x *Float) *Float", Doc: "Sqrt sets z to the rounded square root of x, and returns it.", Pos: "sqrt.go:33"},
+		"math/rand": {Doc: "Package rand implements pseudo-random number generators suitable for tasks such as simulation, but it should not be used for security-sensitive work."},
+		"math/rand.Rand.ExpFloat64": {Sig: "func (r *Rand) ExpFloat64() float64", Doc: "ExpFloat64 returns an exponentially distributed float64 in the range (0, +[math.MaxFloat64]] with an exponential distribution whose rate parameter (lambda) is 1 and whose mean is 1/lambda (1).", Pos: "exp.go:30"},
+		"math/rand.Rand.NormFloat64": {Sig: "func (r *Rand) NormFloat64() float64", Doc: "NormFloat64 returns a normally distributed float64 in the range -math.MaxFloat64 through +[math.MaxFloat64] inclusive, with standard normal distribution (mean = 0, stddev = 1).", Pos: "normal.go:37"},
+		"math/rand.Source": {Sig: "type Source interface", Doc: "A Source represents a source of uniformly-distributed pseudo-random int64 values in the range [0, 1<<63).", Pos: "rand.go:31"},
+		"math/rand.Source64": {Sig: "type Source64 interface", Doc: "A Source64 is a [Source] that can also generate uniformly-distributed pseudo-random uint64 values in the range [0, 1<<64) directly.", Pos: "rand.go:42"},
+		"math/rand.NewSource": {Sig: "func NewSource(seed int64) Source", Doc: "NewSource returns a new pseudo-random [Source] seeded with the given value.", Pos: "rand.go:51"},
+		"math/rand.Rand": {Sig: "type Rand struct", Doc: "A Rand is a source of random numbers.", Pos: "rand.go:62"},
+		"math/rand.New": {Sig: "func New(src Source) *Rand", Doc: "New returns a new [Rand] that uses random values from src to generate other random values.", Pos: "rand.go:78"},
+		"math/rand.Rand.Seed": {Sig: "func (r *Rand) Seed(seed int64)", Doc: "Seed uses the provided seed value to initialize the generator to a deterministic state.", Pos: "rand.go:85"},
+		"math/rand.Rand.Int63": {Sig: "func (r *Rand) Int63() int64", Doc: "Int63 returns a non-negative pseudo-random 63-bit integer as an int64.", Pos: "rand.go:96"},
+		"math/rand.Rand.Uint32": {Sig: "func (r *Rand) Uint32() uint32", Doc: "Uint32 returns a pseudo-random 32-bit value as a uint32.", Pos: "rand.go:99"},
+		"math/rand.Rand.Uint64": {Sig: "func (r *Rand) Uint64() uint64", Doc: "Uint64 returns a pseudo-random 64-bit value as a uint64.", Pos: "rand.go:102"},
+		"math/rand.Rand.Int31": {Sig: "func (r *Rand) Int31() int32", Doc: "Int31 returns a non-negative pseudo-random 31-bit integer as an int32.", Pos: "rand.go:110"},
+		"math/rand.Rand.Int": {Sig: "func (r *Rand) Int() int", Doc: "Int returns a non-negative pseudo-random int.", Pos: "rand.go:113"},
+		"math/rand.Rand.Int63n": {Sig: "func (r *Rand) Int63n(n int64) int64", Doc: "Int63n returns, as an int64, a non-negative pseudo-random number in the half-open interval [0,n).", Pos: "rand.go:120"},
+		"math/rand.Rand.Int31n": {Sig: "func (r *Rand) Int31n(n int32) int32", Doc: "Int31n returns, as an int32, a non-negative pseudo-random number in the half-open interval [0,n).", Pos: "rand.go:137"},
+		"math/rand.Rand.Intn": {Sig: "func (r *Rand) Intn(n int) int", Doc: "Intn returns, as an int, a non-negative pseudo-random number in the half-open interval [0,n).", Pos: "rand.go:178"},
+		"math/rand.Rand.Float64": {Sig: "func (r *Rand) Float64() float64", Doc: "Float64 returns, as a float64, a pseudo-random number in the half-open interval [0.0,1.0).", Pos: "rand.go:189"},
+		"math/rand.Rand.Float32": {Sig: "func (r *Rand) Float32() float32", Doc: "Float32 returns, as a float32, a pseudo-random number in the half-open interval [0.0,1.0).", Pos: "rand.go:215"},
+		"math/rand.Rand.Perm": {Sig: "func (r *Rand) Perm(n int) []int", Doc: "Perm returns, as a slice of n ints, a pseudo-random permutation of the integers in the half-open interval [0,n).", Pos: "rand.go:229"},
+		"math/rand.Rand.Shuffle": {Sig: "func (r *Rand) Shuffle(n int, swap func(i, j int))", Doc: "Shuffle pseudo-randomizes the order of elements.", Pos: "rand.go:247"},
+		"math/rand.Rand.Read": {Sig: "func (r *Rand) Read(p []byte) (n int, err error)", Doc: "Read generates len(p) random bytes and writes them into p.", Pos: "rand.go:272"},
+		"math/rand.Seed": {Sig: "func Seed(seed int64)", Doc: "Seed uses the provided seed value to initialize the default Source to a deterministic state.", Pos: "rand.go:400"},
+		"math/rand.Int63": {Sig: "func Int63() int64", Doc: "Int63 returns a non-negative pseudo-random 63-bit integer as an int64 from the default [Source].", Pos: "rand.go:434"},
+		"math/rand.Uint32": {Sig: "func Uint32() uint32", Doc: "Uint32 returns a pseudo-random 32-bit value as a uint32 from the default [Source].", Pos: "rand.go:438"},
+		"math/rand.Uint64": {Sig: "func Uint64() uint64", Doc: "Uint64 returns a pseudo-random 64-bit value as a uint64 from the default [Source].", Pos: "rand.go:442"},
+		"math/rand.Int31": {Sig: "func Int31() int32", Doc: "Int31 returns a non-negative pseudo-random 31-bit integer as an int32 from the default [Source].", Pos: "rand.go:446"},
+		"math/rand.Int": {Sig: "func Int() int", Doc: "Int returns a non-negative pseudo-random int from the default [Source].", Pos: "rand.go:449"},
+		"math/rand.Int63n": {Sig: "func Int63n(n int64) int64", Doc: "Int63n returns, as an int64, a non-negative pseudo-random number in the half-open interval [0,n) from the default [Source].", Pos: "rand.go:454"},
+		"math/rand.Int31n": {Sig: "func Int31n(n int32) int32", Doc: "Int31n returns, as an int32, a non-negative pseudo-random number in the half-open interval [0,n) from the default [Source].", Pos: "rand.go:459"},
+		"math/rand.Intn": {Sig: "func Intn(n int) int", Doc: "Intn returns, as an int, a non-negative pseudo-random number in the half-open interval [0,n) from the default [Source].", Pos: "rand.go:464"},
+		"math/rand.Float64": {Sig: "func Float64() float64", Doc: "Float64 returns, as a float64, a pseudo-random number in the half-open interval [0.0,1.0) from the default [Source].", Pos: "rand.go:468"},
+		"math/rand.Float32": {Sig: "func Float32() float32", Doc: "Float32 returns, as a float32, a pseudo-random number in the half-open interval [0.0,1.0) from the default [Source].", Pos: "rand.go:472"},
+		"math/rand.Perm": {Sig: "func Perm(n int) []int", Doc: "Perm returns, as a slice of n ints, a pseudo-random permutation of the integers in the half-open interval [0,n) from the default [Source].", Pos: "rand.go:476"},
+		"math/rand.Shuffle": {Sig: "func Shuffle(n int, swap func(i, j int))", Doc: "Shuffle pseudo-randomizes the order of elements using the default [Source].", Pos: "rand.go:481"},
+		"math/rand.Read": {Sig: "func Read(p []byte) (n int, err error)", Doc: "Read generates len(p) random bytes from the default [Source] and writes them into p.", Pos: "rand.go:489"},
+		"math/rand.NormFloat64": {Sig: "func NormFloat64() float64", Doc: "NormFloat64 returns a normally distributed float64 in the range [-math.MaxFloat64, +[math.MaxFloat64]] with standard normal distribution (mean = 0, stddev = 1) from the default [Source].", Pos: "rand.go:499"},
+		"math/rand.ExpFloat64": {Sig: "func ExpFloat64() float64", Doc: "ExpFloat64 returns an exponentially distributed float64 in the range (0, +[math.MaxFloat64]] with an exponential distribution whose rate parameter (lambda) is 1 and whose mean is 1/lambda (1) from the default [Source].", Pos: "rand.go:508"},
+		"math/rand.Zipf": {Sig: "type Zipf struct", Doc: "A Zipf generates Zipf distributed variates.", Pos: "zipf.go:15"},
+		"math/rand.NewZipf": {Sig: "func NewZipf(r *Rand, s float64, v float64, imax uint64) *Zipf", Doc: "NewZipf returns a [Zipf] variate generator.", Pos: "zipf.go:39"},
+		"math/rand.Zipf.Uint64": {Sig: "func (z *Zipf) Uint64() uint64", Doc: "Uint64 returns a value drawn from the [Zipf] distribution described by the [Zipf] object.", Pos: "zipf.go:58"},
+		"os": {Doc: "Package os provides a platform-independent interface to operating system functionality."},
+		"os.File.Readdir": {Sig: "func (f *File) Readdir(n int) ([]FileInfo, error)", Doc: "Readdir reads the contents of the directory associated with file and returns a slice of up to n [FileInfo] values, as would be returned by [Lstat], in directory order.", Pos: "dir.go:40"},
+		"os.File.Readdirnames": {Sig: "func (f *File) Readdirnames(n int) (names []string, err error)", Doc: "Readdirnames reads the contents of the directory associated with file and returns a slice of up to n names of files in the directory, in directory order.", Pos: "dir.go:69"},
+		"os.DirEntry": {Sig: "type DirEntry fs.DirEntry", Doc: "A DirEntry is an entry read from a directory (using the [ReadDir] function or a [File.ReadDir] method).", Pos: "dir.go:85"},
+		"os.File.ReadDir": {Sig: "func (f *File) ReadDir(n int) ([]DirEntry, error)", Doc: "ReadDir reads the contents of the directory associated with the file f and returns a slice of [DirEntry] values in directory order.", Pos: "dir.go:97"},
+		"os.ReadDir": {Sig: "func ReadDir(name string) ([]DirEntry, error)", Doc: "ReadDir reads the named directory, returning all its directory entries sorted by filename.", Pos: "dir.go:114"},
+		"os.CopyFS": {Sig: "func CopyFS(dir string, fsys fs.FS) error", Doc: "CopyFS copies the file system fsys into the directory dir, creating dir if necessary.", Pos: "dir.go:145"},
+		"os.Expand": {Sig: "func Expand(s string, mapping func(string) string) string", Doc: "Expand replaces ${var} or $var in the string based on the mapping function.", Pos: "env.go:16"},
+		"os.ExpandEnv": {Sig: "func ExpandEnv(s string) string", Doc: "ExpandEnv replaces ${var} or $var in the string according to the values of the current environment variables.", Pos: "env.go:50"},
+		"os.Getenv": {Sig: "func Getenv(key string) string", Doc: "Getenv retrieves the value of the environment variable named by the key.", Pos: "env.go:101"},
+		"os.LookupEnv": {Sig: "func LookupEnv(key string) (string, bool)", Doc: "LookupEnv retrieves the value of the environment variable named by the key.", Pos: "env.go:112"},
+		"os.Setenv": {Sig: "func Setenv(key, value string) error", Doc: "Setenv sets the value of the environment variable named by the key.", Pos: "env.go:119"},
+		"os.Unsetenv": {Sig: "func Unsetenv(key string) error", Doc: "Unsetenv unsets a single environment variable.", Pos: "env.go:128"},
+		"os.Clearenv": {Sig: "func Clearenv()", Doc: "Clearenv deletes all environment variables.", Pos: "env.go:133"},
+		"os.Environ": {Sig: "func Environ() []string", Doc: "Environ returns a copy of strings representing the environment, in the form \"key=value\".", Pos: "env.go:139"},
+		"os.ErrInvalid": {Sig: "var ErrInvalid", Doc: "ErrInvalid indicates an invalid argument.", Pos: "error.go:19"},
+		"os.ErrPermission": {Sig: "var ErrPermission", Doc: "Portable analogs of some common system call errors.", Pos: "error.go:21"},
+		"os.ErrExist": {Sig: "var ErrExist", Doc: "Portable analogs of some common system call errors.", Pos: "error.go:22"},
+		"os.ErrNotExist": {Sig: "var ErrNotExist", Doc: "Portable analogs of some common system call errors.", Pos: "error.go:23"},
+		"os.ErrClosed": {Sig: "var ErrClosed", Doc: "Portable analogs of some common system call errors.", Pos: "error.go:24"},
+		"os.ErrNoDeadline": {Sig: "var ErrNoDeadline", Doc: "Portable analogs of some common system call errors.", Pos: "error.go:26"},
+		"os.ErrDeadlineExceeded": {Sig: "var ErrDeadlineExceeded", Doc: "Portable analogs of some common system call errors.", Pos: "error.go:27"},
+		"os.PathError": {Sig: "type PathError fs.PathError", Doc: "PathError records an error and the operation and file path that caused it.", Pos: "error.go:46"},
+		"os.SyscallError": {Sig: "type SyscallError struct", Doc: "SyscallError records an error from a specific system call.", Pos: "error.go:49"},
+		"os.SyscallError.Error": {Sig: "func (e *SyscallError) Error() string", Doc: "", Pos: "error.go:54"},
+		"os.SyscallError.Unwrap": {Sig: "func (e *SyscallError) Unwrap() error", Doc: "", Pos: "error.go:56"},
+		"os.SyscallError.Timeout": {Sig: "func (e *SyscallError) Timeout() bool", Doc: "Timeout reports whether this error represents a timeout.", Pos: "error.go:59"},
+		"os.NewSyscallError": {Sig: "func NewSyscallError(syscall string, err error) error", Doc: "NewSyscallError returns, as an error, a new [SyscallError] with the given system call name and error details.", Pos: "error.go:67"},
+		"os.IsExist": {Sig: "func IsExist(err error) bool", Doc: "IsExist returns a boolean indicating whether its argument is known to report that a file or directory already exists.", Pos: "error.go:80"},
+		"os.IsNotExist": {Sig: "func IsNotExist(err error) bool", Doc: "IsNotExist returns a boolean indicating whether its argument is known to report that a file or directory does not exist.", Pos: "error.go:90"},
+		"os.IsPermission": {Sig: "func IsPermission(err error) bool", Doc: "IsPermission returns a boolean indicating whether its argument is known to report that permission is denied.", Pos: "error.go:100"},
+		"os.IsTimeout": {Sig: "func IsTimeout(err error) bool", Doc: "IsTimeout returns a boolean indicating whether its argument is known to report that a timeout occurred.", Pos: "error.go:112"},
+		"os.ErrProcessDone": {Sig: "var ErrProcessDone", Doc: "ErrProcessDone indicates a [Process] has finished.", Pos: "exec.go:19"},
+		"os.ErrNoHandle": {Sig: "var ErrNoHandle", Doc: "ErrNoHandle indicates a [Process] does not have a handle.", Pos: "exec.go:23"},
+		"os.Process": {Sig: "type Process struct", Doc: "Process stores the information about a process created by [StartProcess].", Pos: "exec.go:43"},
+		"os.ProcAttr": {Sig: "type ProcAttr struct", Doc: "ProcAttr holds the attributes that will be applied to a new process started by StartProcess.", Pos: "exec.go:206"},
+		"os.Signal": {Sig: "type Signal interface", Doc: "A Signal represents an operating system signal.", Pos: "exec.go:234"},
+		"os.Getpid": {Sig: "func Getpid() int", Doc: "Getpid returns the process id of the caller.", Pos: "exec.go:240"},
+		"os.Getppid": {Sig: "func Getppid() int", Doc: "Getppid returns the process id of the caller's parent.", Pos: "exec.go:243"},
+		"os.FindProcess": {Sig: "func FindProcess(pid int) (*Process, error)", Doc: "FindProcess looks for a running process by its pid.", Pos: "exec.go:254"},
+		"os.StartProcess": {Sig: "func StartProcess(name string, argv []string, attr *ProcAttr) (*Process, error)", Doc: "StartProcess starts a new process with the program, arguments and attributes specified by name, argv and attr.", Pos: "exec.go:271"},
+		"os.Process.Release": {Sig: "func (p *Process) Release() error", Doc: "Release releases any resources associated with the [Process] p, rendering it unusable in the future.", Pos: "exec.go:279"},
+		"os.Process.Kill": {Sig: "func (p *Process) Kill() error", Doc: "Kill causes the [Process] to exit immediately.", Pos: "exec.go:337"},
+		"os.Process.Wait": {Sig: "func (p *Process) Wait() (*ProcessState, error)", Doc: "Wait waits for the [Process] to exit, and then returns a ProcessState describing its status and an error, if any.", Pos: "exec.go:346"},
+		"os.Process.Signal": {Sig: "func (p *Process) Signal(sig Signal) error", Doc: "Signal sends a signal to the [Process].", Pos: "exec.go:352"},
+		"os.Process.WithHandle": {Sig: "func (p *Process) WithHandle(f func(handle uintptr)) error", Doc: "WithHandle calls a supplied function f with a valid process handle as an argument.", Pos: "exec.go:364"},
+		"os.ProcessState.UserTime": {Sig: "func (p *ProcessState) UserTime() time.Duration", Doc: "UserTime returns the user CPU time of the exited process and its children.", Pos: "exec.go:369"},
+		"os.ProcessState.SystemTime": {Sig: "func (p *ProcessState) SystemTime() time.Duration", Doc: "SystemTime returns the system CPU time of the exited process and its children.", Pos: "exec.go:374"},
+		"os.ProcessState.Exited": {Sig: "func (p *ProcessState) Exited() bool", Doc: "Exited reports whether the program has exited.", Pos: "exec.go:381"},
+		"os.ProcessState.Success": {Sig: "func (p *ProcessState) Success() bool", Doc: "Success reports whether the program exited successfully, such as with exit status 0 on Unix.", Pos: "exec.go:387"},
+		"os.ProcessState.Sys": {Sig: "func (p *ProcessState) Sys() any", Doc: "Sys returns system-dependent exit information about the process.", Pos: "exec.go:394"},
+		"os.ProcessState.SysUsage": {Sig: "func (p *ProcessState) SysUsage() any", Doc: "SysUsage returns system-dependent resource usage information about the exited process.", Pos: "exec.go:403"},
+		"os.Interrupt": {Sig: "var Interrupt Signal", Doc: "The only signal values guaranteed to be present in the os package on all systems are os.Interrupt (send the process an interrupt) and os.Kill (force the process to exit).", Pos: "exec_posix.go:22"},
+		"os.Kill": {Sig: "var Kill Signal", Doc: "The only signal values guaranteed to be present in the os package on all systems are os.Interrupt (send the process an interrupt) and os.Kill (force the process to exit).", Pos: "exec_posix.go:23"},
+		"os.ProcessState": {Sig: "type ProcessState struct", Doc: "ProcessState stores information about a process, as reported by Wait.", Pos: "exec_posix.go:98"},
+		"os.ProcessState.Pid": {Sig: "func (p *ProcessState) Pid() int", Doc: "Pid returns the process id of the exited process.", Pos: "exec_posix.go:105"},
+		"os.ProcessState.String": {Sig: "func (p *ProcessState) String() string", Doc: "", Pos: "exec_posix.go:125"},
+		"os.ProcessState.ExitCode": {Sig: "func (p *ProcessState) ExitCode() int", Doc: "ExitCode returns the exit code of the exited process, or -1 if the process hasn't exited or was terminated by a signal.", Pos: "exec_posix.go:157"},
+		"os.Executable": {Sig: "func Executable() (string, error)", Doc: "Executable returns the path name for the executable that started the current process.", Pos: "executable.go:18"},
+		"os.File.Name": {Sig: "func (f *File) Name() string", Doc: "Name returns the name of the file as presented to Open.", Pos: "file.go:63"},
+		"os.Stdin": {Sig: "var Stdin", Doc: "Stdin, Stdout, and Stderr are open Files pointing to the standard input, standard output, and standard error file descriptors.", Pos: "file.go:72"},
+		"os.Stdout": {Sig: "var Stdout", Doc: "Stdin, Stdout, and Stderr are open Files pointing to the standard input, standard output, and standard error file descriptors.", Pos: "file.go:73"},
+		"os.Stderr": {Sig: "var Stderr", Doc: "Stdin, Stdout, and Stderr are open Files pointing to the standard input, standard output, and standard error file descriptors.", Pos: "file.go:74"},
+		"os.O_RDONLY": {Sig: "const O_RDONLY int", Doc: "Exactly one of O_RDONLY, O_WRONLY, or O_RDWR must be specified.", Pos: "file.go:81"},
+		"os.O_WRONLY": {Sig: "const O_WRONLY int", Doc: "Flags to OpenFile wrapping those of the underlying system.", Pos: "file.go:82"},
+		"os.O_RDWR": {Sig: "const O_RDWR int", Doc: "Flags to OpenFile wrapping those of the underlying system.", Pos: "file.go:83"},
+		"os.O_APPEND": {Sig: "const O_APPEND int", Doc: "The remaining values may be or'ed in to control behavior.", Pos: "file.go:85"},
+		"os.O_CREATE": {Sig: "const O_CREATE int", Doc: "Flags to OpenFile wrapping those of the underlying system.", Pos: "file.go:86"},
+		"os.O_EXCL": {Sig: "const O_EXCL int", Doc: "Flags to OpenFile wrapping those of the underlying system.", Pos: "file.go:87"},
+		"os.O_SYNC": {Sig: "const O_SYNC int", Doc: "Flags to OpenFile wrapping those of the underlying system.", Pos: "file.go:88"},
+		"os.O_TRUNC": {Sig: "const O_TRUNC int", Doc: "Flags to OpenFile wrapping those of the underlying system.", Pos: "file.go:89"},
+		"os.SEEK_SET": {Sig: "const SEEK_SET int", Doc: "Seek whence values.", Pos: "file.go:96"},
+		"os.SEEK_CUR": {Sig: "const SEEK_CUR int", Doc: "Seek whence values.", Pos: "file.go:97"},
+		"os.SEEK_END": {Sig: "const SEEK_END int", Doc: "Seek whence values.", Pos: "file.go:98"},
+		"os.LinkError": {Sig: "type LinkError struct", Doc: "LinkError records an error during a link or symlink or rename system call and the paths that caused it.", Pos: "file.go:103"},
+		"os.LinkError.Error": {Sig: "func (e *LinkError) Error() string", Doc: "", Pos: "file.go:110"},
+		"os.LinkError.Unwrap": {Sig: "func (e *LinkError) Unwrap() error", Doc: "", Pos: "file.go:114"},
+		"os.NewFile": {Sig: "func NewFile(fd uintptr, name string) *File", Doc: "NewFile returns a new [File] with the given file descriptor and name.", Pos: "file.go:133"},
+		"os.File.Read": {Sig: "func (f *File) Read(b []byte) (n int, err error)", Doc: "Read reads up to len(b) bytes from the File and stores them in b.", Pos: "file.go:140"},
+		"os.File.ReadAt": {Sig: "func (f *File) ReadAt(b []byte, off int64) (n int, err error)", Doc: "ReadAt reads len(b) bytes from the File starting at byte offset off.", Pos: "file.go:152"},
+		"os.File.ReadFrom": {Sig: "func (f *File) ReadFrom(r io.Reader) (n int64, err error)", Doc: "ReadFrom implements io.ReaderFrom.", Pos: "file.go:175"},
+		"os.File.Write": {Sig: "func (f *File) Write(b []byte) (n int, err error)", Doc: "Write writes len(b) bytes from b to the File.", Pos: "file.go:211"},
+		"os.File.WriteAt": {Sig: "func (f *File) WriteAt(b []byte, off int64) (n int, err error)", Doc: "WriteAt writes len(b) bytes to the File starting at byte offset off.", Pos: "file.go:239"},
+		"os.File.WriteTo": {Sig: "func (f *File) WriteTo(w io.Writer) (n int64, err error)", Doc: "WriteTo implements io.WriterTo.", Pos: "file.go:265"},
+		"os.File.Seek": {Sig: "func (f *File) Seek(offset int64, whence int) (ret int64, err error)", Doc: "Seek sets the offset for the next Read or Write on file to offset, interpreted according to whence: 0 means relative to the origin of the file, 1 means relative to the current offset, and 2 means relative to the end.", Pos: "file.go:303"},
+		"os.File.WriteString": {Sig: "func (f *File) WriteString(s string) (n int, err error)", Doc: "WriteString is like Write, but writes the contents of string s rather than a slice of bytes.", Pos: "file.go:319"},
+		"os.Mkdir": {Sig: "func Mkdir(name string, perm FileMode) error", Doc: "Mkdir creates a new directory with the specified name and permission bits (before umask).", Pos: "file.go:327"},
+		"os.Chdir": {Sig: "func Chdir(dir string) error", Doc: "Chdir changes the current working directory to the named directory.", Pos: "file.go:361"},
+		"os.Open": {Sig: "func Open(name string) (*File, error)", Doc: "Open opens the named file for reading.", Pos: "file.go:389"},
+		"os.Create": {Sig: "func Create(name string) (*File, error)", Doc: "Create creates or truncates the named file.", Pos: "file.go:399"},
+		"os.OpenFile": {Sig: "func OpenFile(name string, flag int, perm FileMode) (*File, error)", Doc: "OpenFile is the generalized open call; most users will use Open or Create instead.", Pos: "file.go:410"},
+		"os.Rename": {Sig: "func Rename(oldpath, newpath string) error", Doc: "Rename renames (moves) oldpath to newpath.", Pos: "file.go:437"},
+		"os.Readlink": {Sig: "func Readlink(name string) (string, error)", Doc: "Readlink returns the destination of the named symbolic link.", Pos: "file.go:446"},
+		"os.TempDir": {Sig: "func TempDir() string", Doc: "TempDir returns the default directory to use for temporary files.", Pos: "file.go:487"},
+		"os.UserCacheDir": {Sig: "func UserCacheDir() (string, error)", Doc: "UserCacheDir returns the default root directory to use for user-specific cached data.", Pos: "file.go:504"},
+		"os.UserConfigDir": {Sig: "func UserConfigDir() (string, error)", Doc: "UserConfigDir returns the default root directory to use for user-specific configuration data.", Pos: "file.go:557"},
+		"os.UserHomeDir": {Sig: "func UserHomeDir() (string, error)", Doc: "UserHomeDir returns the current user's home directory.", Pos: "file.go:605"},
+		"os.Chmod": {Sig: "func Chmod(name string, mode FileMode) error", Doc: "Chmod changes the mode of the named file to mode.", Pos: "file.go:644"},
+		"os.File.Chmod": {Sig: "func (f *File) Chmod(mode FileMode) error", Doc: "Chmod changes the mode of the file to mode.", Pos: "file.go:648"},
+		"os.File.SetDeadline": {Sig: "func (f *File) SetDeadline(t time.Time) error", Doc: "SetDeadline sets the read and write deadlines for a File.", Pos: "file.go:674"},
+		"os.File.SetReadDeadline": {Sig: "func (f *File) SetReadDeadline(t time.Time) error", Doc: "SetReadDeadline sets the deadline for future Read calls and any currently-blocked Read call.", Pos: "file.go:682"},
+		"os.File.SetWriteDeadline": {Sig: "func (f *File) SetWriteDeadline(t time.Time) error", Doc: "SetWriteDeadline sets the deadline for any future Write calls and any currently-blocked Write call.", Pos: "file.go:692"},
+		"os.File.SyscallConn": {Sig: "func (f *File) SyscallConn() (syscall.RawConn, error)", Doc: "SyscallConn returns a raw file.", Pos: "file.go:698"},
+		"os.File.Fd": {Sig: "func (f *File) Fd() uintptr", Doc: "Fd returns the system file descriptor or handle referencing the open file.", Pos: "file.go:722"},
+		"os.DirFS": {Sig: "func DirFS(dir string) fs.FS", Doc: "DirFS returns a file system (an fs.FS) for the tree of files rooted at the directory dir.", Pos: "file.go:743"},
+		"os.ReadFile": {Sig: "func ReadFile(name string) ([]byte, error)", Doc: "ReadFile reads the named file and returns the contents.", Pos: "file.go:871"},
+		"os.WriteFile": {Sig: "func WriteFile(name string, data []byte, perm FileMode) error", Doc: "WriteFile writes data to the named file, creating it if necessary.", Pos: "file.go:939"},
+		"os.File.Close": {Sig: "func (f *File) Close() error", Doc: "Close closes the [File], rendering it unusable for I/O. On files that support [File.SetDeadline], any pending I/O operations will be canceled and return immediately with an [ErrClosed] error.", Pos: "file_posix.go:20"},
+		"os.Chown": {Sig: "func Chown(name string, uid, gid int) error", Doc: "Chown changes the numeric uid and gid of the named file.", Pos: "file_posix.go:106"},
+		"os.Lchown": {Sig: "func Lchown(name string, uid, gid int) error", Doc: "Lchown changes the numeric uid and gid of the named file.", Pos: "file_posix.go:122"},
+		"os.File.Chown": {Sig: "func (f *File) Chown(uid, gid int) error", Doc: "Chown changes the numeric uid and gid of the named file.", Pos: "file_posix.go:137"},
+		"os.File.Truncate": {Sig: "func (f *File) Truncate(size int64) error", Doc: "Truncate changes the size of the file.", Pos: "file_posix.go:150"},
+		"os.File.Sync": {Sig: "func (f *File) Sync() error", Doc: "Sync commits the current contents of the file to stable storage.", Pos: "file_posix.go:163"},
+		"os.Chtimes": {Sig: "func Chtimes(name string, atime time.Time, mtime time.Time) error", Doc: "Chtimes changes the access and modification times of the named file, similar to the Unix utime() or utimes() functions.", Pos: "file_posix.go:180"},
+		"os.File.Chdir": {Sig: "func (f *File) Chdir() error", Doc: "Chdir changes the current working directory to the file, which must be a directory.", Pos: "file_posix.go:205"},
+		"os.DevNull": {Sig: "const DevNull", Doc: "DevNull is the name of the operating system's “null device.” On Unix-like systems, it is \"/dev/null\"; on Windows, \"NUL\".", Pos: "file_unix.go:242"},
+		"os.Truncate": {Sig: "func Truncate(name string, size int64) error", Doc: "Truncate changes the size of the named file.", Pos: "file_unix.go:345"},
+		"os.Remove": {Sig: "func Remove(name string) error", Doc: "Remove removes the named file or (empty) directory.", Pos: "file_unix.go:357"},
+		"os.Link": {Sig: "func Link(oldname, newname string) error", Doc: "Link creates newname as a hard link to the oldname file.", Pos: "file_unix.go:404"},
+		"os.Symlink": {Sig: "func Symlink(oldname, newname string) error", Doc: "Symlink creates newname as a symbolic link to oldname.", Pos: "file_unix.go:418"},
+		"os.Getwd": {Sig: "func Getwd() (dir string, err error)", Doc: "Getwd returns an absolute path name corresponding to the current directory.", Pos: "getwd.go:26"},
+		"os.MkdirAll": {Sig: "func MkdirAll(path string, perm FileMode) error", Doc: "MkdirAll creates a directory named path, along with any necessary parents, and returns nil, or else returns an error.", Pos: "path.go:19"},
+		"os.RemoveAll": {Sig: "func RemoveAll(path string) error", Doc: "RemoveAll removes path and any children it contains.", Pos: "path.go:73"},
+		"os.PathSeparator": {Sig: "const PathSeparator", Doc: "", Pos: "path_unix.go:10"},
+		"os.PathListSeparator": {Sig: "const PathListSeparator", Doc: "", Pos: "path_unix.go:11"},
+		"os.IsPathSeparator": {Sig: "func IsPathSeparator(c uint8) bool", Doc: "IsPathSeparator reports whether c is a directory separator character.", Pos: "path_unix.go:15"},
+		"os.Pipe": {Sig: "func Pipe() (r *File, w *File, err error)", Doc: "Pipe returns a connected pair of Files; reads from r return bytes written to w.", Pos: "pipe2_unix.go:13"},
+		"os.Args": {Sig: "var Args []string", Doc: "Args hold the command-line arguments, starting with the program name.", Pos: "proc.go:16"},
+		"os.Getuid": {Sig: "func Getuid() int", Doc: "Getuid returns the numeric user id of the caller.", Pos: "proc.go:31"},
+		"os.Geteuid": {Sig: "func Geteuid() int", Doc: "Geteuid returns the numeric effective user id of the caller.", Pos: "proc.go:36"},
+		"os.Getgid": {Sig: "func Getgid() int", Doc: "Getgid returns the numeric group id of the caller.", Pos: "proc.go:41"},
+		"os.Getegid": {Sig: "func Getegid() int", Doc: "Getegid returns the numeric effective group id of the caller.", Pos: "proc.go:46"},
+		"os.Getgroups": {Sig: "func Getgroups() ([]int, error)", Doc: "Getgroups returns a list of the numeric ids of groups that the caller belongs to.", Pos: "proc.go:52"},
+		"os.Exit": {Sig: "func Exit(code int)", Doc: "Exit causes the current program to exit with the given status code.", Pos: "proc.go:62"},
+		"os.OpenInRoot": {Sig: "func OpenInRoot(dir, name string) (*File, error)", Doc: "OpenInRoot opens the file name in the directory dir.", Pos: "root.go:25"},
+		"os.Root": {Sig: "type Root struct", Doc: "Root may be used to only access files within a single directory tree.", Pos: "root.go:68"},
+		"os.OpenRoot": {Sig: "func OpenRoot(name string) (*Root, error)", Doc: "OpenRoot opens the named directory.", Pos: "root.go:82"},
+		"os.Root.Name": {Sig: "func (r *Root) Name() string", Doc: "Name returns the name of the directory presented to OpenRoot.", Pos: "root.go:90"},
+		"os.Root.Close": {Sig: "func (r *Root) Close() error", Doc: "Close closes the Root.", Pos: "root.go:96"},
+		"os.Root.Open": {Sig: "func (r *Root) Open(name string) (*File, error)", Doc: "Open opens the named file in the root for reading.", Pos: "root.go:102"},
+		"os.Root.Create": {Sig: "func (r *Root) Create(name string) (*File, error)", Doc: "Create creates or truncates the named file in the root.", Pos: "root.go:108"},
+		"os.Root.OpenFile": {Sig: "func (r *Root) OpenFile(name string, flag int, perm FileMode) (*File, error)", Doc: "OpenFile opens the named file in the root.", Pos: "root.go:117"},
+		"os.Root.OpenRoot": {Sig: "func (r *Root) OpenRoot(name string) (*Root, error)", Doc: "OpenRoot opens the named directory in the root.", Pos: "root.go:132"},
+		"os.Root.Chmod": {Sig: "func (r *Root) Chmod(name string, mode FileMode) error", Doc: "Chmod changes the mode of the named file in the root to mode.", Pos: "root.go:139"},
+		"os.Root.Mkdir": {Sig: "func (r *Root) Mkdir(name string, perm FileMode) error", Doc: "Mkdir creates a new directory in the root with the specified name and permission bits (before umask).", Pos: "root.go:149"},
+		"os.Root.MkdirAll": {Sig: "func (r *Root) MkdirAll(name string, perm FileMode) error", Doc: "MkdirAll creates a new directory in the root, along with any necessary parents.", Pos: "root.go:161"},
+		"os.Root.Chown": {Sig: "func (r *Root) Chown(name string, uid, gid int) error", Doc: "Chown changes the numeric uid and gid of the named file in the root.", Pos: "root.go:170"},
+		"os.Root.Lchown": {Sig: "func (r *Root) Lchown(name string, uid, gid int) error", Doc: "Lchown changes the numeric uid and gid of the named file in the root.", Pos: "root.go:176"},
+		"os.Root.Chtimes": {Sig: "func (r *Root) Chtimes(name string, atime time.Time, mtime time.Time) error", Doc: "Chtimes changes the access and modification times of the named file in the root.", Pos: "root.go:182"},
+		"os.Root.Remove": {Sig: "func (r *Root) Remove(name string) error", Doc: "Remove removes the named file or (empty) directory in the root.", Pos: "root.go:188"},
+		"os.Root.RemoveAll": {Sig: "func (r *Root) RemoveAll(name string) error", Doc: "RemoveAll removes the named file or directory and any children that it contains.", Pos: "root.go:194"},
+		"os.Root.Stat": {Sig: "func (r *Root) Stat(name string) (FileInfo, error)", Doc: "Stat returns a [FileInfo] describing the named file in the root.", Pos: "root.go:200"},
+		"os.Root.Lstat": {Sig: "func (r *Root) Lstat(name string) (FileInfo, error)", Doc: "Lstat returns a [FileInfo] describing the named file in the root.", Pos: "root.go:209"},
+		"os.Root.Readlink": {Sig: "func (r *Root) Readlink(name string) (string, error)", Doc: "Readlink returns the destination of the named symbolic link in the root.", Pos: "root.go:216"},
+		"os.Root.Rename": {Sig: "func (r *Root) Rename(oldname, newname string) error", Doc: "Rename renames (moves) oldname to newname.", Pos: "root.go:223"},
+		"os.Root.Link": {Sig: "func (r *Root) Link(oldname, newname string) error", Doc: "Link creates newname as a hard link to the oldname file.", Pos: "root.go:235"},
+		"os.Root.Symlink": {Sig: "func (r *Root) Symlink(oldname, newname string) error", Doc: "Symlink creates newname as a symbolic link to oldname.", Pos: "root.go:247"},
+		"os.Root.ReadFile": {Sig: "func (r *Root) ReadFile(name string) ([]byte, error)", Doc: "ReadFile reads the named file in the root and returns its contents.", Pos: "root.go:253"},
+		"os.Root.WriteFile": {Sig: "func (r *Root) WriteFile(name string, data []byte, perm FileMode) error", Doc: "WriteFile writes data to the named file in the root, creating it if necessary.", Pos: "root.go:264"},
+		"os.Root.FS": {Sig: "func (r *Root) FS() fs.FS", Doc: "FS returns a file system (an fs.FS) for the tree of files in the root.", Pos: "root.go:359"},
+		"os.Stat": {Sig: "func Stat(name string) (FileInfo, error)", Doc: "Stat returns a [FileInfo] describing the named file.", Pos: "stat.go:11"},
+		"os.Lstat": {Sig: "func Lstat(name string) (FileInfo, error)", Doc: "Lstat returns a [FileInfo] describing the named file.", Pos: "stat.go:24"},
+		"os.File.Stat": {Sig: "func (f *File) Stat() (FileInfo, error)", Doc: "Stat returns the [FileInfo] structure describing file.", Pos: "stat_unix.go:15"},
+		"os.Hostname": {Sig: "func Hostname() (name string, err error)", Doc: "Hostname returns the host name reported by the kernel.", Pos: "sys.go:8"},
+		"os.CreateTemp": {Sig: "func CreateTemp(dir, pattern string) (*File, error)", Doc: "CreateTemp creates a new temporary file in the directory dir, opens the file for reading and writing, and returns the resulting file.", Pos: "tempfile.go:35"},
+		"os.MkdirTemp": {Sig: "func MkdirTemp(dir, pattern string) (string, error)", Doc: "MkdirTemp creates a new temporary directory in the directory dir and returns the pathname of the new directory.", Pos: "tempfile.go:86"},
+		"os.Getpagesize": {Sig: "func Getpagesize() int", Doc: "Getpagesize returns the underlying system's memory page size.", Pos: "types.go:13"},
+		"os.File": {Sig: "type File struct", Doc: "File represents an open file descriptor.", Pos: "types.go:18"},
+		"os.FileInfo": {Sig: "type FileInfo fs.FileInfo", Doc: "A FileInfo describes a file and is returned by [Stat] and [Lstat].", Pos: "types.go:23"},
+		"os.FileMode": {Sig: "type FileMode fs.FileMode", Doc: "A FileMode represents a file's mode and permission bits.", Pos: "types.go:30"},
+		"os.ModeDir": {Sig: "const ModeDir", Doc: "The single letters are the abbreviations used by the String method's formatting.", Pos: "types.go:40"},
+		"os.ModeAppend": {Sig: "const ModeAppend", Doc: "The defined file mode bits are the most significant bits of the [FileMode].", Pos: "types.go:41"},
+		"os.ModeExclusive": {Sig: "const ModeExclusive", Doc: "The defined file mode bits are the most significant bits of the [FileMode].", Pos: "types.go:42"},
+		"os.ModeTemporary": {Sig: "const ModeTemporary", Doc: "The defined file mode bits are the most significant bits of the [FileMode].", Pos: "types.go:43"},
+		"os.ModeSymlink": {Sig: "const ModeSymlink", Doc: "The defined file mode bits are the most significant bits of the [FileMode].", Pos: "types.go:44"},
+		"os.ModeDevice": {Sig: "const ModeDevice", Doc: "The defined file mode bits are the most significant bits of the [FileMode].", Pos: "types.go:45"},
+		"os.ModeNamedPipe": {Sig: "const ModeNamedPipe", Doc: "The defined file mode bits are the most significant bits of the [FileMode].", Pos: "types.go:46"},
+		"os.ModeSocket": {Sig: "const ModeSocket", Doc: "The defined file mode bits are the most significant bits of the [FileMode].", Pos: "types.go:47"},
+		"os.ModeSetuid": {Sig: "const ModeSetuid", Doc: "The defined file mode bits are the most significant bits of the [FileMode].", Pos: "types.go:48"},
+		"os.ModeSetgid": {Sig: "const ModeSetgid", Doc: "The defined file mode bits are the most significant bits of the [FileMode].", Pos: "types.go:49"},
+		"os.ModeCharDevice": {Sig: "const ModeCharDevice", Doc: "The defined file mode bits are the most significant bits of the [FileMode].", Pos: "types.go:50"},
+		"os.ModeSticky": {Sig: "const ModeSticky", Doc: "The defined file mode bits are the most significant bits of the [FileMode].", Pos: "types.go:51"},
+		"os.ModeIrregular": {Sig: "const ModeIrregular", Doc: "The defined file mode bits are the most significant bits of the [FileMode].", Pos: "types.go:52"},
+		"os.ModeType": {Sig: "const ModeType", Doc: "Mask for the type bits.", Pos: "types.go:55"},
+		"os.ModePerm": {Sig: "const ModePerm", Doc: "The defined file mode bits are the most significant bits of the [FileMode].", Pos: "types.go:57"},
+		"os.SameFile": {Sig: "func SameFile(fi1, fi2 FileInfo) bool", Doc: "SameFile reports whether fi1 and fi2 describe the same file.", Pos: "types.go:69"},
+		"os/exec": {Doc: "Package exec runs external commands."},
+		"os/exec.Error": {Sig: "type Error struct", Doc: "Error is returned by [LookPath] when it fails to classify a file as an executable.", Pos: "exec.go:112"},
+		"os/exec.Error.Error": {Sig: "func (e *Error) Error() string", Doc: "", Pos: "exec.go:119"},
+		"os/exec.Error.Unwrap": {Sig: "func (e *Error) Unwrap() error", Doc: "", Pos: "exec.go:123"},
+		"os/exec.ErrWaitDelay": {Sig: "var ErrWaitDelay", Doc: "ErrWaitDelay is returned by [Cmd.Wait] if the process exits with a successful status code but its output pipes are not closed before the command's WaitDelay expires.", Pos: "exec.go:128"},
+		"os/exec.Cmd": {Sig: "type Cmd struct", Doc: "Cmd represents an external command being prepared or run.", Pos: "exec.go:148"},
+		"os/exec.Command": {Sig: "func Command(name string, arg ...string) *Cmd", Doc: "Command returns the [Cmd] struct to execute the named program with the given arguments.", Pos: "exec.go:415"},
+		"os/exec.CommandContext": {Sig: "func CommandContext(ctx context.Context, name string, arg ...string) *Cmd", Doc: "CommandContext is like [Command] but includes a context.", Pos: "exec.go:495"},
+		"os/exec.Cmd.String": {Sig: "func (c *Cmd) String() string", Doc: "String returns a human-readable description of c.", Pos: "exec.go:511"},
+		"os/exec.Cmd.Run": {Sig: "func (c *Cmd) Run() error", Doc: "Run starts the specified command and waits for it to complete.", Pos: "exec.go:639"},
+		"os/exec.Cmd.Start": {Sig: "func (c *Cmd) Start() error", Doc: "Start starts the specified command but does not wait for it to complete.", Pos: "exec.go:652"},
+		"os/exec.ExitError": {Sig: "type ExitError struct", Doc: "An ExitError reports an unsuccessful exit by a command.", Pos: "exec.go:896"},
+		"os/exec.ExitError.Error": {Sig: "func (e *ExitError) Error() string", Doc: "", Pos: "exec.go:912"},
+		"os/exec.Cmd.Wait": {Sig: "func (c *Cmd) Wait() error", Doc: "Wait waits for the command to exit and waits for any copying to stdin or copying from stdout or stderr to complete.", Pos: "exec.go:936"},
+		"os/exec.Cmd.Output": {Sig: "func (c *Cmd) Output() ([]byte, error)", Doc: "Output runs the command and returns its standard output.", Pos: "exec.go:1028"},
+		"os/exec.Cmd.CombinedOutput": {Sig: "func (c *Cmd) CombinedOutput() ([]byte, error)", Doc: "CombinedOutput runs the command and returns its combined standard output and standard error.", Pos: "exec.go:1051"},
+		"os/exec.Cmd.StdinPipe": {Sig: "func (c *Cmd) StdinPipe() (io.WriteCloser, error)", Doc: "StdinPipe returns a pipe that will be connected to the command's standard input when the command starts.", Pos: "exec.go:1071"},
+		"os/exec.Cmd.StdoutPipe": {Sig: "func (c *Cmd) StdoutPipe() (io.ReadCloser, error)", Doc: "StdoutPipe returns a pipe that will be connected to the command's standard output when the command starts.", Pos: "exec.go:1096"},
+		"os/exec.Cmd.StderrPipe": {Sig: "func (c *Cmd) StderrPipe() (io.ReadCloser, error)", Doc: "StderrPipe returns a pipe that will be connected to the command's standard error when the command starts.", Pos: "exec.go:1121"},
+		"os/exec.Cmd.Environ": {Sig: "func (c *Cmd) Environ() []string", Doc: "Environ returns a copy of the environment in which the command would be run as it is currently configured.", Pos: "exec.go:1254"},
+		"os/exec.ErrDot": {Sig: "var ErrDot", Doc: "ErrDot indicates that a path lookup resolved to an executable in the current directory due to ‘.’ being in the path, either implicitly or explicitly.", Pos: "exec.go:1352"},
+		"os/exec.LookPath": {Sig: "func LookPath(file string) (string, error)", Doc: "LookPath searches for an executable named file in the current path, following the conventions of the host operating system.", Pos: "lookpath.go:28"},
+		"os/exec.ErrNotFound": {Sig: "var ErrNotFound", Doc: "ErrNotFound is the error resulting if a path search failed to find an executable file.", Pos: "lp_unix.go:20"},
+		"path/filepath": {Doc: "Package filepath implements utility routines for manipulating filename paths in a way compatible with the target operating system-defined file paths."},
+		"path/filepath.ErrBadPattern": {Sig: "var ErrBadPattern", Doc: "ErrBadPattern indicates a pattern was malformed.", Pos: "match.go:18"},
+		"path/filepath.Match": {Sig: "func Match(pattern, name string) (matched bool, err error)", Doc: "Match reports whether name matches the shell file name pattern.", Pos: "match.go:46"},
+		"path/filepath.Glob": {Sig: "func Glob(pattern string) (matches []string, err error)", Doc: "Glob returns the names of all files matching pattern or nil if there is no matching file.", Pos: "match.go:231"},
+		"path/filepath.Separator": {Sig: "const Separator", Doc: "", Pos: "path.go:24"},
+		"path/filepath.ListSeparator": {Sig: "const ListSeparator", Doc: "", Pos: "path.go:25"},
+		"path/filepath.Clean": {Sig: "func Clean(path string) string", Doc: "Clean returns the shortest path name equivalent to path by purely lexical processing.", Pos: "path.go:55"},
+		"path/filepath.IsLocal": {Sig: "func IsLocal(path string) bool", Doc: "IsLocal reports whether path, using lexical analysis only, has all of these properties:", Pos: "path.go:73"},
+		"path/filepath.Localize": {Sig: "func Localize(path string) (string, error)", Doc: "Localize converts a slash-separated path into an operating system path.", Pos: "path.go:85"},
+		"path/filepath.ToSlash": {Sig: "func ToSlash(path string) string", Doc: "ToSlash returns the result of replacing each separator character in path with a slash ('/') character.", Pos: "path.go:92"},
+		"path/filepath.FromSlash": {Sig: "func FromSlash(path string) string", Doc: "FromSlash returns the result of replacing each slash ('/') character in path with a separator character.", Pos: "path.go:102"},
+		"path/filepath.SplitList": {Sig: "func SplitList(path string) []string", Doc: "SplitList splits a list of paths joined by the OS-specific [ListSeparator], usually found in PATH or GOPATH environment variables.", Pos: "path.go:110"},
+		"path/filepath.Split": {Sig: "func Split(path string) (dir, file string)", Doc: "Split splits path immediately following the final [Separator], separating it into a directory and file name component.", Pos: "path.go:119"},
+		"path/filepath.Join": {Sig: "func Join(elem ...string) string", Doc: "Join joins any number of path elements into a single path, separating them with an OS specific [Separator].", Pos: "path.go:130"},
+		"path/filepath.Ext": {Sig: "func Ext(path string) string", Doc: "Ext returns the file name extension used by path.", Pos: "path.go:138"},
+		"path/filepath.EvalSymlinks": {Sig: "func EvalSymlinks(path string) (string, error)", Doc: "EvalSymlinks returns the path name after the evaluation of any symbolic links.", Pos: "path.go:147"},
+		"path/filepath.IsAbs": {Sig: "func IsAbs(path string) bool", Doc: "IsAbs reports whether the path is absolute.", Pos: "path.go:152"},
+		"path/filepath.Abs": {Sig: "func Abs(path string) (string, error)", Doc: "Abs returns an absolute representation of path.", Pos: "path.go:161"},
+		"path/filepath.Rel": {Sig: "func Rel(basePath, targPath string) (string, error)", Doc: "Rel returns a relative path that is lexically equivalent to targPath when joined to basePath with an intervening separator.", Pos: "path.go:185"},
+		"path/filepath.SkipDir": {Sig: "var SkipDir error", Doc: "SkipDir is used as a return value from [WalkFunc] to indicate that the directory named in the call is to be skipped.", Pos: "path.go:260"},
+		"path/filepath.SkipAll": {Sig: "var SkipAll error", Doc: "SkipAll is used as a return value from [WalkFunc] to indicate that all remaining files and directories are to be skipped.", Pos: "path.go:265"},
+		"path/filepath.WalkFunc": {Sig: "type WalkFunc func(path string, info fs.FileInfo, err error) error", Doc: "WalkFunc is the type of the function called by [Walk] to visit each file or directory.", Pos: "path.go:305"},
+		"path/filepath.WalkDir": {Sig: "func WalkDir(root string, fn fs.WalkDirFunc) error", Doc: "WalkDir walks the file tree rooted at root, calling fn for each file or directory in the tree, including root.", Pos: "path.go:396"},
+		"path/filepath.Walk": {Sig: "func Walk(root string, fn WalkFunc) error", Doc: "Walk walks the file tree rooted at root, calling fn for each file or directory in the tree, including root.", Pos: "path.go:423"},
+		"path/filepath.Base": {Sig: "func Base(path string) string", Doc: "Base returns the last element of path.", Pos: "path.go:456"},
+		"path/filepath.Dir": {Sig: "func Dir(path string) string", Doc: "Dir returns all but the last element of path, typically the path's directory.", Pos: "path.go:470"},
+		"path/filepath.VolumeName": {Sig: "func VolumeName(path string) string", Doc: "VolumeName returns leading volume name.", Pos: "path.go:478"},
+		"path/filepath.HasPrefix": {Sig: "func HasPrefix(p, prefix string) bool", Doc: "HasPrefix exists for historical compatibility and should not be used.", Pos: "path_unix.go:17"},
+		"reflect": {Doc: "Package reflect implements run-time reflection, allowing a program to manipulate objects with arbitrary types."},
+		"reflect.DeepEqual": {Sig: "func DeepEqual(x, y any) bool", Doc: "DeepEqual reports whether x and y are “deeply equal,” defined as follows.", Pos: "deepequal.go:229"},
+		"reflect.Value.Seq": {Sig: "func (v Value) Seq() iter.Seq[Value]", Doc: "Seq returns an iter.Seq[Value] that loops over the elements of v.", Pos: "iter.go:38"},
+		"reflect.Value.Seq2": {Sig: "func (v Value) Seq2() iter.Seq2[Value, Value]", Doc: "Seq2 returns an iter.Seq2[Value, Value] that loops over the elements of v.", Pos: "iter.go:124"},
+		"reflect.MakeFunc": {Sig: "func MakeFunc(typ Type, fn func(args []Value) (results []Value)) Value", Doc: "MakeFunc returns a new function of the given [Type] that wraps the function fn.", Pos: "makefunc.go:47"},
+		"reflect.MapOf": {Sig: "func MapOf(key, elem Type) Type", Doc: "MapOf returns the map type with the given key and element types.", Pos: "map.go:30"},
+		"reflect.Value.MapIndex": {Sig: "func (v Value) MapIndex(key Value) Value", Doc: "MapIndex returns the value associated with key in the map v.", Pos: "map.go:182"},
+		"reflect.Value.MapKeys": {Sig: "func (v Value) MapKeys() []Value", Doc: "MapKeys returns a slice containing all the keys present in the map, in unspecified order.", Pos: "map.go:246"},
+		"reflect.MapIter": {Sig: "type MapIter struct", Doc: "A MapIter is an iterator for ranging over a map.", Pos: "map.go:282"},
+		"reflect.MapIter.Key": {Sig: "func (iter *MapIter) Key() Value", Doc: "Key returns the key of iter's current map entry.", Pos: "map.go:288"},
+		"reflect.Value.SetIterKey": {Sig: "func (v Value) SetIterKey(iter *MapIter)", Doc: "SetIterKey assigns to v the key of iter's current map entry.", Pos: "map.go:307"},
+		"reflect.MapIter.Value": {Sig: "func (iter *MapIter) Value() Value", Doc: "Value returns the value of iter's current map entry.", Pos: "map.go:332"},
+		"reflect.Value.SetIterValue": {Sig: "func (v Value) SetIterValue(iter *MapIter)", Doc: "SetIterValue assigns to v the value of iter's current map entry.", Pos: "map.go:351"},
+		"reflect.MapIter.Next": {Sig: "func (iter *MapIter) Next() bool", Doc: "Next advances the map iterator and reports whether there is another entry.", Pos: "map.go:378"},
+		"reflect.MapIter.Reset": {Sig: "func (iter *MapIter) Reset(v Value)", Doc: "Reset modifies iter to iterate over v.", Pos: "map.go:399"},
+		"reflect.Value.MapRange": {Sig: "func (v Value) MapRange() *MapIter", Doc: "MapRange returns a range iterator for a map.", Pos: "map.go:422"},
+		"reflect.Value.SetMapIndex": {Sig: "func (v Value) SetMapIndex(key, elem Value)", Doc: "SetMapIndex sets the element associated with key in the map v to elem.", Pos: "map.go:439"},
+		"reflect.Swapper": {Sig: "func Swapper(slice any) func(i, j int)", Doc: "Swapper returns a function that swaps the elements in the provided slice.", Pos: "swapper.go:18"},
+		"reflect.Type": {Sig: "type Type interface", Doc: "Type is the representation of a Go type.", Pos: "type.go:41"},
+		"reflect.Kind": {Sig: "type Kind uint", Doc: "A Kind represents the specific kind of type that a [Type] represents.", Pos: "type.go:298"},
+		"reflect.Invalid": {Sig: "const Invalid Kind", Doc: "", Pos: "type.go:301"},
+		"reflect.Bool": {Sig: "const Bool", Doc: "", Pos: "type.go:302"},
+		"reflect.Int": {Sig: "const Int", Doc: "", Pos: "type.go:303"},
+		"reflect.Int8": {Sig: "const Int8", Doc: "", Pos: "type.go:304"},
+		"reflect.Int16": {Sig: "const Int16", Doc: "", Pos: "type.go:305"},
+		"reflect.Int32": {Sig: "const Int32", Doc: "", Pos: "type.go:306"},
+		"reflect.Int64": {Sig: "const Int64", Doc: "", Pos: "type.go:307"},
+		"reflect.Uint": {Sig: "const Uint", Doc: "", Pos: "type.go:308"},
+		"reflect.Uint8": {Sig: "const Uint8", Doc: "", Pos: "type.go:309"},
+		"reflect.Uint16": {Sig: "const Uint16", Doc: "", Pos: "type.go:310"},
+		"reflect.Uint32": {Sig: "const Uint32", Doc: "", Pos: "type.go:311"},
+		"reflect.Uint64": {Sig: "const Uint64", Doc: "", Pos: "type.go:312"},
+		"reflect.Uintptr": {Sig: "const Uintptr", Doc: "", Pos: "type.go:313"},
+		"reflect.Float32": {Sig: "const Float32", Doc: "", Pos: "type.go:314"},
+		"reflect.Float64": {Sig: "const Float64", Doc: "", Pos: "type.go:315"},
+		"reflect.Complex64": {Sig: "const Complex64", Doc: "", Pos: "type.go:316"},
+		"reflect.Complex128": {Sig: "const Complex128", Doc: "", Pos: "type.go:317"},
+		"reflect.Array": {Sig: "const Array", Doc: "", Pos: "type.go:318"},
+		"reflect.Chan": {Sig: "const Chan", Doc: "", Pos: "type.go:319"},
+		"reflect.Func": {Sig: "const Func", Doc: "", Pos: "type.go:320"},
+		"reflect.Interface": {Sig: "const Interface", Doc: "", Pos: "type.go:321"},
+		"reflect.Map": {Sig: "const Map", Doc: "", Pos: "type.go:322"},
+		"reflect.Pointer": {Sig: "const Pointer", Doc: "", Pos: "type.go:323"},
+		"reflect.Slice": {Sig: "const Slice", Doc: "", Pos: "type.go:324"},
+		"reflect.String": {Sig: "const String", Doc: "", Pos: "type.go:325"},
+		"reflect.Struct": {Sig: "const Struct", Doc: "", Pos: "type.go:326"},
+		"reflect.UnsafePointer": {Sig: "const UnsafePointer", Doc: "", Pos: "type.go:327"},
+		"reflect.Ptr": {Sig: "const Ptr", Doc: "Ptr is the old name for the [Pointer] kind.", Pos: "type.go:333"},
+		"reflect.ChanDir": {Sig: "type ChanDir int", Doc: "ChanDir represents a channel type's direction.", Pos: "type.go:368"},
+		"reflect.RecvDir": {Sig: "const RecvDir ChanDir", Doc: "", Pos: "type.go:371"},
+		"reflect.SendDir": {Sig: "const SendDir", Doc: "", Pos: "type.go:372"},
+		"reflect.BothDir": {Sig: "const BothDir", Doc: "", Pos: "type.go:373"},
+		"reflect.Method": {Sig: "type Method struct", Doc: "Method represents a single method.", Pos: "type.go:470"},
+		"reflect.Method.IsExported": {Sig: "func (m Method) IsExported() bool", Doc: "IsExported reports whether the method is exported.", Pos: "type.go:487"},
+		"reflect.Kind.String": {Sig: "func (k Kind) String() string", Doc: "String returns the name of k.", Pos: "type.go:492"},
+		"reflect.ChanDir.String": {Sig: "func (d ChanDir) String() string", Doc: "", Pos: "type.go:1015"},
+		"reflect.StructField": {Sig: "type StructField struct", Doc: "A StructField describes a single field in a struct.", Pos: "type.go:1065"},
+		"reflect.StructField.IsExported": {Sig: "func (f StructField) IsExported() bool", Doc: "IsExported reports whether the field is exported.", Pos: "type.go:1082"},
+		"reflect.StructTag": {Sig: "type StructTag string", Doc: "A StructTag is the tag string in a struct field.", Pos: "type.go:1094"},
+		"reflect.StructTag.Get": {Sig: "func (tag StructTag) Get(key string) string", Doc: "Get returns the value associated with key in the tag string.", Pos: "type.go:1101"},
+		"reflect.StructTag.Lookup": {Sig: "func (tag StructTag) Lookup(key string) (value string, ok bool)", Doc: "Lookup returns the value associated with key in the tag string.", Pos: "type.go:1112"},
+		"reflect.TypeOf": {Sig: "func TypeOf(i any) Type", Doc: "TypeOf returns the reflection [Type] that represents the dynamic type of i.", Pos: "type.go:1367"},
+		"reflect.TypeFor": {Sig: "func TypeFor[T any]() Type", Doc: "TypeFor returns the [Type] that represents the type argument T.", Pos: "type.go:1372"},
+		"reflect.PtrTo": {Sig: "func PtrTo(t Type) Type", Doc: "PtrTo returns the pointer type with element t.", Pos: "type.go:1394"},
+		"reflect.PointerTo": {Sig: "func PointerTo(t Type) Type", Doc: "PointerTo returns the pointer type with element t.", Pos: "type.go:1398"},
+		"reflect.ChanOf": {Sig: "func ChanOf(dir ChanDir, t Type) Type", Doc: "ChanOf returns the channel type with the given direction and element type.", Pos: "type.go:1822"},
+		"reflect.FuncOf": {Sig: "func FuncOf(in, out []Type, variadic bool) Type", Doc: "FuncOf returns the function type with the given argument and result types.", Pos: "type.go:1915"},
+		"reflect.SliceOf": {Sig: "func SliceOf(t Type) Type", Doc: "SliceOf returns the slice type with element type t.", Pos: "type.go:2130"},
+		"reflect.StructOf": {Sig: "func StructOf(fields []StructField) Type", Doc: "StructOf returns the struct type containing fields.", Pos: "type.go:2256"},
+		"reflect.ArrayOf": {Sig: "func ArrayOf(length int, elem Type) Type", Doc: "ArrayOf returns the array type with the given length and element type.", Pos: "type.go:2655"},
+		"reflect.Value": {Sig: "type Value struct", Doc: "Value is the reflection interface to a Go value.", Pos: "value.go:40"},
+		"reflect.ValueError": {Sig: "type ValueError struct", Doc: "A ValueError occurs when a Value method is invoked on a [Value] that does not support it.", Pos: "value.go:174"},
+		"reflect.ValueError.Error": {Sig: "func (e *ValueError) Error() string", Doc: "", Pos: "value.go:179"},
+		"reflect.Value.Addr": {Sig: "func (v Value) Addr() Value", Doc: "Addr returns a pointer value representing the address of v.", Pos: "value.go:269"},
+		"reflect.Value.Bool": {Sig: "func (v Value) Bool() bool", Doc: "Bool returns v's underlying value.", Pos: "value.go:281"},
+		"reflect.Value.Bytes": {Sig: "func (v Value) Bytes() []byte", Doc: "Bytes returns v's underlying value.", Pos: "value.go:298"},
+		"reflect.Value.CanAddr": {Sig: "func (v Value) CanAddr() bool", Doc: "CanAddr reports whether the value's address can be obtained with [Value.Addr].", Pos: "value.go:344"},
+		"reflect.Value.CanSet": {Sig: "func (v Value) CanSet() bool", Doc: "CanSet reports whether the value of v can be changed.", Pos: "value.go:353"},
+		"reflect.Value.Call": {Sig: "func (v Value) Call(in []Value) []Value", Doc: "Call calls the function v with the input arguments in.", Pos: "value.go:366"},
+		"reflect.Value.CallSlice": {Sig: "func (v Value) CallSlice(in []Value) []Value", Doc: "CallSlice calls the variadic function v with the input arguments in, assigning the slice in[len(in)-1] to v's final variadic argument.", Pos: "value.go:380"},
+		"reflect.Value.Cap": {Sig: "func (v Value) Cap() int", Doc: "Cap returns v's capacity.", Pos: "value.go:1155"},
+		"reflect.Value.Close": {Sig: "func (v Value) Close()", Doc: "Close closes the channel v.", Pos: "value.go:1182"},
+		"reflect.Value.CanComplex": {Sig: "func (v Value) CanComplex() bool", Doc: "CanComplex reports whether [Value.Complex] can be used without panicking.", Pos: "value.go:1194"},
+		"reflect.Value.Complex": {Sig: "func (v Value) Complex() complex128", Doc: "Complex returns v's underlying value, as a complex128.", Pos: "value.go:1205"},
+		"reflect.Value.Elem": {Sig: "func (v Value) Elem() Value", Doc: "Elem returns the value that the interface v contains or that the pointer v points to.", Pos: "value.go:1220"},
+		"reflect.Value.Field": {Sig: "func (v Value) Field(i int) Value", Doc: "Field returns the i'th field of the struct v.", Pos: "value.go:1264"},
+		"reflect.Value.FieldByIndex": {Sig: "func (v Value) FieldByIndex(index []int) Value", Doc: "FieldByIndex returns the nested field corresponding to index.", Pos: "value.go:1309"},
+		"reflect.Value.FieldByIndexErr": {Sig: "func (v Value) FieldByIndexErr(index []int) (Value, error)", Doc: "FieldByIndexErr returns the nested field corresponding to index.", Pos: "value.go:1332"},
+		"reflect.Value.FieldByName": {Sig: "func (v Value) FieldByName(name string) Value", Doc: "FieldByName returns the struct field with the given name.", Pos: "value.go:1354"},
+		"reflect.Value.FieldByNameFunc": {Sig: "func (v Value) FieldByNameFunc(match func(string) bool) Value", Doc: "FieldByNameFunc returns the struct field with a name that satisfies the match function.", Pos: "value.go:1366"},
+		"reflect.Value.CanFloat": {Sig: "func (v Value) CanFloat() bool", Doc: "CanFloat reports whether [Value.Float] can be used without panicking.", Pos: "value.go:1374"},
+		"reflect.Value.Float": {Sig: "func (v Value) Float() float64", Doc: "Float returns v's underlying value, as a float64.", Pos: "value.go:1385"},
+		"reflect.Value.Index": {Sig: "func (v Value) Index(i int) Value", Doc: "Index returns v's i'th element.", Pos: "value.go:1400"},
+		"reflect.Value.CanInt": {Sig: "func (v Value) CanInt() bool", Doc: "CanInt reports whether Int can be used without panicking.", Pos: "value.go:1445"},
+		"reflect.Value.Int": {Sig: "func (v Value) Int() int64", Doc: "Int returns v's underlying value, as an int64.", Pos: "value.go:1456"},
+		"reflect.Value.CanInterface": {Sig: "func (v Value) CanInterface() bool", Doc: "CanInterface reports whether [Value.Interface] can be used without panicking.", Pos: "value.go:1475"},
+		"reflect.Value.Interface": {Sig: "func (v Value) Interface() (i any)", Doc: "Interface returns v's current value as an interface{}.", Pos: "value.go:1489"},
+		"reflect.TypeAssert": {Sig: "func TypeAssert[T any](v Value) (T, bool)", Doc: "TypeAssert is semantically equivalent to:", Pos: "value.go:1529"},
+		"reflect.Value.InterfaceData": {Sig: "func (v Value) InterfaceData() [2]uintptr", Doc: "InterfaceData returns a pair of unspecified uintptr values.", Pos: "value.go:1624"},
+		"reflect.Value.IsNil": {Sig: "func (v Value) IsNil() bool", Doc: "IsNil reports whether its argument v is nil.", Pos: "value.go:1643"},
+		"reflect.Value.IsValid": {Sig: "func (v Value) IsValid() bool", Doc: "IsValid reports whether v represents a value.", Pos: "value.go:1668"},
+		"reflect.Value.IsZero": {Sig: "func (v Value) IsZero() bool", Doc: "IsZero reports whether v is the zero value for its type.", Pos: "value.go:1674"},
+		"reflect.Value.SetZero": {Sig: "func (v Value) SetZero()", Doc: "SetZero sets v to be the zero value of v's type.", Pos: "value.go:1801"},
+		"reflect.Value.Kind": {Sig: "func (v Value) Kind() Kind", Doc: "Kind returns v's Kind.", Pos: "value.go:1855"},
+		"reflect.Value.Len": {Sig: "func (v Value) Len() int", Doc: "Len returns v's length.", Pos: "value.go:1861"},
+		"reflect.Value.Method": {Sig: "func (v Value) Method(i int) Value", Doc: "Method returns a function value corresponding to v's i'th method.", Pos: "value.go:1910"},
+		"reflect.Value.NumMethod": {Sig: "func (v Value) NumMethod() int", Doc: "NumMethod returns the number of methods in the value's method set.", Pos: "value.go:1931"},
+		"reflect.Value.MethodByName": {Sig: "func (v Value) MethodByName(name string) Value", Doc: "MethodByName returns a function value corresponding to the method of v with the given name.", Pos: "value.go:1950"},
+		"reflect.Value.NumField": {Sig: "func (v Value) NumField() int", Doc: "NumField returns the number of fields in the struct v.", Pos: "value.go:1966"},
+		"reflect.Value.OverflowComplex": {Sig: "func (v Value) OverflowComplex(x complex128) bool", Doc: "OverflowComplex reports whether the complex128 x cannot be represented by v's type.", Pos: "value.go:1974"},
+		"reflect.Value.OverflowFloat": {Sig: "func (v Value) OverflowFloat(x float64) bool", Doc: "OverflowFloat reports whether the float64 x cannot be represented by v's type.", Pos: "value.go:1987"},
+		"reflect.Value.OverflowInt": {Sig: "func (v Value) OverflowInt(x int64) bool", Doc: "OverflowInt reports whether the int64 x cannot be represented by v's type.", Pos: "value.go:2007"},
+		"reflect.Value.OverflowUint": {Sig: "func (v Value) OverflowUint(x uint64) bool", Doc: "OverflowUint reports whether the uint64 x cannot be represented by v's type.", Pos: "value.go:2020"},
+		"reflect.Value.Pointer": {Sig: "func (v Value) Pointer() uintptr", Doc: "Pointer returns v's value as a uintptr.", Pos: "value.go:2054"},
+		"reflect.Value.Recv": {Sig: "func (v Value) Recv() (x Value, ok bool)", Doc: "Recv receives and returns a value from the channel v.", Pos: "value.go:2103"},
+		"reflect.Value.Send": {Sig: "func (v Value) Send(x Value)", Doc: "Send sends x on the channel v.", Pos: "value.go:2136"},
+		"reflect.Value.Set": {Sig: "func (v Value) Set(x Value)", Doc: "Set assigns x to the value v.", Pos: "value.go:2164"},
+		"reflect.Value.SetBool": {Sig: "func (v Value) SetBool(x bool)", Doc: "SetBool sets v's underlying value.", Pos: "value.go:2185"},
+		"reflect.Value.SetBytes": {Sig: "func (v Value) SetBytes(x []byte)", Doc: "SetBytes sets v's underlying value.", Pos: "value.go:2194"},
+		"reflect.Value.SetComplex": {Sig: "func (v Value) SetComplex(x complex128)", Doc: "SetComplex sets v's underlying value to x.", Pos: "value.go:2218"},
+		"reflect.Value.SetFloat": {Sig: "func (v Value) SetFloat(x float64)", Doc: "SetFloat sets v's underlying value to x.", Pos: "value.go:2233"},
+		"reflect.Value.SetInt": {Sig: "func (v Value) SetInt(x int64)", Doc: "SetInt sets v's underlying value to x.", Pos: "value.go:2248"},
+		"reflect.Value.SetLen": {Sig: "func (v Value) SetLen(n int)", Doc: "SetLen sets v's length to n.", Pos: "value.go:2270"},
+		"reflect.Value.SetCap": {Sig: "func (v Value) SetCap(n int)", Doc: "SetCap sets v's capacity to n.", Pos: "value.go:2284"},
+		"reflect.Value.SetUint": {Sig: "func (v Value) SetUint(x uint64)", Doc: "SetUint sets v's underlying value to x.", Pos: "value.go:2297"},
+		"reflect.Value.SetPointer": {Sig: "func (v Value) SetPointer(x unsafe.Pointer)", Doc: "SetPointer sets the unsafe.Pointer value v to x.", Pos: "value.go:2320"},
+		"reflect.Value.SetString": {Sig: "func (v Value) SetString(x string)", Doc: "SetString sets v's underlying value to x.", Pos: "value.go:2328"},
+		"reflect.Value.Slice": {Sig: "func (v Value) Slice(i, j int) Value", Doc: "Slice returns v[i:j].", Pos: "value.go:2337"},
+		"reflect.Value.Slice3": {Sig: "func (v Value) Slice3(i, j, k int) Value", Doc: "Slice3 is the 3-index form of the slice operation: it returns v[i:j:k].", Pos: "value.go:2399"},
+		"reflect.Value.String": {Sig: "func (v Value) String() string", Doc: "String returns the string v's underlying value, as a string.", Pos: "value.go:2454"},
+		"reflect.Value.TryRecv": {Sig: "func (v Value) TryRecv() (x Value, ok bool)", Doc: "TryRecv attempts to receive a value from the channel v but will not block.", Pos: "value.go:2476"},
+		"reflect.Value.TrySend": {Sig: "func (v Value) TrySend(x Value) bool", Doc: "TrySend attempts to send x on the channel v but will not block.", Pos: "value.go:2486"},
+		"reflect.Value.Type": {Sig: "func (v Value) Type() Type", Doc: "Type returns v's type.", Pos: "value.go:2493"},
+		"reflect.Value.CanUint": {Sig: "func (v Value) CanUint() bool", Doc: "CanUint reports whether [Value.Uint] can be used without panicking.", Pos: "value.go:2544"},
+		"reflect.Value.Uint": {Sig: "func (v Value) Uint() uint64", Doc: "Uint returns v's underlying value, as a uint64.", Pos: "value.go:2555"},
+		"reflect.Value.UnsafeAddr": {Sig: "func (v Value) UnsafeAddr() uintptr", Doc: "UnsafeAddr returns a pointer to v's data, as a uintptr.", Pos: "value.go:2584"},
+		"reflect.Value.UnsafePointer": {Sig: "func (v Value) UnsafePointer() unsafe.Pointer", Doc: "UnsafePointer returns v's value as a unsafe.Pointer.", Pos: "value.go:2610"},
+		"reflect.Value.Fields": {Sig: "func (v Value) Fields() iter.Seq2[StructField, Value]", Doc: "Fields returns an iterator over each [StructField] of v along with its [Value].", Pos: "value.go:2657"},
+		"reflect.Value.Methods": {Sig: "func (v Value) Methods() iter.Seq2[Method, Value]", Doc: "Methods returns an iterator over each [Method] of v along with the corresponding method [Value]; this is a function with v bound as the receiver.", Pos: "value.go:2682"},
+		"reflect.StringHeader": {Sig: "type StringHeader struct", Doc: "StringHeader is the runtime representation of a string.", Pos: "value.go:2702"},
+		"reflect.SliceHeader": {Sig: "type SliceHeader struct", Doc: "SliceHeader is the runtime representation of a slice.", Pos: "value.go:2715"},
+		"reflect.Value.Grow": {Sig: "func (v Value) Grow(n int)", Doc: "Grow increases the slice's capacity, if necessary, to guarantee space for another n elements.", Pos: "value.go:2744"},
+		"reflect.Value.Clear": {Sig: "func (v Value) Clear()", Doc: "Clear clears the contents of a map or zeros the contents of a slice.", Pos: "value.go:2788"},
+		"reflect.Append": {Sig: "func Append(s Value, x ...Value) Value", Doc: "Append appends the values x to a slice s and returns the resulting slice.", Pos: "value.go:2803"},
+		"reflect.AppendSlice": {Sig: "func AppendSlice(s, t Value) Value", Doc: "AppendSlice appends a slice t to a slice s and returns the resulting slice.", Pos: "value.go:2815"},
+		"reflect.Copy": {Sig: "func Copy(dst, src Value) int", Doc: "Copy copies the contents of src into dst until either dst has been filled or src has been exhausted.", Pos: "value.go:2834"},
+		"reflect.SelectDir": {Sig: "type SelectDir int", Doc: "A SelectDir describes the communication direction of a select case.", Pos: "value.go:2907"},
+		"reflect.SelectSend": {Sig: "const SelectSend", Doc: "", Pos: "value.go:2913"},
+		"reflect.SelectRecv": {Sig: "const SelectRecv", Doc: "", Pos: "value.go:2914"},
+		"reflect.SelectDefault": {Sig: "const SelectDefault", Doc: "", Pos: "value.go:2915"},
+		"reflect.SelectCase": {Sig: "type SelectCase struct", Doc: "A SelectCase describes a single case in a select operation.", Pos: "value.go:2934"},
+		"reflect.Select": {Sig: "func Select(cases []SelectCase) (chosen int, recv Value, recvOK bool)", Doc: "Select executes a select operation described by the list of cases.", Pos: "value.go:2952"},
+		"reflect.MakeSlice": {Sig: "func MakeSlice(typ Type, len, cap int) Value", Doc: "MakeSlice creates a new zero-initialized slice value for the specified slice type, length, and capacity.", Pos: "value.go:3085"},
+		"reflect.SliceAt": {Sig: "func SliceAt(typ Type, p unsafe.Pointer, n int) Value", Doc: "SliceAt returns a [Value] representing a slice whose underlying data starts at p, with length and capacity equal to n.", Pos: "value.go:3108"},
+		"reflect.MakeChan": {Sig: "func MakeChan(typ Type, buffer int) Value", Doc: "MakeChan creates a new channel with the specified type and buffer size.", Pos: "value.go:3115"},
+		"reflect.MakeMap": {Sig: "func MakeMap(typ Type) Value", Doc: "MakeMap creates a new map with the specified type.", Pos: "value.go:3132"},
+		"reflect.MakeMapWithSize": {Sig: "func MakeMapWithSize(typ Type, n int) Value", Doc: "MakeMapWithSize creates a new map with the specified type and initial space for approximately n elements.", Pos: "value.go:3138"},
+		"reflect.Indirect": {Sig: "func Indirect(v Value) Value", Doc: "Indirect returns the value that v points to.", Pos: "value.go:3151"},
+		"reflect.ValueOf": {Sig: "func ValueOf(i any) Value", Doc: "ValueOf returns a new Value initialized to the concrete value stored in the interface i.", Pos: "value.go:3160"},
+		"reflect.Zero": {Sig: "func Zero(typ Type) Value", Doc: "Zero returns a Value representing the zero value for the specified type.", Pos: "value.go:3172"},
+		"reflect.New": {Sig: "func New(typ Type) Value", Doc: "New returns a Value representing a pointer to a new zero value for the specified type.", Pos: "value.go:3195"},
+		"reflect.NewAt": {Sig: "func NewAt(typ Type, p unsafe.Pointer) Value", Doc: "NewAt returns a Value representing a pointer to a value of the specified type, using p as that pointer.", Pos: "value.go:3212"},
+		"reflect.Value.Convert": {Sig: "func (v Value) Convert(t Type) Value", Doc: "Convert returns the value v converted to type t.", Pos: "value.go:3262"},
+		"reflect.Value.CanConvert": {Sig: "func (v Value) CanConvert(t Type) bool", Doc: "CanConvert reports whether the value v can be converted to type t.", Pos: "value.go:3276"},
+		"reflect.Value.Comparable": {Sig: "func (v Value) Comparable() bool", Doc: "Comparable reports whether the value v is comparable.", Pos: "value.go:3302"},
+		"reflect.Value.Equal": {Sig: "func (v Value) Equal(u Value) bool", Doc: "Equal reports true if v is equal to u.", Pos: "value.go:3344"},
+		"reflect.VisibleFields": {Sig: "func VisibleFields(t Type) []StructField", Doc: "VisibleFields returns all the visible fields in t, which must be a struct type.", Pos: "visiblefields.go:16"},
+		"regexp": {Doc: "Package regexp implements regular expression search."},
+		"regexp.Regexp": {Sig: "type Regexp struct", Doc: "Regexp is the representation of a compiled regular expression.", Pos: "regexp.go:79"},
+		"regexp.Regexp.String": {Sig: "func (re *Regexp) String() string", Doc: "String returns the source text used to compile the regular expression.", Pos: "regexp.go:102"},
+		"regexp.Regexp.Copy": {Sig: "func (re *Regexp) Copy() *Regexp", Doc: "Copy returns a new [Regexp] object copied from re.", Pos: "regexp.go:114"},
+		"regexp.Compile": {Sig: "func Compile(expr string) (*Regexp, error)", Doc: "Compile parses a regular expression and returns, if successful, a [Regexp] object that can be used to match against text.", Pos: "regexp.go:129"},
+		"regexp.CompilePOSIX": {Sig: "func CompilePOSIX(expr string) (*Regexp, error)", Doc: "CompilePOSIX is like [Compile] but restricts the regular expression to POSIX ERE (egrep) syntax and changes the match semantics to leftmost-longest.", Pos: "regexp.go:152"},
+		"regexp.Regexp.Longest": {Sig: "func (re *Regexp) Longest()", Doc: "Longest makes future searches prefer the leftmost-longest match.", Pos: "regexp.go:162"},
+		"regexp.MustCompile": {Sig: "func MustCompile(str string) *Regexp", Doc: "MustCompile is like [Compile] but panics if the expression cannot be parsed.", Pos: "regexp.go:309"},
+		"regexp.MustCompilePOSIX": {Sig: "func MustCompilePOSIX(str string) *Regexp", Doc: "MustCompilePOSIX is like [CompilePOSIX] but panics if the expression cannot be parsed.", Pos: "regexp.go:320"},
+		"regexp.Regexp.NumSubexp": {Sig: "func (re *Regexp) NumSubexp() int", Doc: "NumSubexp returns the number of parenthesized subexpressions in this [Regexp].", Pos: "regexp.go:336"},
+		"regexp.Regexp.SubexpNames": {Sig: "func (re *Regexp) SubexpNames() []string", Doc: "SubexpNames returns the names of the parenthesized subexpressions in this [Regexp].", Pos: "regexp.go:345"},
+		"regexp.Regexp.SubexpIndex": {Sig: "func (re *Regexp) SubexpIndex(name string) int", Doc: "SubexpIndex returns the index of the first subexpression with the given name, or -1 if there is no subexpression with that name.", Pos: "regexp.go:356"},
+		"regexp.Regexp.LiteralPrefix": {Sig: "func (re *Regexp) LiteralPrefix() (prefix string, complete bool)", Doc: "LiteralPrefix returns a literal string that must begin any match of the regular expression re.", Pos: "regexp.go:493"},
+		"regexp.Regexp.MatchReader": {Sig: "func (re *Regexp) MatchReader(r io.RuneReader) bool", Doc: "MatchReader reports whether the text returned by the io.RuneReader contains any match of the regular expression re.", Pos: "regexp.go:499"},
+		"regexp.Regexp.MatchString": {Sig: "func (re *Regexp) MatchString(s string) bool", Doc: "MatchString reports whether the string s contains any match of the regular expression re.", Pos: "regexp.go:505"},
+		"regexp.Regexp.Match": {Sig: "func (re *Regexp) Match(b []byte) bool", Doc: "Match reports whether the byte slice b contains any match of the regular expression re.", Pos: "regexp.go:511"},
+		"regexp.MatchReader": {Sig: "func MatchReader(pattern string, r io.RuneReader) (matched bool, err error)", Doc: "MatchReader reports whether the text returned by the io.RuneReader contains any match of the regular expression pattern.", Pos: "regexp.go:518"},
+		"regexp.MatchString": {Sig: "func MatchString(pattern string, s string) (matched bool, err error)", Doc: "MatchString reports whether the string s contains any match of the regular expression pattern.", Pos: "regexp.go:529"},
+		"regexp.Match": {Sig: "func Match(pattern string, b []byte) (matched bool, err error)", Doc: "Match reports whether the byte slice b contains any match of the regular expression pattern.", Pos: "regexp.go:540"},
+		"regexp.Regexp.ReplaceAllString": {Sig: "func (re *Regexp) ReplaceAllString(src, repl string) string", Doc: "ReplaceAllString returns a copy of src, replacing matches of the [Regexp] with the replacement string repl.", Pos: "regexp.go:551"},
+		"regexp.Regexp.ReplaceAllLiteralString": {Sig: "func (re *Regexp) ReplaceAllLiteralString(src, repl string) string", Doc: "ReplaceAllLiteralString returns a copy of src, replacing matches of the [Regexp] with the replacement string repl.", Pos: "regexp.go:565"},
+		"regexp.Regexp.ReplaceAllStringFunc": {Sig: "func (re *Regexp) ReplaceAllStringFunc(src string, repl func(string) string) string", Doc: "ReplaceAllStringFunc returns a copy of src in which all matches of the [Regexp] have been replaced by the return value of function repl applied to the matched substring.", Pos: "regexp.go:575"},
+		"regexp.Regexp.ReplaceAll": {Sig: "func (re *Regexp) ReplaceAll(src, repl []byte) []byte", Doc: "ReplaceAll returns a copy of src, replacing matches of the [Regexp] with the replacement text repl.", Pos: "regexp.go:650"},
+		"regexp.Regexp.ReplaceAllLiteral": {Sig: "func (re *Regexp) ReplaceAllLiteral(src, repl []byte) []byte", Doc: "ReplaceAllLiteral returns a copy of src, replacing matches of the [Regexp] with the replacement bytes repl.", Pos: "regexp.go:668"},
+		"regexp.Regexp.ReplaceAllFunc": {Sig: "func (re *Regexp) ReplaceAllFunc(src []byte, repl func([]byte) []byte) []byte", Doc: "ReplaceAllFunc returns a copy of src in which all matches of the [Regexp] have been replaced by the return value of function repl applied to the matched byte slice.", Pos: "regexp.go:678"},
+		"regexp.QuoteMeta": {Sig: "func QuoteMeta(s string) string", Doc: "QuoteMeta returns a string that escapes all regular expression metacharacters inside the argument text; the returned string is a regular expression matching the literal text.", Pos: "regexp.go:701"},
+		"regexp.Regexp.Find": {Sig: "func (re *Regexp) Find(b []byte) []byte", Doc: "Find returns the text of the leftmost match for re in b.", Pos: "regexp.go:807"},
+		"regexp.Regexp.FindString": {Sig: "func (re *Regexp) FindString(s string) string", Doc: "FindString returns the text of the leftmost match for re in s.", Pos: "regexp.go:819"},
+		"regexp.Regexp.FindIndex": {Sig: "func (re *Regexp) FindIndex(b []byte) (m []int)", Doc: "FindIndex returns the location of the leftmost match for re in b.", Pos: "regexp.go:831"},
+		"regexp.Regexp.FindStringIndex": {Sig: "func (re *Regexp) FindStringIndex(s string) (m []int)", Doc: "FindStringIndex returns the location of the leftmost match for re in s.", Pos: "regexp.go:842"},
+		"regexp.Regexp.FindReaderIndex": {Sig: "func (re *Regexp) FindReaderIndex(r io.RuneReader) (m []int)", Doc: "FindReaderIndex returns the location of the leftmost match for re in r.", Pos: "regexp.go:856"},
+		"regexp.Regexp.FindSubmatch": {Sig: "func (re *Regexp) FindSubmatch(b []byte) [][]byte", Doc: "FindSubmatch returns the first match for re in b, including submatches.", Pos: "regexp.go:867"},
+		"regexp.Regexp.FindStringSubmatch": {Sig: "func (re *Regexp) FindStringSubmatch(s string) []string", Doc: "FindStringSubmatch returns the first match for re in s, including submatches.", Pos: "regexp.go:885"},
+		"regexp.Regexp.FindSubmatchIndex": {Sig: "func (re *Regexp) FindSubmatchIndex(b []byte) []int", Doc: "FindSubmatchIndex returns the first match for re in b, including submatches.", Pos: "regexp.go:903"},
+		"regexp.Regexp.FindStringSubmatchIndex": {Sig: "func (re *Regexp) FindStringSubmatchIndex(s string) []int", Doc: "FindStringSubmatchIndex returns the first match for re in s, including submatches.", Pos: "regexp.go:910"},
+		"regexp.Regexp.FindReaderSubmatchIndex": {Sig: "func (re *Regexp) FindReaderSubmatchIndex(r io.RuneReader) []int", Doc: "FindReaderSubmatchIndex returns the first match for re in r, including submatches.", Pos: "regexp.go:921"},
+		"regexp.Regexp.FindAll": {Sig: "func (re *Regexp) FindAll(b []byte, n int) [][]byte", Doc: "FindAll returns all the matches for re in b.", Pos: "regexp.go:1084"},
+		"regexp.Regexp.FindAllString": {Sig: "func (re *Regexp) FindAllString(s string, n int) []string", Doc: "FindAllString returns all the matches for re in s.", Pos: "regexp.go:1091"},
+		"regexp.Regexp.FindAllIndex": {Sig: "func (re *Regexp) FindAllIndex(b []byte, n int) [][]int", Doc: "FindAllIndex returns the locations of all matches for re in b.", Pos: "regexp.go:1098"},
+		"regexp.Regexp.FindAllStringIndex": {Sig: "func (re *Regexp) FindAllStringIndex(s string, n int) [][]int", Doc: "FindAllStringIndex returns the locations of all matches for re in s.", Pos: "regexp.go:1105"},
+		"regexp.Regexp.FindAllSubmatch": {Sig: "func (re *Regexp) FindAllSubmatch(b []byte, n int) [][][]byte", Doc: "FindAllSubmatch returns the locations of all matches for re in b, including submatch locations.", Pos: "regexp.go:1115"},
+		"regexp.Regexp.FindAllStringSubmatch": {Sig: "func (re *Regexp) FindAllStringSubmatch(s string, n int) [][]string", Doc: "FindAllStringSubmatch returns the locations of all matches for re in s, including submatch locations.", Pos: "regexp.go:1125"},
+		"regexp.Regexp.FindAllSubmatchIndex": {Sig: "func (re *Regexp) FindAllSubmatchIndex(b []byte, n int) [][]int", Doc: "FindAllSubmatchIndex returns the locations of all matches for re in b, including submatch locations.", Pos: "regexp.go:1135"},
+		"regexp.Regexp.FindAllStringSubmatchIndex": {Sig: "func (re *Regexp) FindAllStringSubmatchIndex(s string, n int) [][]int", Doc: "FindAllStringSubmatchIndex returns the locations of all matches for re in s, including submatch locations.", Pos: "regexp.go:1145"},
+		"regexp.Regexp.Expand": {Sig: "func (re *Regexp) Expand(dst []byte, template []byte, src []byte, match []int) []byte", Doc: "Expand appends template to dst and returns the result; during the append, Expand replaces variables in the template with corresponding matches drawn from src.", Pos: "regexp.go:1166"},
+		"regexp.Regexp.ExpandString": {Sig: "func (re *Regexp) ExpandString(dst []byte, template string, src string, match []int) []byte", Doc: "ExpandString is like [Regexp.Expand] but the template and source are strings.", Pos: "regexp.go:1173"},
+		"regexp.Regexp.Split": {Sig: "func (re *Regexp) Split(s string, n int) []string", Doc: "Split slices s into substrings separated by the expression and returns a slice of the substrings between those expression matches.", Pos: "regexp.go:1291"},
+		"regexp.Regexp.AppendText": {Sig: "func (re *Regexp) AppendText(b []byte) ([]byte, error)", Doc: "AppendText implements encoding.TextAppender.", Pos: "regexp.go:1329"},
+		"regexp.Regexp.MarshalText": {Sig: "func (re *Regexp) MarshalText() ([]byte, error)", Doc: "MarshalText implements encoding.TextMarshaler.", Pos: "regexp.go:1337"},
+		"regexp.Regexp.UnmarshalText": {Sig: "func (re *Regexp) UnmarshalText(text []byte) error", Doc: "UnmarshalText implements encoding.TextUnmarshaler by calling [Compile] on the encoded value.", Pos: "regexp.go:1343"},
+		"regexp/syntax": {Doc: "Package syntax parses regular expressions into parse trees and compiles parse trees into programs."},
+		"regexp/syntax.Compile": {Sig: "func Compile(re *Regexp) (*Prog, error)", Doc: "Compile compiles the regexp into a program to be executed.", Pos: "compile.go:71"},
+		"regexp/syntax.Op.String": {Sig: "func (i Op) String() string", Doc: "", Pos: "op_string.go:42"},
+		"regexp/syntax.Error": {Sig: "type Error struct", Doc: "An Error describes a failure to parse a regular expression and gives the offending expression.", Pos: "parse.go:17"},
+		"regexp/syntax.Error.Error": {Sig: "func (e *Error) Error() string", Doc: "", Pos: "parse.go:22"},
+		"regexp/syntax.ErrorCode": {Sig: "type ErrorCode string", Doc: "An ErrorCode describes a failure to parse a regular expression.", Pos: "parse.go:27"},
+		"regexp/syntax.ErrInternalError": {Sig: "const ErrInternalError ErrorCode", Doc: "Unexpected error", Pos: "parse.go:31"},
+		"regexp/syntax.ErrInvalidCharClass": {Sig: "const ErrInvalidCharClass ErrorCode", Doc: "Parse errors", Pos: "parse.go:34"},
+		"regexp/syntax.ErrInvalidCharRange": {Sig: "const ErrInvalidCharRange ErrorCode", Doc: "", Pos: "parse.go:35"},
+		"regexp/syntax.ErrInvalidEscape": {Sig: "const ErrInvalidEscape ErrorCode", Doc: "", Pos: "parse.go:36"},
+		"regexp/syntax.ErrInvalidNamedCapture": {Sig: "const ErrInvalidNamedCapture ErrorCode", Doc: "", Pos: "parse.go:37"},
+		"regexp/syntax.ErrInvalidPerlOp": {Sig: "const ErrInvalidPerlOp ErrorCode", Doc: "", Pos: "parse.go:38"},
+		"regexp/syntax.ErrInvalidRepeatOp": {Sig: "const ErrInvalidRepeatOp ErrorCode", Doc: "", Pos: "parse.go:39"},
+		"regexp/syntax.ErrInvalidRepeatSize": {Sig: "const ErrInvalidRepeatSize ErrorCode", Doc: "", Pos: "parse.go:40"},
+		"regexp/syntax.ErrInvalidUTF8": {Sig: "const ErrInvalidUTF8 ErrorCode", Doc: "", Pos: "parse.go:41"},
+		"regexp/syntax.ErrMissingBracket": {Sig: "const ErrMissingBracket ErrorCode", Doc: "", Pos: "parse.go:42"},
+		"regexp/syntax.ErrMissingParen": {Sig: "const ErrMissingParen ErrorCode", Doc: "", Pos: "parse.go:43"},
+		"regexp/syntax.ErrMissingRepeatArgument": {Sig: "const ErrMissingRepeatArgument ErrorCode", Doc: "", Pos: "parse.go:44"},
+		"regexp/syntax.ErrTrailingBackslash": {Sig: "const ErrTrailingBackslash ErrorCode", Doc: "", Pos: "parse.go:45"},
+		"regexp/syntax.ErrUnexpectedParen": {Sig: "const ErrUnexpectedParen ErrorCode", Doc: "", Pos: "parse.go:46"},
+		"regexp/syntax.ErrNestingDepth": {Sig: "const ErrNestingDepth ErrorCode", Doc: "", Pos: "parse.go:47"},
+		"regexp/syntax.ErrLarge": {Sig: "const ErrLarge ErrorCode", Doc: "", Pos: "parse.go:48"},
+		"regexp/syntax.ErrorCode.String": {Sig: "func (e ErrorCode) String() string", Doc: "", Pos: "parse.go:51"},
+		"regexp/syntax.Flags": {Sig: "type Flags uint16", Doc: "Flags control the behavior of the parser and record information about regexp context.", Pos: "parse.go:56"},
+		"regexp/syntax.FoldCase": {Sig: "const FoldCase Flags", Doc: "", Pos: "parse.go:59"},
+		"regexp/syntax.Literal": {Sig: "const Literal", Doc: "", Pos: "parse.go:60"},
+		"regexp/syntax.ClassNL": {Sig: "const ClassNL", Doc: "", Pos: "parse.go:61"},
+		"regexp/syntax.DotNL": {Sig: "const DotNL", Doc: "", Pos: "parse.go:62"},
+		"regexp/syntax.OneLine": {Sig: "const OneLine", Doc: "", Pos: "parse.go:63"},
+		"regexp/syntax.NonGreedy": {Sig: "const NonGreedy", Doc: "", Pos: "parse.go:64"},
+		"regexp/syntax.PerlX": {Sig: "const PerlX", Doc: "", Pos: "parse.go:65"},
+		"regexp/syntax.UnicodeGroups": {Sig: "const UnicodeGroups", Doc: "", Pos: "parse.go:66"},
+		"regexp/syntax.WasDollar": {Sig: "const WasDollar", Doc: "", Pos: "parse.go:67"},
+		"regexp/syntax.Simple": {Sig: "const Simple", Doc: "", Pos: "parse.go:68"},
+		"regexp/syntax.MatchNL": {Sig: "const MatchNL", Doc: "", Pos: "parse.go:70"},
+		"regexp/syntax.Perl": {Sig: "const Perl", Doc: "", Pos: "parse.go:72"},
+		"regexp/syntax.POSIX": {Sig: "const POSIX Flags", Doc: "", Pos: "parse.go:73"},
+		"regexp/syntax.Parse": {Sig: "func Parse(s string, flags Flags) (*Regexp, error)", Doc: "Parse parses a regular expression string s, controlled by the specified Flags, and returns a regular expression parse tree.", Pos: "parse.go:887"},
+		"regexp/syntax.Prog": {Sig: "type Prog struct", Doc: "A Prog is a compiled regular expression program.", Pos: "prog.go:18"},
+		"regexp/syntax.InstOp": {Sig: "type InstOp uint8", Doc: "An InstOp is an instruction opcode.", Pos: "prog.go:25"},
+		"regexp/syntax.InstAlt": {Sig: "const InstAlt InstOp", Doc: "", Pos: "prog.go:28"},
+		"regexp/syntax.InstAltMatch": {Sig: "const InstAltMatch", Doc: "", Pos: "prog.go:29"},
+		"regexp/syntax.InstCapture": {Sig: "const InstCapture", Doc: "", Pos: "prog.go:30"},
+		"regexp/syntax.InstEmptyWidth": {Sig: "const InstEmptyWidth", Doc: "", Pos: "prog.go:31"},
+		"regexp/syntax.InstMatch": {Sig: "const InstMatch", Doc: "", Pos: "prog.go:32"},
+		"regexp/syntax.InstFail": {Sig: "const InstFail", Doc: "", Pos: "prog.go:33"},
+		"regexp/syntax.InstNop": {Sig: "const InstNop", Doc: "", Pos: "prog.go:34"},
+		"regexp/syntax.InstRune": {Sig: "const InstRune", Doc: "", Pos: "prog.go:35"},
+		"regexp/syntax.InstRune1": {Sig: "const InstRune1", Doc: "", Pos: "prog.go:36"},
+		"regexp/syntax.InstRuneAny": {Sig: "const InstRuneAny", Doc: "", Pos: "prog.go:37"},
+		"regexp/syntax.InstRuneAnyNotNL": {Sig: "const InstRuneAnyNotNL", Doc: "", Pos: "prog.go:38"},
+		"regexp/syntax.InstOp.String": {Sig: "func (i InstOp) String() string", Doc: "", Pos: "prog.go:55"},
+		"regexp/syntax.EmptyOp": {Sig: "type EmptyOp uint8", Doc: "An EmptyOp specifies a kind or mixture of zero-width assertions.", Pos: "prog.go:63"},
+		"regexp/syntax.EmptyBeginLine": {Sig: "const EmptyBeginLine EmptyOp", Doc: "", Pos: "prog.go:66"},
+		"regexp/syntax.EmptyEndLine": {Sig: "const EmptyEndLine", Doc: "", Pos: "prog.go:67"},
+		"regexp/syntax.EmptyBeginText": {Sig: "const EmptyBeginText", Doc: "", Pos: "prog.go:68"},
+		"regexp/syntax.EmptyEndText": {Sig: "const EmptyEndText", Doc: "", Pos: "prog.go:69"},
+		"regexp/syntax.EmptyWordBoundary": {Sig: "const EmptyWordBoundary", Doc: "", Pos: "prog.go:70"},
+		"regexp/syntax.EmptyNoWordBoundary": {Sig: "const EmptyNoWordBoundary", Doc: "", Pos: "prog.go:71"},
+		"regexp/syntax.EmptyOpContext": {Sig: "func EmptyOpContext(r1, r2 rune) EmptyOp", Doc: "EmptyOpContext returns the zero-width assertions satisfied at the position between the runes r1 and r2.", Pos: "prog.go:80"},
+		"regexp/syntax.IsWordChar": {Sig: "func IsWordChar(r rune) bool", Doc: "IsWordChar reports whether r is considered a “word character” during the evaluation of the \\b and \\B zero-width assertions.", Pos: "prog.go:108"},
+		"regexp/syntax.Inst": {Sig: "type Inst struct", Doc: "An Inst is a single instruction in a regular expression program.", Pos: "prog.go:115"},
+		"regexp/syntax.Prog.String": {Sig: "func (p *Prog) String() string", Doc: "", Pos: "prog.go:122"},
+		"regexp/syntax.Prog.Prefix": {Sig: "func (p *Prog) Prefix() (prefix string, complete bool)", Doc: "Prefix returns a literal string that all matches for the regexp must start with.", Pos: "prog.go:150"},
+		"regexp/syntax.Prog.StartCond": {Sig: "func (p *Prog) StartCond() EmptyOp", Doc: "StartCond returns the leading empty-width conditions that must be true in any match.", Pos: "prog.go:169"},
+		"regexp/syntax.Inst.MatchRune": {Sig: "func (i *Inst) MatchRune(r rune) bool", Doc: "MatchRune reports whether the instruction matches (and consumes) r.", Pos: "prog.go:195"},
+		"regexp/syntax.Inst.MatchRunePos": {Sig: "func (i *Inst) MatchRunePos(r rune) int", Doc: "MatchRunePos checks whether the instruction matches (and consumes) r.", Pos: "prog.go:204"},
+		"regexp/syntax.Inst.MatchEmptyWidth": {Sig: "func (i *Inst) MatchEmptyWidth(before rune, after rune) bool", Doc: "MatchEmptyWidth reports whether the instruction matches an empty string between the runes before and after.", Pos: "prog.go:266"},
+		"regexp/syntax.Inst.String": {Sig: "func (i *Inst) String() string", Doc: "", Pos: "prog.go:284"},
+		"regexp/syntax.Regexp": {Sig: "type Regexp struct", Doc: "A Regexp is a node in a regular expression syntax tree.", Pos: "regexp.go:18"},
+		"regexp/syntax.Op": {Sig: "type Op uint8", Doc: "An Op is a single regular expression operator.", Pos: "regexp.go:33"},
+		"regexp/syntax.OpNoMatch": {Sig: "const OpNoMatch Op", Doc: "", Pos: "regexp.go:40"},
+		"regexp/syntax.OpEmptyMatch": {Sig: "const OpEmptyMatch", Doc: "", Pos: "regexp.go:41"},
+		"regexp/syntax.OpLiteral": {Sig: "const OpLiteral", Doc: "", Pos: "regexp.go:42"},
+		"regexp/syntax.OpCharClass": {Sig: "const OpCharClass", Doc: "", Pos: "regexp.go:43"},
+		"regexp/syntax.OpAnyCharNotNL": {Sig: "const OpAnyCharNotNL", Doc: "", Pos: "regexp.go:44"},
+		"regexp/syntax.OpAnyChar": {Sig: "const OpAnyChar", Doc: "", Pos: "regexp.go:45"},
+		"regexp/syntax.OpBeginLine": {Sig: "const OpBeginLine", Doc: "", Pos: "regexp.go:46"},
+		"regexp/syntax.OpEndLine": {Sig: "const OpEndLine", Doc: "", Pos: "regexp.go:47"},
+		"regexp/syntax.OpBeginText": {Sig: "const OpBeginText", Doc: "", Pos: "regexp.go:48"},
+		"regexp/syntax.OpEndText": {Sig: "const OpEndText", Doc: "", Pos: "regexp.go:49"},
+		"regexp/syntax.OpWordBoundary": {Sig: "const OpWordBoundary", Doc: "", Pos: "regexp.go:50"},
+		"regexp/syntax.OpNoWordBoundary": {Sig: "const OpNoWordBoundary", Doc: "", Pos: "regexp.go:51"},
+		"regexp/syntax.OpCapture": {Sig: "const OpCapture", Doc: "", Pos: "regexp.go:52"},
+		"regexp/syntax.OpStar": {Sig: "const OpStar", Doc: "", Pos: "regexp.go:53"},
+		"regexp/syntax.OpPlus": {Sig: "const OpPlus", Doc: "", Pos: "regexp.go:54"},
+		"regexp/syntax.OpQuest": {Sig: "const OpQuest", Doc: "", Pos: "regexp.go:55"},
+		"regexp/syntax.OpRepeat": {Sig: "const OpRepeat", Doc: "", Pos: "regexp.go:56"},
+		"regexp/syntax.OpConcat": {Sig: "const OpConcat", Doc: "", Pos: "regexp.go:57"},
+		"regexp/syntax.OpAlternate": {Sig: "const OpAlternate", Doc: "", Pos: "regexp.go:58"},
+		"regexp/syntax.Regexp.Equal": {Sig: "func (x *Regexp) Equal(y *Regexp) bool", Doc: "Equal reports whether x and y have identical structure.", Pos: "regexp.go:64"},
+		"regexp/syntax.Regexp.String": {Sig: "func (re *Regexp) String() string", Doc: "", Pos: "regexp.go:384"},
+		"regexp/syntax.Regexp.MaxCap": {Sig: "func (re *Regexp) MaxCap() int", Doc: "MaxCap walks the regexp to find the maximum capture index.", Pos: "regexp.go:437"},
+		"regexp/syntax.Regexp.CapNames": {Sig: "func (re *Regexp) CapNames() []string", Doc: "CapNames walks the regexp to find the names of capturing groups.", Pos: "regexp.go:451"},
+		"regexp/syntax.Regexp.Simplify": {Sig: "func (re *Regexp) Simplify() *Regexp", Doc: "Simplify returns a regexp equivalent to re but without counted repetitions and with various other simplifications, such as rewriting /(?:a+)+/ to /a+/.", Pos: "simplify.go:14"},
+		"runtime": {Doc: "Package runtime contains operations that interact with Go's runtime system, such as functions to control goroutines."},
+		"runtime.Compiler": {Sig: "const Compiler", Doc: "Compiler is the name of the compiler toolchain that built the running binary.", Pos: "compiler.go:12"},
+		"runtime.SetCPUProfileRate": {Sig: "func SetCPUProfileRate(hz int)", Doc: "SetCPUProfileRate sets the CPU profiling rate to hz samples per second.", Pos: "cpuprof.go:68"},
+		"runtime.CPUProfile": {Sig: "func CPUProfile() []byte", Doc: "CPUProfile panics.", Pos: "cpuprof.go:208"},
+		"runtime.GOMAXPROCS": {Sig: "func GOMAXPROCS(n int) int", Doc: "GOMAXPROCS sets the maximum number of CPUs that can be executing simultaneously and returns the previous setting.", Pos: "debug.go:70"},
+		"runtime.SetDefaultGOMAXPROCS": {Sig: "func SetDefaultGOMAXPROCS()", Doc: "SetDefaultGOMAXPROCS updates the GOMAXPROCS setting to the runtime default, as described by [GOMAXPROCS], ignoring the GOMAXPROCS environment variable.", Pos: "debug.go:117"},
+		"runtime.NumCPU": {Sig: "func NumCPU() int", Doc: "NumCPU returns the number of logical CPUs usable by the current process.", Pos: "debug.go:154"},
+		"runtime.NumCgoCall": {Sig: "func NumCgoCall() int64", Doc: "NumCgoCall returns the number of cgo calls made by the current process.", Pos: "debug.go:159"},
+		"runtime.NumGoroutine": {Sig: "func NumGoroutine() int", Doc: "NumGoroutine returns the number of goroutines that currently exist.", Pos: "debug.go:179"},
+		"runtime.Error": {Sig: "type Error interface", Doc: "Error identifies a runtime error used in panic.", Pos: "error.go:24"},
+		"runtime.TypeAssertionError": {Sig: "type TypeAssertionError struct", Doc: "A TypeAssertionError explains a failed type assertion.", Pos: "error.go:35"},
+		"runtime.TypeAssertionError.RuntimeError": {Sig: "func (*TypeAssertionError) RuntimeError()", Doc: "", Pos: "error.go:42"},
+		"runtime.TypeAssertionError.Error": {Sig: "func (e *TypeAssertionError) Error() string", Doc: "", Pos: "error.go:44"},
+		"runtime.Caller": {Sig: "func Caller(skip int) (pc uintptr, file string, line int, ok bool)", Doc: "Caller reports file and line number information about function invocations on the calling goroutine's stack.", Pos: "extern.go:311"},
+		"runtime.Callers": {Sig: "func Callers(skip int, pc []uintptr) int", Doc: "Callers fills the slice pc with the return program counters of function invocations on the calling goroutine's stack.", Pos: "extern.go:334"},
+		"runtime.GOROOT": {Sig: "func GOROOT() string", Doc: "GOROOT returns the root of the Go tree.", Pos: "extern.go:354"},
+		"runtime.Version": {Sig: "func Version() string", Doc: "Version returns the Go tree's version string.", Pos: "extern.go:375"},
+		"runtime.GOOS": {Sig: "const GOOS string", Doc: "GOOS is the running program's operating system target: one of darwin, freebsd, linux, and so on.", Pos: "extern.go:382"},
+		"runtime.GOARCH": {Sig: "const GOARCH string", Doc: "GOARCH is the running program's architecture target: one of 386, amd64, arm, s390x, and so on.", Pos: "extern.go:386"},
+		"runtime.AddCleanup": {Sig: "func AddCleanup[T, S any](ptr *T, cleanup func(S), arg S) Cleanup", Doc: "AddCleanup attaches a cleanup function to ptr.", Pos: "mcleanup.go:76"},
+		"runtime.Cleanup": {Sig: "type Cleanup struct", Doc: "Cleanup is a handle to a cleanup call for a specific object.", Pos: "mcleanup.go:208"},
+		"runtime.Cleanup.Stop": {Sig: "func (c Cleanup) Stop()", Doc: "Stop cancels the cleanup call.", Pos: "mcleanup.go:219"},
+		"runtime.SetFinalizer": {Sig: "func SetFinalizer(obj any, finalizer any)", Doc: "SetFinalizer sets the finalizer associated with obj to the provided finalizer function.", Pos: "mfinal.go:432"},
+		"runtime.KeepAlive": {Sig: "func KeepAlive(x any)", Doc: "KeepAlive marks its argument as currently reachable.", Pos: "mfinal.go:575"},
+		"runtime.GC": {Sig: "func GC()", Doc: "GC runs a garbage collection and blocks the caller until the garbage collection is complete.", Pos: "mgc.go:522"},
+		"runtime.SetBlockProfileRate": {Sig: "func SetBlockProfileRate(rate int)", Doc: "SetBlockProfileRate controls the fraction of goroutine blocking events that are reported in the blocking profile.", Pos: "mprof.go:483"},
+		"runtime.SetMutexProfileFraction": {Sig: "func SetMutexProfileFraction(rate int) int", Doc: "SetMutexProfileFraction controls the fraction of mutex contention events that are reported in the mutex profile.", Pos: "mprof.go:819"},
+		"runtime.StackRecord": {Sig: "type StackRecord struct", Doc: "A StackRecord describes a single execution stack.", Pos: "mprof.go:841"},
+		"runtime.StackRecord.Stack": {Sig: "func (r *StackRecord) Stack() []uintptr", Doc: "Stack returns the stack trace associated with the record, a prefix of r.Stack0.", Pos: "mprof.go:847"},
+		"runtime.MemProfileRate": {Sig: "var MemProfileRate int", Doc: "MemProfileRate controls the fraction of memory allocations that are recorded and reported in the memory profile.", Pos: "mprof.go:870"},
+		"runtime.MemProfileRecord": {Sig: "type MemProfileRecord struct", Doc: "A MemProfileRecord describes the live objects allocated by a particular call sequence (stack trace).", Pos: "mprof.go:880"},
+		"runtime.MemProfileRecord.InUseBytes": {Sig: "func (r *MemProfileRecord) InUseBytes() int64", Doc: "InUseBytes returns the number of bytes in use (AllocBytes - FreeBytes).", Pos: "mprof.go:887"},
+		"runtime.MemProfileRecord.InUseObjects": {Sig: "func (r *MemProfileRecord) InUseObjects() int64", Doc: "InUseObjects returns the number of objects in use (AllocObjects - FreeObjects).", Pos: "mprof.go:890"},
+		"runtime.MemProfileRecord.Stack": {Sig: "func (r *MemProfileRecord) Stack() []uintptr", Doc: "Stack returns the stack trace associated with the record, a prefix of r.Stack0.", Pos: "mprof.go:896"},
+		"runtime.MemProfile": {Sig: "func MemProfile(p []MemProfileRecord, inuseZero bool) (n int, ok bool)", Doc: "MemProfile returns a profile of memory allocated and freed per allocation site.", Pos: "mprof.go:926"},
+		"runtime.BlockProfileRecord": {Sig: "type BlockProfileRecord struct", Doc: "BlockProfileRecord describes blocking events originated at a particular call sequence (stack trace).", Pos: "mprof.go:1040"},
+		"runtime.BlockProfile": {Sig: "func BlockProfile(p []BlockProfileRecord) (n int, ok bool)", Doc: "BlockProfile returns n, the number of records in the current blocking profile.", Pos: "mprof.go:1053"},
+		"runtime.MutexProfile": {Sig: "func MutexProfile(p []BlockProfileRecord) (n int, ok bool)", Doc: "MutexProfile returns n, the number of records in the current mutex profile.", Pos: "mprof.go:1152"},
+		"runtime.ThreadCreateProfile": {Sig: "func ThreadCreateProfile(p []StackRecord) (n int, ok bool)", Doc: "ThreadCreateProfile returns n, the number of records in the thread creation profile.", Pos: "mprof.go:1203"},
+		"runtime.GoroutineProfile": {Sig: "func GoroutineProfile(p []StackRecord) (n int, ok bool)", Doc: "GoroutineProfile returns n, the number of records in the active goroutine stack profile.", Pos: "mprof.go:1647"},
+		"runtime.Stack": {Sig: "func Stack(buf []byte, all bool) int", Doc: "Stack formats a stack trace of the calling goroutine into buf and returns the number of bytes written to buf.", Pos: "mprof.go:1690"},
+		"runtime.MemStats": {Sig: "type MemStats struct", Doc: "A MemStats records statistics about the memory allocator.", Pos: "mstats.go:55"},
+		"runtime.ReadMemStats": {Sig: "func ReadMemStats(m *MemStats)", Doc: "ReadMemStats populates m with memory allocator statistics.", Pos: "mstats.go:356"},
+		"runtime.Goexit": {Sig: "func Goexit()", Doc: "Goexit terminates the goroutine that calls it.", Pos: "panic.go:682"},
+		"runtime.PanicNilError": {Sig: "type PanicNilError struct", Doc: "A PanicNilError happens when code calls panic(nil).", Pos: "panic.go:784"},
+		"runtime.PanicNilError.Error": {Sig: "func (*PanicNilError) Error() string", Doc: "", Pos: "panic.go:794"},
+		"runtime.PanicNilError.RuntimeError": {Sig: "func (*PanicNilError) RuntimeError()", Doc: "", Pos: "panic.go:795"},
+		"runtime.Pinner": {Sig: "type Pinner struct", Doc: "A Pinner is a set of Go objects each pinned to a fixed location in memory.", Pos: "pinner.go:34"},
+		"runtime.Pinner.Pin": {Sig: "func (p *Pinner) Pin(pointer any)", Doc: "Pin pins a Go object, preventing it from being moved or freed by the garbage collector until the [Pinner.Unpin] method has been called.", Pos: "pinner.go:49"},
+		"runtime.Pinner.Unpin": {Sig: "func (p *Pinner) Unpin()", Doc: "Unpin unpins all pinned objects of the [Pinner].", Pos: "pinner.go:86"},
+		"runtime.Gosched": {Sig: "func Gosched()", Doc: "Gosched yields the processor, allowing other goroutines to run.", Pos: "proc.go:401"},
+		"runtime.Breakpoint": {Sig: "func Breakpoint()", Doc: "Breakpoint executes a breakpoint trap.", Pos: "proc.go:5640"},
+		"runtime.LockOSThread": {Sig: "func LockOSThread()", Doc: "LockOSThread wires the calling goroutine to its current operating system thread.", Pos: "proc.go:5674"},
+		"runtime.UnlockOSThread": {Sig: "func UnlockOSThread()", Doc: "UnlockOSThread undoes an earlier call to LockOSThread.", Pos: "proc.go:5727"},
+		"runtime.Frames": {Sig: "type Frames struct", Doc: "Frames may be used to get function/file/line information for a slice of PC values returned by [Callers].", Pos: "symtab.go:17"},
+		"runtime.Frame": {Sig: "type Frame struct", Doc: "Frame is the information returned by [Frames] for each call frame.", Pos: "symtab.go:30"},
+		"runtime.CallersFrames": {Sig: "func CallersFrames(callers []uintptr) *Frames", Doc: "CallersFrames takes a slice of PC values returned by [Callers] and prepares to return function/file/line information.", Pos: "symtab.go:80"},
+		"runtime.Frames.Next": {Sig: "func (ci *Frames) Next() (frame Frame, more bool)", Doc: "Next returns a [Frame] representing the next call frame in the slice of PC values.", Pos: "symtab.go:95"},
+		"runtime.Func": {Sig: "type Func struct", Doc: "A Func represents a Go function in the running binary.", Pos: "symtab.go:344"},
+		"runtime.FuncForPC": {Sig: "func FuncForPC(pc uintptr) *Func", Doc: "FuncForPC returns a *[Func] describing the function that contains the given program counter address, or else nil.", Pos: "symtab.go:782"},
+		"runtime.Func.Name": {Sig: "func (f *Func) Name() string", Doc: "Name returns the name of the function.", Pos: "symtab.go:809"},
+		"runtime.Func.Entry": {Sig: "func (f *Func) Entry() uintptr", Doc: "Entry returns the entry address of the function.", Pos: "symtab.go:822"},
+		"runtime.Func.FileLine": {Sig: "func (f *Func) FileLine(pc uintptr) (file string, line int)", Doc: "FileLine returns the file name and line number of the source code corresponding to the program counter pc.", Pos: "symtab.go:835"},
+		"runtime.StartTrace": {Sig: "func StartTrace() error", Doc: "StartTrace enables tracing for the current process.", Pos: "trace.go:318"},
+		"runtime.StopTrace": {Sig: "func StopTrace()", Doc: "StopTrace stops tracing, if it was previously enabled.", Pos: "trace.go:457"},
+		"runtime.ReadTrace": {Sig: "func ReadTrace() (buf []byte)", Doc: "ReadTrace returns the next chunk of binary tracing data, blocking until data is available.", Pos: "trace.go:886"},
+		"runtime.SetCgoTraceback": {Sig: "func SetCgoTraceback(version int, traceback, context, symbolizer unsafe.Pointer)", Doc: "SetCgoTraceback records three C functions to use to gather traceback information from C code and to convert that traceback information into symbolic information.", Pos: "traceback.go:1683"},
+		"runtime/pprof": {Doc: "Package pprof writes runtime profiling data in the format expected by the pprof visualization tool."},
+		"runtime/pprof.LabelSet": {Sig: "type LabelSet struct", Doc: "LabelSet is a set of labels.", Pos: "label.go:16"},
+		"runtime/pprof.WithLabels": {Sig: "func WithLabels(ctx context.Context, labels LabelSet) context.Context", Doc: "WithLabels returns a new context.Context with the given labels added.", Pos: "label.go:56"},
+		"runtime/pprof.Labels": {Sig: "func Labels(args ...string) LabelSet", Doc: "Labels takes an even number of strings representing key-value pairs and makes a [LabelSet] containing them.", Pos: "label.go:99"},
+		"runtime/pprof.Label": {Sig: "func Label(ctx context.Context, key string) (string, bool)", Doc: "Label returns the value of the label with the given key on ctx, and a boolean indicating whether that label exists.", Pos: "label.go:129"},
+		"runtime/pprof.ForLabels": {Sig: "func ForLabels(ctx context.Context, f func(key, value string) bool)", Doc: "ForLabels invokes f with each label set on the context.", Pos: "label.go:141"},
+		"runtime/pprof.Profile": {Sig: "type Profile struct", Doc: "A Profile is a collection of stack traces showing the call sequences that led to instances of a particular event, such as allocation.", Pos: "pprof.go:173"},
+		"runtime/pprof.NewProfile": {Sig: "func NewProfile(name string) *Profile", Doc: "NewProfile creates a new profile with the given name.", Pos: "pprof.go:280"},
+		"runtime/pprof.Lookup": {Sig: "func Lookup(name string) *Profile", Doc: "Lookup returns the profile with the given name, or nil if no such profile exists.", Pos: "pprof.go:298"},
+		"runtime/pprof.Profiles": {Sig: "func Profiles() []*Profile", Doc: "Profiles returns a slice of all the known profiles, sorted by name.", Pos: "pprof.go:305"},
+		"runtime/pprof.Profile.Name": {Sig: "func (p *Profile) Name() string", Doc: "Name returns this profile's name, which can be passed to [Lookup] to reobtain the profile.", Pos: "pprof.go:322"},
+		"runtime/pprof.Profile.Count": {Sig: "func (p *Profile) Count() int", Doc: "Count returns the number of execution stacks currently in the profile.", Pos: "pprof.go:327"},
+		"runtime/pprof.Profile.Add": {Sig: "func (p *Profile) Add(value any, skip int)", Doc: "Add adds the current execution stack to the profile, associated with value.", Pos: "pprof.go:353"},
+		"runtime/pprof.Profile.Remove": {Sig: "func (p *Profile) Remove(value any)", Doc: "Remove removes the execution stack associated with value from the profile.", Pos: "pprof.go:379"},
+		"runtime/pprof.Profile.WriteTo": {Sig: "func (p *Profile) WriteTo(w io.Writer, debug int) error", Doc: "WriteTo writes a pprof-formatted snapshot of the profile to w.", Pos: "pprof.go:400"},
+		"runtime/pprof.WriteHeapProfile": {Sig: "func WriteHeapProfile(w io.Writer) error", Doc: "WriteHeapProfile is shorthand for [Lookup](\"heap\").WriteTo(w, 0).", Pos: "pprof.go:607"},
+		"runtime/pprof.StartCPUProfile": {Sig: "func StartCPUProfile(w io.Writer) error", Doc: "StartCPUProfile enables CPU profiling for the current process.", Pos: "pprof.go:885"},
+		"runtime/pprof.StopCPUProfile": {Sig: "func StopCPUProfile()", Doc: "StopCPUProfile stops the current CPU profile, if any.", Pos: "pprof.go:947"},
+		"runtime/pprof.SetGoroutineLabels": {Sig: "func SetGoroutineLabels(ctx context.Context)", Doc: "SetGoroutineLabels sets the current goroutine's labels to match ctx.", Pos: "runtime.go:41"},
+		"runtime/pprof.Do": {Sig: "func Do(ctx context.Context, labels LabelSet, f func(context.Context))", Doc: "Do calls f with a copy of the parent context with the given labels added to the parent's label map.", Pos: "runtime.go:53"},
+		"sort": {Doc: "Package sort provides primitives for sorting slices and user-defined collections."},
+		"sort.Search": {Sig: "func Search(n int, f func(int) bool) int", Doc: "Search uses binary search to find and return the smallest index i in [0, n) at which f(i) is true, assuming that on the range [0, n), f(i) == true implies f(i+1) == true.", Pos: "search.go:58"},
+		"sort.Find": {Sig: "func Find(n int, cmp func(int) int) (i int, found bool)", Doc: "Find uses binary search to find and return the smallest index i in [0, n) at which cmp(i) <= 0.", Pos: "search.go:99"},
+		"sort.SearchInts": {Sig: "func SearchInts(a []int, x int) int", Doc: "SearchInts searches for x in a sorted slice of ints and returns the index as specified by [Search].", Pos: "search.go:123"},
+		"sort.SearchFloat64s": {Sig: "func SearchFloat64s(a []float64, x float64) int", Doc: "SearchFloat64s searches for x in a sorted slice of float64s and returns the index as specified by [Search].", Pos: "search.go:131"},
+		"sort.SearchStrings": {Sig: "func SearchStrings(a []string, x string) int", Doc: "SearchStrings searches for x in a sorted slice of strings and returns the index as specified by Search.", Pos: "search.go:139"},
+		"sort.IntSlice.Search": {Sig: "func (p IntSlice) Search(x int) int", Doc: "Search returns the result of applying [SearchInts] to the receiver and x.", Pos: "search.go:144"},
+		"sort.Float64Slice.Search": {Sig: "func (p Float64Slice) Search(x float64) int", Doc: "Search returns the result of applying [SearchFloat64s] to the receiver and x.", Pos: "search.go:147"},
+		"sort.StringSlice.Search": {Sig: "func (p StringSlice) Search(x string) int", Doc: "Search returns the result of applying [SearchStrings] to the receiver and x.", Pos: "search.go:150"},
+		"sort.Slice": {Sig: "func Slice(x any, less func(i, j int) bool)", Doc: "Slice sorts the slice x given the provided less function.", Pos: "slice.go:24"},
+		"sort.SliceStable": {Sig: "func SliceStable(x any, less func(i, j int) bool)", Doc: "SliceStable sorts the slice x using the provided less function, keeping equal elements in their original order.", Pos: "slice.go:41"},
+		"sort.SliceIsSorted": {Sig: "func SliceIsSorted(x any, less func(i, j int) bool) bool", Doc: "SliceIsSorted reports whether the slice x is sorted according to the provided less function.", Pos: "slice.go:52"},
+		"sort.Interface": {Sig: "type Interface interface", Doc: "An implementation of Interface can be sorted by the routines in this package.", Pos: "sort.go:17"},
+		"sort.Sort": {Sig: "func Sort(data Interface)", Doc: "Sort sorts data in ascending order as determined by the Less method.", Pos: "sort.go:50"},
+		"sort.Reverse": {Sig: "func Reverse(data Interface) Interface", Doc: "Reverse returns the reverse order for data.", Pos: "sort.go:102"},
+		"sort.IsSorted": {Sig: "func IsSorted(data Interface) bool", Doc: "IsSorted reports whether data is sorted.", Pos: "sort.go:110"},
+		"sort.IntSlice": {Sig: "type IntSlice []int", Doc: "IntSlice attaches the methods of Interface to []int, sorting in increasing order.", Pos: "sort.go:123"},
+		"sort.IntSlice.Len": {Sig: "func (x IntSlice) Len() int", Doc: "", Pos: "sort.go:125"},
+		"sort.IntSlice.Less": {Sig: "func (x IntSlice) Less(i, j int) bool", Doc: "", Pos: "sort.go:126"},
+		"sort.IntSlice.Swap": {Sig: "func (x IntSlice) Swap(i, j int)", Doc: "", Pos: "sort.go:127"},
+		"sort.IntSlice.Sort": {Sig: "func (x IntSlice) Sort()", Doc: "Sort is a convenience method: x.Sort() calls Sort(x).", Pos: "sort.go:130"},
+		"sort.Float64Slice": {Sig: "type Float64Slice []float64", Doc: "Float64Slice implements Interface for a []float64, sorting in increasing order, with not-a-number (NaN) values ordered before other values.", Pos: "sort.go:134"},
+		"sort.Float64Slice.Len": {Sig: "func (x Float64Slice) Len() int", Doc: "", Pos: "sort.go:136"},
+		"sort.Float64Slice.Less": {Sig: "func (x Float64Slice) Less(i, j int) bool", Doc: "Less reports whether x[i] should be ordered before x[j], as required by the sort Interface.", Pos: "sort.go:144"},
+		"sort.Float64Slice.Swap": {Sig: "func (x Float64Slice) Swap(i, j int)", Doc: "", Pos: "sort.go:145"},
+		"sort.Float64Slice.Sort": {Sig: "func (x Float64Slice) Sort()", Doc: "Sort is a convenience method: x.Sort() calls Sort(x).", Pos: "sort.go:153"},
+		"sort.StringSlice": {Sig: "type StringSlice []string", Doc: "StringSlice attaches the methods of Interface to []string, sorting in increasing order.", Pos: "sort.go:156"},
+		"sort.StringSlice.Len": {Sig: "func (x StringSlice) Len() int", Doc: "", Pos: "sort.go:158"},
+		"sort.StringSlice.Less": {Sig: "func (x StringSlice) Less(i, j int) bool", Doc: "", Pos: "sort.go:159"},
+		"sort.StringSlice.Swap": {Sig: "func (x StringSlice) Swap(i, j int)", Doc: "", Pos: "sort.go:160"},
+		"sort.StringSlice.Sort": {Sig: "func (x StringSlice) Sort()", Doc: "Sort is a convenience method: x.Sort() calls Sort(x).", Pos: "sort.go:163"},
+		"sort.Ints": {Sig: "func Ints(x []int)", Doc: "Ints sorts a slice of ints in increasing order.", Pos: "sort.go:170"},
+		"sort.Float64s": {Sig: "func Float64s(x []float64)", Doc: "Float64s sorts a slice of float64s in increasing order.", Pos: "sort.go:176"},
+		"sort.Strings": {Sig: "func Strings(x []string)", Doc: "Strings sorts a slice of strings in increasing order.", Pos: "sort.go:181"},
+		"sort.IntsAreSorted": {Sig: "func IntsAreSorted(x []int) bool", Doc: "IntsAreSorted reports whether the slice x is sorted in increasing order.", Pos: "sort.go:186"},
+		"sort.Float64sAreSorted": {Sig: "func Float64sAreSorted(x []float64) bool", Doc: "Float64sAreSorted reports whether the slice x is sorted in increasing order, with not-a-number (NaN) values before any other values.", Pos: "sort.go:192"},
+		"sort.StringsAreSorted": {Sig: "func StringsAreSorted(x []string) bool", Doc: "StringsAreSorted reports whether the slice x is sorted in increasing order.", Pos: "sort.go:197"},
+		"sort.Stable": {Sig: "func Stable(data Interface)", Doc: "Stable sorts data in ascending order as determined by the Less method, while keeping the original order of equal elements.", Pos: "sort.go:233"},
+		"strconv": {Doc: "Package strconv implements conversions to and from string representations of basic data types."},
+		"strconv.IntSize": {Sig: "const IntSize", Doc: "IntSize is the size in bits of an int or uint value.", Pos: "number.go:14"},
+		"strconv.ParseBool": {Sig: "func ParseBool(str string) (bool, error)", Doc: "ParseBool returns the boolean value represented by the string.", Pos: "number.go:19"},
+		"strconv.FormatBool": {Sig: "func FormatBool(b bool) string", Doc: "FormatBool returns \"true\" or \"false\" according to the value of b.", Pos: "number.go:28"},
+		"strconv.AppendBool": {Sig: "func AppendBool(dst []byte, b bool) []byte", Doc: "AppendBool appends \"true\" or \"false\", according to the value of b, to dst and returns the extended buffer.", Pos: "number.go:34"},
+		"strconv.ParseComplex": {Sig: "func ParseComplex(s string, bitSize int) (complex128, error)", Doc: "ParseComplex converts the string s to a complex number with the precision specified by bitSize: 64 for complex64, or 128 for complex128.", Pos: "number.go:58"},
+		"strconv.ParseFloat": {Sig: "func ParseFloat(s string, bitSize int) (float64, error)", Doc: "ParseFloat converts the string s to a floating-point number with the precision specified by bitSize: 32 for float32, or 64 for float64.", Pos: "number.go:93"},
+		"strconv.ParseUint": {Sig: "func ParseUint(s string, base int, bitSize int) (uint64, error)", Doc: "ParseUint is like [ParseInt] but for unsigned numbers.", Pos: "number.go:104"},
+		"strconv.ParseInt": {Sig: "func ParseInt(s string, base int, bitSize int) (i int64, err error)", Doc: "ParseInt interprets a string s in the given base (0, 2 to 36) and bit size (0 to 64) and returns the corresponding value i.", Pos: "number.go:137"},
+		"strconv.Atoi": {Sig: "func Atoi(s string) (int, error)", Doc: "Atoi is equivalent to ParseInt(s, 10, 0), converted to type int.", Pos: "number.go:146"},
+		"strconv.FormatComplex": {Sig: "func FormatComplex(c complex128, fmt byte, prec, bitSize int) string", Doc: "FormatComplex converts the complex number c to a string of the form (a+bi) where a and b are the real and imaginary parts, formatted according to the format fmt and precision prec.", Pos: "number.go:161"},
+		"strconv.FormatFloat": {Sig: "func FormatFloat(f float64, fmt byte, prec, bitSize int) string", Doc: "FormatFloat converts the floating-point number f to a string, according to the format fmt and precision prec.", Pos: "number.go:189"},
+		"strconv.AppendFloat": {Sig: "func AppendFloat(dst []byte, f float64, fmt byte, prec, bitSize int) []byte", Doc: "AppendFloat appends the string form of the floating-point number f, as generated by [FormatFloat], to dst and returns the extended buffer.", Pos: "number.go:195"},
+		"strconv.FormatUint": {Sig: "func FormatUint(i uint64, base int) string", Doc: "FormatUint returns the string representation of i in the given base, for 2 <= base <= 36.", Pos: "number.go:202"},
+		"strconv.FormatInt": {Sig: "func FormatInt(i int64, base int) string", Doc: "FormatInt returns the string representation of i in the given base, for 2 <= base <= 36.", Pos: "number.go:209"},
+		"strconv.Itoa": {Sig: "func Itoa(i int) string", Doc: "Itoa is equivalent to [FormatInt](int64(i), 10).", Pos: "number.go:214"},
+		"strconv.AppendInt": {Sig: "func AppendInt(dst []byte, i int64, base int) []byte", Doc: "AppendInt appends the string form of the integer i, as generated by [FormatInt], to dst and returns the extended buffer.", Pos: "number.go:220"},
+		"strconv.AppendUint": {Sig: "func AppendUint(dst []byte, i uint64, base int) []byte", Doc: "AppendUint appends the string form of the unsigned integer i, as generated by [FormatUint], to dst and returns the extended buffer.", Pos: "number.go:226"},
+		"strconv.ErrRange": {Sig: "var ErrRange", Doc: "ErrRange indicates that a value is out of range for the target type.", Pos: "number.go:246"},
+		"strconv.ErrSyntax": {Sig: "var ErrSyntax", Doc: "ErrSyntax indicates that a value does not have the right syntax for the target type.", Pos: "number.go:249"},
+		"strconv.NumError": {Sig: "type NumError struct", Doc: "A NumError records a failed conversion.", Pos: "number.go:252"},
+		"strconv.NumError.Error": {Sig: "func (e *NumError) Error() string", Doc: "", Pos: "number.go:258"},
+		"strconv.NumError.Unwrap": {Sig: "func (e *NumError) Unwrap() error", Doc: "", Pos: "number.go:262"},
+		"strconv.Quote": {Sig: "func Quote(s string) string", Doc: "Quote returns a double-quoted Go string literal representing s.", Pos: "quote.go:121"},
+		"strconv.AppendQuote": {Sig: "func AppendQuote(dst []byte, s string) []byte", Doc: "AppendQuote appends a double-quoted Go string literal representing s, as generated by [Quote], to dst and returns the extended buffer.", Pos: "quote.go:127"},
+		"strconv.QuoteToASCII": {Sig: "func QuoteToASCII(s string) string", Doc: "QuoteToASCII returns a double-quoted Go string literal representing s.", Pos: "quote.go:134"},
+		"strconv.AppendQuoteToASCII": {Sig: "func AppendQuoteToASCII(dst []byte, s string) []byte", Doc: "AppendQuoteToASCII appends a double-quoted Go string literal representing s, as generated by [QuoteToASCII], to dst and returns the extended buffer.", Pos: "quote.go:140"},
+		"strconv.QuoteToGraphic": {Sig: "func QuoteToGraphic(s string) string", Doc: "QuoteToGraphic returns a double-quoted Go string literal representing s.", Pos: "quote.go:148"},
+		"strconv.AppendQuoteToGraphic": {Sig: "func AppendQuoteToGraphic(dst []byte, s string) []byte", Doc: "AppendQuoteToGraphic appends a double-quoted Go string literal representing s, as generated by [QuoteToGraphic], to dst and returns the extended buffer.", Pos: "quote.go:154"},
+		"strconv.QuoteRune": {Sig: "func QuoteRune(r rune) string", Doc: "QuoteRune returns a single-quoted Go character literal representing the rune.", Pos: "quote.go:163"},
+		"strconv.AppendQuoteRune": {Sig: "func AppendQuoteRune(dst []byte, r rune) []byte", Doc: "AppendQuoteRune appends a single-quoted Go character literal representing the rune, as generated by [QuoteRune], to dst and returns the extended buffer.", Pos: "quote.go:169"},
+		"strconv.QuoteRuneToASCII": {Sig: "func QuoteRuneToASCII(r rune) string", Doc: "QuoteRuneToASCII returns a single-quoted Go character literal representing the rune.", Pos: "quote.go:179"},
+		"strconv.AppendQuoteRuneToASCII": {Sig: "func AppendQuoteRuneToASCII(dst []byte, r rune) []byte", Doc: "AppendQuoteRuneToASCII appends a single-quoted Go character literal representing the rune, as generated by [QuoteRuneToASCII], to dst and returns the extended buffer.", Pos: "quote.go:185"},
+		"strconv.QuoteRuneToGraphic": {Sig: "func QuoteRuneToGraphic(r rune) string", Doc: "QuoteRuneToGraphic returns a single-quoted Go character literal representing the rune.", Pos: "quote.go:195"},
+		"strconv.AppendQuoteRuneToGraphic": {Sig: "func AppendQuoteRuneToGraphic(dst []byte, r rune) []byte", Doc: "AppendQuoteRuneToGraphic appends a single-quoted Go character literal representing the rune, as generated by [QuoteRuneToGraphic], to dst and returns the extended buffer.", Pos: "quote.go:201"},
+		"strconv.CanBackquote": {Sig: "func CanBackquote(s string) bool", Doc: "CanBackquote reports whether the string s can be represented unchanged as a single-line backquoted string without control characters other than tab.", Pos: "quote.go:208"},
+		"strconv.UnquoteChar": {Sig: "func UnquoteChar(s string, quote byte) (value rune, multibyte bool, tail string, err error)", Doc: "UnquoteChar decodes the first character or byte in the escaped string or character literal represented by the string s.", Pos: "quote.go:255"},
+		"strconv.QuotedPrefix": {Sig: "func QuotedPrefix(s string) (string, error)", Doc: "QuotedPrefix returns the quoted string (as understood by [Unquote]) at the prefix of s.", Pos: "quote.go:368"},
+		"strconv.Unquote": {Sig: "func Unquote(s string) (string, error)", Doc: "Unquote interprets s as a single-quoted, double-quoted, or backquoted Go string literal, returning the string value that s quotes.", Pos: "quote.go:379"},
+		"strconv.IsPrint": {Sig: "func IsPrint(r rune) bool", Doc: "IsPrint reports whether the rune is defined as printable by Go, with the same definition as unicode.IsPrint: letters, numbers, punctuation, symbols and ASCII space.", Pos: "quote.go:518"},
+		"strconv.IsGraphic": {Sig: "func IsGraphic(r rune) bool", Doc: "IsGraphic reports whether the rune is defined as a Graphic by Unicode.", Pos: "quote.go:564"},
+		"strings": {Doc: "Package strings implements simple functions to manipulate UTF-8 encoded strings."},
+		"strings.Builder": {Sig: "type Builder struct", Doc: "A Builder is used to efficiently build a string using [Builder.Write] methods.", Pos: "builder.go:17"},
+		"strings.Builder.String": {Sig: "func (b *Builder) String() string", Doc: "String returns the accumulated string.", Pos: "builder.go:46"},
+		"strings.Builder.Len": {Sig: "func (b *Builder) Len() int", Doc: "Len returns the number of accumulated bytes; b.Len() == len(b.String()).", Pos: "builder.go:51"},
+		"strings.Builder.Cap": {Sig: "func (b *Builder) Cap() int", Doc: "Cap returns the capacity of the builder's underlying byte slice.", Pos: "builder.go:56"},
+		"strings.Builder.Reset": {Sig: "func (b *Builder) Reset()", Doc: "Reset resets the [Builder] to be empty.", Pos: "builder.go:59"},
+		"strings.Builder.Grow": {Sig: "func (b *Builder) Grow(n int)", Doc: "Grow grows b's capacity, if necessary, to guarantee space for another n bytes.", Pos: "builder.go:75"},
+		"strings.Builder.Write": {Sig: "func (b *Builder) Write(p []byte) (int, error)", Doc: "Write appends the contents of p to b's buffer.", Pos: "builder.go:87"},
+		"strings.Builder.WriteByte": {Sig: "func (b *Builder) WriteByte(c byte) error", Doc: "WriteByte appends the byte c to b's buffer.", Pos: "builder.go:95"},
+		"strings.Builder.WriteRune": {Sig: "func (b *Builder) WriteRune(r rune) (int, error)", Doc: "WriteRune appends the UTF-8 encoding of Unicode code point r to b's buffer.", Pos: "builder.go:103"},
+		"strings.Builder.WriteString": {Sig: "func (b *Builder) WriteString(s string) (int, error)", Doc: "WriteString appends the contents of s to b's buffer.", Pos: "builder.go:112"},
+		"strings.Clone": {Sig: "func Clone(s string) string", Doc: "Clone returns a fresh copy of s.", Pos: "clone.go:21"},
+		"strings.Compare": {Sig: "func Compare(a, b string) int", Doc: "Compare returns an integer comparing two strings lexicographically.", Pos: "compare.go:15"},
+		"strings.Lines": {Sig: "func Lines(s string) iter.Seq[string]", Doc: "Lines returns an iterator over the newline-terminated lines in the string s.", Pos: "iter.go:18"},
+		"strings.SplitSeq": {Sig: "func SplitSeq(s, sep string) iter.Seq[string]", Doc: "SplitSeq returns an iterator over all substrings of s separated by sep.", Pos: "iter.go:67"},
+		"strings.SplitAfterSeq": {Sig: "func SplitAfterSeq(s, sep string) iter.Seq[string]", Doc: "SplitAfterSeq returns an iterator over substrings of s split after each instance of sep.", Pos: "iter.go:75"},
+		"strings.FieldsSeq": {Sig: "func FieldsSeq(s string) iter.Seq[string]", Doc: "FieldsSeq returns an iterator over substrings of s split around runs of whitespace characters, as defined by unicode.IsSpace.", Pos: "iter.go:83"},
+		"strings.FieldsFuncSeq": {Sig: "func FieldsFuncSeq(s string, f func(rune) bool) iter.Seq[string]", Doc: "FieldsFuncSeq returns an iterator over substrings of s split around runs of Unicode code points satisfying f(c).", Pos: "iter.go:116"},
+		"strings.Reader": {Sig: "type Reader struct", Doc: "A Reader implements the io.Reader, io.ReaderAt, io.ByteReader, io.ByteScanner, io.RuneReader, io.RuneScanner, io.Seeker, and io.WriterTo interfaces by reading from a string.", Pos: "reader.go:17"},
+		"strings.Reader.Len": {Sig: "func (r *Reader) Len() int", Doc: "Len returns the number of bytes of the unread portion of the string.", Pos: "reader.go:25"},
+		"strings.Reader.Size": {Sig: "func (r *Reader) Size() int64", Doc: "Size returns the original length of the underlying string.", Pos: "reader.go:36"},
+		"strings.Reader.Read": {Sig: "func (r *Reader) Read(b []byte) (n int, err error)", Doc: "Read implements the io.Reader interface.", Pos: "reader.go:39"},
+		"strings.Reader.ReadAt": {Sig: "func (r *Reader) ReadAt(b []byte, off int64) (n int, err error)", Doc: "ReadAt implements the io.ReaderAt interface.", Pos: "reader.go:50"},
+		"strings.Reader.ReadByte": {Sig: "func (r *Reader) ReadByte() (byte, error)", Doc: "ReadByte implements the io.ByteReader interface.", Pos: "reader.go:66"},
+		"strings.Reader.UnreadByte": {Sig: "func (r *Reader) UnreadByte() error", Doc: "UnreadByte implements the io.ByteScanner interface.", Pos: "reader.go:77"},
+		"strings.Reader.ReadRune": {Sig: "func (r *Reader) ReadRune() (ch rune, size int, err error)", Doc: "ReadRune implements the io.RuneReader interface.", Pos: "reader.go:87"},
+		"strings.Reader.UnreadRune": {Sig: "func (r *Reader) UnreadRune() error", Doc: "UnreadRune implements the io.RuneScanner interface.", Pos: "reader.go:99"},
+		"strings.Reader.Seek": {Sig: "func (r *Reader) Seek(offset int64, whence int) (int64, error)", Doc: "Seek implements the io.Seeker interface.", Pos: "reader.go:112"},
+		"strings.Reader.WriteTo": {Sig: "func (r *Reader) WriteTo(w io.Writer) (n int64, err error)", Doc: "WriteTo implements the io.WriterTo interface.", Pos: "reader.go:133"},
+		"strings.Reader.Reset": {Sig: "func (r *Reader) Reset(s string)", Doc: "Reset resets the [Reader] to be reading from s.", Pos: "reader.go:152"},
+		"strings.NewReader": {Sig: "func NewReader(s string) *Reader", Doc: "NewReader returns a new [Reader] reading from s.", Pos: "reader.go:156"},
+		"strings.Replacer": {Sig: "type Replacer struct", Doc: "Replacer replaces a list of strings with replacements.", Pos: "replace.go:14"},
+		"strings.NewReplacer": {Sig: "func NewReplacer(oldnew ...string) *Replacer", Doc: "NewReplacer returns a new [Replacer] from a list of old, new string pairs.", Pos: "replace.go:32"},
+		"strings.Replacer.Replace": {Sig: "func (r *Replacer) Replace(s string) string", Doc: "Replace returns a copy of s with all replacements performed.", Pos: "replace.go:95"},
+		"strings.Replacer.WriteString": {Sig: "func (r *Replacer) WriteString(w io.Writer, s string) (n int, err error)", Doc: "WriteString writes s to w with all replacements performed.", Pos: "replace.go:101"},
+		"strings.Count": {Sig: "func Count(s, substr string) int", Doc: "Count counts the number of non-overlapping instances of substr in s.", Pos: "strings.go:42"},
+		"strings.Contains": {Sig: "func Contains(s, substr string) bool", Doc: "Contains reports whether substr is within s.", Pos: "strings.go:62"},
+		"strings.ContainsAny": {Sig: "func ContainsAny(s, chars string) bool", Doc: "ContainsAny reports whether any Unicode code points in chars are within s.", Pos: "strings.go:67"},
+		"strings.ContainsRune": {Sig: "func ContainsRune(s string, r rune) bool", Doc: "ContainsRune reports whether the Unicode code point r is within s.", Pos: "strings.go:72"},
+		"strings.ContainsFunc": {Sig: "func ContainsFunc(s string, f func(rune) bool) bool", Doc: "ContainsFunc reports whether any Unicode code points r within s satisfy f(r).", Pos: "strings.go:78"},
+		"strings.LastIndex": {Sig: "func LastIndex(s, substr string) int", Doc: "LastIndex returns the index of the last instance of substr in s, or -1 if substr is not present in s.", Pos: "strings.go:83"},
+		"strings.IndexByte": {Sig: "func IndexByte(s string, c byte) int", Doc: "IndexByte returns the index of the first instance of c in s, or -1 if c is not present in s.", Pos: "strings.go:102"},
+		"strings.IndexRune": {Sig: "func IndexRune(s string, r rune) int", Doc: "IndexRune returns the index of the first instance of the Unicode code point r, or -1 if rune is not present in s.", Pos: "strings.go:110"},
+		"strings.IndexAny": {Sig: "func IndexAny(s, chars string) int", Doc: "IndexAny returns the index of the first instance of any Unicode code point from chars in s, or -1 if no Unicode code point from chars is present in s.", Pos: "strings.go:184"},
+		"strings.LastIndexAny": {Sig: "func LastIndexAny(s, chars string) int", Doc: "LastIndexAny returns the index of the last instance of any Unicode code point from chars in s, or -1 if no Unicode code point from chars is present in s.", Pos: "strings.go:218"},
+		"strings.LastIndexByte": {Sig: "func LastIndexByte(s string, c byte) int", Doc: "LastIndexByte returns the index of the last instance of c in s, or -1 if c is not present in s.", Pos: "strings.go:268"},
+		"strings.SplitN": {Sig: "func SplitN(s, sep string, n int) []string", Doc: "SplitN slices s into substrings separated by sep and returns a slice of the substrings between those separators.", Pos: "strings.go:314"},
+		"strings.SplitAfterN": {Sig: "func SplitAfterN(s, sep string, n int) []string", Doc: "SplitAfterN slices s into substrings after each instance of sep and returns a slice of those substrings.", Pos: "strings.go:326"},
+		"strings.Split": {Sig: "func Split(s, sep string) []string", Doc: "Split slices s into all substrings separated by sep and returns a slice of the substrings between those separators.", Pos: "strings.go:342"},
+		"strings.SplitAfter": {Sig: "func SplitAfter(s, sep string) []string", Doc: "SplitAfter slices s into all substrings after each instance of sep and returns a slice of those substrings.", Pos: "strings.go:354"},
+		"strings.Fields": {Sig: "func Fields(s string) []string", Doc: "Fields splits the string s around each instance of one or more consecutive white space characters, as defined by unicode.IsSpace, returning a slice of substrings of s or an empty slice if s contains only white space.", Pos: "strings.go:365"},
+		"strings.FieldsFunc": {Sig: "func FieldsFunc(s string, f func(rune) bool) []string", Doc: "FieldsFunc splits the string s at each run of Unicode code points c satisfying f(c) and returns an array of slices of s.", Pos: "strings.go:422"},
+		"strings.Join": {Sig: "func Join(elems []string, sep string) string", Doc: "Join concatenates the elements of its first argument to create a single string.", Pos: "strings.go:468"},
+		"strings.HasPrefix": {Sig: "func HasPrefix(s, prefix string) bool", Doc: "HasPrefix reports whether the string s begins with prefix.", Pos: "strings.go:501"},
+		"strings.HasSuffix": {Sig: "func HasSuffix(s, suffix string) bool", Doc: "HasSuffix reports whether the string s ends with suffix.", Pos: "strings.go:506"},
+		"strings.Map": {Sig: "func Map(mapping func(rune) rune, s string) string", Doc: "Map returns a copy of the string s with all its characters modified according to the mapping function.", Pos: "strings.go:513"},
+		"strings.Repeat": {Sig: "func Repeat(s string, count int) string", Doc: "Repeat returns a new string consisting of count copies of the string s.", Pos: "strings.go:597"},
+		"strings.ToUpper": {Sig: "func ToUpper(s string) string", Doc: "ToUpper returns s with all Unicode letters mapped to their upper case.", Pos: "strings.go:668"},
+		"strings.ToLower": {Sig: "func ToLower(s string) string", Doc: "ToLower returns s with all Unicode letters mapped to their lower case.", Pos: "strings.go:708"},
+		"strings.ToTitle": {Sig: "func ToTitle(s string) string", Doc: "ToTitle returns a copy of the string s with all Unicode letters mapped to their Unicode title case.", Pos: "strings.go:749"},
+		"strings.ToUpperSpecial": {Sig: "func ToUpperSpecial(c unicode.SpecialCase, s string) string", Doc: "ToUpperSpecial returns a copy of the string s with all Unicode letters mapped to their upper case using the case mapping specified by c.", Pos: "strings.go:753"},
+		"strings.ToLowerSpecial": {Sig: "func ToLowerSpecial(c unicode.SpecialCase, s string) string", Doc: "ToLowerSpecial returns a copy of the string s with all Unicode letters mapped to their lower case using the case mapping specified by c.", Pos: "strings.go:759"},
+		"strings.ToTitleSpecial": {Sig: "func ToTitleSpecial(c unicode.SpecialCase, s string) string", Doc: "ToTitleSpecial returns a copy of the string s with all Unicode letters mapped to their Unicode title case, giving priority to the special casing rules.", Pos: "strings.go:765"},
+		"strings.ToValidUTF8": {Sig: "func ToValidUTF8(s, replacement string) string", Doc: "ToValidUTF8 returns a copy of the string s with each run of invalid UTF-8 byte sequences replaced by the replacement string, which may be empty.", Pos: "strings.go:771"},
+		"strings.Title": {Sig: "func Title(s string) string", Doc: "Title returns a copy of the string s with all Unicode letters that begin words mapped to their Unicode title case.", Pos: "strings.go:849"},
+		"strings.TrimLeftFunc": {Sig: "func TrimLeftFunc(s string, f func(rune) bool) string", Doc: "TrimLeftFunc returns a slice of the string s with all leading Unicode code points c satisfying f(c) removed.", Pos: "strings.go:868"},
+		"strings.TrimRightFunc": {Sig: "func TrimRightFunc(s string, f func(rune) bool) string", Doc: "TrimRightFunc returns a slice of the string s with all trailing Unicode code points c satisfying f(c) removed.", Pos: "strings.go:878"},
+		"strings.TrimFunc": {Sig: "func TrimFunc(s string, f func(rune) bool) string", Doc: "TrimFunc returns a slice of the string s with all leading and trailing Unicode code points c satisfying f(c) removed.", Pos: "strings.go:891"},
+		"strings.IndexFunc": {Sig: "func IndexFunc(s string, f func(rune) bool) int", Doc: "IndexFunc returns the index into s of the first Unicode code point satisfying f(c), or -1 if none do.", Pos: "strings.go:897"},
+		"strings.LastIndexFunc": {Sig: "func LastIndexFunc(s string, f func(rune) bool) int", Doc: "LastIndexFunc returns the index into s of the last Unicode code point satisfying f(c), or -1 if none do.", Pos: "strings.go:903"},
+		"strings.Trim": {Sig: "func Trim(s, cutset string) string", Doc: "Trim returns a slice of the string s with all leading and trailing Unicode code points contained in cutset removed.", Pos: "strings.go:980"},
+		"strings.TrimLeft": {Sig: "func TrimLeft(s, cutset string) string", Doc: "TrimLeft returns a slice of the string s with all leading Unicode code points contained in cutset removed.", Pos: "strings.go:997"},
+		"strings.TrimRight": {Sig: "func TrimRight(s, cutset string) string", Doc: "TrimRight returns a slice of the string s, with all trailing Unicode code points contained in cutset removed.", Pos: "strings.go:1042"},
+		"strings.TrimSpace": {Sig: "func TrimSpace(s string) string", Doc: "TrimSpace returns a slice (substring) of the string s, with all leading and trailing white space removed, as defined by Unicode.", Pos: "strings.go:1089"},
+		"strings.TrimPrefix": {Sig: "func TrimPrefix(s, prefix string) string", Doc: "TrimPrefix returns s without the provided leading prefix string.", Pos: "strings.go:1120"},
+		"strings.TrimSuffix": {Sig: "func TrimSuffix(s, suffix string) string", Doc: "TrimSuffix returns s without the provided trailing suffix string.", Pos: "strings.go:1126"},
+		"strings.Replace": {Sig: "func Replace(s, old, new string, n int) string", Doc: "Replace returns a copy of the string s with the first n non-overlapping instances of old replaced by new.", Pos: "strings.go:1136"},
+		"strings.ReplaceAll": {Sig: "func ReplaceAll(s, old, new string) string", Doc: "ReplaceAll returns a copy of the string s with all non-overlapping instances of old replaced by new.", Pos: "strings.go:1178"},
+		"strings.EqualFold": {Sig: "func EqualFold(s, t string) bool", Doc: "EqualFold reports whether s and t, interpreted as UTF-8 strings, are equal under simple Unicode case-folding, which is a more general form of case-insensitivity.", Pos: "strings.go:1185"},
+		"strings.Index": {Sig: "func Index(s, substr string) int", Doc: "Index returns the index of the first instance of substr in s, or -1 if substr is not present in s.", Pos: "strings.go:1263"},
+		"strings.Cut": {Sig: "func Cut(s, sep string) (before, after string, found bool)", Doc: "Cut slices s around the first instance of sep, returning the text before and after sep.", Pos: "strings.go:1271"},
+		"strings.CutPrefix": {Sig: "func CutPrefix(s, prefix string) (after string, found bool)", Doc: "CutPrefix returns s without the provided leading prefix string and reports whether it found the prefix.", Pos: "strings.go:1279"},
+		"strings.CutSuffix": {Sig: "func CutSuffix(s, suffix string) (before string, found bool)", Doc: "CutSuffix returns s without the provided ending suffix string and reports whether it found the suffix.", Pos: "strings.go:1287"},
+		"strings.CutLast": {Sig: "func CutLast(s, sep string) (before, after string, found bool)", Doc: "CutLast slices s around the last instance of sep, returning the text before and after sep.", Pos: "strings.go:1295"},
+		"sync": {Doc: "Package sync provides basic synchronization primitives such as mutual exclusion locks."},
+		"sync.Cond": {Sig: "type Cond struct", Doc: "Cond implements a condition variable, a rendezvous point for goroutines waiting for or announcing the occurrence of an event.", Pos: "cond.go:37"},
+		"sync.NewCond": {Sig: "func NewCond(l Locker) *Cond", Doc: "NewCond returns a new Cond with Locker l.", Pos: "cond.go:48"},
+		"sync.Cond.Wait": {Sig: "func (c *Cond) Wait()", Doc: "Wait atomically unlocks c.L and suspends execution of the calling goroutine.", Pos: "cond.go:67"},
+		"sync.Cond.Signal": {Sig: "func (c *Cond) Signal()", Doc: "Signal wakes one goroutine waiting on c, if there is any.", Pos: "cond.go:82"},
+		"sync.Cond.Broadcast": {Sig: "func (c *Cond) Broadcast()", Doc: "Broadcast wakes all goroutines waiting on c.", Pos: "cond.go:93"},
+		"sync.Map": {Sig: "type Map struct", Doc: "Map is like a Go map[any]any but is safe for concurrent use by multiple goroutines without additional locking or coordination.", Pos: "map.go:38"},
+		"sync.Map.Load": {Sig: "func (m *Map) Load(key any) (value any, ok bool)", Doc: "Load returns the value stored in the map for a key, or nil if no value is present.", Pos: "map.go:47"},
+		"sync.Map.Store": {Sig: "func (m *Map) Store(key, value any)", Doc: "Store sets the value for a key.", Pos: "map.go:52"},
+		"sync.Map.Clear": {Sig: "func (m *Map) Clear()", Doc: "Clear deletes all the entries, resulting in an empty Map.", Pos: "map.go:57"},
+		"sync.Map.LoadOrStore": {Sig: "func (m *Map) LoadOrStore(key, value any) (actual any, loaded bool)", Doc: "LoadOrStore returns the existing value for the key if present.", Pos: "map.go:64"},
+		"sync.Map.LoadAndDelete": {Sig: "func (m *Map) LoadAndDelete(key any) (value any, loaded bool)", Doc: "LoadAndDelete deletes the value for a key, returning the previous value if any.", Pos: "map.go:70"},
+		"sync.Map.Delete": {Sig: "func (m *Map) Delete(key any)", Doc: "Delete deletes the value for a key.", Pos: "map.go:76"},
+		"sync.Map.Swap": {Sig: "func (m *Map) Swap(key, value any) (previous any, loaded bool)", Doc: "Swap swaps the value for a key and returns the previous value if any.", Pos: "map.go:82"},
+		"sync.Map.CompareAndSwap": {Sig: "func (m *Map) CompareAndSwap(key, old, new any) (swapped bool)", Doc: "CompareAndSwap swaps the old and new values for key if the value stored in the map is equal to old.", Pos: "map.go:89"},
+		"sync.Map.CompareAndDelete": {Sig: "func (m *Map) CompareAndDelete(key, old any) (deleted bool)", Doc: "CompareAndDelete deletes the entry for key if its value is equal to old.", Pos: "map.go:98"},
+		"sync.Map.Range": {Sig: "func (m *Map) Range(f func(key, value any) bool)", Doc: "Range calls f sequentially for each key and value present in the map.", Pos: "map.go:113"},
+		"sync.Mutex": {Sig: "type Mutex struct", Doc: "A Mutex is a mutual exclusion lock.", Pos: "mutex.go:30"},
+		"sync.Locker": {Sig: "type Locker interface", Doc: "A Locker represents an object that can be locked and unlocked.", Pos: "mutex.go:37"},
+		"sync.Mutex.Lock": {Sig: "func (m *Mutex) Lock()", Doc: "Lock locks m.", Pos: "mutex.go:45"},
+		"sync.Mutex.TryLock": {Sig: "func (m *Mutex) TryLock() bool", Doc: "TryLock tries to lock m and reports whether it succeeded.", Pos: "mutex.go:54"},
+		"sync.Mutex.Unlock": {Sig: "func (m *Mutex) Unlock()", Doc: "Unlock unlocks m.", Pos: "mutex.go:64"},
+		"sync.Once": {Sig: "type Once struct", Doc: "Once is an object that will perform exactly one action.", Pos: "once.go:20"},
+		"sync.Once.Do": {Sig: "func (o *Once) Do(f func())", Doc: "Do calls the function f if and only if Do is being called for the first time for this instance of [Once].", Pos: "once.go:52"},
+		"sync.OnceFunc": {Sig: "func OnceFunc(f func()) func()", Doc: "OnceFunc returns a function that invokes f only once.", Pos: "oncefunc.go:11"},
+		"sync.OnceValue": {Sig: "func OnceValue[T any](f func() T) func() T", Doc: "OnceValue returns a function that invokes f only once and returns the value returned by f.", Pos: "oncefunc.go:46"},
+		"sync.OnceValues": {Sig: "func OnceValues[T1, T2 any](f func() (T1, T2)) func() (T1, T2)", Doc: "OnceValues returns a function that invokes f only once and returns the values returned by f.", Pos: "oncefunc.go:80"},
+		"sync.Pool": {Sig: "type Pool struct", Doc: "A Pool is a set of temporary objects that may be individually saved and retrieved.", Pos: "pool.go:52"},
+		"sync.Pool.Put": {Sig: "func (p *Pool) Put(x any)", Doc: "Put adds x to the pool.", Pos: "pool.go:100"},
+		"sync.Pool.Get": {Sig: "func (p *Pool) Get() any", Doc: "Get selects an arbitrary item from the [Pool], removes it from the Pool, and returns it to the caller.", Pos: "pool.go:132"},
+		"sync.RWMutex": {Sig: "type RWMutex struct", Doc: "A RWMutex is a reader/writer mutual exclusion lock.", Pos: "rwmutex.go:39"},
+		"sync.RWMutex.RLock": {Sig: "func (rw *RWMutex) RLock()", Doc: "RLock locks rw for reading.", Pos: "rwmutex.go:67"},
+		"sync.RWMutex.TryRLock": {Sig: "func (rw *RWMutex) TryRLock() bool", Doc: "TryRLock tries to lock rw for reading and reports whether it succeeded.", Pos: "rwmutex.go:87"},
+		"sync.RWMutex.RUnlock": {Sig: "func (rw *RWMutex) RUnlock()", Doc: "RUnlock undoes a single [RWMutex.RLock] call; it does not affect other simultaneous readers.", Pos: "rwmutex.go:114"},
+		"sync.RWMutex.Lock": {Sig: "func (rw *RWMutex) Lock()", Doc: "Lock locks rw for writing.", Pos: "rwmutex.go:144"},
+		"sync.RWMutex.TryLock": {Sig: "func (rw *RWMutex) TryLock() bool", Doc: "TryLock tries to lock rw for writing and reports whether it succeeded.", Pos: "rwmutex.go:169"},
+		"sync.RWMutex.Unlock": {Sig: "func (rw *RWMutex) Unlock()", Doc: "Unlock unlocks rw for writing.", Pos: "rwmutex.go:201"},
+		"sync.RWMutex.RLocker": {Sig: "func (rw *RWMutex) RLocker() Locker", Doc: "RLocker returns a [Locker] interface that implements the [Locker.Lock] and [Locker.Unlock] methods by calling rw.RLock and rw.RUnlock.", Pos: "rwmutex.go:240"},
+		"sync.WaitGroup": {Sig: "type WaitGroup struct", Doc: "A WaitGroup is a counting semaphore typically used to wait for a group of goroutines or tasks to finish.", Pos: "waitgroup.go:48"},
+		"sync.WaitGroup.Add": {Sig: "func (wg *WaitGroup) Add(delta int)", Doc: "Add adds delta, which may be negative, to the [WaitGroup] task counter.", Pos: "waitgroup.go:77"},
+		"sync.WaitGroup.Done": {Sig: "func (wg *WaitGroup) Done()", Doc: "Done decrements the [WaitGroup] task counter by one.", Pos: "waitgroup.go:155"},
+		"sync.WaitGroup.Wait": {Sig: "func (wg *WaitGroup) Wait()", Doc: "Wait blocks until the [WaitGroup] task counter is zero.", Pos: "waitgroup.go:160"},
+		"sync.WaitGroup.Go": {Sig: "func (wg *WaitGroup) Go(f func())", Doc: "Go calls f in a new goroutine and adds that task to the [WaitGroup].", Pos: "waitgroup.go:236"},
+		"sync/atomic": {Doc: "Package atomic provides low-level atomic memory primitives useful for implementing synchronization algorithms."},
+		"sync/atomic.SwapInt32": {Sig: "func SwapInt32(addr *int32, new int32) (old int32)", Doc: "SwapInt32 atomically stores new into *addr and returns the previous *addr value.", Pos: "doc.go:75"},
+		"sync/atomic.SwapUint32": {Sig: "func SwapUint32(addr *uint32, new uint32) (old uint32)", Doc: "SwapUint32 atomically stores new into *addr and returns the previous *addr value.", Pos: "doc.go:81"},
+		"sync/atomic.SwapUintptr": {Sig: "func SwapUintptr(addr *uintptr, new uintptr) (old uintptr)", Doc: "SwapUintptr atomically stores new into *addr and returns the previous *addr value.", Pos: "doc.go:88"},
+		"sync/atomic.SwapPointer": {Sig: "func SwapPointer(addr *unsafe.Pointer, new unsafe.Pointer) (old unsafe.Pointer)", Doc: "SwapPointer atomically stores new into *addr and returns the previous *addr value.", Pos: "doc.go:92"},
+		"sync/atomic.CompareAndSwapInt32": {Sig: "func CompareAndSwapInt32(addr *int32, old, new int32) (swapped bool)", Doc: "CompareAndSwapInt32 executes the compare-and-swap operation for an int32 value.", Pos: "doc.go:98"},
+		"sync/atomic.CompareAndSwapUint32": {Sig: "func CompareAndSwapUint32(addr *uint32, old, new uint32) (swapped bool)", Doc: "CompareAndSwapUint32 executes the compare-and-swap operation for a uint32 value.", Pos: "doc.go:104"},
+		"sync/atomic.CompareAndSwapUintptr": {Sig: "func CompareAndSwapUintptr(addr *uintptr, old, new uintptr) (swapped bool)", Doc: "CompareAndSwapUintptr executes the compare-and-swap operation for a uintptr value.", Pos: "doc.go:111"},
+		"sync/atomic.CompareAndSwapPointer": {Sig: "func CompareAndSwapPointer(addr *unsafe.Pointer, old, new unsafe.Pointer) (swapped bool)", Doc: "CompareAndSwapPointer executes the compare-and-swap operation for a unsafe.Pointer value.", Pos: "doc.go:115"},
+		"sync/atomic.AddInt32": {Sig: "func AddInt32(addr *int32, delta int32) (new int32)", Doc: "AddInt32 atomically adds delta to *addr and returns the new value.", Pos: "doc.go:121"},
+		"sync/atomic.AddUint32": {Sig: "func AddUint32(addr *uint32, delta uint32) (new uint32)", Doc: "AddUint32 atomically adds delta to *addr and returns the new value.", Pos: "doc.go:129"},
+		"sync/atomic.AddUintptr": {Sig: "func AddUintptr(addr *uintptr, delta uintptr) (new uintptr)", Doc: "AddUintptr atomically adds delta to *addr and returns the new value.", Pos: "doc.go:135"},
+		"sync/atomic.AndInt32": {Sig: "func AndInt32(addr *int32, mask int32) (old int32)", Doc: "AndInt32 atomically performs a bitwise AND operation on *addr using the bitmask provided as mask and returns the old value.", Pos: "doc.go:142"},
+		"sync/atomic.AndUint32": {Sig: "func AndUint32(addr *uint32, mask uint32) (old uint32)", Doc: "AndUint32 atomically performs a bitwise AND operation on *addr using the bitmask provided as mask and returns the old value.", Pos: "doc.go:149"},
+		"sync/atomic.AndUintptr": {Sig: "func AndUintptr(addr *uintptr, mask uintptr) (old uintptr)", Doc: "AndUintptr atomically performs a bitwise AND operation on *addr using the bitmask provided as mask and returns the old value.", Pos: "doc.go:156"},
+		"sync/atomic.OrInt32": {Sig: "func OrInt32(addr *int32, mask int32) (old int32)", Doc: "OrInt32 atomically performs a bitwise OR operation on *addr using the bitmask provided as mask and returns the old value.", Pos: "doc.go:163"},
+		"sync/atomic.OrUint32": {Sig: "func OrUint32(addr *uint32, mask uint32) (old uint32)", Doc: "OrUint32 atomically performs a bitwise OR operation on *addr using the bitmask provided as mask and returns the old value.", Pos: "doc.go:170"},
+		"sync/atomic.OrUintptr": {Sig: "func OrUintptr(addr *uintptr, mask uintptr) (old uintptr)", Doc: "OrUintptr atomically performs a bitwise OR operation on *addr using the bitmask provided as mask and returns the old value.", Pos: "doc.go:177"},
+		"sync/atomic.LoadInt32": {Sig: "func LoadInt32(addr *int32) (val int32)", Doc: "LoadInt32 atomically loads *addr.", Pos: "doc.go:183"},
+		"sync/atomic.LoadUint32": {Sig: "func LoadUint32(addr *uint32) (val uint32)", Doc: "LoadUint32 atomically loads *addr.", Pos: "doc.go:189"},
+		"sync/atomic.LoadUintptr": {Sig: "func LoadUintptr(addr *uintptr) (val uintptr)", Doc: "LoadUintptr atomically loads *addr.", Pos: "doc.go:195"},
+		"sync/atomic.LoadPointer": {Sig: "func LoadPointer(addr *unsafe.Pointer) (val unsafe.Pointer)", Doc: "LoadPointer atomically loads *addr.", Pos: "doc.go:199"},
+		"sync/atomic.StoreInt32": {Sig: "func StoreInt32(addr *int32, val int32)", Doc: "StoreInt32 atomically stores val into *addr.", Pos: "doc.go:205"},
+		"sync/atomic.StoreUint32": {Sig: "func StoreUint32(addr *uint32, val uint32)", Doc: "StoreUint32 atomically stores val into *addr.", Pos: "doc.go:211"},
+		"sync/atomic.StoreUintptr": {Sig: "func StoreUintptr(addr *uintptr, val uintptr)", Doc: "StoreUintptr atomically stores val into *addr.", Pos: "doc.go:218"},
+		"sync/atomic.StorePointer": {Sig: "func StorePointer(addr *unsafe.Pointer, val unsafe.Pointer)", Doc: "StorePointer atomically stores val into *addr.", Pos: "doc.go:222"},
+		"sync/atomic.SwapInt64": {Sig: "func SwapInt64(addr *int64, new int64) (old int64)", Doc: "SwapInt64 atomically stores new into *addr and returns the previous *addr value.", Pos: "doc_64.go:14"},
+		"sync/atomic.SwapUint64": {Sig: "func SwapUint64(addr *uint64, new uint64) (old uint64)", Doc: "SwapUint64 atomically stores new into *addr and returns the previous *addr value.", Pos: "doc_64.go:21"},
+		"sync/atomic.CompareAndSwapInt64": {Sig: "func CompareAndSwapInt64(addr *int64, old, new int64) (swapped bool)", Doc: "CompareAndSwapInt64 executes the compare-and-swap operation for an int64 value.", Pos: "doc_64.go:28"},
+		"sync/atomic.CompareAndSwapUint64": {Sig: "func CompareAndSwapUint64(addr *uint64, old, new uint64) (swapped bool)", Doc: "CompareAndSwapUint64 executes the compare-and-swap operation for a uint64 value.", Pos: "doc_64.go:35"},
+		"sync/atomic.AddInt64": {Sig: "func AddInt64(addr *int64, delta int64) (new int64)", Doc: "AddInt64 atomically adds delta to *addr and returns the new value.", Pos: "doc_64.go:42"},
+		"sync/atomic.AddUint64": {Sig: "func AddUint64(addr *uint64, delta uint64) (new uint64)", Doc: "AddUint64 atomically adds delta to *addr and returns the new value.", Pos: "doc_64.go:51"},
+		"sync/atomic.AndInt64": {Sig: "func AndInt64(addr *int64, mask int64) (old int64)", Doc: "AndInt64 atomically performs a bitwise AND operation on *addr using the bitmask provided as mask and returns the old value.", Pos: "doc_64.go:58"},
+		"sync/atomic.AndUint64": {Sig: "func AndUint64(addr *uint64, mask uint64) (old uint64)", Doc: "AndUint64 atomically performs a bitwise AND operation on *addr using the bitmask provided as mask and returns the old.", Pos: "doc_64.go:65"},
+		"sync/atomic.OrInt64": {Sig: "func OrInt64(addr *int64, mask int64) (old int64)", Doc: "OrInt64 atomically performs a bitwise OR operation on *addr using the bitmask provided as mask and returns the old value.", Pos: "doc_64.go:72"},
+		"sync/atomic.OrUint64": {Sig: "func OrUint64(addr *uint64, mask uint64) (old uint64)", Doc: "OrUint64 atomically performs a bitwise OR operation on *addr using the bitmask provided as mask and returns the old value.", Pos: "doc_64.go:79"},
+		"sync/atomic.LoadInt64": {Sig: "func LoadInt64(addr *int64) (val int64)", Doc: "LoadInt64 atomically loads *addr.", Pos: "doc_64.go:86"},
+		"sync/atomic.LoadUint64": {Sig: "func LoadUint64(addr *uint64) (val uint64)", Doc: "LoadUint64 atomically loads *addr.", Pos: "doc_64.go:93"},
+		"sync/atomic.StoreInt64": {Sig: "func StoreInt64(addr *int64, val int64)", Doc: "StoreInt64 atomically stores val into *addr.", Pos: "doc_64.go:100"},
+		"sync/atomic.StoreUint64": {Sig: "func StoreUint64(addr *uint64, val uint64)", Doc: "StoreUint64 atomically stores val into *addr.", Pos: "doc_64.go:107"},
+		"sync/atomic.Bool": {Sig: "type Bool struct", Doc: "A Bool is an atomic boolean value.", Pos: "type.go:13"},
+		"sync/atomic.Bool.Load": {Sig: "func (x *Bool) Load() bool", Doc: "Load atomically loads and returns the value stored in x.", Pos: "type.go:19"},
+		"sync/atomic.Bool.Store": {Sig: "func (x *Bool) Store(val bool)", Doc: "Store atomically stores val into x.", Pos: "type.go:22"},
+		"sync/atomic.Bool.Swap": {Sig: "func (x *Bool) Swap(new bool) (old bool)", Doc: "Swap atomically stores new into x and returns the previous value.", Pos: "type.go:25"},
+		"sync/atomic.Bool.CompareAndSwap": {Sig: "func (x *Bool) CompareAndSwap(old, new bool) (swapped bool)", Doc: "CompareAndSwap executes the compare-and-swap operation for the boolean value x.", Pos: "type.go:28"},
+		"sync/atomic.Pointer": {Sig: "type Pointer struct", Doc: "A Pointer is an atomic pointer of type *T. The zero value is a nil *T.", Pos: "type.go:47"},
+		"sync/atomic.Int32": {Sig: "type Int32 struct", Doc: "An Int32 is an atomic int32.", Pos: "type.go:74"},
+		"sync/atomic.Int32.Load": {Sig: "func (x *Int32) Load() int32", Doc: "Load atomically loads and returns the value stored in x.", Pos: "type.go:80"},
+		"sync/atomic.Int32.Store": {Sig: "func (x *Int32) Store(val int32)", Doc: "Store atomically stores val into x.", Pos: "type.go:83"},
+		"sync/atomic.Int32.Swap": {Sig: "func (x *Int32) Swap(new int32) (old int32)", Doc: "Swap atomically stores new into x and returns the previous value.", Pos: "type.go:86"},
+		"sync/atomic.Int32.CompareAndSwap": {Sig: "func (x *Int32) CompareAndSwap(old, new int32) (swapped bool)", Doc: "CompareAndSwap executes the compare-and-swap operation for x.", Pos: "type.go:89"},
+		"sync/atomic.Int32.Add": {Sig: "func (x *Int32) Add(delta int32) (new int32)", Doc: "Add atomically adds delta to x and returns the new value.", Pos: "type.go:94"},
+		"sync/atomic.Int32.And": {Sig: "func (x *Int32) And(mask int32) (old int32)", Doc: "And atomically performs a bitwise AND operation on x using the bitmask provided as mask and returns the old value.", Pos: "type.go:98"},
+		"sync/atomic.Int32.Or": {Sig: "func (x *Int32) Or(mask int32) (old int32)", Doc: "Or atomically performs a bitwise OR operation on x using the bitmask provided as mask and returns the old value.", Pos: "type.go:102"},
+		"sync/atomic.Int64": {Sig: "type Int64 struct", Doc: "An Int64 is an atomic int64.", Pos: "type.go:107"},
+		"sync/atomic.Int64.Load": {Sig: "func (x *Int64) Load() int64", Doc: "Load atomically loads and returns the value stored in x.", Pos: "type.go:114"},
+		"sync/atomic.Int64.Store": {Sig: "func (x *Int64) Store(val int64)", Doc: "Store atomically stores val into x.", Pos: "type.go:117"},
+		"sync/atomic.Int64.Swap": {Sig: "func (x *Int64) Swap(new int64) (old int64)", Doc: "Swap atomically stores new into x and returns the previous value.", Pos: "type.go:120"},
+		"sync/atomic.Int64.CompareAndSwap": {Sig: "func (x *Int64) CompareAndSwap(old, new int64) (swapped bool)", Doc: "CompareAndSwap executes the compare-and-swap operation for x.", Pos: "type.go:123"},
+		"sync/atomic.Int64.Add": {Sig: "func (x *Int64) Add(delta int64) (new int64)", Doc: "Add atomically adds delta to x and returns the new value.", Pos: "type.go:128"},
+		"sync/atomic.Int64.And": {Sig: "func (x *Int64) And(mask int64) (old int64)", Doc: "And atomically performs a bitwise AND operation on x using the bitmask provided as mask and returns the old value.", Pos: "type.go:132"},
+		"sync/atomic.Int64.Or": {Sig: "func (x *Int64) Or(mask int64) (old int64)", Doc: "Or atomically performs a bitwise OR operation on x using the bitmask provided as mask and returns the old value.", Pos: "type.go:136"},
+		"sync/atomic.Uint32": {Sig: "type Uint32 struct", Doc: "A Uint32 is an atomic uint32.", Pos: "type.go:141"},
+		"sync/atomic.Uint32.Load": {Sig: "func (x *Uint32) Load() uint32", Doc: "Load atomically loads and returns the value stored in x.", Pos: "type.go:147"},
+		"sync/atomic.Uint32.Store": {Sig: "func (x *Uint32) Store(val uint32)", Doc: "Store atomically stores val into x.", Pos: "type.go:150"},
+		"sync/atomic.Uint32.Swap": {Sig: "func (x *Uint32) Swap(new uint32) (old uint32)", Doc: "Swap atomically stores new into x and returns the previous value.", Pos: "type.go:153"},
+		"sync/atomic.Uint32.CompareAndSwap": {Sig: "func (x *Uint32) CompareAndSwap(old, new uint32) (swapped bool)", Doc: "CompareAndSwap executes the compare-and-swap operation for x.", Pos: "type.go:156"},
+		"sync/atomic.Uint32.Add": {Sig: "func (x *Uint32) Add(delta uint32) (new uint32)", Doc: "Add atomically adds delta to x and returns the new value.", Pos: "type.go:161"},
+		"sync/atomic.Uint32.And": {Sig: "func (x *Uint32) And(mask uint32) (old uint32)", Doc: "And atomically performs a bitwise AND operation on x using the bitmask provided as mask and returns the old value.", Pos: "type.go:165"},
+		"sync/atomic.Uint32.Or": {Sig: "func (x *Uint32) Or(mask uint32) (old uint32)", Doc: "Or atomically performs a bitwise OR operation on x using the bitmask provided as mask and returns the old value.", Pos: "type.go:169"},
+		"sync/atomic.Uint64": {Sig: "type Uint64 struct", Doc: "A Uint64 is an atomic uint64.", Pos: "type.go:174"},
+		"sync/atomic.Uint64.Load": {Sig: "func (x *Uint64) Load() uint64", Doc: "Load atomically loads and returns the value stored in x.", Pos: "type.go:181"},
+		"sync/atomic.Uint64.Store": {Sig: "func (x *Uint64) Store(val uint64)", Doc: "Store atomically stores val into x.", Pos: "type.go:184"},
+		"sync/atomic.Uint64.Swap": {Sig: "func (x *Uint64) Swap(new uint64) (old uint64)", Doc: "Swap atomically stores new into x and returns the previous value.", Pos: "type.go:187"},
+		"sync/atomic.Uint64.CompareAndSwap": {Sig: "func (x *Uint64) CompareAndSwap(old, new uint64) (swapped bool)", Doc: "CompareAndSwap executes the compare-and-swap operation for x.", Pos: "type.go:190"},
+		"sync/atomic.Uint64.Add": {Sig: "func (x *Uint64) Add(delta uint64) (new uint64)", Doc: "Add atomically adds delta to x and returns the new value.", Pos: "type.go:195"},
+		"sync/atomic.Uint64.And": {Sig: "func (x *Uint64) And(mask uint64) (old uint64)", Doc: "And atomically performs a bitwise AND operation on x using the bitmask provided as mask and returns the old value.", Pos: "type.go:199"},
+		"sync/atomic.Uint64.Or": {Sig: "func (x *Uint64) Or(mask uint64) (old uint64)", Doc: "Or atomically performs a bitwise OR operation on x using the bitmask provided as mask and returns the old value.", Pos: "type.go:203"},
+		"sync/atomic.Uintptr": {Sig: "type Uintptr struct", Doc: "A Uintptr is an atomic uintptr.", Pos: "type.go:208"},
+		"sync/atomic.Uintptr.Load": {Sig: "func (x *Uintptr) Load() uintptr", Doc: "Load atomically loads and returns the value stored in x.", Pos: "type.go:214"},
+		"sync/atomic.Uintptr.Store": {Sig: "func (x *Uintptr) Store(val uintptr)", Doc: "Store atomically stores val into x.", Pos: "type.go:217"},
+		"sync/atomic.Uintptr.Swap": {Sig: "func (x *Uintptr) Swap(new uintptr) (old uintptr)", Doc: "Swap atomically stores new into x and returns the previous value.", Pos: "type.go:220"},
+		"sync/atomic.Uintptr.CompareAndSwap": {Sig: "func (x *Uintptr) CompareAndSwap(old, new uintptr) (swapped bool)", Doc: "CompareAndSwap executes the compare-and-swap operation for x.", Pos: "type.go:223"},
+		"sync/atomic.Uintptr.Add": {Sig: "func (x *Uintptr) Add(delta uintptr) (new uintptr)", Doc: "Add atomically adds delta to x and returns the new value.", Pos: "type.go:228"},
+		"sync/atomic.Uintptr.And": {Sig: "func (x *Uintptr) And(mask uintptr) (old uintptr)", Doc: "And atomically performs a bitwise AND operation on x using the bitmask provided as mask and returns the old value.", Pos: "type.go:232"},
+		"sync/atomic.Uintptr.Or": {Sig: "func (x *Uintptr) Or(mask uintptr) (old uintptr)", Doc: "Or atomically performs a bitwise OR operation on x using the bitmask provided as mask and returns the old value.", Pos: "type.go:236"},
+		"sync/atomic.Value": {Sig: "type Value struct", Doc: "A Value provides an atomic load and store of a consistently typed value.", Pos: "value.go:16"},
+		"sync/atomic.Value.Load": {Sig: "func (v *Value) Load() (val any)", Doc: "Load returns the value set by the most recent Store.", Pos: "value.go:28"},
+		"sync/atomic.Value.Store": {Sig: "func (v *Value) Store(val any)", Doc: "Store sets the value of the [Value] v to val.", Pos: "value.go:47"},
+		"sync/atomic.Value.Swap": {Sig: "func (v *Value) Swap(new any) (old any)", Doc: "Swap stores new into Value and returns the previous value.", Pos: "value.go:90"},
+		"sync/atomic.Value.CompareAndSwap": {Sig: "func (v *Value) CompareAndSwap(old, new any) (swapped bool)", Doc: "CompareAndSwap executes the compare-and-swap operation for the [Value].", Pos: "value.go:134"},
+		"syscall": {Doc: "Package syscall contains an interface to the low-level operating system primitives."},
+		"syscall.ParseDirent": {Sig: "func ParseDirent(buf []byte, max int, names []string) (consumed int, count int, newnames []string)", Doc: "ParseDirent parses up to max directory entries in buf, appending the names to names.", Pos: "dirent.go:61"},
+		"syscall.Unsetenv": {Sig: "func Unsetenv(key string) error", Doc: "", Pos: "env_unix.go:52"},
+		"syscall.Getenv": {Sig: "func Getenv(key string) (value string, found bool)", Doc: "", Pos: "env_unix.go:66"},
+		"syscall.Setenv": {Sig: "func Setenv(key, value string) error", Doc: "", Pos: "env_unix.go:88"},
+		"syscall.Clearenv": {Sig: "func Clearenv()", Doc: "", Pos: "env_unix.go:123"},
+		"syscall.Environ": {Sig: "func Environ() []string", Doc: "", Pos: "env_unix.go:135"},
+		"syscall.CLONE_VM": {Sig: "const CLONE_VM", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:19"},
+		"syscall.CLONE_FS": {Sig: "const CLONE_FS", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:20"},
+		"syscall.CLONE_FILES": {Sig: "const CLONE_FILES", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:21"},
+		"syscall.CLONE_SIGHAND": {Sig: "const CLONE_SIGHAND", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:22"},
+		"syscall.CLONE_PIDFD": {Sig: "const CLONE_PIDFD", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:23"},
+		"syscall.CLONE_PTRACE": {Sig: "const CLONE_PTRACE", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:24"},
+		"syscall.CLONE_VFORK": {Sig: "const CLONE_VFORK", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:25"},
+		"syscall.CLONE_PARENT": {Sig: "const CLONE_PARENT", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:26"},
+		"syscall.CLONE_THREAD": {Sig: "const CLONE_THREAD", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:27"},
+		"syscall.CLONE_NEWNS": {Sig: "const CLONE_NEWNS", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:28"},
+		"syscall.CLONE_SYSVSEM": {Sig: "const CLONE_SYSVSEM", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:29"},
+		"syscall.CLONE_SETTLS": {Sig: "const CLONE_SETTLS", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:30"},
+		"syscall.CLONE_PARENT_SETTID": {Sig: "const CLONE_PARENT_SETTID", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:31"},
+		"syscall.CLONE_CHILD_CLEARTID": {Sig: "const CLONE_CHILD_CLEARTID", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:32"},
+		"syscall.CLONE_DETACHED": {Sig: "const CLONE_DETACHED", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:33"},
+		"syscall.CLONE_UNTRACED": {Sig: "const CLONE_UNTRACED", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:34"},
+		"syscall.CLONE_CHILD_SETTID": {Sig: "const CLONE_CHILD_SETTID", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:35"},
+		"syscall.CLONE_NEWCGROUP": {Sig: "const CLONE_NEWCGROUP", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:36"},
+		"syscall.CLONE_NEWUTS": {Sig: "const CLONE_NEWUTS", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:37"},
+		"syscall.CLONE_NEWIPC": {Sig: "const CLONE_NEWIPC", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:38"},
+		"syscall.CLONE_NEWUSER": {Sig: "const CLONE_NEWUSER", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:39"},
+		"syscall.CLONE_NEWPID": {Sig: "const CLONE_NEWPID", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:40"},
+		"syscall.CLONE_NEWNET": {Sig: "const CLONE_NEWNET", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:41"},
+		"syscall.CLONE_IO": {Sig: "const CLONE_IO", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:42"},
+		"syscall.CLONE_CLEAR_SIGHAND": {Sig: "const CLONE_CLEAR_SIGHAND", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:46"},
+		"syscall.CLONE_INTO_CGROUP": {Sig: "const CLONE_INTO_CGROUP", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:47"},
+		"syscall.CLONE_NEWTIME": {Sig: "const CLONE_NEWTIME", Doc: "Linux unshare/clone/clone2/clone3 flags, architecture-independent, copied from linux/sched.h.", Pos: "exec_linux.go:52"},
+		"syscall.SysProcIDMap": {Sig: "type SysProcIDMap struct", Doc: "SysProcIDMap holds Container ID to Host ID mappings used for User Namespaces in Linux.", Pos: "exec_linux.go:61"},
+		"syscall.SysProcAttr": {Sig: "type SysProcAttr struct", Doc: "", Pos: "exec_linux.go:67"},
+		"syscall.ForkLock": {Sig: "var ForkLock sync.RWMutex", Doc: "ForkLock is used to synchronize creation of new file descriptors with fork.", Pos: "exec_unix.go:65"},
+		"syscall.StringSlicePtr": {Sig: "func StringSlicePtr(ss []string) []*byte", Doc: "StringSlicePtr converts a slice of strings to a slice of pointers to NUL-terminated byte arrays.", Pos: "exec_unix.go:72"},
+		"syscall.SlicePtrFromStrings": {Sig: "func SlicePtrFromStrings(ss []string) ([]*byte, error)", Doc: "SlicePtrFromStrings converts a slice of strings to a slice of pointers to NUL-terminated byte arrays.", Pos: "exec_unix.go:84"},
+		"syscall.CloseOnExec": {Sig: "func CloseOnExec(fd int)", Doc: "", Pos: "exec_unix.go:103"},
+		"syscall.SetNonblock": {Sig: "func SetNonblock(fd int, nonblocking bool) (err error)", Doc: "", Pos: "exec_unix.go:105"},
+		"syscall.Credential": {Sig: "type Credential struct", Doc: "Credential holds user and group identities to be assumed by a child process started by [StartProcess].", Pos: "exec_unix.go:124"},
+		"syscall.ProcAttr": {Sig: "type ProcAttr struct", Doc: "ProcAttr holds attributes that will be applied to a new process started by [StartProcess].", Pos: "exec_unix.go:133"},
+		"syscall.ForkExec": {Sig: "func ForkExec(argv0 string, argv []string, attr *ProcAttr) (pid int, err error)", Doc: "Combination of fork and exec, careful to be thread safe.", Pos: "exec_unix.go:252"},
+		"syscall.StartProcess": {Sig: "func StartProcess(argv0 string, argv []string, attr *ProcAttr) (pid int, handle uintptr, err error)", Doc: "StartProcess wraps [ForkExec] for package os.", Pos: "exec_unix.go:257"},
+		"syscall.Exec": {Sig: "func Exec(argv0 string, argv []string, envv []string) (err error)", Doc: "Exec invokes the execve(2) system call.", Pos: "exec_unix.go:271"},
+		"syscall.FcntlFlock": {Sig: "func FcntlFlock(fd uintptr, cmd int, lk *Flock_t) error", Doc: "FcntlFlock performs a fcntl syscall for the [F_GETLK], [F_SETLK] or [F_SETLKW] command.", Pos: "flock_linux.go:14"},
+		"syscall.LsfStmt": {Sig: "func LsfStmt(code, k int) *SockFilter", Doc: "Deprecated: Use golang.org/x/net/bpf instead.", Pos: "lsf_linux.go:14"},
+		"syscall.LsfJump": {Sig: "func LsfJump(code, k, jt, jf int) *SockFilter", Doc: "Deprecated: Use golang.org/x/net/bpf instead.", Pos: "lsf_linux.go:19"},
+		"syscall.LsfSocket": {Sig: "func LsfSocket(ifindex, proto int) (int, error)", Doc: "Deprecated: Use golang.org/x/net/bpf instead.", Pos: "lsf_linux.go:24"},
+		"syscall.SetLsfPromisc": {Sig: "func SetLsfPromisc(name string, m bool) error", Doc: "Deprecated: Use golang.org/x/net/bpf instead.", Pos: "lsf_linux.go:50"},
+		"syscall.AttachLsf": {Sig: "func AttachLsf(fd int, i []SockFilter) error", Doc: "Deprecated: Use golang.org/x/net/bpf instead.", Pos: "lsf_linux.go:75"},
+		"syscall.DetachLsf": {Sig: "func DetachLsf(fd int) error", Doc: "Deprecated: Use golang.org/x/net/bpf instead.", Pos: "lsf_linux.go:83"},
+		"syscall.RawConn": {Sig: "type RawConn interface", Doc: "A RawConn is a raw network connection.", Pos: "net.go:8"},
+		"syscall.Conn": {Sig: "type Conn interface", Doc: "Conn is implemented by some types in the net and os packages to provide access to the underlying file descriptor or handle.", Pos: "net.go:31"},
+		"syscall.NetlinkRouteRequest": {Sig: "type NetlinkRouteRequest struct", Doc: "NetlinkRouteRequest represents a request message to receive routing and link states from the kernel.", Pos: "netlink_linux.go:27"},
+		"syscall.NetlinkRIB": {Sig: "func NetlinkRIB(proto, family int) ([]byte, error)", Doc: "NetlinkRIB returns routing information base, as known as RIB, which consists of network facility information, states and parameters.", Pos: "netlink_linux.go:60"},
+		"syscall.NetlinkMessage": {Sig: "type NetlinkMessage struct", Doc: "NetlinkMessage represents a netlink message.", Pos: "netlink_linux.go:118"},
+		"syscall.ParseNetlinkMessage": {Sig: "func ParseNetlinkMessage(b []byte) ([]NetlinkMessage, error)", Doc: "ParseNetlinkMessage parses b as an array of netlink messages and returns the slice containing the NetlinkMessage structures.", Pos: "netlink_linux.go:125"},
+		"syscall.NetlinkRouteAttr": {Sig: "type NetlinkRouteAttr struct", Doc: "NetlinkRouteAttr represents a netlink route attribute.", Pos: "netlink_linux.go:149"},
+		"syscall.ParseNetlinkRouteAttr": {Sig: "func ParseNetlinkRouteAttr(m *NetlinkMessage) ([]NetlinkRouteAttr, error)", Doc: "ParseNetlinkRouteAttr parses m's payload as an array of netlink route attributes and returns the slice containing the NetlinkRouteAttr structures.", Pos: "netlink_linux.go:157"},
+		"syscall.Setrlimit": {Sig: "func Setrlimit(resource int, rlim *Rlimit) error", Doc: "", Pos: "rlimit.go:49"},
+		"syscall.UnixCredentials": {Sig: "func UnixCredentials(ucred *Ucred) []byte", Doc: "UnixCredentials encodes credentials into a socket control message for sending to another process.", Pos: "sockcmsg_linux.go:14"},
+		"syscall.ParseUnixCredentials": {Sig: "func ParseUnixCredentials(m *SocketControlMessage) (*Ucred, error)", Doc: "ParseUnixCredentials decodes a socket control message that contains credentials in a Ucred structure.", Pos: "sockcmsg_linux.go:27"},
+		"syscall.CmsgLen": {Sig: "func CmsgLen(datalen int) int", Doc: "CmsgLen returns the value to store in the Len field of the [Cmsghdr] structure, taking into account any necessary alignment.", Pos: "sockcmsg_unix.go:17"},
+		"syscall.CmsgSpace": {Sig: "func CmsgSpace(datalen int) int", Doc: "CmsgSpace returns the number of bytes an ancillary element with payload of the passed data length occupies.", Pos: "sockcmsg_unix.go:23"},
+		"syscall.SocketControlMessage": {Sig: "type SocketControlMessage struct", Doc: "SocketControlMessage represents a socket control message.", Pos: "sockcmsg_unix.go:32"},
+		"syscall.ParseSocketControlMessage": {Sig: "func ParseSocketControlMessage(b []byte) ([]SocketControlMessage, error)", Doc: "ParseSocketControlMessage parses b as an array of socket control messages.", Pos: "sockcmsg_unix.go:39"},
+		"syscall.UnixRights": {Sig: "func UnixRights(fds ...int) []byte", Doc: "UnixRights encodes a set of open file descriptors into a socket control message for sending to another process.", Pos: "sockcmsg_unix.go:64"},
+		"syscall.ParseUnixRights": {Sig: "func ParseUnixRights(m *SocketControlMessage) ([]int, error)", Doc: "ParseUnixRights decodes a socket control message that contains an integer array of open file descriptors from another process.", Pos: "sockcmsg_unix.go:79"},
+		"syscall.StringByteSlice": {Sig: "func StringByteSlice(s string) []byte", Doc: "StringByteSlice converts a string to a NUL-terminated []byte, If s contains a NUL byte this function panics instead of returning an error.", Pos: "syscall.go:37"},
+		"syscall.ByteSliceFromString": {Sig: "func ByteSliceFromString(s string) ([]byte, error)", Doc: "ByteSliceFromString returns a NUL-terminated slice of bytes containing the text of s.", Pos: "syscall.go:48"},
+		"syscall.StringBytePtr": {Sig: "func StringBytePtr(s string) *byte", Doc: "StringBytePtr returns a pointer to a NUL-terminated array of bytes.", Pos: "syscall.go:62"},
+		"syscall.BytePtrFromString": {Sig: "func BytePtrFromString(s string) (*byte, error)", Doc: "BytePtrFromString returns a pointer to a NUL-terminated array of bytes containing the text of s.", Pos: "syscall.go:67"},
+		"syscall.Timespec.Unix": {Sig: "func (ts *Timespec) Unix() (sec int64, nsec int64)", Doc: "Unix returns the time stored in ts as seconds plus nanoseconds.", Pos: "syscall.go:80"},
+		"syscall.Timeval.Unix": {Sig: "func (tv *Timeval) Unix() (sec int64, nsec int64)", Doc: "Unix returns the time stored in tv as seconds plus nanoseconds.", Pos: "syscall.go:85"},
+		"syscall.Timespec.Nano": {Sig: "func (ts *Timespec) Nano() int64", Doc: "Nano returns the time stored in ts as nanoseconds.", Pos: "syscall.go:90"},
+		"syscall.Timeval.Nano": {Sig: "func (tv *Timeval) Nano() int64", Doc: "Nano returns the time stored in tv as nanoseconds.", Pos: "syscall.go:95"},
+		"syscall.Getpagesize": {Sig: "func Getpagesize() int", Doc: "", Pos: "syscall.go:101"},
+		"syscall.Exit": {Sig: "func Exit(code int)", Doc: "", Pos: "syscall.go:102"},
+		"syscall.RawSyscall": {Sig: "func RawSyscall(trap, a1, a2, a3 uintptr) (r1, r2 uintptr, err Errno)", Doc: "", Pos: "syscall_linux.go:55"},
+		"syscall.RawSyscall6": {Sig: "func RawSyscall6(trap, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr, err Errno)", Doc: "", Pos: "syscall_linux.go:63"},
+		"syscall.Syscall": {Sig: "func Syscall(trap, a1, a2, a3 uintptr) (r1, r2 uintptr, err Errno)", Doc: "", Pos: "syscall_linux.go:73"},
+		"syscall.Syscall6": {Sig: "func Syscall6(trap, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr, err Errno)", Doc: "", Pos: "syscall_linux.go:95"},
+		"syscall.Access": {Sig: "func Access(path string, mode uint32) (err error)", Doc: "", Pos: "syscall_linux.go:109"},
+		"syscall.Chmod": {Sig: "func Chmod(path string, mode uint32) (err error)", Doc: "", Pos: "syscall_linux.go:113"},
+		"syscall.Chown": {Sig: "func Chown(path string, uid int, gid int) (err error)", Doc: "", Pos: "syscall_linux.go:117"},
+		"syscall.Creat": {Sig: "func Creat(path string, mode uint32) (fd int, err error)", Doc: "", Pos: "syscall_linux.go:121"},
+		"syscall.EpollCreate": {Sig: "func EpollCreate(size int) (fd int, err error)", Doc: "", Pos: "syscall_linux.go:125"},
+		"syscall.Faccessat": {Sig: "func Faccessat(dirfd int, path string, mode uint32, flags int) (err error)", Doc: "", Pos: "syscall_linux.go:154"},
+		"syscall.Fchmodat": {Sig: "func Fchmodat(dirfd int, path string, mode uint32, flags int) error", Doc: "", Pos: "syscall_linux.go:246"},
+		"syscall.Link": {Sig: "func Link(oldpath string, newpath string) (err error)", Doc: "", Pos: "syscall_linux.go:267"},
+		"syscall.Mkdir": {Sig: "func Mkdir(path string, mode uint32) (err error)", Doc: "", Pos: "syscall_linux.go:271"},
+		"syscall.Mknod": {Sig: "func Mknod(path string, mode uint32, dev int) (err error)", Doc: "", Pos: "syscall_linux.go:275"},
+		"syscall.Open": {Sig: "func Open(path string, mode int, perm uint32) (fd int, err error)", Doc: "", Pos: "syscall_linux.go:279"},
+		"syscall.Openat": {Sig: "func Openat(dirfd int, path string, flags int, mode uint32) (fd int, err error)", Doc: "", Pos: "syscall_linux.go:285"},
+		"syscall.Pipe": {Sig: "func Pipe(p []int) error", Doc: "", Pos: "syscall_linux.go:289"},
+		"syscall.Pipe2": {Sig: "func Pipe2(p []int, flags int) error", Doc: "", Pos: "syscall_linux.go:295"},
+		"syscall.Readlink": {Sig: "func Readlink(path string, buf []byte) (n int, err error)", Doc: "", Pos: "syscall_linux.go:310"},
+		"syscall.Rename": {Sig: "func Rename(oldpath string, newpath string) (err error)", Doc: "", Pos: "syscall_linux.go:314"},
+		"syscall.Rmdir": {Sig: "func Rmdir(path string) error", Doc: "", Pos: "syscall_linux.go:318"},
+		"syscall.Symlink": {Sig: "func Symlink(oldpath string, newpath string) (err error)", Doc: "", Pos: "syscall_linux.go:324"},
+		"syscall.Unlink": {Sig: "func Unlink(path string) error", Doc: "", Pos: "syscall_linux.go:328"},
+		"syscall.Unlinkat": {Sig: "func Unlinkat(dirfd int, path string) error", Doc: "", Pos: "syscall_linux.go:334"},
+		"syscall.Utimes": {Sig: "func Utimes(path string, tv []Timeval) (err error)", Doc: "", Pos: "syscall_linux.go:338"},
+		"syscall.UtimesNano": {Sig: "func UtimesNano(path string, ts []Timespec) (err error)", Doc: "", Pos: "syscall_linux.go:347"},
+		"syscall.Futimesat": {Sig: "func Futimesat(dirfd int, path string, tv []Timeval) (err error)", Doc: "", Pos: "syscall_linux.go:354"},
+		"syscall.Futimes": {Sig: "func Futimes(fd int, tv []Timeval) (err error)", Doc: "", Pos: "syscall_linux.go:361"},
+		"syscall.ImplementsGetwd": {Sig: "const ImplementsGetwd", Doc: "", Pos: "syscall_linux.go:367"},
+		"syscall.Getwd": {Sig: "func Getwd() (wd string, err error)", Doc: "", Pos: "syscall_linux.go:371"},
+		"syscall.Getgroups": {Sig: "func Getgroups() (gids []int, err error)", Doc: "", Pos: "syscall_linux.go:391"},
+		"syscall.Setgroups": {Sig: "func Setgroups(gids []int) (err error)", Doc: "", Pos: "syscall_linux.go:419"},
+		"syscall.WaitStatus": {Sig: "type WaitStatus uint32", Doc: "", Pos: "syscall_linux.go:450"},
+		"syscall.WaitStatus.Exited": {Sig: "func (w WaitStatus) Exited() bool", Doc: "", Pos: "syscall_linux.go:469"},
+		"syscall.WaitStatus.Signaled": {Sig: "func (w WaitStatus) Signaled() bool", Doc: "", Pos: "syscall_linux.go:471"},
+		"syscall.WaitStatus.Stopped": {Sig: "func (w WaitStatus) Stopped() bool", Doc: "", Pos: "syscall_linux.go:473"},
+		"syscall.WaitStatus.Continued": {Sig: "func (w WaitStatus) Continued() bool", Doc: "", Pos: "syscall_linux.go:475"},
+		"syscall.WaitStatus.CoreDump": {Sig: "func (w WaitStatus) CoreDump() bool", Doc: "", Pos: "syscall_linux.go:477"},
+		"syscall.WaitStatus.ExitStatus": {Sig: "func (w WaitStatus) ExitStatus() int", Doc: "", Pos: "syscall_linux.go:479"},
+		"syscall.WaitStatus.Signal": {Sig: "func (w WaitStatus) Signal() Signal", Doc: "", Pos: "syscall_linux.go:486"},
+		"syscall.WaitStatus.StopSignal": {Sig: "func (w WaitStatus) StopSignal() Signal", Doc: "", Pos: "syscall_linux.go:493"},
+		"syscall.WaitStatus.TrapCause": {Sig: "func (w WaitStatus) TrapCause() int", Doc: "", Pos: "syscall_linux.go:500"},
+		"syscall.Wait4": {Sig: "func Wait4(pid int, wstatus *WaitStatus, options int, rusage *Rusage) (wpid int, err error)", Doc: "", Pos: "syscall_linux.go:509"},
+		"syscall.Mkfifo": {Sig: "func Mkfifo(path string, mode uint32) (err error)", Doc: "", Pos: "syscall_linux.go:518"},
+		"syscall.SockaddrLinklayer": {Sig: "type SockaddrLinklayer struct", Doc: "", Pos: "syscall_linux.go:581"},
+		"syscall.SockaddrNetlink": {Sig: "type SockaddrNetlink struct", Doc: "", Pos: "syscall_linux.go:605"},
+		"syscall.Accept": {Sig: "func Accept(fd int) (nfd int, sa Sockaddr, err error)", Doc: "", Pos: "syscall_linux.go:687"},
+		"syscall.Accept4": {Sig: "func Accept4(fd int, flags int) (nfd int, sa Sockaddr, err error)", Doc: "", Pos: "syscall_linux.go:691"},
+		"syscall.Getsockname": {Sig: "func Getsockname(fd int) (sa Sockaddr, err error)", Doc: "", Pos: "syscall_linux.go:709"},
+		"syscall.GetsockoptInet4Addr": {Sig: "func GetsockoptInet4Addr(fd, level, opt int) (value [4]byte, err error)", Doc: "", Pos: "syscall_linux.go:718"},
+		"syscall.GetsockoptIPMreq": {Sig: "func GetsockoptIPMreq(fd, level, opt int) (*IPMreq, error)", Doc: "", Pos: "syscall_linux.go:724"},
+		"syscall.GetsockoptIPMreqn": {Sig: "func GetsockoptIPMreqn(fd, level, opt int) (*IPMreqn, error)", Doc: "", Pos: "syscall_linux.go:731"},
+		"syscall.GetsockoptIPv6Mreq": {Sig: "func GetsockoptIPv6Mreq(fd, level, opt int) (*IPv6Mreq, error)", Doc: "", Pos: "syscall_linux.go:738"},
+		"syscall.GetsockoptIPv6MTUInfo": {Sig: "func GetsockoptIPv6MTUInfo(fd, level, opt int) (*IPv6MTUInfo, error)", Doc: "", Pos: "syscall_linux.go:745"},
+		"syscall.GetsockoptICMPv6Filter": {Sig: "func GetsockoptICMPv6Filter(fd, level, opt int) (*ICMPv6Filter, error)", Doc: "", Pos: "syscall_linux.go:752"},
+		"syscall.GetsockoptUcred": {Sig: "func GetsockoptUcred(fd, level, opt int) (*Ucred, error)", Doc: "", Pos: "syscall_linux.go:759"},
+		"syscall.SetsockoptIPMreqn": {Sig: "func SetsockoptIPMreqn(fd, level, opt int, mreq *IPMreqn) (err error)", Doc: "", Pos: "syscall_linux.go:766"},
+		"syscall.BindToDevice": {Sig: "func BindToDevice(fd int, device string) (err error)", Doc: "BindToDevice binds the socket associated with fd to device.", Pos: "syscall_linux.go:844"},
+		"syscall.PtracePeekText": {Sig: "func PtracePeekText(pid int, addr uintptr, out []byte) (count int, err error)", Doc: "", Pos: "syscall_linux.go:891"},
+		"syscall.PtracePeekData": {Sig: "func PtracePeekData(pid int, addr uintptr, out []byte) (count int, err error)", Doc: "", Pos: "syscall_linux.go:895"},
+		"syscall.PtracePokeText": {Sig: "func PtracePokeText(pid int, addr uintptr, data []byte) (count int, err error)", Doc: "", Pos: "syscall_linux.go:950"},
+		"syscall.PtracePokeData": {Sig: "func PtracePokeData(pid int, addr uintptr, data []byte) (count int, err error)", Doc: "", Pos: "syscall_linux.go:954"},
+		"syscall.PtraceGetRegs": {Sig: "func PtraceGetRegs(pid int, regsout *PtraceRegs) (err error)", Doc: "", Pos: "syscall_linux.go:962"},
+		"syscall.PtraceSetRegs": {Sig: "func PtraceSetRegs(pid int, regs *PtraceRegs) (err error)", Doc: "", Pos: "syscall_linux.go:969"},
+		"syscall.PtraceSetOptions": {Sig: "func PtraceSetOptions(pid int, options int) (err error)", Doc: "", Pos: "syscall_linux.go:976"},
+		"syscall.PtraceGetEventMsg": {Sig: "func PtraceGetEventMsg(pid int) (msg uint, err error)", Doc: "", Pos: "syscall_linux.go:980"},
+		"syscall.PtraceCont": {Sig: "func PtraceCont(pid int, signal int) (err error)", Doc: "", Pos: "syscall_linux.go:987"},
+		"syscall.PtraceSyscall": {Sig: "func PtraceSyscall(pid int, signal int) (err error)", Doc: "", Pos: "syscall_linux.go:991"},
+		"syscall.PtraceSingleStep": {Sig: "func PtraceSingleStep(pid int) (err error)", Doc: "", Pos: "syscall_linux.go:995"},
+		"syscall.PtraceAttach": {Sig: "func PtraceAttach(pid int) (err error)", Doc: "", Pos: "syscall_linux.go:997"},
+		"syscall.PtraceDetach": {Sig: "func PtraceDetach(pid int) (err error)", Doc: "", Pos: "syscall_linux.go:999"},
+		"syscall.Reboot": {Sig: "func Reboot(cmd int) (err error)", Doc: "", Pos: "syscall_linux.go:1003"},
+		"syscall.ReadDirent": {Sig: "func ReadDirent(fd int, buf []byte) (n int, err error)", Doc: "", Pos: "syscall_linux.go:1007"},
+		"syscall.Mount": {Sig: "func Mount(source string, target string, fstype string, flags uintptr, data string) (err error)", Doc: "", Pos: "syscall_linux.go:1029"},
+		"syscall.Getpgrp": {Sig: "func Getpgrp() (pid int)", Doc: "", Pos: "syscall_linux.go:1069"},
+		"syscall.AllThreadsSyscall": {Sig: "func AllThreadsSyscall(trap, a1, a2, a3 uintptr) (r1, r2 uintptr, err Errno)", Doc: "AllThreadsSyscall performs a syscall on each OS thread of the Go runtime.", Pos: "syscall_linux.go:1123"},
+		"syscall.AllThreadsSyscall6": {Sig: "func AllThreadsSyscall6(trap, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr, err Errno)", Doc: "AllThreadsSyscall6 is like [AllThreadsSyscall], but extended to six arguments.", Pos: "syscall_linux.go:1135"},
+		"syscall.Setegid": {Sig: "func Setegid(egid int) (err error)", Doc: "", Pos: "syscall_linux.go:1152"},
+		"syscall.Seteuid": {Sig: "func Seteuid(euid int) (err error)", Doc: "", Pos: "syscall_linux.go:1165"},
+		"syscall.Setgid": {Sig: "func Setgid(gid int) (err error)", Doc: "", Pos: "syscall_linux.go:1178"},
+		"syscall.Setregid": {Sig: "func Setregid(rgid, egid int) (err error)", Doc: "", Pos: "syscall_linux.go:1191"},
+		"syscall.Setresgid": {Sig: "func Setresgid(rgid, egid, sgid int) (err error)", Doc: "", Pos: "syscall_linux.go:1204"},
+		"syscall.Setresuid": {Sig: "func Setresuid(ruid, euid, suid int) (err error)", Doc: "", Pos: "syscall_linux.go:1217"},
+		"syscall.Setreuid": {Sig: "func Setreuid(ruid, euid int) (err error)", Doc: "", Pos: "syscall_linux.go:1230"},
+		"syscall.Setuid": {Sig: "func Setuid(uid int) (err error)", Doc: "", Pos: "syscall_linux.go:1243"},
+		"syscall.Mmap": {Sig: "func Mmap(fd int, offset int64, length int, prot int, flags int) (data []byte, err error)", Doc: "", Pos: "syscall_linux.go:1278"},
+		"syscall.Munmap": {Sig: "func Munmap(b []byte) (err error)", Doc: "", Pos: "syscall_linux.go:1282"},
+		"syscall.Getrlimit": {Sig: "func Getrlimit(resource int, rlim *Rlimit) (err error)", Doc: "", Pos: "syscall_linux.go:1293"},
+		"syscall.Stat": {Sig: "func Stat(path string, stat *Stat_t) (err error)", Doc: "", Pos: "syscall_linux_amd64.go:62"},
+		"syscall.Lchown": {Sig: "func Lchown(path string, uid int, gid int) (err error)", Doc: "", Pos: "syscall_linux_amd64.go:66"},
+		"syscall.Lstat": {Sig: "func Lstat(path string, stat *Stat_t) (err error)", Doc: "", Pos: "syscall_linux_amd64.go:70"},
+		"syscall.Gettimeofday": {Sig: "func Gettimeofday(tv *Timeval) (err error)", Doc: "", Pos: "syscall_linux_amd64.go:82"},
+		"syscall.Time": {Sig: "func Time(t *Time_t) (tt Time_t, err error)", Doc: "", Pos: "syscall_linux_amd64.go:90"},
+		"syscall.PtraceRegs.PC": {Sig: "func (r *PtraceRegs) PC() uint64", Doc: "", Pos: "syscall_linux_amd64.go:113"},
+		"syscall.PtraceRegs.SetPC": {Sig: "func (r *PtraceRegs) SetPC(pc uint64)", Doc: "", Pos: "syscall_linux_amd64.go:115"},
+		"syscall.Iovec.SetLen": {Sig: "func (iov *Iovec) SetLen(length int)", Doc: "", Pos: "syscall_linux_amd64.go:117"},
+		"syscall.Msghdr.SetControllen": {Sig: "func (msghdr *Msghdr) SetControllen(length int)", Doc: "", Pos: "syscall_linux_amd64.go:121"},
+		"syscall.Cmsghdr.SetLen": {Sig: "func (cmsg *Cmsghdr) SetLen(length int)", Doc: "", Pos: "syscall_linux_amd64.go:125"},
+		"syscall.Stdin": {Sig: "var Stdin", Doc: "", Pos: "syscall_unix.go:23"},
+		"syscall.Stdout": {Sig: "var Stdout", Doc: "", Pos: "syscall_unix.go:24"},
+		"syscall.Stderr": {Sig: "var Stderr", Doc: "", Pos: "syscall_unix.go:25"},
+		"syscall.Errno": {Sig: "type Errno uintptr", Doc: "An Errno is an unsigned number describing an error condition.", Pos: "syscall_unix.go:108"},
+		"syscall.Errno.Error": {Sig: "func (e Errno) Error() string", Doc: "", Pos: "syscall_unix.go:110"},
+		"syscall.Errno.Is": {Sig: "func (e Errno) Is(target error) bool", Doc: "", Pos: "syscall_unix.go:120"},
+		"syscall.Errno.Temporary": {Sig: "func (e Errno) Temporary() bool", Doc: "", Pos: "syscall_unix.go:134"},
+		"syscall.Errno.Timeout": {Sig: "func (e Errno) Timeout() bool", Doc: "", Pos: "syscall_unix.go:138"},
+		"syscall.Signal": {Sig: "type Signal int", Doc: "A Signal is a number describing a process signal.", Pos: "syscall_unix.go:168"},
+		"syscall.Signal.Signal": {Sig: "func (s Signal) Signal()", Doc: "", Pos: "syscall_unix.go:170"},
+		"syscall.Signal.String": {Sig: "func (s Signal) String() string", Doc: "", Pos: "syscall_unix.go:172"},
+		"syscall.Read": {Sig: "func Read(fd int, p []byte) (n int, err error)", Doc: "", Pos: "syscall_unix.go:182"},
+		"syscall.Write": {Sig: "func Write(fd int, p []byte) (n int, err error)", Doc: "", Pos: "syscall_unix.go:201"},
+		"syscall.Pread": {Sig: "func Pread(fd int, p []byte, offset int64) (n int, err error)", Doc: "", Pos: "syscall_unix.go:225"},
+		"syscall.Pwrite": {Sig: "func Pwrite(fd int, p []byte, offset int64) (n int, err error)", Doc: "", Pos: "syscall_unix.go:244"},
+		"syscall.SocketDisableIPv6": {Sig: "var SocketDisableIPv6 bool", Doc: "For testing: clients can set this flag to force creation of IPv6 sockets to return [EAFNOSUPPORT].", Pos: "syscall_unix.go:263"},
+		"syscall.Sockaddr": {Sig: "type Sockaddr interface", Doc: "", Pos: "syscall_unix.go:265"},
+		"syscall.SockaddrInet4": {Sig: "type SockaddrInet4 struct", Doc: "", Pos: "syscall_unix.go:269"},
+		"syscall.SockaddrInet6": {Sig: "type SockaddrInet6 struct", Doc: "", Pos: "syscall_unix.go:275"},
+		"syscall.SockaddrUnix": {Sig: "type SockaddrUnix struct", Doc: "", Pos: "syscall_unix.go:282"},
+		"syscall.Bind": {Sig: "func Bind(fd int, sa Sockaddr) (err error)", Doc: "", Pos: "syscall_unix.go:287"},
+		"syscall.Connect": {Sig: "func Connect(fd int, sa Sockaddr) (err error)", Doc: "", Pos: "syscall_unix.go:295"},
+		"syscall.Getpeername": {Sig: "func Getpeername(fd int) (sa Sockaddr, err error)", Doc: "", Pos: "syscall_unix.go:303"},
+		"syscall.GetsockoptInt": {Sig: "func GetsockoptInt(fd, level, opt int) (value int, err error)", Doc: "", Pos: "syscall_unix.go:312"},
+		"syscall.Recvfrom": {Sig: "func Recvfrom(fd int, p []byte, flags int) (n int, from Sockaddr, err error)", Doc: "", Pos: "syscall_unix.go:319"},
+		"syscall.Recvmsg": {Sig: "func Recvmsg(fd int, p, oob []byte, flags int) (n, oobn int, recvflags int, from Sockaddr, err error)", Doc: "", Pos: "syscall_unix.go:385"},
+		"syscall.Sendmsg": {Sig: "func Sendmsg(fd int, p, oob []byte, to Sockaddr, flags int) (err error)", Doc: "", Pos: "syscall_unix.go:398"},
+		"syscall.SendmsgN": {Sig: "func SendmsgN(fd int, p, oob []byte, to Sockaddr, flags int) (n int, err error)", Doc: "", Pos: "syscall_unix.go:403"},
+		"syscall.Sendto": {Sig: "func Sendto(fd int, p []byte, flags int, to Sockaddr) (err error)", Doc: "", Pos: "syscall_unix.go:455"},
+		"syscall.SetsockoptByte": {Sig: "func SetsockoptByte(fd, level, opt int, value byte) (err error)", Doc: "", Pos: "syscall_unix.go:469"},
+		"syscall.SetsockoptInt": {Sig: "func SetsockoptInt(fd, level, opt int, value int) (err error)", Doc: "", Pos: "syscall_unix.go:473"},
+		"syscall.SetsockoptInet4Addr": {Sig: "func SetsockoptInet4Addr(fd, level, opt int, value [4]byte) (err error)", Doc: "", Pos: "syscall_unix.go:478"},
+		"syscall.SetsockoptIPMreq": {Sig: "func SetsockoptIPMreq(fd, level, opt int, mreq *IPMreq) (err error)", Doc: "", Pos: "syscall_unix.go:482"},
+		"syscall.SetsockoptIPv6Mreq": {Sig: "func SetsockoptIPv6Mreq(fd, level, opt int, mreq *IPv6Mreq) (err error)", Doc: "", Pos: "syscall_unix.go:486"},
+		"syscall.SetsockoptICMPv6Filter": {Sig: "func SetsockoptICMPv6Filter(fd, level, opt int, filter *ICMPv6Filter) error", Doc: "", Pos: "syscall_unix.go:490"},
+		"syscall.SetsockoptLinger": {Sig: "func SetsockoptLinger(fd, level, opt int, l *Linger) (err error)", Doc: "", Pos: "syscall_unix.go:494"},
+		"syscall.SetsockoptString": {Sig: "func SetsockoptString(fd, level, opt int, s string) (err error)", Doc: "", Pos: "syscall_unix.go:498"},
+		"syscall.SetsockoptTimeval": {Sig: "func SetsockoptTimeval(fd, level, opt int, tv *Timeval) (err error)", Doc: "", Pos: "syscall_unix.go:506"},
+		"syscall.Socket": {Sig: "func Socket(domain, typ, proto int) (fd int, err error)", Doc: "", Pos: "syscall_unix.go:510"},
+		"syscall.Socketpair": {Sig: "func Socketpair(domain, typ, proto int) (fd [2]int, err error)", Doc: "", Pos: "syscall_unix.go:518"},
+		"syscall.Sendfile": {Sig: "func Sendfile(outfd int, infd int, offset *int64, count int) (written int, err error)", Doc: "Sendfile copies up to count bytes from file descriptor infd to file descriptor outfd.", Pos: "syscall_unix.go:544"},
+		"syscall.TimespecToNsec": {Sig: "func TimespecToNsec(ts Timespec) int64", Doc: "TimespecToNsec returns the time stored in ts as nanoseconds.", Pos: "timestruct.go:10"},
+		"syscall.NsecToTimespec": {Sig: "func NsecToTimespec(nsec int64) Timespec", Doc: "NsecToTimespec converts a number of nanoseconds into a [Timespec].", Pos: "timestruct.go:13"},
+		"syscall.TimevalToNsec": {Sig: "func TimevalToNsec(tv Timeval) int64", Doc: "TimevalToNsec returns the time stored in tv as nanoseconds.", Pos: "timestruct.go:24"},
+		"syscall.NsecToTimeval": {Sig: "func NsecToTimeval(nsec int64) Timeval", Doc: "NsecToTimeval converts a number of nanoseconds into a [Timeval].", Pos: "timestruct.go:27"},
+		"syscall.AF_ALG": {Sig: "const AF_ALG", Doc: "", Pos: "zerrors_linux_amd64.go:10"},
+		"syscall.AF_APPLETALK": {Sig: "const AF_APPLETALK", Doc: "", Pos: "zerrors_linux_amd64.go:11"},
+		"syscall.AF_ASH": {Sig: "const AF_ASH", Doc: "", Pos: "zerrors_linux_amd64.go:12"},
+		"syscall.AF_ATMPVC": {Sig: "const AF_ATMPVC", Doc: "", Pos: "zerrors_linux_amd64.go:13"},
+		"syscall.AF_ATMSVC": {Sig: "const AF_ATMSVC", Doc: "", Pos: "zerrors_linux_amd64.go:14"},
+		"syscall.AF_AX25": {Sig: "const AF_AX25", Doc: "", Pos: "zerrors_linux_amd64.go:15"},
+		"syscall.AF_BLUETOOTH": {Sig: "const AF_BLUETOOTH", Doc: "", Pos: "zerrors_linux_amd64.go:16"},
+		"syscall.AF_BRIDGE": {Sig: "const AF_BRIDGE", Doc: "", Pos: "zerrors_linux_amd64.go:17"},
+		"syscall.AF_CAIF": {Sig: "const AF_CAIF", Doc: "", Pos: "zerrors_linux_amd64.go:18"},
+		"syscall.AF_CAN": {Sig: "const AF_CAN", Doc: "", Pos: "zerrors_linux_amd64.go:19"},
+		"syscall.AF_DECnet": {Sig: "const AF_DECnet", Doc: "", Pos: "zerrors_linux_amd64.go:20"},
+		"syscall.AF_ECONET": {Sig: "const AF_ECONET", Doc: "", Pos: "zerrors_linux_amd64.go:21"},
+		"syscall.AF_FILE": {Sig: "const AF_FILE", Doc: "", Pos: "zerrors_linux_amd64.go:22"},
+		"syscall.AF_IEEE802154": {Sig: "const AF_IEEE802154", Doc: "", Pos: "zerrors_linux_amd64.go:23"},
+		"syscall.AF_INET": {Sig: "const AF_INET", Doc: "", Pos: "zerrors_linux_amd64.go:24"},
+		"syscall.AF_INET6": {Sig: "const AF_INET6", Doc: "", Pos: "zerrors_linux_amd64.go:25"},
+		"syscall.AF_IPX": {Sig: "const AF_IPX", Doc: "", Pos: "zerrors_linux_amd64.go:26"},
+		"syscall.AF_IRDA": {Sig: "const AF_IRDA", Doc: "", Pos: "zerrors_linux_amd64.go:27"},
+		"syscall.AF_ISDN": {Sig: "const AF_ISDN", Doc: "", Pos: "zerrors_linux_amd64.go:28"},
+		"syscall.AF_IUCV": {Sig: "const AF_IUCV", Doc: "", Pos: "zerrors_linux_amd64.go:29"},
+		"syscall.AF_KEY": {Sig: "const AF_KEY", Doc: "", Pos: "zerrors_linux_amd64.go:30"},
+		"syscall.AF_LLC": {Sig: "const AF_LLC", Doc: "", Pos: "zerrors_linux_amd64.go:31"},
+		"syscall.AF_LOCAL": {Sig: "const AF_LOCAL", Doc: "", Pos: "zerrors_linux_amd64.go:32"},
+		"syscall.AF_MAX": {Sig: "const AF_MAX", Doc: "", Pos: "zerrors_linux_amd64.go:33"},
+		"syscall.AF_NETBEUI": {Sig: "const AF_NETBEUI", Doc: "", Pos: "zerrors_linux_amd64.go:34"},
+		"syscall.AF_NETLINK": {Sig: "const AF_NETLINK", Doc: "", Pos: "zerrors_linux_amd64.go:35"},
+		"syscall.AF_NETROM": {Sig: "const AF_NETROM", Doc: "", Pos: "zerrors_linux_amd64.go:36"},
+		"syscall.AF_PACKET": {Sig: "const AF_PACKET", Doc: "", Pos: "zerrors_linux_amd64.go:37"},
+		"syscall.AF_PHONET": {Sig: "const AF_PHONET", Doc: "", Pos: "zerrors_linux_amd64.go:38"},
+		"syscall.AF_PPPOX": {Sig: "const AF_PPPOX", Doc: "", Pos: "zerrors_linux_amd64.go:39"},
+		"syscall.AF_RDS": {Sig: "const AF_RDS", Doc: "", Pos: "zerrors_linux_amd64.go:40"},
+		"syscall.AF_ROSE": {Sig: "const AF_ROSE", Doc: "", Pos: "zerrors_linux_amd64.go:41"},
+		"syscall.AF_ROUTE": {Sig: "const AF_ROUTE", Doc: "", Pos: "zerrors_linux_amd64.go:42"},
+		"syscall.AF_RXRPC": {Sig: "const AF_RXRPC", Doc: "", Pos: "zerrors_linux_amd64.go:43"},
+		"syscall.AF_SECURITY": {Sig: "const AF_SECURITY", Doc: "", Pos: "zerrors_linux_amd64.go:44"},
+		"syscall.AF_SNA": {Sig: "const AF_SNA", Doc: "", Pos: "zerrors_linux_amd64.go:45"},
+		"syscall.AF_TIPC": {Sig: "const AF_TIPC", Doc: "", Pos: "zerrors_linux_amd64.go:46"},
+		"syscall.AF_UNIX": {Sig: "const AF_UNIX", Doc: "", Pos: "zerrors_linux_amd64.go:47"},
+		"syscall.AF_UNSPEC": {Sig: "const AF_UNSPEC", Doc: "", Pos: "zerrors_linux_amd64.go:48"},
+		"syscall.AF_WANPIPE": {Sig: "const AF_WANPIPE", Doc: "", Pos: "zerrors_linux_amd64.go:49"},
+		"syscall.AF_X25": {Sig: "const AF_X25", Doc: "", Pos: "zerrors_linux_amd64.go:50"},
+		"syscall.ARPHRD_ADAPT": {Sig: "const ARPHRD_ADAPT", Doc: "", Pos: "zerrors_linux_amd64.go:51"},
+		"syscall.ARPHRD_APPLETLK": {Sig: "const ARPHRD_APPLETLK", Doc: "", Pos: "zerrors_linux_amd64.go:52"},
+		"syscall.ARPHRD_ARCNET": {Sig: "const ARPHRD_ARCNET", Doc: "", Pos: "zerrors_linux_amd64.go:53"},
+		"syscall.ARPHRD_ASH": {Sig: "const ARPHRD_ASH", Doc: "", Pos: "zerrors_linux_amd64.go:54"},
+		"syscall.ARPHRD_ATM": {Sig: "const ARPHRD_ATM", Doc: "", Pos: "zerrors_linux_amd64.go:55"},
+		"syscall.ARPHRD_AX25": {Sig: "const ARPHRD_AX25", Doc: "", Pos: "zerrors_linux_amd64.go:56"},
+		"syscall.ARPHRD_BIF": {Sig: "const ARPHRD_BIF", Doc: "", Pos: "zerrors_linux_amd64.go:57"},
+		"syscall.ARPHRD_CHAOS": {Sig: "const ARPHRD_CHAOS", Doc: "", Pos: "zerrors_linux_amd64.go:58"},
+		"syscall.ARPHRD_CISCO": {Sig: "const ARPHRD_CISCO", Doc: "", Pos: "zerrors_linux_amd64.go:59"},
+		"syscall.ARPHRD_CSLIP": {Sig: "const ARPHRD_CSLIP", Doc: "", Pos: "zerrors_linux_amd64.go:60"},
+		"syscall.ARPHRD_CSLIP6": {Sig: "const ARPHRD_CSLIP6", Doc: "", Pos: "zerrors_linux_amd64.go:61"},
+		"syscall.ARPHRD_DDCMP": {Sig: "const ARPHRD_DDCMP", Doc: "", Pos: "zerrors_linux_amd64.go:62"},
+		"syscall.ARPHRD_DLCI": {Sig: "const ARPHRD_DLCI", Doc: "", Pos: "zerrors_linux_amd64.go:63"},
+		"syscall.ARPHRD_ECONET": {Sig: "const ARPHRD_ECONET", Doc: "", Pos: "zerrors_linux_amd64.go:64"},
+		"syscall.ARPHRD_EETHER": {Sig: "const ARPHRD_EETHER", Doc: "", Pos: "zerrors_linux_amd64.go:65"},
+		"syscall.ARPHRD_ETHER": {Sig: "const ARPHRD_ETHER", Doc: "", Pos: "zerrors_linux_amd64.go:66"},
+		"syscall.ARPHRD_EUI64": {Sig: "const ARPHRD_EUI64", Doc: "", Pos: "zerrors_linux_amd64.go:67"},
+		"syscall.ARPHRD_FCAL": {Sig: "const ARPHRD_FCAL", Doc: "", Pos: "zerrors_linux_amd64.go:68"},
+		"syscall.ARPHRD_FCFABRIC": {Sig: "const ARPHRD_FCFABRIC", Doc: "", Pos: "zerrors_linux_amd64.go:69"},
+		"syscall.ARPHRD_FCPL": {Sig: "const ARPHRD_FCPL", Doc: "", Pos: "zerrors_linux_amd64.go:70"},
+		"syscall.ARPHRD_FCPP": {Sig: "const ARPHRD_FCPP", Doc: "", Pos: "zerrors_linux_amd64.go:71"},
+		"syscall.ARPHRD_FDDI": {Sig: "const ARPHRD_FDDI", Doc: "", Pos: "zerrors_linux_amd64.go:72"},
+		"syscall.ARPHRD_FRAD": {Sig: "const ARPHRD_FRAD", Doc: "", Pos: "zerrors_linux_amd64.go:73"},
+		"syscall.ARPHRD_HDLC": {Sig: "const ARPHRD_HDLC", Doc: "", Pos: "zerrors_linux_amd64.go:74"},
+		"syscall.ARPHRD_HIPPI": {Sig: "const ARPHRD_HIPPI", Doc: "", Pos: "zerrors_linux_amd64.go:75"},
+		"syscall.ARPHRD_HWX25": {Sig: "const ARPHRD_HWX25", Doc: "", Pos: "zerrors_linux_amd64.go:76"},
+		"syscall.ARPHRD_IEEE1394": {Sig: "const ARPHRD_IEEE1394", Doc: "", Pos: "zerrors_linux_amd64.go:77"},
+		"syscall.ARPHRD_IEEE802": {Sig: "const ARPHRD_IEEE802", Doc: "", Pos: "zerrors_linux_amd64.go:78"},
+		"syscall.ARPHRD_IEEE80211": {Sig: "const ARPHRD_IEEE80211", Doc: "", Pos: "zerrors_linux_amd64.go:79"},
+		"syscall.ARPHRD_IEEE80211_PRISM": {Sig: "const ARPHRD_IEEE80211_PRISM", Doc: "", Pos: "zerrors_linux_amd64.go:80"},
+		"syscall.ARPHRD_IEEE80211_RADIOTAP": {Sig: "const ARPHRD_IEEE80211_RADIOTAP", Doc: "", Pos: "zerrors_linux_amd64.go:81"},
+		"syscall.ARPHRD_IEEE802154": {Sig: "const ARPHRD_IEEE802154", Doc: "", Pos: "zerrors_linux_amd64.go:82"},
+		"syscall.ARPHRD_IEEE802154_PHY": {Sig: "const ARPHRD_IEEE802154_PHY", Doc: "", Pos: "zerrors_linux_amd64.go:83"},
+		"syscall.ARPHRD_IEEE802_TR": {Sig: "const ARPHRD_IEEE802_TR", Doc: "", Pos: "zerrors_linux_amd64.go:84"},
+		"syscall.ARPHRD_INFINIBAND": {Sig: "const ARPHRD_INFINIBAND", Doc: "", Pos: "zerrors_linux_amd64.go:85"},
+		"syscall.ARPHRD_IPDDP": {Sig: "const ARPHRD_IPDDP", Doc: "", Pos: "zerrors_linux_amd64.go:86"},
+		"syscall.ARPHRD_IPGRE": {Sig: "const ARPHRD_IPGRE", Doc: "", Pos: "zerrors_linux_amd64.go:87"},
+		"syscall.ARPHRD_IRDA": {Sig: "const ARPHRD_IRDA", Doc: "", Pos: "zerrors_linux_amd64.go:88"},
+		"syscall.ARPHRD_LAPB": {Sig: "const ARPHRD_LAPB", Doc: "", Pos: "zerrors_linux_amd64.go:89"},
+		"syscall.ARPHRD_LOCALTLK": {Sig: "const ARPHRD_LOCALTLK", Doc: "", Pos: "zerrors_linux_amd64.go:90"},
+		"syscall.ARPHRD_LOOPBACK": {Sig: "const ARPHRD_LOOPBACK", Doc: "", Pos: "zerrors_linux_amd64.go:91"},
+		"syscall.ARPHRD_METRICOM": {Sig: "const ARPHRD_METRICOM", Doc: "", Pos: "zerrors_linux_amd64.go:92"},
+		"syscall.ARPHRD_NETROM": {Sig: "const ARPHRD_NETROM", Doc: "", Pos: "zerrors_linux_amd64.go:93"},
+		"syscall.ARPHRD_NONE": {Sig: "const ARPHRD_NONE", Doc: "", Pos: "zerrors_linux_amd64.go:94"},
+		"syscall.ARPHRD_PIMREG": {Sig: "const ARPHRD_PIMREG", Doc: "", Pos: "zerrors_linux_amd64.go:95"},
+		"syscall.ARPHRD_PPP": {Sig: "const ARPHRD_PPP", Doc: "", Pos: "zerrors_linux_amd64.go:96"},
+		"syscall.ARPHRD_PRONET": {Sig: "const ARPHRD_PRONET", Doc: "", Pos: "zerrors_linux_amd64.go:97"},
+		"syscall.ARPHRD_RAWHDLC": {Sig: "const ARPHRD_RAWHDLC", Doc: "", Pos: "zerrors_linux_amd64.go:98"},
+		"syscall.ARPHRD_ROSE": {Sig: "const ARPHRD_ROSE", Doc: "", Pos: "zerrors_linux_amd64.go:99"},
+		"syscall.ARPHRD_RSRVD": {Sig: "const ARPHRD_RSRVD", Doc: "", Pos: "zerrors_linux_amd64.go:100"},
+		"syscall.ARPHRD_SIT": {Sig: "const ARPHRD_SIT", Doc: "", Pos: "zerrors_linux_amd64.go:101"},
+		"syscall.ARPHRD_SKIP": {Sig: "const ARPHRD_SKIP", Doc: "", Pos: "zerrors_linux_amd64.go:102"},
+		"syscall.ARPHRD_SLIP": {Sig: "const ARPHRD_SLIP", Doc: "", Pos: "zerrors_linux_amd64.go:103"},
+		"syscall.ARPHRD_SLIP6": {Sig: "const ARPHRD_SLIP6", Doc: "", Pos: "zerrors_linux_amd64.go:104"},
+		"syscall.ARPHRD_TUNNEL": {Sig: "const ARPHRD_TUNNEL", Doc: "", Pos: "zerrors_linux_amd64.go:105"},
+		"syscall.ARPHRD_TUNNEL6": {Sig: "const ARPHRD_TUNNEL6", Doc: "", Pos: "zerrors_linux_amd64.go:106"},
+		"syscall.ARPHRD_VOID": {Sig: "const ARPHRD_VOID", Doc: "", Pos: "zerrors_linux_amd64.go:107"},
+		"syscall.ARPHRD_X25": {Sig: "const ARPHRD_X25", Doc: "", Pos: "zerrors_linux_amd64.go:108"},
+		"syscall.BPF_A": {Sig: "const BPF_A", Doc: "", Pos: "zerrors_linux_amd64.go:109"},
+		"syscall.BPF_ABS": {Sig: "const BPF_ABS", Doc: "", Pos: "zerrors_linux_amd64.go:110"},
+		"syscall.BPF_ADD": {Sig: "const BPF_ADD", Doc: "", Pos: "zerrors_linux_amd64.go:111"},
+		"syscall.BPF_ALU": {Sig: "const BPF_ALU", Doc: "", Pos: "zerrors_linux_amd64.go:112"},
+		"syscall.BPF_AND": {Sig: "const BPF_AND", Doc: "", Pos: "zerrors_linux_amd64.go:113"},
+		"syscall.BPF_B": {Sig: "const BPF_B", Doc: "", Pos: "zerrors_linux_amd64.go:114"},
+		"syscall.BPF_DIV": {Sig: "const BPF_DIV", Doc: "", Pos: "zerrors_linux_amd64.go:115"},
+		"syscall.BPF_H": {Sig: "const BPF_H", Doc: "", Pos: "zerrors_linux_amd64.go:116"},
+		"syscall.BPF_IMM": {Sig: "const BPF_IMM", Doc: "", Pos: "zerrors_linux_amd64.go:117"},
+		"syscall.BPF_IND": {Sig: "const BPF_IND", Doc: "", Pos: "zerrors_linux_amd64.go:118"},
+		"syscall.BPF_JA": {Sig: "const BPF_JA", Doc: "", Pos: "zerrors_linux_amd64.go:119"},
+		"syscall.BPF_JEQ": {Sig: "const BPF_JEQ", Doc: "", Pos: "zerrors_linux_amd64.go:120"},
+		"syscall.BPF_JGE": {Sig: "const BPF_JGE", Doc: "", Pos: "zerrors_linux_amd64.go:121"},
+		"syscall.BPF_JGT": {Sig: "const BPF_JGT", Doc: "", Pos: "zerrors_linux_amd64.go:122"},
+		"syscall.BPF_JMP": {Sig: "const BPF_JMP", Doc: "", Pos: "zerrors_linux_amd64.go:123"},
+		"syscall.BPF_JSET": {Sig: "const BPF_JSET", Doc: "", Pos: "zerrors_linux_amd64.go:124"},
+		"syscall.BPF_K": {Sig: "const BPF_K", Doc: "", Pos: "zerrors_linux_amd64.go:125"},
+		"syscall.BPF_LD": {Sig: "const BPF_LD", Doc: "", Pos: "zerrors_linux_amd64.go:126"},
+		"syscall.BPF_LDX": {Sig: "const BPF_LDX", Doc: "", Pos: "zerrors_linux_amd64.go:127"},
+		"syscall.BPF_LEN": {Sig: "const BPF_LEN", Doc: "", Pos: "zerrors_linux_amd64.go:128"},
+		"syscall.BPF_LSH": {Sig: "const BPF_LSH", Doc: "", Pos: "zerrors_linux_amd64.go:129"},
+		"syscall.BPF_MAJOR_VERSION": {Sig: "const BPF_MAJOR_VERSION", Doc: "", Pos: "zerrors_linux_amd64.go:130"},
+		"syscall.BPF_MAXINSNS": {Sig: "const BPF_MAXINSNS", Doc: "", Pos: "zerrors_linux_amd64.go:131"},
+		"syscall.BPF_MEM": {Sig: "const BPF_MEM", Doc: "", Pos: "zerrors_linux_amd64.go:132"},
+		"syscall.BPF_MEMWORDS": {Sig: "const BPF_MEMWORDS", Doc: "", Pos: "zerrors_linux_amd64.go:133"},
+		"syscall.BPF_MINOR_VERSION": {Sig: "const BPF_MINOR_VERSION", Doc: "", Pos: "zerrors_linux_amd64.go:134"},
+		"syscall.BPF_MISC": {Sig: "const BPF_MISC", Doc: "", Pos: "zerrors_linux_amd64.go:135"},
+		"syscall.BPF_MSH": {Sig: "const BPF_MSH", Doc: "", Pos: "zerrors_linux_amd64.go:136"},
+		"syscall.BPF_MUL": {Sig: "const BPF_MUL", Doc: "", Pos: "zerrors_linux_amd64.go:137"},
+		"syscall.BPF_NEG": {Sig: "const BPF_NEG", Doc: "", Pos: "zerrors_linux_amd64.go:138"},
+		"syscall.BPF_OR": {Sig: "const BPF_OR", Doc: "", Pos: "zerrors_linux_amd64.go:139"},
+		"syscall.BPF_RET": {Sig: "const BPF_RET", Doc: "", Pos: "zerrors_linux_amd64.go:140"},
+		"syscall.BPF_RSH": {Sig: "const BPF_RSH", Doc: "", Pos: "zerrors_linux_amd64.go:141"},
+		"syscall.BPF_ST": {Sig: "const BPF_ST", Doc: "", Pos: "zerrors_linux_amd64.go:142"},
+		"syscall.BPF_STX": {Sig: "const BPF_STX", Doc: "", Pos: "zerrors_linux_amd64.go:143"},
+		"syscall.BPF_SUB": {Sig: "const BPF_SUB", Doc: "", Pos: "zerrors_linux_amd64.go:144"},
+		"syscall.BPF_TAX": {Sig: "const BPF_TAX", Doc: "", Pos: "zerrors_linux_amd64.go:145"},
+		"syscall.BPF_TXA": {Sig: "const BPF_TXA", Doc: "", Pos: "zerrors_linux_amd64.go:146"},
+		"syscall.BPF_W": {Sig: "const BPF_W", Doc: "", Pos: "zerrors_linux_amd64.go:147"},
+		"syscall.BPF_X": {Sig: "const BPF_X", Doc: "", Pos: "zerrors_linux_amd64.go:148"},
+		"syscall.DT_BLK": {Sig: "const DT_BLK", Doc: "", Pos: "zerrors_linux_amd64.go:149"},
+		"syscall.DT_CHR": {Sig: "const DT_CHR", Doc: "", Pos: "zerrors_linux_amd64.go:150"},
+		"syscall.DT_DIR": {Sig: "const DT_DIR", Doc: "", Pos: "zerrors_linux_amd64.go:151"},
+		"syscall.DT_FIFO": {Sig: "const DT_FIFO", Doc: "", Pos: "zerrors_linux_amd64.go:152"},
+		"syscall.DT_LNK": {Sig: "const DT_LNK", Doc: "", Pos: "zerrors_linux_amd64.go:153"},
+		"syscall.DT_REG": {Sig: "const DT_REG", Doc: "", Pos: "zerrors_linux_amd64.go:154"},
+		"syscall.DT_SOCK": {Sig: "const DT_SOCK", Doc: "", Pos: "zerrors_linux_amd64.go:155"},
+		"syscall.DT_UNKNOWN": {Sig: "const DT_UNKNOWN", Doc: "", Pos: "zerrors_linux_amd64.go:156"},
+		"syscall.DT_WHT": {Sig: "const DT_WHT", Doc: "", Pos: "zerrors_linux_amd64.go:157"},
+		"syscall.EPOLLERR": {Sig: "const EPOLLERR", Doc: "", Pos: "zerrors_linux_amd64.go:158"},
+		"syscall.EPOLLET": {Sig: "const EPOLLET", Doc: "", Pos: "zerrors_linux_amd64.go:159"},
+		"syscall.EPOLLHUP": {Sig: "const EPOLLHUP", Doc: "", Pos: "zerrors_linux_amd64.go:160"},
+		"syscall.EPOLLIN": {Sig: "const EPOLLIN", Doc: "", Pos: "zerrors_linux_amd64.go:161"},
+		"syscall.EPOLLMSG": {Sig: "const EPOLLMSG", Doc: "", Pos: "zerrors_linux_amd64.go:162"},
+		"syscall.EPOLLONESHOT": {Sig: "const EPOLLONESHOT", Doc: "", Pos: "zerrors_linux_amd64.go:163"},
+		"syscall.EPOLLOUT": {Sig: "const EPOLLOUT", Doc: "", Pos: "zerrors_linux_amd64.go:164"},
+		"syscall.EPOLLPRI": {Sig: "const EPOLLPRI", Doc: "", Pos: "zerrors_linux_amd64.go:165"},
+		"syscall.EPOLLRDBAND": {Sig: "const EPOLLRDBAND", Doc: "", Pos: "zerrors_linux_amd64.go:166"},
+		"syscall.EPOLLRDHUP": {Sig: "const EPOLLRDHUP", Doc: "", Pos: "zerrors_linux_amd64.go:167"},
+		"syscall.EPOLLRDNORM": {Sig: "const EPOLLRDNORM", Doc: "", Pos: "zerrors_linux_amd64.go:168"},
+		"syscall.EPOLLWRBAND": {Sig: "const EPOLLWRBAND", Doc: "", Pos: "zerrors_linux_amd64.go:169"},
+		"syscall.EPOLLWRNORM": {Sig: "const EPOLLWRNORM", Doc: "", Pos: "zerrors_linux_amd64.go:170"},
+		"syscall.EPOLL_CLOEXEC": {Sig: "const EPOLL_CLOEXEC", Doc: "", Pos: "zerrors_linux_amd64.go:171"},
+		"syscall.EPOLL_CTL_ADD": {Sig: "const EPOLL_CTL_ADD", Doc: "", Pos: "zerrors_linux_amd64.go:172"},
+		"syscall.EPOLL_CTL_DEL": {Sig: "const EPOLL_CTL_DEL", Doc: "", Pos: "zerrors_linux_amd64.go:173"},
+		"syscall.EPOLL_CTL_MOD": {Sig: "const EPOLL_CTL_MOD", Doc: "", Pos: "zerrors_linux_amd64.go:174"},
+		"syscall.EPOLL_NONBLOCK": {Sig: "const EPOLL_NONBLOCK", Doc: "", Pos: "zerrors_linux_amd64.go:175"},
+		"syscall.ETH_P_1588": {Sig: "const ETH_P_1588", Doc: "", Pos: "zerrors_linux_amd64.go:176"},
+		"syscall.ETH_P_8021Q": {Sig: "const ETH_P_8021Q", Doc: "", Pos: "zerrors_linux_amd64.go:177"},
+		"syscall.ETH_P_802_2": {Sig: "const ETH_P_802_2", Doc: "", Pos: "zerrors_linux_amd64.go:178"},
+		"syscall.ETH_P_802_3": {Sig: "const ETH_P_802_3", Doc: "", Pos: "zerrors_linux_amd64.go:179"},
+		"syscall.ETH_P_AARP": {Sig: "const ETH_P_AARP", Doc: "", Pos: "zerrors_linux_amd64.go:180"},
+		"syscall.ETH_P_ALL": {Sig: "const ETH_P_ALL", Doc: "", Pos: "zerrors_linux_amd64.go:181"},
+		"syscall.ETH_P_AOE": {Sig: "const ETH_P_AOE", Doc: "", Pos: "zerrors_linux_amd64.go:182"},
+		"syscall.ETH_P_ARCNET": {Sig: "const ETH_P_ARCNET", Doc: "", Pos: "zerrors_linux_amd64.go:183"},
+		"syscall.ETH_P_ARP": {Sig: "const ETH_P_ARP", Doc: "", Pos: "zerrors_linux_amd64.go:184"},
+		"syscall.ETH_P_ATALK": {Sig: "const ETH_P_ATALK", Doc: "", Pos: "zerrors_linux_amd64.go:185"},
+		"syscall.ETH_P_ATMFATE": {Sig: "const ETH_P_ATMFATE", Doc: "", Pos: "zerrors_linux_amd64.go:186"},
+		"syscall.ETH_P_ATMMPOA": {Sig: "const ETH_P_ATMMPOA", Doc: "", Pos: "zerrors_linux_amd64.go:187"},
+		"syscall.ETH_P_AX25": {Sig: "const ETH_P_AX25", Doc: "", Pos: "zerrors_linux_amd64.go:188"},
+		"syscall.ETH_P_BPQ": {Sig: "const ETH_P_BPQ", Doc: "", Pos: "zerrors_linux_amd64.go:189"},
+		"syscall.ETH_P_CAIF": {Sig: "const ETH_P_CAIF", Doc: "", Pos: "zerrors_linux_amd64.go:190"},
+		"syscall.ETH_P_CAN": {Sig: "const ETH_P_CAN", Doc: "", Pos: "zerrors_linux_amd64.go:191"},
+		"syscall.ETH_P_CONTROL": {Sig: "const ETH_P_CONTROL", Doc: "", Pos: "zerrors_linux_amd64.go:192"},
+		"syscall.ETH_P_CUST": {Sig: "const ETH_P_CUST", Doc: "", Pos: "zerrors_linux_amd64.go:193"},
+		"syscall.ETH_P_DDCMP": {Sig: "const ETH_P_DDCMP", Doc: "", Pos: "zerrors_linux_amd64.go:194"},
+		"syscall.ETH_P_DEC": {Sig: "const ETH_P_DEC", Doc: "", Pos: "zerrors_linux_amd64.go:195"},
+		"syscall.ETH_P_DIAG": {Sig: "const ETH_P_DIAG", Doc: "", Pos: "zerrors_linux_amd64.go:196"},
+		"syscall.ETH_P_DNA_DL": {Sig: "const ETH_P_DNA_DL", Doc: "", Pos: "zerrors_linux_amd64.go:197"},
+		"syscall.ETH_P_DNA_RC": {Sig: "const ETH_P_DNA_RC", Doc: "", Pos: "zerrors_linux_amd64.go:198"},
+		"syscall.ETH_P_DNA_RT": {Sig: "const ETH_P_DNA_RT", Doc: "", Pos: "zerrors_linux_amd64.go:199"},
+		"syscall.ETH_P_DSA": {Sig: "const ETH_P_DSA", Doc: "", Pos: "zerrors_linux_amd64.go:200"},
+		"syscall.ETH_P_ECONET": {Sig: "const ETH_P_ECONET", Doc: "", Pos: "zerrors_linux_amd64.go:201"},
+		"syscall.ETH_P_EDSA": {Sig: "const ETH_P_EDSA", Doc: "", Pos: "zerrors_linux_amd64.go:202"},
+		"syscall.ETH_P_FCOE": {Sig: "const ETH_P_FCOE", Doc: "", Pos: "zerrors_linux_amd64.go:203"},
+		"syscall.ETH_P_FIP": {Sig: "const ETH_P_FIP", Doc: "", Pos: "zerrors_linux_amd64.go:204"},
+		"syscall.ETH_P_HDLC": {Sig: "const ETH_P_HDLC", Doc: "", Pos: "zerrors_linux_amd64.go:205"},
+		"syscall.ETH_P_IEEE802154": {Sig: "const ETH_P_IEEE802154", Doc: "", Pos: "zerrors_linux_amd64.go:206"},
+		"syscall.ETH_P_IEEEPUP": {Sig: "const ETH_P_IEEEPUP", Doc: "", Pos: "zerrors_linux_amd64.go:207"},
+		"syscall.ETH_P_IEEEPUPAT": {Sig: "const ETH_P_IEEEPUPAT", Doc: "", Pos: "zerrors_linux_amd64.go:208"},
+		"syscall.ETH_P_IP": {Sig: "const ETH_P_IP", Doc: "", Pos: "zerrors_linux_amd64.go:209"},
+		"syscall.ETH_P_IPV6": {Sig: "const ETH_P_IPV6", Doc: "", Pos: "zerrors_linux_amd64.go:210"},
+		"syscall.ETH_P_IPX": {Sig: "const ETH_P_IPX", Doc: "", Pos: "zerrors_linux_amd64.go:211"},
+		"syscall.ETH_P_IRDA": {Sig: "const ETH_P_IRDA", Doc: "", Pos: "zerrors_linux_amd64.go:212"},
+		"syscall.ETH_P_LAT": {Sig: "const ETH_P_LAT", Doc: "", Pos: "zerrors_linux_amd64.go:213"},
+		"syscall.ETH_P_LINK_CTL": {Sig: "const ETH_P_LINK_CTL", Doc: "", Pos: "zerrors_linux_amd64.go:214"},
+		"syscall.ETH_P_LOCALTALK": {Sig: "const ETH_P_LOCALTALK", Doc: "", Pos: "zerrors_linux_amd64.go:215"},
+		"syscall.ETH_P_LOOP": {Sig: "const ETH_P_LOOP", Doc: "", Pos: "zerrors_linux_amd64.go:216"},
+		"syscall.ETH_P_MOBITEX": {Sig: "const ETH_P_MOBITEX", Doc: "", Pos: "zerrors_linux_amd64.go:217"},
+		"syscall.ETH_P_MPLS_MC": {Sig: "const ETH_P_MPLS_MC", Doc: "", Pos: "zerrors_linux_amd64.go:218"},
+		"syscall.ETH_P_MPLS_UC": {Sig: "const ETH_P_MPLS_UC", Doc: "", Pos: "zerrors_linux_amd64.go:219"},
+		"syscall.ETH_P_PAE": {Sig: "const ETH_P_PAE", Doc: "", Pos: "zerrors_linux_amd64.go:220"},
+		"syscall.ETH_P_PAUSE": {Sig: "const ETH_P_PAUSE", Doc: "", Pos: "zerrors_linux_amd64.go:221"},
+		"syscall.ETH_P_PHONET": {Sig: "const ETH_P_PHONET", Doc: "", Pos: "zerrors_linux_amd64.go:222"},
+		"syscall.ETH_P_PPPTALK": {Sig: "const ETH_P_PPPTALK", Doc: "", Pos: "zerrors_linux_amd64.go:223"},
+		"syscall.ETH_P_PPP_DISC": {Sig: "const ETH_P_PPP_DISC", Doc: "", Pos: "zerrors_linux_amd64.go:224"},
+		"syscall.ETH_P_PPP_MP": {Sig: "const ETH_P_PPP_MP", Doc: "", Pos: "zerrors_linux_amd64.go:225"},
+		"syscall.ETH_P_PPP_SES": {Sig: "const ETH_P_PPP_SES", Doc: "", Pos: "zerrors_linux_amd64.go:226"},
+		"syscall.ETH_P_PUP": {Sig: "const ETH_P_PUP", Doc: "", Pos: "zerrors_linux_amd64.go:227"},
+		"syscall.ETH_P_PUPAT": {Sig: "const ETH_P_PUPAT", Doc: "", Pos: "zerrors_linux_amd64.go:228"},
+		"syscall.ETH_P_RARP": {Sig: "const ETH_P_RARP", Doc: "", Pos: "zerrors_linux_amd64.go:229"},
+		"syscall.ETH_P_SCA": {Sig: "const ETH_P_SCA", Doc: "", Pos: "zerrors_linux_amd64.go:230"},
+		"syscall.ETH_P_SLOW": {Sig: "const ETH_P_SLOW", Doc: "", Pos: "zerrors_linux_amd64.go:231"},
+		"syscall.ETH_P_SNAP": {Sig: "const ETH_P_SNAP", Doc: "", Pos: "zerrors_linux_amd64.go:232"},
+		"syscall.ETH_P_TEB": {Sig: "const ETH_P_TEB", Doc: "", Pos: "zerrors_linux_amd64.go:233"},
+		"syscall.ETH_P_TIPC": {Sig: "const ETH_P_TIPC", Doc: "", Pos: "zerrors_linux_amd64.go:234"},
+		"syscall.ETH_P_TRAILER": {Sig: "const ETH_P_TRAILER", Doc: "", Pos: "zerrors_linux_amd64.go:235"},
+		"syscall.ETH_P_TR_802_2": {Sig: "const ETH_P_TR_802_2", Doc: "", Pos: "zerrors_linux_amd64.go:236"},
+		"syscall.ETH_P_WAN_PPP": {Sig: "const ETH_P_WAN_PPP", Doc: "", Pos: "zerrors_linux_amd64.go:237"},
+		"syscall.ETH_P_WCCP": {Sig: "const ETH_P_WCCP", Doc: "", Pos: "zerrors_linux_amd64.go:238"},
+		"syscall.ETH_P_X25": {Sig: "const ETH_P_X25", Doc: "", Pos: "zerrors_linux_amd64.go:239"},
+		"syscall.FD_CLOEXEC": {Sig: "const FD_CLOEXEC", Doc: "", Pos: "zerrors_linux_amd64.go:240"},
+		"syscall.FD_SETSIZE": {Sig: "const FD_SETSIZE", Doc: "", Pos: "zerrors_linux_amd64.go:241"},
+		"syscall.F_DUPFD": {Sig: "const F_DUPFD", Doc: "", Pos: "zerrors_linux_amd64.go:242"},
+		"syscall.F_DUPFD_CLOEXEC": {Sig: "const F_DUPFD_CLOEXEC", Doc: "", Pos: "zerrors_linux_amd64.go:243"},
+		"syscall.F_EXLCK": {Sig: "const F_EXLCK", Doc: "", Pos: "zerrors_linux_amd64.go:244"},
+		"syscall.F_GETFD": {Sig: "const F_GETFD", Doc: "", Pos: "zerrors_linux_amd64.go:245"},
+		"syscall.F_GETFL": {Sig: "const F_GETFL", Doc: "", Pos: "zerrors_linux_amd64.go:246"},
+		"syscall.F_GETLEASE": {Sig: "const F_GETLEASE", Doc: "", Pos: "zerrors_linux_amd64.go:247"},
+		"syscall.F_GETLK": {Sig: "const F_GETLK", Doc: "", Pos: "zerrors_linux_amd64.go:248"},
+		"syscall.F_GETLK64": {Sig: "const F_GETLK64", Doc: "", Pos: "zerrors_linux_amd64.go:249"},
+		"syscall.F_GETOWN": {Sig: "const F_GETOWN", Doc: "", Pos: "zerrors_linux_amd64.go:250"},
+		"syscall.F_GETOWN_EX": {Sig: "const F_GETOWN_EX", Doc: "", Pos: "zerrors_linux_amd64.go:251"},
+		"syscall.F_GETPIPE_SZ": {Sig: "const F_GETPIPE_SZ", Doc: "", Pos: "zerrors_linux_amd64.go:252"},
+		"syscall.F_GETSIG": {Sig: "const F_GETSIG", Doc: "", Pos: "zerrors_linux_amd64.go:253"},
+		"syscall.F_LOCK": {Sig: "const F_LOCK", Doc: "", Pos: "zerrors_linux_amd64.go:254"},
+		"syscall.F_NOTIFY": {Sig: "const F_NOTIFY", Doc: "", Pos: "zerrors_linux_amd64.go:255"},
+		"syscall.F_OK": {Sig: "const F_OK", Doc: "", Pos: "zerrors_linux_amd64.go:256"},
+		"syscall.F_RDLCK": {Sig: "const F_RDLCK", Doc: "", Pos: "zerrors_linux_amd64.go:257"},
+		"syscall.F_SETFD": {Sig: "const F_SETFD", Doc: "", Pos: "zerrors_linux_amd64.go:258"},
+		"syscall.F_SETFL": {Sig: "const F_SETFL", Doc: "", Pos: "zerrors_linux_amd64.go:259"},
+		"syscall.F_SETLEASE": {Sig: "const F_SETLEASE", Doc: "", Pos: "zerrors_linux_amd64.go:260"},
+		"syscall.F_SETLK": {Sig: "const F_SETLK", Doc: "", Pos: "zerrors_linux_amd64.go:261"},
+		"syscall.F_SETLK64": {Sig: "const F_SETLK64", Doc: "", Pos: "zerrors_linux_amd64.go:262"},
+		"syscall.F_SETLKW": {Sig: "const F_SETLKW", Doc: "", Pos: "zerrors_linux_amd64.go:263"},
+		"syscall.F_SETLKW64": {Sig: "const F_SETLKW64", Doc: "", Pos: "zerrors_linux_amd64.go:264"},
+		"syscall.F_SETOWN": {Sig: "const F_SETOWN", Doc: "", Pos: "zerrors_linux_amd64.go:265"},
+		"syscall.F_SETOWN_EX": {Sig: "const F_SETOWN_EX", Doc: "", Pos: "zerrors_linux_amd64.go:266"},
+		"syscall.F_SETPIPE_SZ": {Sig: "const F_SETPIPE_SZ", Doc: "", Pos: "zerrors_linux_amd64.go:267"},
+		"syscall.F_SETSIG": {Sig: "const F_SETSIG", Doc: "", Pos: "zerrors_linux_amd64.go:268"},
+		"syscall.F_SHLCK": {Sig: "const F_SHLCK", Doc: "", Pos: "zerrors_linux_amd64.go:269"},
+		"syscall.F_TEST": {Sig: "const F_TEST", Doc: "", Pos: "zerrors_linux_amd64.go:270"},
+		"syscall.F_TLOCK": {Sig: "const F_TLOCK", Doc: "", Pos: "zerrors_linux_amd64.go:271"},
+		"syscall.F_ULOCK": {Sig: "const F_ULOCK", Doc: "", Pos: "zerrors_linux_amd64.go:272"},
+		"syscall.F_UNLCK": {Sig: "const F_UNLCK", Doc: "", Pos: "zerrors_linux_amd64.go:273"},
+		"syscall.F_WRLCK": {Sig: "const F_WRLCK", Doc: "", Pos: "zerrors_linux_amd64.go:274"},
+		"syscall.ICMPV6_FILTER": {Sig: "const ICMPV6_FILTER", Doc: "", Pos: "zerrors_linux_amd64.go:275"},
+		"syscall.IFA_F_DADFAILED": {Sig: "const IFA_F_DADFAILED", Doc: "", Pos: "zerrors_linux_amd64.go:276"},
+		"syscall.IFA_F_DEPRECATED": {Sig: "const IFA_F_DEPRECATED", Doc: "", Pos: "zerrors_linux_amd64.go:277"},
+		"syscall.IFA_F_HOMEADDRESS": {Sig: "const IFA_F_HOMEADDRESS", Doc: "", Pos: "zerrors_linux_amd64.go:278"},
+		"syscall.IFA_F_NODAD": {Sig: "const IFA_F_NODAD", Doc: "", Pos: "zerrors_linux_amd64.go:279"},
+		"syscall.IFA_F_OPTIMISTIC": {Sig: "const IFA_F_OPTIMISTIC", Doc: "", Pos: "zerrors_linux_amd64.go:280"},
+		"syscall.IFA_F_PERMANENT": {Sig: "const IFA_F_PERMANENT", Doc: "", Pos: "zerrors_linux_amd64.go:281"},
+		"syscall.IFA_F_SECONDARY": {Sig: "const IFA_F_SECONDARY", Doc: "", Pos: "zerrors_linux_amd64.go:282"},
+		"syscall.IFA_F_TEMPORARY": {Sig: "const IFA_F_TEMPORARY", Doc: "", Pos: "zerrors_linux_amd64.go:283"},
+		"syscall.IFA_F_TENTATIVE": {Sig: "const IFA_F_TENTATIVE", Doc: "", Pos: "zerrors_linux_amd64.go:284"},
+		"syscall.IFA_MAX": {Sig: "const IFA_MAX", Doc: "", Pos: "zerrors_linux_amd64.go:285"},
+		"syscall.IFF_ALLMULTI": {Sig: "const IFF_ALLMULTI", Doc: "", Pos: "zerrors_linux_amd64.go:286"},
+		"syscall.IFF_AUTOMEDIA": {Sig: "const IFF_AUTOMEDIA", Doc: "", Pos: "zerrors_linux_amd64.go:287"},
+		"syscall.IFF_BROADCAST": {Sig: "const IFF_BROADCAST", Doc: "", Pos: "zerrors_linux_amd64.go:288"},
+		"syscall.IFF_DEBUG": {Sig: "const IFF_DEBUG", Doc: "", Pos: "zerrors_linux_amd64.go:289"},
+		"syscall.IFF_DYNAMIC": {Sig: "const IFF_DYNAMIC", Doc: "", Pos: "zerrors_linux_amd64.go:290"},
+		"syscall.IFF_LOOPBACK": {Sig: "const IFF_LOOPBACK", Doc: "", Pos: "zerrors_linux_amd64.go:291"},
+		"syscall.IFF_MASTER": {Sig: "const IFF_MASTER", Doc: "", Pos: "zerrors_linux_amd64.go:292"},
+		"syscall.IFF_MULTICAST": {Sig: "const IFF_MULTICAST", Doc: "", Pos: "zerrors_linux_amd64.go:293"},
+		"syscall.IFF_NOARP": {Sig: "const IFF_NOARP", Doc: "", Pos: "zerrors_linux_amd64.go:294"},
+		"syscall.IFF_NOTRAILERS": {Sig: "const IFF_NOTRAILERS", Doc: "", Pos: "zerrors_linux_amd64.go:295"},
+		"syscall.IFF_NO_PI": {Sig: "const IFF_NO_PI", Doc: "", Pos: "zerrors_linux_amd64.go:296"},
+		"syscall.IFF_ONE_QUEUE": {Sig: "const IFF_ONE_QUEUE", Doc: "", Pos: "zerrors_linux_amd64.go:297"},
+		"syscall.IFF_POINTOPOINT": {Sig: "const IFF_POINTOPOINT", Doc: "", Pos: "zerrors_linux_amd64.go:298"},
+		"syscall.IFF_PORTSEL": {Sig: "const IFF_PORTSEL", Doc: "", Pos: "zerrors_linux_amd64.go:299"},
+		"syscall.IFF_PROMISC": {Sig: "const IFF_PROMISC", Doc: "", Pos: "zerrors_linux_amd64.go:300"},
+		"syscall.IFF_RUNNING": {Sig: "const IFF_RUNNING", Doc: "", Pos: "zerrors_linux_amd64.go:301"},
+		"syscall.IFF_SLAVE": {Sig: "const IFF_SLAVE", Doc: "", Pos: "zerrors_linux_amd64.go:302"},
+		"syscall.IFF_TAP": {Sig: "const IFF_TAP", Doc: "", Pos: "zerrors_linux_amd64.go:303"},
+		"syscall.IFF_TUN": {Sig: "const IFF_TUN", Doc: "", Pos: "zerrors_linux_amd64.go:304"},
+		"syscall.IFF_TUN_EXCL": {Sig: "const IFF_TUN_EXCL", Doc: "", Pos: "zerrors_linux_amd64.go:305"},
+		"syscall.IFF_UP": {Sig: "const IFF_UP", Doc: "", Pos: "zerrors_linux_amd64.go:306"},
+		"syscall.IFF_VNET_HDR": {Sig: "const IFF_VNET_HDR", Doc: "", Pos: "zerrors_linux_amd64.go:307"},
+		"syscall.IFNAMSIZ": {Sig: "const IFNAMSIZ", Doc: "", Pos: "zerrors_linux_amd64.go:308"},
+		"syscall.IN_ACCESS": {Sig: "const IN_ACCESS", Doc: "", Pos: "zerrors_linux_amd64.go:309"},
+		"syscall.IN_ALL_EVENTS": {Sig: "const IN_ALL_EVENTS", Doc: "", Pos: "zerrors_linux_amd64.go:310"},
+		"syscall.IN_ATTRIB": {Sig: "const IN_ATTRIB", Doc: "", Pos: "zerrors_linux_amd64.go:311"},
+		"syscall.IN_CLASSA_HOST": {Sig: "const IN_CLASSA_HOST", Doc: "", Pos: "zerrors_linux_amd64.go:312"},
+		"syscall.IN_CLASSA_MAX": {Sig: "const IN_CLASSA_MAX", Doc: "", Pos: "zerrors_linux_amd64.go:313"},
+		"syscall.IN_CLASSA_NET": {Sig: "const IN_CLASSA_NET", Doc: "", Pos: "zerrors_linux_amd64.go:314"},
+		"syscall.IN_CLASSA_NSHIFT": {Sig: "const IN_CLASSA_NSHIFT", Doc: "", Pos: "zerrors_linux_amd64.go:315"},
+		"syscall.IN_CLASSB_HOST": {Sig: "const IN_CLASSB_HOST", Doc: "", Pos: "zerrors_linux_amd64.go:316"},
+		"syscall.IN_CLASSB_MAX": {Sig: "const IN_CLASSB_MAX", Doc: "", Pos: "zerrors_linux_amd64.go:317"},
+		"syscall.IN_CLASSB_NET": {Sig: "const IN_CLASSB_NET", Doc: "", Pos: "zerrors_linux_amd64.go:318"},
+		"syscall.IN_CLASSB_NSHIFT": {Sig: "const IN_CLASSB_NSHIFT", Doc: "", Pos: "zerrors_linux_amd64.go:319"},
+		"syscall.IN_CLASSC_HOST": {Sig: "const IN_CLASSC_HOST", Doc: "", Pos: "zerrors_linux_amd64.go:320"},
+		"syscall.IN_CLASSC_NET": {Sig: "const IN_CLASSC_NET", Doc: "", Pos: "zerrors_linux_amd64.go:321"},
+		"syscall.IN_CLASSC_NSHIFT": {Sig: "const IN_CLASSC_NSHIFT", Doc: "", Pos: "zerrors_linux_amd64.go:322"},
+		"syscall.IN_CLOEXEC": {Sig: "const IN_CLOEXEC", Doc: "", Pos: "zerrors_linux_amd64.go:323"},
+		"syscall.IN_CLOSE": {Sig: "const IN_CLOSE", Doc: "", Pos: "zerrors_linux_amd64.go:324"},
+		"syscall.IN_CLOSE_NOWRITE": {Sig: "const IN_CLOSE_NOWRITE", Doc: "", Pos: "zerrors_linux_amd64.go:325"},
+		"syscall.IN_CLOSE_WRITE": {Sig: "const IN_CLOSE_WRITE", Doc: "", Pos: "zerrors_linux_amd64.go:326"},
+		"syscall.IN_CREATE": {Sig: "const IN_CREATE", Doc: "", Pos: "zerrors_linux_amd64.go:327"},
+		"syscall.IN_DELETE": {Sig: "const IN_DELETE", Doc: "", Pos: "zerrors_linux_amd64.go:328"},
+		"syscall.IN_DELETE_SELF": {Sig: "const IN_DELETE_SELF", Doc: "", Pos: "zerrors_linux_amd64.go:329"},
+		"syscall.IN_DONT_FOLLOW": {Sig: "const IN_DONT_FOLLOW", Doc: "", Pos: "zerrors_linux_amd64.go:330"},
+		"syscall.IN_EXCL_UNLINK": {Sig: "const IN_EXCL_UNLINK", Doc: "", Pos: "zerrors_linux_amd64.go:331"},
+		"syscall.IN_IGNORED": {Sig: "const IN_IGNORED", Doc: "", Pos: "zerrors_linux_amd64.go:332"},
+		"syscall.IN_ISDIR": {Sig: "const IN_ISDIR", Doc: "", Pos: "zerrors_linux_amd64.go:333"},
+		"syscall.IN_LOOPBACKNET": {Sig: "const IN_LOOPBACKNET", Doc: "", Pos: "zerrors_linux_amd64.go:334"},
+		"syscall.IN_MASK_ADD": {Sig: "const IN_MASK_ADD", Doc: "", Pos: "zerrors_linux_amd64.go:335"},
+		"syscall.IN_MODIFY": {Sig: "const IN_MODIFY", Doc: "", Pos: "zerrors_linux_amd64.go:336"},
+		"syscall.IN_MOVE": {Sig: "const IN_MOVE", Doc: "", Pos: "zerrors_linux_amd64.go:337"},
+		"syscall.IN_MOVED_FROM": {Sig: "const IN_MOVED_FROM", Doc: "", Pos: "zerrors_linux_amd64.go:338"},
+		"syscall.IN_MOVED_TO": {Sig: "const IN_MOVED_TO", Doc: "", Pos: "zerrors_linux_amd64.go:339"},
+		"syscall.IN_MOVE_SELF": {Sig: "const IN_MOVE_SELF", Doc: "", Pos: "zerrors_linux_amd64.go:340"},
+		"syscall.IN_NONBLOCK": {Sig: "const IN_NONBLOCK", Doc: "", Pos: "zerrors_linux_amd64.go:341"},
+		"syscall.IN_ONESHOT": {Sig: "const IN_ONESHOT", Doc: "", Pos: "zerrors_linux_amd64.go:342"},
+		"syscall.IN_ONLYDIR": {Sig: "const IN_ONLYDIR", Doc: "", Pos: "zerrors_linux_amd64.go:343"},
+		"syscall.IN_OPEN": {Sig: "const IN_OPEN", Doc: "", Pos: "zerrors_linux_amd64.go:344"},
+		"syscall.IN_Q_OVERFLOW": {Sig: "const IN_Q_OVERFLOW", Doc: "", Pos: "zerrors_linux_amd64.go:345"},
+		"syscall.IN_UNMOUNT": {Sig: "const IN_UNMOUNT", Doc: "", Pos: "zerrors_linux_amd64.go:346"},
+		"syscall.IPPROTO_AH": {Sig: "const IPPROTO_AH", Doc: "", Pos: "zerrors_linux_amd64.go:347"},
+		"syscall.IPPROTO_COMP": {Sig: "const IPPROTO_COMP", Doc: "", Pos: "zerrors_linux_amd64.go:348"},
+		"syscall.IPPROTO_DCCP": {Sig: "const IPPROTO_DCCP", Doc: "", Pos: "zerrors_linux_amd64.go:349"},
+		"syscall.IPPROTO_DSTOPTS": {Sig: "const IPPROTO_DSTOPTS", Doc: "", Pos: "zerrors_linux_amd64.go:350"},
+		"syscall.IPPROTO_EGP": {Sig: "const IPPROTO_EGP", Doc: "", Pos: "zerrors_linux_amd64.go:351"},
+		"syscall.IPPROTO_ENCAP": {Sig: "const IPPROTO_ENCAP", Doc: "", Pos: "zerrors_linux_amd64.go:352"},
+		"syscall.IPPROTO_ESP": {Sig: "const IPPROTO_ESP", Doc: "", Pos: "zerrors_linux_amd64.go:353"},
+		"syscall.IPPROTO_FRAGMENT": {Sig: "const IPPROTO_FRAGMENT", Doc: "", Pos: "zerrors_linux_amd64.go:354"},
+		"syscall.IPPROTO_GRE": {Sig: "const IPPROTO_GRE", Doc: "", Pos: "zerrors_linux_amd64.go:355"},
+		"syscall.IPPROTO_HOPOPTS": {Sig: "const IPPROTO_HOPOPTS", Doc: "", Pos: "zerrors_linux_amd64.go:356"},
+		"syscall.IPPROTO_ICMP": {Sig: "const IPPROTO_ICMP", Doc: "", Pos: "zerrors_linux_amd64.go:357"},
+		"syscall.IPPROTO_ICMPV6": {Sig: "const IPPROTO_ICMPV6", Doc: "", Pos: "zerrors_linux_amd64.go:358"},
+		"syscall.IPPROTO_IDP": {Sig: "const IPPROTO_IDP", Doc: "", Pos: "zerrors_linux_amd64.go:359"},
+		"syscall.IPPROTO_IGMP": {Sig: "const IPPROTO_IGMP", Doc: "", Pos: "zerrors_linux_amd64.go:360"},
+		"syscall.IPPROTO_IP": {Sig: "const IPPROTO_IP", Doc: "", Pos: "zerrors_linux_amd64.go:361"},
+		"syscall.IPPROTO_IPIP": {Sig: "const IPPROTO_IPIP", Doc: "", Pos: "zerrors_linux_amd64.go:362"},
+		"syscall.IPPROTO_IPV6": {Sig: "const IPPROTO_IPV6", Doc: "", Pos: "zerrors_linux_amd64.go:363"},
+		"syscall.IPPROTO_MTP": {Sig: "const IPPROTO_MTP", Doc: "", Pos: "zerrors_linux_amd64.go:364"},
+		"syscall.IPPROTO_NONE": {Sig: "const IPPROTO_NONE", Doc: "", Pos: "zerrors_linux_amd64.go:365"},
+		"syscall.IPPROTO_PIM": {Sig: "const IPPROTO_PIM", Doc: "", Pos: "zerrors_linux_amd64.go:366"},
+		"syscall.IPPROTO_PUP": {Sig: "const IPPROTO_PUP", Doc: "", Pos: "zerrors_linux_amd64.go:367"},
+		"syscall.IPPROTO_RAW": {Sig: "const IPPROTO_RAW", Doc: "", Pos: "zerrors_linux_amd64.go:368"},
+		"syscall.IPPROTO_ROUTING": {Sig: "const IPPROTO_ROUTING", Doc: "", Pos: "zerrors_linux_amd64.go:369"},
+		"syscall.IPPROTO_RSVP": {Sig: "const IPPROTO_RSVP", Doc: "", Pos: "zerrors_linux_amd64.go:370"},
+		"syscall.IPPROTO_SCTP": {Sig: "const IPPROTO_SCTP", Doc: "", Pos: "zerrors_linux_amd64.go:371"},
+		"syscall.IPPROTO_TCP": {Sig: "const IPPROTO_TCP", Doc: "", Pos: "zerrors_linux_amd64.go:372"},
+		"syscall.IPPROTO_TP": {Sig: "const IPPROTO_TP", Doc: "", Pos: "zerrors_linux_amd64.go:373"},
+		"syscall.IPPROTO_UDP": {Sig: "const IPPROTO_UDP", Doc: "", Pos: "zerrors_linux_amd64.go:374"},
+		"syscall.IPPROTO_UDPLITE": {Sig: "const IPPROTO_UDPLITE", Doc: "", Pos: "zerrors_linux_amd64.go:375"},
+		"syscall.IPV6_2292DSTOPTS": {Sig: "const IPV6_2292DSTOPTS", Doc: "", Pos: "zerrors_linux_amd64.go:376"},
+		"syscall.IPV6_2292HOPLIMIT": {Sig: "const IPV6_2292HOPLIMIT", Doc: "", Pos: "zerrors_linux_amd64.go:377"},
+		"syscall.IPV6_2292HOPOPTS": {Sig: "const IPV6_2292HOPOPTS", Doc: "", Pos: "zerrors_linux_amd64.go:378"},
+		"syscall.IPV6_2292PKTINFO": {Sig: "const IPV6_2292PKTINFO", Doc: "", Pos: "zerrors_linux_amd64.go:379"},
+		"syscall.IPV6_2292PKTOPTIONS": {Sig: "const IPV6_2292PKTOPTIONS", Doc: "", Pos: "zerrors_linux_amd64.go:380"},
+		"syscall.IPV6_2292RTHDR": {Sig: "const IPV6_2292RTHDR", Doc: "", Pos: "zerrors_linux_amd64.go:381"},
+		"syscall.IPV6_ADDRFORM": {Sig: "const IPV6_ADDRFORM", Doc: "", Pos: "zerrors_linux_amd64.go:382"},
+		"syscall.IPV6_ADD_MEMBERSHIP": {Sig: "const IPV6_ADD_MEMBERSHIP", Doc: "", Pos: "zerrors_linux_amd64.go:383"},
+		"syscall.IPV6_AUTHHDR": {Sig: "const IPV6_AUTHHDR", Doc: "", Pos: "zerrors_linux_amd64.go:384"},
+		"syscall.IPV6_CHECKSUM": {Sig: "const IPV6_CHECKSUM", Doc: "", Pos: "zerrors_linux_amd64.go:385"},
+		"syscall.IPV6_DROP_MEMBERSHIP": {Sig: "const IPV6_DROP_MEMBERSHIP", Doc: "", Pos: "zerrors_linux_amd64.go:386"},
+		"syscall.IPV6_DSTOPTS": {Sig: "const IPV6_DSTOPTS", Doc: "", Pos: "zerrors_linux_amd64.go:387"},
+		"syscall.IPV6_HOPLIMIT": {Sig: "const IPV6_HOPLIMIT", Doc: "", Pos: "zerrors_linux_amd64.go:388"},
+		"syscall.IPV6_HOPOPTS": {Sig: "const IPV6_HOPOPTS", Doc: "", Pos: "zerrors_linux_amd64.go:389"},
+		"syscall.IPV6_IPSEC_POLICY": {Sig: "const IPV6_IPSEC_POLICY", Doc: "", Pos: "zerrors_linux_amd64.go:390"},
+		"syscall.IPV6_JOIN_ANYCAST": {Sig: "const IPV6_JOIN_ANYCAST", Doc: "", Pos: "zerrors_linux_amd64.go:391"},
+		"syscall.IPV6_JOIN_GROUP": {Sig: "const IPV6_JOIN_GROUP", Doc: "", Pos: "zerrors_linux_amd64.go:392"},
+		"syscall.IPV6_LEAVE_ANYCAST": {Sig: "const IPV6_LEAVE_ANYCAST", Doc: "", Pos: "zerrors_linux_amd64.go:393"},
+		"syscall.IPV6_LEAVE_GROUP": {Sig: "const IPV6_LEAVE_GROUP", Doc: "", Pos: "zerrors_linux_amd64.go:394"},
+		"syscall.IPV6_MTU": {Sig: "const IPV6_MTU", Doc: "", Pos: "zerrors_linux_amd64.go:395"},
+		"syscall.IPV6_MTU_DISCOVER": {Sig: "const IPV6_MTU_DISCOVER", Doc: "", Pos: "zerrors_linux_amd64.go:396"},
+		"syscall.IPV6_MULTICAST_HOPS": {Sig: "const IPV6_MULTICAST_HOPS", Doc: "", Pos: "zerrors_linux_amd64.go:397"},
+		"syscall.IPV6_MULTICAST_IF": {Sig: "const IPV6_MULTICAST_IF", Doc: "", Pos: "zerrors_linux_amd64.go:398"},
+		"syscall.IPV6_MULTICAST_LOOP": {Sig: "const IPV6_MULTICAST_LOOP", Doc: "", Pos: "zerrors_linux_amd64.go:399"},
+		"syscall.IPV6_NEXTHOP": {Sig: "const IPV6_NEXTHOP", Doc: "", Pos: "zerrors_linux_amd64.go:400"},
+		"syscall.IPV6_PKTINFO": {Sig: "const IPV6_PKTINFO", Doc: "", Pos: "zerrors_linux_amd64.go:401"},
+		"syscall.IPV6_PMTUDISC_DO": {Sig: "const IPV6_PMTUDISC_DO", Doc: "", Pos: "zerrors_linux_amd64.go:402"},
+		"syscall.IPV6_PMTUDISC_DONT": {Sig: "const IPV6_PMTUDISC_DONT", Doc: "", Pos: "zerrors_linux_amd64.go:403"},
+		"syscall.IPV6_PMTUDISC_PROBE": {Sig: "const IPV6_PMTUDISC_PROBE", Doc: "", Pos: "zerrors_linux_amd64.go:404"},
+		"syscall.IPV6_PMTUDISC_WANT": {Sig: "const IPV6_PMTUDISC_WANT", Doc: "", Pos: "zerrors_linux_amd64.go:405"},
+		"syscall.IPV6_RECVDSTOPTS": {Sig: "const IPV6_RECVDSTOPTS", Doc: "", Pos: "zerrors_linux_amd64.go:406"},
+		"syscall.IPV6_RECVERR": {Sig: "const IPV6_RECVERR", Doc: "", Pos: "zerrors_linux_amd64.go:407"},
+		"syscall.IPV6_RECVHOPLIMIT": {Sig: "const IPV6_RECVHOPLIMIT", Doc: "", Pos: "zerrors_linux_amd64.go:408"},
+		"syscall.IPV6_RECVHOPOPTS": {Sig: "const IPV6_RECVHOPOPTS", Doc: "", Pos: "zerrors_linux_amd64.go:409"},
+		"syscall.IPV6_RECVPKTINFO": {Sig: "const IPV6_RECVPKTINFO", Doc: "", Pos: "zerrors_linux_amd64.go:410"},
+		"syscall.IPV6_RECVRTHDR": {Sig: "const IPV6_RECVRTHDR", Doc: "", Pos: "zerrors_linux_amd64.go:411"},
+		"syscall.IPV6_RECVTCLASS": {Sig: "const IPV6_RECVTCLASS", Doc: "", Pos: "zerrors_linux_amd64.go:412"},
+		"syscall.IPV6_ROUTER_ALERT": {Sig: "const IPV6_ROUTER_ALERT", Doc: "", Pos: "zerrors_linux_amd64.go:413"},
+		"syscall.IPV6_RTHDR": {Sig: "const IPV6_RTHDR", Doc: "", Pos: "zerrors_linux_amd64.go:414"},
+		"syscall.IPV6_RTHDRDSTOPTS": {Sig: "const IPV6_RTHDRDSTOPTS", Doc: "", Pos: "zerrors_linux_amd64.go:415"},
+		"syscall.IPV6_RTHDR_LOOSE": {Sig: "const IPV6_RTHDR_LOOSE", Doc: "", Pos: "zerrors_linux_amd64.go:416"},
+		"syscall.IPV6_RTHDR_STRICT": {Sig: "const IPV6_RTHDR_STRICT", Doc: "", Pos: "zerrors_linux_amd64.go:417"},
+		"syscall.IPV6_RTHDR_TYPE_0": {Sig: "const IPV6_RTHDR_TYPE_0", Doc: "", Pos: "zerrors_linux_amd64.go:418"},
+		"syscall.IPV6_RXDSTOPTS": {Sig: "const IPV6_RXDSTOPTS", Doc: "", Pos: "zerrors_linux_amd64.go:419"},
+		"syscall.IPV6_RXHOPOPTS": {Sig: "const IPV6_RXHOPOPTS", Doc: "", Pos: "zerrors_linux_amd64.go:420"},
+		"syscall.IPV6_TCLASS": {Sig: "const IPV6_TCLASS", Doc: "", Pos: "zerrors_linux_amd64.go:421"},
+		"syscall.IPV6_UNICAST_HOPS": {Sig: "const IPV6_UNICAST_HOPS", Doc: "", Pos: "zerrors_linux_amd64.go:422"},
+		"syscall.IPV6_V6ONLY": {Sig: "const IPV6_V6ONLY", Doc: "", Pos: "zerrors_linux_amd64.go:423"},
+		"syscall.IPV6_XFRM_POLICY": {Sig: "const IPV6_XFRM_POLICY", Doc: "", Pos: "zerrors_linux_amd64.go:424"},
+		"syscall.IP_ADD_MEMBERSHIP": {Sig: "const IP_ADD_MEMBERSHIP", Doc: "", Pos: "zerrors_linux_amd64.go:425"},
+		"syscall.IP_ADD_SOURCE_MEMBERSHIP": {Sig: "const IP_ADD_SOURCE_MEMBERSHIP", Doc: "", Pos: "zerrors_linux_amd64.go:426"},
+		"syscall.IP_BLOCK_SOURCE": {Sig: "const IP_BLOCK_SOURCE", Doc: "", Pos: "zerrors_linux_amd64.go:427"},
+		"syscall.IP_DEFAULT_MULTICAST_LOOP": {Sig: "const IP_DEFAULT_MULTICAST_LOOP", Doc: "", Pos: "zerrors_linux_amd64.go:428"},
+		"syscall.IP_DEFAULT_MULTICAST_TTL": {Sig: "const IP_DEFAULT_MULTICAST_TTL", Doc: "", Pos: "zerrors_linux_amd64.go:429"},
+		"syscall.IP_DF": {Sig: "const IP_DF", Doc: "", Pos: "zerrors_linux_amd64.go:430"},
+		"syscall.IP_DROP_MEMBERSHIP": {Sig: "const IP_DROP_MEMBERSHIP", Doc: "", Pos: "zerrors_linux_amd64.go:431"},
+		"syscall.IP_DROP_SOURCE_MEMBERSHIP": {Sig: "const IP_DROP_SOURCE_MEMBERSHIP", Doc: "", Pos: "zerrors_linux_amd64.go:432"},
+		"syscall.IP_FREEBIND": {Sig: "const IP_FREEBIND", Doc: "", Pos: "zerrors_linux_amd64.go:433"},
+		"syscall.IP_HDRINCL": {Sig: "const IP_HDRINCL", Doc: "", Pos: "zerrors_linux_amd64.go:434"},
+		"syscall.IP_IPSEC_POLICY": {Sig: "const IP_IPSEC_POLICY", Doc: "", Pos: "zerrors_linux_amd64.go:435"},
+		"syscall.IP_MAXPACKET": {Sig: "const IP_MAXPACKET", Doc: "", Pos: "zerrors_linux_amd64.go:436"},
+		"syscall.IP_MAX_MEMBERSHIPS": {Sig: "const IP_MAX_MEMBERSHIPS", Doc: "", Pos: "zerrors_linux_amd64.go:437"},
+		"syscall.IP_MF": {Sig: "const IP_MF", Doc: "", Pos: "zerrors_linux_amd64.go:438"},
+		"syscall.IP_MINTTL": {Sig: "const IP_MINTTL", Doc: "", Pos: "zerrors_linux_amd64.go:439"},
+		"syscall.IP_MSFILTER": {Sig: "const IP_MSFILTER", Doc: "", Pos: "zerrors_linux_amd64.go:440"},
+		"syscall.IP_MSS": {Sig: "const IP_MSS", Doc: "", Pos: "zerrors_linux_amd64.go:441"},
+		"syscall.IP_MTU": {Sig: "const IP_MTU", Doc: "", Pos: "zerrors_linux_amd64.go:442"},
+		"syscall.IP_MTU_DISCOVER": {Sig: "const IP_MTU_DISCOVER", Doc: "", Pos: "zerrors_linux_amd64.go:443"},
+		"syscall.IP_MULTICAST_IF": {Sig: "const IP_MULTICAST_IF", Doc: "", Pos: "zerrors_linux_amd64.go:444"},
+		"syscall.IP_MULTICAST_LOOP": {Sig: "const IP_MULTICAST_LOOP", Doc: "", Pos: "zerrors_linux_amd64.go:445"},
+		"syscall.IP_MULTICAST_TTL": {Sig: "const IP_MULTICAST_TTL", Doc: "", Pos: "zerrors_linux_amd64.go:446"},
+		"syscall.IP_OFFMASK": {Sig: "const IP_OFFMASK", Doc: "", Pos: "zerrors_linux_amd64.go:447"},
+		"syscall.IP_OPTIONS": {Sig: "const IP_OPTIONS", Doc: "", Pos: "zerrors_linux_amd64.go:448"},
+		"syscall.IP_ORIGDSTADDR": {Sig: "const IP_ORIGDSTADDR", Doc: "", Pos: "zerrors_linux_amd64.go:449"},
+		"syscall.IP_PASSSEC": {Sig: "const IP_PASSSEC", Doc: "", Pos: "zerrors_linux_amd64.go:450"},
+		"syscall.IP_PKTINFO": {Sig: "const IP_PKTINFO", Doc: "", Pos: "zerrors_linux_amd64.go:451"},
+		"syscall.IP_PKTOPTIONS": {Sig: "const IP_PKTOPTIONS", Doc: "", Pos: "zerrors_linux_amd64.go:452"},
+		"syscall.IP_PMTUDISC": {Sig: "const IP_PMTUDISC", Doc: "", Pos: "zerrors_linux_amd64.go:453"},
+		"syscall.IP_PMTUDISC_DO": {Sig: "const IP_PMTUDISC_DO", Doc: "", Pos: "zerrors_linux_amd64.go:454"},
+		"syscall.IP_PMTUDISC_DONT": {Sig: "const IP_PMTUDISC_DONT", Doc: "", Pos: "zerrors_linux_amd64.go:455"},
+		"syscall.IP_PMTUDISC_PROBE": {Sig: "const IP_PMTUDISC_PROBE", Doc: "", Pos: "zerrors_linux_amd64.go:456"},
+		"syscall.IP_PMTUDISC_WANT": {Sig: "const IP_PMTUDISC_WANT", Doc: "", Pos: "zerrors_linux_amd64.go:457"},
+		"syscall.IP_RECVERR": {Sig: "const IP_RECVERR", Doc: "", Pos: "zerrors_linux_amd64.go:458"},
+		"syscall.IP_RECVOPTS": {Sig: "const IP_RECVOPTS", Doc: "", Pos: "zerrors_linux_amd64.go:459"},
+		"syscall.IP_RECVORIGDSTADDR": {Sig: "const IP_RECVORIGDSTADDR", Doc: "", Pos: "zerrors_linux_amd64.go:460"},
+		"syscall.IP_RECVRETOPTS": {Sig: "const IP_RECVRETOPTS", Doc: "", Pos: "zerrors_linux_amd64.go:461"},
+		"syscall.IP_RECVTOS": {Sig: "const IP_RECVTOS", Doc: "", Pos: "zerrors_linux_amd64.go:462"},
+		"syscall.IP_RECVTTL": {Sig: "const IP_RECVTTL", Doc: "", Pos: "zerrors_linux_amd64.go:463"},
+		"syscall.IP_RETOPTS": {Sig: "const IP_RETOPTS", Doc: "", Pos: "zerrors_linux_amd64.go:464"},
+		"syscall.IP_RF": {Sig: "const IP_RF", Doc: "", Pos: "zerrors_linux_amd64.go:465"},
+		"syscall.IP_ROUTER_ALERT": {Sig: "const IP_ROUTER_ALERT", Doc: "", Pos: "zerrors_linux_amd64.go:466"},
+		"syscall.IP_TOS": {Sig: "const IP_TOS", Doc: "", Pos: "zerrors_linux_amd64.go:467"},
+		"syscall.IP_TRANSPARENT": {Sig: "const IP_TRANSPARENT", Doc: "", Pos: "zerrors_linux_amd64.go:468"},
+		"syscall.IP_TTL": {Sig: "const IP_TTL", Doc: "", Pos: "zerrors_linux_amd64.go:469"},
+		"syscall.IP_UNBLOCK_SOURCE": {Sig: "const IP_UNBLOCK_SOURCE", Doc: "", Pos: "zerrors_linux_amd64.go:470"},
+		"syscall.IP_XFRM_POLICY": {Sig: "const IP_XFRM_POLICY", Doc: "", Pos: "zerrors_linux_amd64.go:471"},
+		"syscall.LINUX_REBOOT_CMD_CAD_OFF": {Sig: "const LINUX_REBOOT_CMD_CAD_OFF", Doc: "", Pos: "zerrors_linux_amd64.go:472"},
+		"syscall.LINUX_REBOOT_CMD_CAD_ON": {Sig: "const LINUX_REBOOT_CMD_CAD_ON", Doc: "", Pos: "zerrors_linux_amd64.go:473"},
+		"syscall.LINUX_REBOOT_CMD_HALT": {Sig: "const LINUX_REBOOT_CMD_HALT", Doc: "", Pos: "zerrors_linux_amd64.go:474"},
+		"syscall.LINUX_REBOOT_CMD_KEXEC": {Sig: "const LINUX_REBOOT_CMD_KEXEC", Doc: "", Pos: "zerrors_linux_amd64.go:475"},
+		"syscall.LINUX_REBOOT_CMD_POWER_OFF": {Sig: "const LINUX_REBOOT_CMD_POWER_OFF", Doc: "", Pos: "zerrors_linux_amd64.go:476"},
+		"syscall.LINUX_REBOOT_CMD_RESTART": {Sig: "const LINUX_REBOOT_CMD_RESTART", Doc: "", Pos: "zerrors_linux_amd64.go:477"},
+		"syscall.LINUX_REBOOT_CMD_RESTART2": {Sig: "const LINUX_REBOOT_CMD_RESTART2", Doc: "", Pos: "zerrors_linux_amd64.go:478"},
+		"syscall.LINUX_REBOOT_CMD_SW_SUSPEND": {Sig: "const LINUX_REBOOT_CMD_SW_SUSPEND", Doc: "", Pos: "zerrors_linux_amd64.go:479"},
+		"syscall.LINUX_REBOOT_MAGIC1": {Sig: "const LINUX_REBOOT_MAGIC1", Doc: "", Pos: "zerrors_linux_amd64.go:480"},
+		"syscall.LINUX_REBOOT_MAGIC2": {Sig: "const LINUX_REBOOT_MAGIC2", Doc: "", Pos: "zerrors_linux_amd64.go:481"},
+		"syscall.LOCK_EX": {Sig: "const LOCK_EX", Doc: "", Pos: "zerrors_linux_amd64.go:482"},
+		"syscall.LOCK_NB": {Sig: "const LOCK_NB", Doc: "", Pos: "zerrors_linux_amd64.go:483"},
+		"syscall.LOCK_SH": {Sig: "const LOCK_SH", Doc: "", Pos: "zerrors_linux_amd64.go:484"},
+		"syscall.LOCK_UN": {Sig: "const LOCK_UN", Doc: "", Pos: "zerrors_linux_amd64.go:485"},
+		"syscall.MADV_DOFORK": {Sig: "const MADV_DOFORK", Doc: "", Pos: "zerrors_linux_amd64.go:486"},
+		"syscall.MADV_DONTFORK": {Sig: "const MADV_DONTFORK", Doc: "", Pos: "zerrors_linux_amd64.go:487"},
+		"syscall.MADV_DONTNEED": {Sig: "const MADV_DONTNEED", Doc: "", Pos: "zerrors_linux_amd64.go:488"},
+		"syscall.MADV_HUGEPAGE": {Sig: "const MADV_HUGEPAGE", Doc: "", Pos: "zerrors_linux_amd64.go:489"},
+		"syscall.MADV_HWPOISON": {Sig: "const MADV_HWPOISON", Doc: "", Pos: "zerrors_linux_amd64.go:490"},
+		"syscall.MADV_MERGEABLE": {Sig: "const MADV_MERGEABLE", Doc: "", Pos: "zerrors_linux_amd64.go:491"},
+		"syscall.MADV_NOHUGEPAGE": {Sig: "const MADV_NOHUGEPAGE", Doc: "", Pos: "zerrors_linux_amd64.go:492"},
+		"syscall.MADV_NORMAL": {Sig: "const MADV_NORMAL", Doc: "", Pos: "zerrors_linux_amd64.go:493"},
+		"syscall.MADV_RANDOM": {Sig: "const MADV_RANDOM", Doc: "", Pos: "zerrors_linux_amd64.go:494"},
+		"syscall.MADV_REMOVE": {Sig: "const MADV_REMOVE", Doc: "", Pos: "zerrors_linux_amd64.go:495"},
+		"syscall.MADV_SEQUENTIAL": {Sig: "const MADV_SEQUENTIAL", Doc: "", Pos: "zerrors_linux_amd64.go:496"},
+		"syscall.MADV_UNMERGEABLE": {Sig: "const MADV_UNMERGEABLE", Doc: "", Pos: "zerrors_linux_amd64.go:497"},
+		"syscall.MADV_WILLNEED": {Sig: "const MADV_WILLNEED", Doc: "", Pos: "zerrors_linux_amd64.go:498"},
+		"syscall.MAP_32BIT": {Sig: "const MAP_32BIT", Doc: "", Pos: "zerrors_linux_amd64.go:499"},
+		"syscall.MAP_ANON": {Sig: "const MAP_ANON", Doc: "", Pos: "zerrors_linux_amd64.go:500"},
+		"syscall.MAP_ANONYMOUS": {Sig: "const MAP_ANONYMOUS", Doc: "", Pos: "zerrors_linux_amd64.go:501"},
+		"syscall.MAP_DENYWRITE": {Sig: "const MAP_DENYWRITE", Doc: "", Pos: "zerrors_linux_amd64.go:502"},
+		"syscall.MAP_EXECUTABLE": {Sig: "const MAP_EXECUTABLE", Doc: "", Pos: "zerrors_linux_amd64.go:503"},
+		"syscall.MAP_FILE": {Sig: "const MAP_FILE", Doc: "", Pos: "zerrors_linux_amd64.go:504"},
+		"syscall.MAP_FIXED": {Sig: "const MAP_FIXED", Doc: "", Pos: "zerrors_linux_amd64.go:505"},
+		"syscall.MAP_GROWSDOWN": {Sig: "const MAP_GROWSDOWN", Doc: "", Pos: "zerrors_linux_amd64.go:506"},
+		"syscall.MAP_HUGETLB": {Sig: "const MAP_HUGETLB", Doc: "", Pos: "zerrors_linux_amd64.go:507"},
+		"syscall.MAP_LOCKED": {Sig: "const MAP_LOCKED", Doc: "", Pos: "zerrors_linux_amd64.go:508"},
+		"syscall.MAP_NONBLOCK": {Sig: "const MAP_NONBLOCK", Doc: "", Pos: "zerrors_linux_amd64.go:509"},
+		"syscall.MAP_NORESERVE": {Sig: "const MAP_NORESERVE", Doc: "", Pos: "zerrors_linux_amd64.go:510"},
+		"syscall.MAP_POPULATE": {Sig: "const MAP_POPULATE", Doc: "", Pos: "zerrors_linux_amd64.go:511"},
+		"syscall.MAP_PRIVATE": {Sig: "const MAP_PRIVATE", Doc: "", Pos: "zerrors_linux_amd64.go:512"},
+		"syscall.MAP_SHARED": {Sig: "const MAP_SHARED", Doc: "", Pos: "zerrors_linux_amd64.go:513"},
+		"syscall.MAP_STACK": {Sig: "const MAP_STACK", Doc: "", Pos: "zerrors_linux_amd64.go:514"},
+		"syscall.MAP_TYPE": {Sig: "const MAP_TYPE", Doc: "", Pos: "zerrors_linux_amd64.go:515"},
+		"syscall.MCL_CURRENT": {Sig: "const MCL_CURRENT", Doc: "", Pos: "zerrors_linux_amd64.go:516"},
+		"syscall.MCL_FUTURE": {Sig: "const MCL_FUTURE", Doc: "", Pos: "zerrors_linux_amd64.go:517"},
+		"syscall.MNT_DETACH": {Sig: "const MNT_DETACH", Doc: "", Pos: "zerrors_linux_amd64.go:518"},
+		"syscall.MNT_EXPIRE": {Sig: "const MNT_EXPIRE", Doc: "", Pos: "zerrors_linux_amd64.go:519"},
+		"syscall.MNT_FORCE": {Sig: "const MNT_FORCE", Doc: "", Pos: "zerrors_linux_amd64.go:520"},
+		"syscall.MSG_CMSG_CLOEXEC": {Sig: "const MSG_CMSG_CLOEXEC", Doc: "", Pos: "zerrors_linux_amd64.go:521"},
+		"syscall.MSG_CONFIRM": {Sig: "const MSG_CONFIRM", Doc: "", Pos: "zerrors_linux_amd64.go:522"},
+		"syscall.MSG_CTRUNC": {Sig: "const MSG_CTRUNC", Doc: "", Pos: "zerrors_linux_amd64.go:523"},
+		"syscall.MSG_DONTROUTE": {Sig: "const MSG_DONTROUTE", Doc: "", Pos: "zerrors_linux_amd64.go:524"},
+		"syscall.MSG_DONTWAIT": {Sig: "const MSG_DONTWAIT", Doc: "", Pos: "zerrors_linux_amd64.go:525"},
+		"syscall.MSG_EOR": {Sig: "const MSG_EOR", Doc: "", Pos: "zerrors_linux_amd64.go:526"},
+		"syscall.MSG_ERRQUEUE": {Sig: "const MSG_ERRQUEUE", Doc: "", Pos: "zerrors_linux_amd64.go:527"},
+		"syscall.MSG_FASTOPEN": {Sig: "const MSG_FASTOPEN", Doc: "", Pos: "zerrors_linux_amd64.go:528"},
+		"syscall.MSG_FIN": {Sig: "const MSG_FIN", Doc: "", Pos: "zerrors_linux_amd64.go:529"},
+		"syscall.MSG_MORE": {Sig: "const MSG_MORE", Doc: "", Pos: "zerrors_linux_amd64.go:530"},
+		"syscall.MSG_NOSIGNAL": {Sig: "const MSG_NOSIGNAL", Doc: "", Pos: "zerrors_linux_amd64.go:531"},
+		"syscall.MSG_OOB": {Sig: "const MSG_OOB", Doc: "", Pos: "zerrors_linux_amd64.go:532"},
+		"syscall.MSG_PEEK": {Sig: "const MSG_PEEK", Doc: "", Pos: "zerrors_linux_amd64.go:533"},
+		"syscall.MSG_PROXY": {Sig: "const MSG_PROXY", Doc: "", Pos: "zerrors_linux_amd64.go:534"},
+		"syscall.MSG_RST": {Sig: "const MSG_RST", Doc: "", Pos: "zerrors_linux_amd64.go:535"},
+		"syscall.MSG_SYN": {Sig: "const MSG_SYN", Doc: "", Pos: "zerrors_linux_amd64.go:536"},
+		"syscall.MSG_TRUNC": {Sig: "const MSG_TRUNC", Doc: "", Pos: "zerrors_linux_amd64.go:537"},
+		"syscall.MSG_TRYHARD": {Sig: "const MSG_TRYHARD", Doc: "", Pos: "zerrors_linux_amd64.go:538"},
+		"syscall.MSG_WAITALL": {Sig: "const MSG_WAITALL", Doc: "", Pos: "zerrors_linux_amd64.go:539"},
+		"syscall.MSG_WAITFORONE": {Sig: "const MSG_WAITFORONE", Doc: "", Pos: "zerrors_linux_amd64.go:540"},
+		"syscall.MS_ACTIVE": {Sig: "const MS_ACTIVE", Doc: "", Pos: "zerrors_linux_amd64.go:541"},
+		"syscall.MS_ASYNC": {Sig: "const MS_ASYNC", Doc: "", Pos: "zerrors_linux_amd64.go:542"},
+		"syscall.MS_BIND": {Sig: "const MS_BIND", Doc: "", Pos: "zerrors_linux_amd64.go:543"},
+		"syscall.MS_DIRSYNC": {Sig: "const MS_DIRSYNC", Doc: "", Pos: "zerrors_linux_amd64.go:544"},
+		"syscall.MS_INVALIDATE": {Sig: "const MS_INVALIDATE", Doc: "", Pos: "zerrors_linux_amd64.go:545"},
+		"syscall.MS_I_VERSION": {Sig: "const MS_I_VERSION", Doc: "", Pos: "zerrors_linux_amd64.go:546"},
+		"syscall.MS_KERNMOUNT": {Sig: "const MS_KERNMOUNT", Doc: "", Pos: "zerrors_linux_amd64.go:547"},
+		"syscall.MS_MANDLOCK": {Sig: "const MS_MANDLOCK", Doc: "", Pos: "zerrors_linux_amd64.go:548"},
+		"syscall.MS_MGC_MSK": {Sig: "const MS_MGC_MSK", Doc: "", Pos: "zerrors_linux_amd64.go:549"},
+		"syscall.MS_MGC_VAL": {Sig: "const MS_MGC_VAL", Doc: "", Pos: "zerrors_linux_amd64.go:550"},
+		"syscall.MS_MOVE": {Sig: "const MS_MOVE", Doc: "", Pos: "zerrors_linux_amd64.go:551"},
+		"syscall.MS_NOATIME": {Sig: "const MS_NOATIME", Doc: "", Pos: "zerrors_linux_amd64.go:552"},
+		"syscall.MS_NODEV": {Sig: "const MS_NODEV", Doc: "", Pos: "zerrors_linux_amd64.go:553"},
+		"syscall.MS_NODIRATIME": {Sig: "const MS_NODIRATIME", Doc: "", Pos: "zerrors_linux_amd64.go:554"},
+		"syscall.MS_NOEXEC": {Sig: "const MS_NOEXEC", Doc: "", Pos: "zerrors_linux_amd64.go:555"},
+		"syscall.MS_NOSUID": {Sig: "const MS_NOSUID", Doc: "", Pos: "zerrors_linux_amd64.go:556"},
+		"syscall.MS_NOUSER": {Sig: "const MS_NOUSER", Doc: "", Pos: "zerrors_linux_amd64.go:557"},
+		"syscall.MS_POSIXACL": {Sig: "const MS_POSIXACL", Doc: "", Pos: "zerrors_linux_amd64.go:558"},
+		"syscall.MS_PRIVATE": {Sig: "const MS_PRIVATE", Doc: "", Pos: "zerrors_linux_amd64.go:559"},
+		"syscall.MS_RDONLY": {Sig: "const MS_RDONLY", Doc: "", Pos: "zerrors_linux_amd64.go:560"},
+		"syscall.MS_REC": {Sig: "const MS_REC", Doc: "", Pos: "zerrors_linux_amd64.go:561"},
+		"syscall.MS_RELATIME": {Sig: "const MS_RELATIME", Doc: "", Pos: "zerrors_linux_amd64.go:562"},
+		"syscall.MS_REMOUNT": {Sig: "const MS_REMOUNT", Doc: "", Pos: "zerrors_linux_amd64.go:563"},
+		"syscall.MS_RMT_MASK": {Sig: "const MS_RMT_MASK", Doc: "", Pos: "zerrors_linux_amd64.go:564"},
+		"syscall.MS_SHARED": {Sig: "const MS_SHARED", Doc: "", Pos: "zerrors_linux_amd64.go:565"},
+		"syscall.MS_SILENT": {Sig: "const MS_SILENT", Doc: "", Pos: "zerrors_linux_amd64.go:566"},
+		"syscall.MS_SLAVE": {Sig: "const MS_SLAVE", Doc: "", Pos: "zerrors_linux_amd64.go:567"},
+		"syscall.MS_STRICTATIME": {Sig: "const MS_STRICTATIME", Doc: "", Pos: "zerrors_linux_amd64.go:568"},
+		"syscall.MS_SYNC": {Sig: "const MS_SYNC", Doc: "", Pos: "zerrors_linux_amd64.go:569"},
+		"syscall.MS_SYNCHRONOUS": {Sig: "const MS_SYNCHRONOUS", Doc: "", Pos: "zerrors_linux_amd64.go:570"},
+		"syscall.MS_UNBINDABLE": {Sig: "const MS_UNBINDABLE", Doc: "", Pos: "zerrors_linux_amd64.go:571"},
+		"syscall.NAME_MAX": {Sig: "const NAME_MAX", Doc: "", Pos: "zerrors_linux_amd64.go:572"},
+		"syscall.NETLINK_ADD_MEMBERSHIP": {Sig: "const NETLINK_ADD_MEMBERSHIP", Doc: "", Pos: "zerrors_linux_amd64.go:573"},
+		"syscall.NETLINK_AUDIT": {Sig: "const NETLINK_AUDIT", Doc: "", Pos: "zerrors_linux_amd64.go:574"},
+		"syscall.NETLINK_BROADCAST_ERROR": {Sig: "const NETLINK_BROADCAST_ERROR", Doc: "", Pos: "zerrors_linux_amd64.go:575"},
+		"syscall.NETLINK_CONNECTOR": {Sig: "const NETLINK_CONNECTOR", Doc: "", Pos: "zerrors_linux_amd64.go:576"},
+		"syscall.NETLINK_DNRTMSG": {Sig: "const NETLINK_DNRTMSG", Doc: "", Pos: "zerrors_linux_amd64.go:577"},
+		"syscall.NETLINK_DROP_MEMBERSHIP": {Sig: "const NETLINK_DROP_MEMBERSHIP", Doc: "", Pos: "zerrors_linux_amd64.go:578"},
+		"syscall.NETLINK_ECRYPTFS": {Sig: "const NETLINK_ECRYPTFS", Doc: "", Pos: "zerrors_linux_amd64.go:579"},
+		"syscall.NETLINK_FIB_LOOKUP": {Sig: "const NETLINK_FIB_LOOKUP", Doc: "", Pos: "zerrors_linux_amd64.go:580"},
+		"syscall.NETLINK_FIREWALL": {Sig: "const NETLINK_FIREWALL", Doc: "", Pos: "zerrors_linux_amd64.go:581"},
+		"syscall.NETLINK_GENERIC": {Sig: "const NETLINK_GENERIC", Doc: "", Pos: "zerrors_linux_amd64.go:582"},
+		"syscall.NETLINK_INET_DIAG": {Sig: "const NETLINK_INET_DIAG", Doc: "", Pos: "zerrors_linux_amd64.go:583"},
+		"syscall.NETLINK_IP6_FW": {Sig: "const NETLINK_IP6_FW", Doc: "", Pos: "zerrors_linux_amd64.go:584"},
+		"syscall.NETLINK_ISCSI": {Sig: "const NETLINK_ISCSI", Doc: "", Pos: "zerrors_linux_amd64.go:585"},
+		"syscall.NETLINK_KOBJECT_UEVENT": {Sig: "const NETLINK_KOBJECT_UEVENT", Doc: "", Pos: "zerrors_linux_amd64.go:586"},
+		"syscall.NETLINK_NETFILTER": {Sig: "const NETLINK_NETFILTER", Doc: "", Pos: "zerrors_linux_amd64.go:587"},
+		"syscall.NETLINK_NFLOG": {Sig: "const NETLINK_NFLOG", Doc: "", Pos: "zerrors_linux_amd64.go:588"},
+		"syscall.NETLINK_NO_ENOBUFS": {Sig: "const NETLINK_NO_ENOBUFS", Doc: "", Pos: "zerrors_linux_amd64.go:589"},
+		"syscall.NETLINK_PKTINFO": {Sig: "const NETLINK_PKTINFO", Doc: "", Pos: "zerrors_linux_amd64.go:590"},
+		"syscall.NETLINK_ROUTE": {Sig: "const NETLINK_ROUTE", Doc: "", Pos: "zerrors_linux_amd64.go:591"},
+		"syscall.NETLINK_SCSITRANSPORT": {Sig: "const NETLINK_SCSITRANSPORT", Doc: "", Pos: "zerrors_linux_amd64.go:592"},
+		"syscall.NETLINK_SELINUX": {Sig: "const NETLINK_SELINUX", Doc: "", Pos: "zerrors_linux_amd64.go:593"},
+		"syscall.NETLINK_UNUSED": {Sig: "const NETLINK_UNUSED", Doc: "", Pos: "zerrors_linux_amd64.go:594"},
+		"syscall.NETLINK_USERSOCK": {Sig: "const NETLINK_USERSOCK", Doc: "", Pos: "zerrors_linux_amd64.go:595"},
+		"syscall.NETLINK_XFRM": {Sig: "const NETLINK_XFRM", Doc: "", Pos: "zerrors_linux_amd64.go:596"},
+		"syscall.NLA_ALIGNTO": {Sig: "const NLA_ALIGNTO", Doc: "", Pos: "zerrors_linux_amd64.go:597"},
+		"syscall.NLA_F_NESTED": {Sig: "const NLA_F_NESTED", Doc: "", Pos: "zerrors_linux_amd64.go:598"},
+		"syscall.NLA_F_NET_BYTEORDER": {Sig: "const NLA_F_NET_BYTEORDER", Doc: "", Pos: "zerrors_linux_amd64.go:599"},
+		"syscall.NLA_HDRLEN": {Sig: "const NLA_HDRLEN", Doc: "", Pos: "zerrors_linux_amd64.go:600"},
+		"syscall.NLMSG_ALIGNTO": {Sig: "const NLMSG_ALIGNTO", Doc: "", Pos: "zerrors_linux_amd64.go:601"},
+		"syscall.NLMSG_DONE": {Sig: "const NLMSG_DONE", Doc: "", Pos: "zerrors_linux_amd64.go:602"},
+		"syscall.NLMSG_ERROR": {Sig: "const NLMSG_ERROR", Doc: "", Pos: "zerrors_linux_amd64.go:603"},
+		"syscall.NLMSG_HDRLEN": {Sig: "const NLMSG_HDRLEN", Doc: "", Pos: "zerrors_linux_amd64.go:604"},
+		"syscall.NLMSG_MIN_TYPE": {Sig: "const NLMSG_MIN_TYPE", Doc: "", Pos: "zerrors_linux_amd64.go:605"},
+		"syscall.NLMSG_NOOP": {Sig: "const NLMSG_NOOP", Doc: "", Pos: "zerrors_linux_amd64.go:606"},
+		"syscall.NLMSG_OVERRUN": {Sig: "const NLMSG_OVERRUN", Doc: "", Pos: "zerrors_linux_amd64.go:607"},
+		"syscall.NLM_F_ACK": {Sig: "const NLM_F_ACK", Doc: "", Pos: "zerrors_linux_amd64.go:608"},
+		"syscall.NLM_F_APPEND": {Sig: "const NLM_F_APPEND", Doc: "", Pos: "zerrors_linux_amd64.go:609"},
+		"syscall.NLM_F_ATOMIC": {Sig: "const NLM_F_ATOMIC", Doc: "", Pos: "zerrors_linux_amd64.go:610"},
+		"syscall.NLM_F_CREATE": {Sig: "const NLM_F_CREATE", Doc: "", Pos: "zerrors_linux_amd64.go:611"},
+		"syscall.NLM_F_DUMP": {Sig: "const NLM_F_DUMP", Doc: "", Pos: "zerrors_linux_amd64.go:612"},
+		"syscall.NLM_F_ECHO": {Sig: "const NLM_F_ECHO", Doc: "", Pos: "zerrors_linux_amd64.go:613"},
+		"syscall.NLM_F_EXCL": {Sig: "const NLM_F_EXCL", Doc: "", Pos: "zerrors_linux_amd64.go:614"},
+		"syscall.NLM_F_MATCH": {Sig: "const NLM_F_MATCH", Doc: "", Pos: "zerrors_linux_amd64.go:615"},
+		"syscall.NLM_F_MULTI": {Sig: "const NLM_F_MULTI", Doc: "", Pos: "zerrors_linux_amd64.go:616"},
+		"syscall.NLM_F_REPLACE": {Sig: "const NLM_F_REPLACE", Doc: "", Pos: "zerrors_linux_amd64.go:617"},
+		"syscall.NLM_F_REQUEST": {Sig: "const NLM_F_REQUEST", Doc: "", Pos: "zerrors_linux_amd64.go:618"},
+		"syscall.NLM_F_ROOT": {Sig: "const NLM_F_ROOT", Doc: "", Pos: "zerrors_linux_amd64.go:619"},
+		"syscall.O_ACCMODE": {Sig: "const O_ACCMODE", Doc: "", Pos: "zerrors_linux_amd64.go:620"},
+		"syscall.O_APPEND": {Sig: "const O_APPEND", Doc: "", Pos: "zerrors_linux_amd64.go:621"},
+		"syscall.O_ASYNC": {Sig: "const O_ASYNC", Doc: "", Pos: "zerrors_linux_amd64.go:622"},
+		"syscall.O_CLOEXEC": {Sig: "const O_CLOEXEC", Doc: "", Pos: "zerrors_linux_amd64.go:623"},
+		"syscall.O_CREAT": {Sig: "const O_CREAT", Doc: "", Pos: "zerrors_linux_amd64.go:624"},
+		"syscall.O_DIRECT": {Sig: "const O_DIRECT", Doc: "", Pos: "zerrors_linux_amd64.go:625"},
+		"syscall.O_DIRECTORY": {Sig: "const O_DIRECTORY", Doc: "", Pos: "zerrors_linux_amd64.go:626"},
+		"syscall.O_DSYNC": {Sig: "const O_DSYNC", Doc: "", Pos: "zerrors_linux_amd64.go:627"},
+		"syscall.O_EXCL": {Sig: "const O_EXCL", Doc: "", Pos: "zerrors_linux_amd64.go:628"},
+		"syscall.O_FSYNC": {Sig: "const O_FSYNC", Doc: "", Pos: "zerrors_linux_amd64.go:629"},
+		"syscall.O_LARGEFILE": {Sig: "const O_LARGEFILE", Doc: "", Pos: "zerrors_linux_amd64.go:630"},
+		"syscall.O_NDELAY": {Sig: "const O_NDELAY", Doc: "", Pos: "zerrors_linux_amd64.go:631"},
+		"syscall.O_NOATIME": {Sig: "const O_NOATIME", Doc: "", Pos: "zerrors_linux_amd64.go:632"},
+		"syscall.O_NOCTTY": {Sig: "const O_NOCTTY", Doc: "", Pos: "zerrors_linux_amd64.go:633"},
+		"syscall.O_NOFOLLOW": {Sig: "const O_NOFOLLOW", Doc: "", Pos: "zerrors_linux_amd64.go:634"},
+		"syscall.O_NONBLOCK": {Sig: "const O_NONBLOCK", Doc: "", Pos: "zerrors_linux_amd64.go:635"},
+		"syscall.O_RDONLY": {Sig: "const O_RDONLY", Doc: "", Pos: "zerrors_linux_amd64.go:636"},
+		"syscall.O_RDWR": {Sig: "const O_RDWR", Doc: "", Pos: "zerrors_linux_amd64.go:637"},
+		"syscall.O_RSYNC": {Sig: "const O_RSYNC", Doc: "", Pos: "zerrors_linux_amd64.go:638"},
+		"syscall.O_SYNC": {Sig: "const O_SYNC", Doc: "", Pos: "zerrors_linux_amd64.go:639"},
+		"syscall.O_TRUNC": {Sig: "const O_TRUNC", Doc: "", Pos: "zerrors_linux_amd64.go:640"},
+		"syscall.O_WRONLY": {Sig: "const O_WRONLY", Doc: "", Pos: "zerrors_linux_amd64.go:641"},
+		"syscall.PACKET_ADD_MEMBERSHIP": {Sig: "const PACKET_ADD_MEMBERSHIP", Doc: "", Pos: "zerrors_linux_amd64.go:642"},
+		"syscall.PACKET_BROADCAST": {Sig: "const PACKET_BROADCAST", Doc: "", Pos: "zerrors_linux_amd64.go:643"},
+		"syscall.PACKET_DROP_MEMBERSHIP": {Sig: "const PACKET_DROP_MEMBERSHIP", Doc: "", Pos: "zerrors_linux_amd64.go:644"},
+		"syscall.PACKET_FASTROUTE": {Sig: "const PACKET_FASTROUTE", Doc: "", Pos: "zerrors_linux_amd64.go:645"},
+		"syscall.PACKET_HOST": {Sig: "const PACKET_HOST", Doc: "", Pos: "zerrors_linux_amd64.go:646"},
+		"syscall.PACKET_LOOPBACK": {Sig: "const PACKET_LOOPBACK", Doc: "", Pos: "zerrors_linux_amd64.go:647"},
+		"syscall.PACKET_MR_ALLMULTI": {Sig: "const PACKET_MR_ALLMULTI", Doc: "", Pos: "zerrors_linux_amd64.go:648"},
+		"syscall.PACKET_MR_MULTICAST": {Sig: "const PACKET_MR_MULTICAST", Doc: "", Pos: "zerrors_linux_amd64.go:649"},
+		"syscall.PACKET_MR_PROMISC": {Sig: "const PACKET_MR_PROMISC", Doc: "", Pos: "zerrors_linux_amd64.go:650"},
+		"syscall.PACKET_MULTICAST": {Sig: "const PACKET_MULTICAST", Doc: "", Pos: "zerrors_linux_amd64.go:651"},
+		"syscall.PACKET_OTHERHOST": {Sig: "const PACKET_OTHERHOST", Doc: "", Pos: "zerrors_linux_amd64.go:652"},
+		"syscall.PACKET_OUTGOING": {Sig: "const PACKET_OUTGOING", Doc: "", Pos: "zerrors_linux_amd64.go:653"},
+		"syscall.PACKET_RECV_OUTPUT": {Sig: "const PACKET_RECV_OUTPUT", Doc: "", Pos: "zerrors_linux_amd64.go:654"},
+		"syscall.PACKET_RX_RING": {Sig: "const PACKET_RX_RING", Doc: "", Pos: "zerrors_linux_amd64.go:655"},
+		"syscall.PACKET_STATISTICS": {Sig: "const PACKET_STATISTICS", Doc: "", Pos: "zerrors_linux_amd64.go:656"},
+		"syscall.PRIO_PGRP": {Sig: "const PRIO_PGRP", Doc: "", Pos: "zerrors_linux_amd64.go:657"},
+		"syscall.PRIO_PROCESS": {Sig: "const PRIO_PROCESS", Doc: "", Pos: "zerrors_linux_amd64.go:658"},
+		"syscall.PRIO_USER": {Sig: "const PRIO_USER", Doc: "", Pos: "zerrors_linux_amd64.go:659"},
+		"syscall.PROT_EXEC": {Sig: "const PROT_EXEC", Doc: "", Pos: "zerrors_linux_amd64.go:660"},
+		"syscall.PROT_GROWSDOWN": {Sig: "const PROT_GROWSDOWN", Doc: "", Pos: "zerrors_linux_amd64.go:661"},
+		"syscall.PROT_GROWSUP": {Sig: "const PROT_GROWSUP", Doc: "", Pos: "zerrors_linux_amd64.go:662"},
+		"syscall.PROT_NONE": {Sig: "const PROT_NONE", Doc: "", Pos: "zerrors_linux_amd64.go:663"},
+		"syscall.PROT_READ": {Sig: "const PROT_READ", Doc: "", Pos: "zerrors_linux_amd64.go:664"},
+		"syscall.PROT_WRITE": {Sig: "const PROT_WRITE", Doc: "", Pos: "zerrors_linux_amd64.go:665"},
+		"syscall.PR_CAPBSET_DROP": {Sig: "const PR_CAPBSET_DROP", Doc: "", Pos: "zerrors_linux_amd64.go:666"},
+		"syscall.PR_CAPBSET_READ": {Sig: "const PR_CAPBSET_READ", Doc: "", Pos: "zerrors_linux_amd64.go:667"},
+		"syscall.PR_ENDIAN_BIG": {Sig: "const PR_ENDIAN_BIG", Doc: "", Pos: "zerrors_linux_amd64.go:668"},
+		"syscall.PR_ENDIAN_LITTLE": {Sig: "const PR_ENDIAN_LITTLE", Doc: "", Pos: "zerrors_linux_amd64.go:669"},
+		"syscall.PR_ENDIAN_PPC_LITTLE": {Sig: "const PR_ENDIAN_PPC_LITTLE", Doc: "", Pos: "zerrors_linux_amd64.go:670"},
+		"syscall.PR_FPEMU_NOPRINT": {Sig: "const PR_FPEMU_NOPRINT", Doc: "", Pos: "zerrors_linux_amd64.go:671"},
+		"syscall.PR_FPEMU_SIGFPE": {Sig: "const PR_FPEMU_SIGFPE", Doc: "", Pos: "zerrors_linux_amd64.go:672"},
+		"syscall.PR_FP_EXC_ASYNC": {Sig: "const PR_FP_EXC_ASYNC", Doc: "", Pos: "zerrors_linux_amd64.go:673"},
+		"syscall.PR_FP_EXC_DISABLED": {Sig: "const PR_FP_EXC_DISABLED", Doc: "", Pos: "zerrors_linux_amd64.go:674"},
+		"syscall.PR_FP_EXC_DIV": {Sig: "const PR_FP_EXC_DIV", Doc: "", Pos: "zerrors_linux_amd64.go:675"},
+		"syscall.PR_FP_EXC_INV": {Sig: "const PR_FP_EXC_INV", Doc: "", Pos: "zerrors_linux_amd64.go:676"},
+		"syscall.PR_FP_EXC_NONRECOV": {Sig: "const PR_FP_EXC_NONRECOV", Doc: "", Pos: "zerrors_linux_amd64.go:677"},
+		"syscall.PR_FP_EXC_OVF": {Sig: "const PR_FP_EXC_OVF", Doc: "", Pos: "zerrors_linux_amd64.go:678"},
+		"syscall.PR_FP_EXC_PRECISE": {Sig: "const PR_FP_EXC_PRECISE", Doc: "", Pos: "zerrors_linux_amd64.go:679"},
+		"syscall.PR_FP_EXC_RES": {Sig: "const PR_FP_EXC_RES", Doc: "", Pos: "zerrors_linux_amd64.go:680"},
+		"syscall.PR_FP_EXC_SW_ENABLE": {Sig: "const PR_FP_EXC_SW_ENABLE", Doc: "", Pos: "zerrors_linux_amd64.go:681"},
+		"syscall.PR_FP_EXC_UND": {Sig: "const PR_FP_EXC_UND", Doc: "", Pos: "zerrors_linux_amd64.go:682"},
+		"syscall.PR_GET_DUMPABLE": {Sig: "const PR_GET_DUMPABLE", Doc: "", Pos: "zerrors_linux_amd64.go:683"},
+		"syscall.PR_GET_ENDIAN": {Sig: "const PR_GET_ENDIAN", Doc: "", Pos: "zerrors_linux_amd64.go:684"},
+		"syscall.PR_GET_FPEMU": {Sig: "const PR_GET_FPEMU", Doc: "", Pos: "zerrors_linux_amd64.go:685"},
+		"syscall.PR_GET_FPEXC": {Sig: "const PR_GET_FPEXC", Doc: "", Pos: "zerrors_linux_amd64.go:686"},
+		"syscall.PR_GET_KEEPCAPS": {Sig: "const PR_GET_KEEPCAPS", Doc: "", Pos: "zerrors_linux_amd64.go:687"},
+		"syscall.PR_GET_NAME": {Sig: "const PR_GET_NAME", Doc: "", Pos: "zerrors_linux_amd64.go:688"},
+		"syscall.PR_GET_PDEATHSIG": {Sig: "const PR_GET_PDEATHSIG", Doc: "", Pos: "zerrors_linux_amd64.go:689"},
+		"syscall.PR_GET_SECCOMP": {Sig: "const PR_GET_SECCOMP", Doc: "", Pos: "zerrors_linux_amd64.go:690"},
+		"syscall.PR_GET_SECUREBITS": {Sig: "const PR_GET_SECUREBITS", Doc: "", Pos: "zerrors_linux_amd64.go:691"},
+		"syscall.PR_GET_TIMERSLACK": {Sig: "const PR_GET_TIMERSLACK", Doc: "", Pos: "zerrors_linux_amd64.go:692"},
+		"syscall.PR_GET_TIMING": {Sig: "const PR_GET_TIMING", Doc: "", Pos: "zerrors_linux_amd64.go:693"},
+		"syscall.PR_GET_TSC": {Sig: "const PR_GET_TSC", Doc: "", Pos: "zerrors_linux_amd64.go:694"},
+		"syscall.PR_GET_UNALIGN": {Sig: "const PR_GET_UNALIGN", Doc: "", Pos: "zerrors_linux_amd64.go:695"},
+		"syscall.PR_MCE_KILL": {Sig: "const PR_MCE_KILL", Doc: "", Pos: "zerrors_linux_amd64.go:696"},
+		"syscall.PR_MCE_KILL_CLEAR": {Sig: "const PR_MCE_KILL_CLEAR", Doc: "", Pos: "zerrors_linux_amd64.go:697"},
+		"syscall.PR_MCE_KILL_DEFAULT": {Sig: "const PR_MCE_KILL_DEFAULT", Doc: "", Pos: "zerrors_linux_amd64.go:698"},
+		"syscall.PR_MCE_KILL_EARLY": {Sig: "const PR_MCE_KILL_EARLY", Doc: "", Pos: "zerrors_linux_amd64.go:699"},
+		"syscall.PR_MCE_KILL_GET": {Sig: "const PR_MCE_KILL_GET", Doc: "", Pos: "zerrors_linux_amd64.go:700"},
+		"syscall.PR_MCE_KILL_LATE": {Sig: "const PR_MCE_KILL_LATE", Doc: "", Pos: "zerrors_linux_amd64.go:701"},
+		"syscall.PR_MCE_KILL_SET": {Sig: "const PR_MCE_KILL_SET", Doc: "", Pos: "zerrors_linux_amd64.go:702"},
+		"syscall.PR_SET_DUMPABLE": {Sig: "const PR_SET_DUMPABLE", Doc: "", Pos: "zerrors_linux_amd64.go:703"},
+		"syscall.PR_SET_ENDIAN": {Sig: "const PR_SET_ENDIAN", Doc: "", Pos: "zerrors_linux_amd64.go:704"},
+		"syscall.PR_SET_FPEMU": {Sig: "const PR_SET_FPEMU", Doc: "", Pos: "zerrors_linux_amd64.go:705"},
+		"syscall.PR_SET_FPEXC": {Sig: "const PR_SET_FPEXC", Doc: "", Pos: "zerrors_linux_amd64.go:706"},
+		"syscall.PR_SET_KEEPCAPS": {Sig: "const PR_SET_KEEPCAPS", Doc: "", Pos: "zerrors_linux_amd64.go:707"},
+		"syscall.PR_SET_NAME": {Sig: "const PR_SET_NAME", Doc: "", Pos: "zerrors_linux_amd64.go:708"},
+		"syscall.PR_SET_PDEATHSIG": {Sig: "const PR_SET_PDEATHSIG", Doc: "", Pos: "zerrors_linux_amd64.go:709"},
+		"syscall.PR_SET_PTRACER": {Sig: "const PR_SET_PTRACER", Doc: "", Pos: "zerrors_linux_amd64.go:710"},
+		"syscall.PR_SET_SECCOMP": {Sig: "const PR_SET_SECCOMP", Doc: "", Pos: "zerrors_linux_amd64.go:711"},
+		"syscall.PR_SET_SECUREBITS": {Sig: "const PR_SET_SECUREBITS", Doc: "", Pos: "zerrors_linux_amd64.go:712"},
+		"syscall.PR_SET_TIMERSLACK": {Sig: "const PR_SET_TIMERSLACK", Doc: "", Pos: "zerrors_linux_amd64.go:713"},
+		"syscall.PR_SET_TIMING": {Sig: "const PR_SET_TIMING", Doc: "", Pos: "zerrors_linux_amd64.go:714"},
+		"syscall.PR_SET_TSC": {Sig: "const PR_SET_TSC", Doc: "", Pos: "zerrors_linux_amd64.go:715"},
+		"syscall.PR_SET_UNALIGN": {Sig: "const PR_SET_UNALIGN", Doc: "", Pos: "zerrors_linux_amd64.go:716"},
+		"syscall.PR_TASK_PERF_EVENTS_DISABLE": {Sig: "const PR_TASK_PERF_EVENTS_DISABLE", Doc: "", Pos: "zerrors_linux_amd64.go:717"},
+		"syscall.PR_TASK_PERF_EVENTS_ENABLE": {Sig: "const PR_TASK_PERF_EVENTS_ENABLE", Doc: "", Pos: "zerrors_linux_amd64.go:718"},
+		"syscall.PR_TIMING_STATISTICAL": {Sig: "const PR_TIMING_STATISTICAL", Doc: "", Pos: "zerrors_linux_amd64.go:719"},
+		"syscall.PR_TIMING_TIMESTAMP": {Sig: "const PR_TIMING_TIMESTAMP", Doc: "", Pos: "zerrors_linux_amd64.go:720"},
+		"syscall.PR_TSC_ENABLE": {Sig: "const PR_TSC_ENABLE", Doc: "", Pos: "zerrors_linux_amd64.go:721"},
+		"syscall.PR_TSC_SIGSEGV": {Sig: "const PR_TSC_SIGSEGV", Doc: "", Pos: "zerrors_linux_amd64.go:722"},
+		"syscall.PR_UNALIGN_NOPRINT": {Sig: "const PR_UNALIGN_NOPRINT", Doc: "", Pos: "zerrors_linux_amd64.go:723"},
+		"syscall.PR_UNALIGN_SIGBUS": {Sig: "const PR_UNALIGN_SIGBUS", Doc: "", Pos: "zerrors_linux_amd64.go:724"},
+		"syscall.PTRACE_ARCH_PRCTL": {Sig: "const PTRACE_ARCH_PRCTL", Doc: "", Pos: "zerrors_linux_amd64.go:725"},
+		"syscall.PTRACE_ATTACH": {Sig: "const PTRACE_ATTACH", Doc: "", Pos: "zerrors_linux_amd64.go:726"},
+		"syscall.PTRACE_CONT": {Sig: "const PTRACE_CONT", Doc: "", Pos: "zerrors_linux_amd64.go:727"},
+		"syscall.PTRACE_DETACH": {Sig: "const PTRACE_DETACH", Doc: "", Pos: "zerrors_linux_amd64.go:728"},
+		"syscall.PTRACE_EVENT_CLONE": {Sig: "const PTRACE_EVENT_CLONE", Doc: "", Pos: "zerrors_linux_amd64.go:729"},
+		"syscall.PTRACE_EVENT_EXEC": {Sig: "const PTRACE_EVENT_EXEC", Doc: "", Pos: "zerrors_linux_amd64.go:730"},
+		"syscall.PTRACE_EVENT_EXIT": {Sig: "const PTRACE_EVENT_EXIT", Doc: "", Pos: "zerrors_linux_amd64.go:731"},
+		"syscall.PTRACE_EVENT_FORK": {Sig: "const PTRACE_EVENT_FORK", Doc: "", Pos: "zerrors_linux_amd64.go:732"},
+		"syscall.PTRACE_EVENT_VFORK": {Sig: "const PTRACE_EVENT_VFORK", Doc: "", Pos: "zerrors_linux_amd64.go:733"},
+		"syscall.PTRACE_EVENT_VFORK_DONE": {Sig: "const PTRACE_EVENT_VFORK_DONE", Doc: "", Pos: "zerrors_linux_amd64.go:734"},
+		"syscall.PTRACE_GETEVENTMSG": {Sig: "const PTRACE_GETEVENTMSG", Doc: "", Pos: "zerrors_linux_amd64.go:735"},
+		"syscall.PTRACE_GETFPREGS": {Sig: "const PTRACE_GETFPREGS", Doc: "", Pos: "zerrors_linux_amd64.go:736"},
+		"syscall.PTRACE_GETFPXREGS": {Sig: "const PTRACE_GETFPXREGS", Doc: "", Pos: "zerrors_linux_amd64.go:737"},
+		"syscall.PTRACE_GETREGS": {Sig: "const PTRACE_GETREGS", Doc: "", Pos: "zerrors_linux_amd64.go:738"},
+		"syscall.PTRACE_GETREGSET": {Sig: "const PTRACE_GETREGSET", Doc: "", Pos: "zerrors_linux_amd64.go:739"},
+		"syscall.PTRACE_GETSIGINFO": {Sig: "const PTRACE_GETSIGINFO", Doc: "", Pos: "zerrors_linux_amd64.go:740"},
+		"syscall.PTRACE_GET_THREAD_AREA": {Sig: "const PTRACE_GET_THREAD_AREA", Doc: "", Pos: "zerrors_linux_amd64.go:741"},
+		"syscall.PTRACE_KILL": {Sig: "const PTRACE_KILL", Doc: "", Pos: "zerrors_linux_amd64.go:742"},
+		"syscall.PTRACE_OLDSETOPTIONS": {Sig: "const PTRACE_OLDSETOPTIONS", Doc: "", Pos: "zerrors_linux_amd64.go:743"},
+		"syscall.PTRACE_O_MASK": {Sig: "const PTRACE_O_MASK", Doc: "", Pos: "zerrors_linux_amd64.go:744"},
+		"syscall.PTRACE_O_TRACECLONE": {Sig: "const PTRACE_O_TRACECLONE", Doc: "", Pos: "zerrors_linux_amd64.go:745"},
+		"syscall.PTRACE_O_TRACEEXEC": {Sig: "const PTRACE_O_TRACEEXEC", Doc: "", Pos: "zerrors_linux_amd64.go:746"},
+		"syscall.PTRACE_O_TRACEEXIT": {Sig: "const PTRACE_O_TRACEEXIT", Doc: "", Pos: "zerrors_linux_amd64.go:747"},
+		"syscall.PTRACE_O_TRACEFORK": {Sig: "const PTRACE_O_TRACEFORK", Doc: "", Pos: "zerrors_linux_amd64.go:748"},
+		"syscall.PTRACE_O_TRACESYSGOOD": {Sig: "const PTRACE_O_TRACESYSGOOD", Doc: "", Pos: "zerrors_linux_amd64.go:749"},
+		"syscall.PTRACE_O_TRACEVFORK": {Sig: "const PTRACE_O_TRACEVFORK", Doc: "", Pos: "zerrors_linux_amd64.go:750"},
+		"syscall.PTRACE_O_TRACEVFORKDONE": {Sig: "const PTRACE_O_TRACEVFORKDONE", Doc: "", Pos: "zerrors_linux_amd64.go:751"},
+		"syscall.PTRACE_PEEKDATA": {Sig: "const PTRACE_PEEKDATA", Doc: "", Pos: "zerrors_linux_amd64.go:752"},
+		"syscall.PTRACE_PEEKTEXT": {Sig: "const PTRACE_PEEKTEXT", Doc: "", Pos: "zerrors_linux_amd64.go:753"},
+		"syscall.PTRACE_PEEKUSR": {Sig: "const PTRACE_PEEKUSR", Doc: "", Pos: "zerrors_linux_amd64.go:754"},
+		"syscall.PTRACE_POKEDATA": {Sig: "const PTRACE_POKEDATA", Doc: "", Pos: "zerrors_linux_amd64.go:755"},
+		"syscall.PTRACE_POKETEXT": {Sig: "const PTRACE_POKETEXT", Doc: "", Pos: "zerrors_linux_amd64.go:756"},
+		"syscall.PTRACE_POKEUSR": {Sig: "const PTRACE_POKEUSR", Doc: "", Pos: "zerrors_linux_amd64.go:757"},
+		"syscall.PTRACE_SETFPREGS": {Sig: "const PTRACE_SETFPREGS", Doc: "", Pos: "zerrors_linux_amd64.go:758"},
+		"syscall.PTRACE_SETFPXREGS": {Sig: "const PTRACE_SETFPXREGS", Doc: "", Pos: "zerrors_linux_amd64.go:759"},
+		"syscall.PTRACE_SETOPTIONS": {Sig: "const PTRACE_SETOPTIONS", Doc: "", Pos: "zerrors_linux_amd64.go:760"},
+		"syscall.PTRACE_SETREGS": {Sig: "const PTRACE_SETREGS", Doc: "", Pos: "zerrors_linux_amd64.go:761"},
+		"syscall.PTRACE_SETREGSET": {Sig: "const PTRACE_SETREGSET", Doc: "", Pos: "zerrors_linux_amd64.go:762"},
+		"syscall.PTRACE_SETSIGINFO": {Sig: "const PTRACE_SETSIGINFO", Doc: "", Pos: "zerrors_linux_amd64.go:763"},
+		"syscall.PTRACE_SET_THREAD_AREA": {Sig: "const PTRACE_SET_THREAD_AREA", Doc: "", Pos: "zerrors_linux_amd64.go:764"},
+		"syscall.PTRACE_SINGLEBLOCK": {Sig: "const PTRACE_SINGLEBLOCK", Doc: "", Pos: "zerrors_linux_amd64.go:765"},
+		"syscall.PTRACE_SINGLESTEP": {Sig: "const PTRACE_SINGLESTEP", Doc: "", Pos: "zerrors_linux_amd64.go:766"},
+		"syscall.PTRACE_SYSCALL": {Sig: "const PTRACE_SYSCALL", Doc: "", Pos: "zerrors_linux_amd64.go:767"},
+		"syscall.PTRACE_SYSEMU": {Sig: "const PTRACE_SYSEMU", Doc: "", Pos: "zerrors_linux_amd64.go:768"},
+		"syscall.PTRACE_SYSEMU_SINGLESTEP": {Sig: "const PTRACE_SYSEMU_SINGLESTEP", Doc: "", Pos: "zerrors_linux_amd64.go:769"},
+		"syscall.PTRACE_TRACEME": {Sig: "const PTRACE_TRACEME", Doc: "", Pos: "zerrors_linux_amd64.go:770"},
+		"syscall.RLIMIT_AS": {Sig: "const RLIMIT_AS", Doc: "", Pos: "zerrors_linux_amd64.go:771"},
+		"syscall.RLIMIT_CORE": {Sig: "const RLIMIT_CORE", Doc: "", Pos: "zerrors_linux_amd64.go:772"},
+		"syscall.RLIMIT_CPU": {Sig: "const RLIMIT_CPU", Doc: "", Pos: "zerrors_linux_amd64.go:773"},
+		"syscall.RLIMIT_DATA": {Sig: "const RLIMIT_DATA", Doc: "", Pos: "zerrors_linux_amd64.go:774"},
+		"syscall.RLIMIT_FSIZE": {Sig: "const RLIMIT_FSIZE", Doc: "", Pos: "zerrors_linux_amd64.go:775"},
+		"syscall.RLIMIT_NOFILE": {Sig: "const RLIMIT_NOFILE", Doc: "", Pos: "zerrors_linux_amd64.go:776"},
+		"syscall.RLIMIT_STACK": {Sig: "const RLIMIT_STACK", Doc: "", Pos: "zerrors_linux_amd64.go:777"},
+		"syscall.RLIM_INFINITY": {Sig: "const RLIM_INFINITY", Doc: "", Pos: "zerrors_linux_amd64.go:778"},
+		"syscall.RTAX_ADVMSS": {Sig: "const RTAX_ADVMSS", Doc: "", Pos: "zerrors_linux_amd64.go:779"},
+		"syscall.RTAX_CWND": {Sig: "const RTAX_CWND", Doc: "", Pos: "zerrors_linux_amd64.go:780"},
+		"syscall.RTAX_FEATURES": {Sig: "const RTAX_FEATURES", Doc: "", Pos: "zerrors_linux_amd64.go:781"},
+		"syscall.RTAX_FEATURE_ALLFRAG": {Sig: "const RTAX_FEATURE_ALLFRAG", Doc: "", Pos: "zerrors_linux_amd64.go:782"},
+		"syscall.RTAX_FEATURE_ECN": {Sig: "const RTAX_FEATURE_ECN", Doc: "", Pos: "zerrors_linux_amd64.go:783"},
+		"syscall.RTAX_FEATURE_SACK": {Sig: "const RTAX_FEATURE_SACK", Doc: "", Pos: "zerrors_linux_amd64.go:784"},
+		"syscall.RTAX_FEATURE_TIMESTAMP": {Sig: "const RTAX_FEATURE_TIMESTAMP", Doc: "", Pos: "zerrors_linux_amd64.go:785"},
+		"syscall.RTAX_HOPLIMIT": {Sig: "const RTAX_HOPLIMIT", Doc: "", Pos: "zerrors_linux_amd64.go:786"},
+		"syscall.RTAX_INITCWND": {Sig: "const RTAX_INITCWND", Doc: "", Pos: "zerrors_linux_amd64.go:787"},
+		"syscall.RTAX_INITRWND": {Sig: "const RTAX_INITRWND", Doc: "", Pos: "zerrors_linux_amd64.go:788"},
+		"syscall.RTAX_LOCK": {Sig: "const RTAX_LOCK", Doc: "", Pos: "zerrors_linux_amd64.go:789"},
+		"syscall.RTAX_MAX": {Sig: "const RTAX_MAX", Doc: "", Pos: "zerrors_linux_amd64.go:790"},
+		"syscall.RTAX_MTU": {Sig: "const RTAX_MTU", Doc: "", Pos: "zerrors_linux_amd64.go:791"},
+		"syscall.RTAX_REORDERING": {Sig: "const RTAX_REORDERING", Doc: "", Pos: "zerrors_linux_amd64.go:792"},
+		"syscall.RTAX_RTO_MIN": {Sig: "const RTAX_RTO_MIN", Doc: "", Pos: "zerrors_linux_amd64.go:793"},
+		"syscall.RTAX_RTT": {Sig: "const RTAX_RTT", Doc: "", Pos: "zerrors_linux_amd64.go:794"},
+		"syscall.RTAX_RTTVAR": {Sig: "const RTAX_RTTVAR", Doc: "", Pos: "zerrors_linux_amd64.go:795"},
+		"syscall.RTAX_SSTHRESH": {Sig: "const RTAX_SSTHRESH", Doc: "", Pos: "zerrors_linux_amd64.go:796"},
+		"syscall.RTAX_UNSPEC": {Sig: "const RTAX_UNSPEC", Doc: "", Pos: "zerrors_linux_amd64.go:797"},
+		"syscall.RTAX_WINDOW": {Sig: "const RTAX_WINDOW", Doc: "", Pos: "zerrors_linux_amd64.go:798"},
+		"syscall.RTA_ALIGNTO": {Sig: "const RTA_ALIGNTO", Doc: "", Pos: "zerrors_linux_amd64.go:799"},
+		"syscall.RTA_MAX": {Sig: "const RTA_MAX", Doc: "", Pos: "zerrors_linux_amd64.go:800"},
+		"syscall.RTCF_DIRECTSRC": {Sig: "const RTCF_DIRECTSRC", Doc: "", Pos: "zerrors_linux_amd64.go:801"},
+		"syscall.RTCF_DOREDIRECT": {Sig: "const RTCF_DOREDIRECT", Doc: "", Pos: "zerrors_linux_amd64.go:802"},
+		"syscall.RTCF_LOG": {Sig: "const RTCF_LOG", Doc: "", Pos: "zerrors_linux_amd64.go:803"},
+		"syscall.RTCF_MASQ": {Sig: "const RTCF_MASQ", Doc: "", Pos: "zerrors_linux_amd64.go:804"},
+		"syscall.RTCF_NAT": {Sig: "const RTCF_NAT", Doc: "", Pos: "zerrors_linux_amd64.go:805"},
+		"syscall.RTCF_VALVE": {Sig: "const RTCF_VALVE", Doc: "", Pos: "zerrors_linux_amd64.go:806"},
+		"syscall.RTF_ADDRCLASSMASK": {Sig: "const RTF_ADDRCLASSMASK", Doc: "", Pos: "zerrors_linux_amd64.go:807"},
+		"syscall.RTF_ADDRCONF": {Sig: "const RTF_ADDRCONF", Doc: "", Pos: "zerrors_linux_amd64.go:808"},
+		"syscall.RTF_ALLONLINK": {Sig: "const RTF_ALLONLINK", Doc: "", Pos: "zerrors_linux_amd64.go:809"},
+		"syscall.RTF_BROADCAST": {Sig: "const RTF_BROADCAST", Doc: "", Pos: "zerrors_linux_amd64.go:810"},
+		"syscall.RTF_CACHE": {Sig: "const RTF_CACHE", Doc: "", Pos: "zerrors_linux_amd64.go:811"},
+		"syscall.RTF_DEFAULT": {Sig: "const RTF_DEFAULT", Doc: "", Pos: "zerrors_linux_amd64.go:812"},
+		"syscall.RTF_DYNAMIC": {Sig: "const RTF_DYNAMIC", Doc: "", Pos: "zerrors_linux_amd64.go:813"},
+		"syscall.RTF_FLOW": {Sig: "const RTF_FLOW", Doc: "", Pos: "zerrors_linux_amd64.go:814"},
+		"syscall.RTF_GATEWAY": {Sig: "const RTF_GATEWAY", Doc: "", Pos: "zerrors_linux_amd64.go:815"},
+		"syscall.RTF_HOST": {Sig: "const RTF_HOST", Doc: "", Pos: "zerrors_linux_amd64.go:816"},
+		"syscall.RTF_INTERFACE": {Sig: "const RTF_INTERFACE", Doc: "", Pos: "zerrors_linux_amd64.go:817"},
+		"syscall.RTF_IRTT": {Sig: "const RTF_IRTT", Doc: "", Pos: "zerrors_linux_amd64.go:818"},
+		"syscall.RTF_LINKRT": {Sig: "const RTF_LINKRT", Doc: "", Pos: "zerrors_linux_amd64.go:819"},
+		"syscall.RTF_LOCAL": {Sig: "const RTF_LOCAL", Doc: "", Pos: "zerrors_linux_amd64.go:820"},
+		"syscall.RTF_MODIFIED": {Sig: "const RTF_MODIFIED", Doc: "", Pos: "zerrors_linux_amd64.go:821"},
+		"syscall.RTF_MSS": {Sig: "const RTF_MSS", Doc: "", Pos: "zerrors_linux_amd64.go:822"},
+		"syscall.RTF_MTU": {Sig: "const RTF_MTU", Doc: "", Pos: "zerrors_linux_amd64.go:823"},
+		"syscall.RTF_MULTICAST": {Sig: "const RTF_MULTICAST", Doc: "", Pos: "zerrors_linux_amd64.go:824"},
+		"syscall.RTF_NAT": {Sig: "const RTF_NAT", Doc: "", Pos: "zerrors_linux_amd64.go:825"},
+		"syscall.RTF_NOFORWARD": {Sig: "const RTF_NOFORWARD", Doc: "", Pos: "zerrors_linux_amd64.go:826"},
+		"syscall.RTF_NONEXTHOP": {Sig: "const RTF_NONEXTHOP", Doc: "", Pos: "zerrors_linux_amd64.go:827"},
+		"syscall.RTF_NOPMTUDISC": {Sig: "const RTF_NOPMTUDISC", Doc: "", Pos: "zerrors_linux_amd64.go:828"},
+		"syscall.RTF_POLICY": {Sig: "const RTF_POLICY", Doc: "", Pos: "zerrors_linux_amd64.go:829"},
+		"syscall.RTF_REINSTATE": {Sig: "const RTF_REINSTATE", Doc: "", Pos: "zerrors_linux_amd64.go:830"},
+		"syscall.RTF_REJECT": {Sig: "const RTF_REJECT", Doc: "", Pos: "zerrors_linux_amd64.go:831"},
+		"syscall.RTF_STATIC": {Sig: "const RTF_STATIC", Doc: "", Pos: "zerrors_linux_amd64.go:832"},
+		"syscall.RTF_THROW": {Sig: "const RTF_THROW", Doc: "", Pos: "zerrors_linux_amd64.go:833"},
+		"syscall.RTF_UP": {Sig: "const RTF_UP", Doc: "", Pos: "zerrors_linux_amd64.go:834"},
+		"syscall.RTF_WINDOW": {Sig: "const RTF_WINDOW", Doc: "", Pos: "zerrors_linux_amd64.go:835"},
+		"syscall.RTF_XRESOLVE": {Sig: "const RTF_XRESOLVE", Doc: "", Pos: "zerrors_linux_amd64.go:836"},
+		"syscall.RTM_BASE": {Sig: "const RTM_BASE", Doc: "", Pos: "zerrors_linux_amd64.go:837"},
+		"syscall.RTM_DELACTION": {Sig: "const RTM_DELACTION", Doc: "", Pos: "zerrors_linux_amd64.go:838"},
+		"syscall.RTM_DELADDR": {Sig: "const RTM_DELADDR", Doc: "", Pos: "zerrors_linux_amd64.go:839"},
+		"syscall.RTM_DELADDRLABEL": {Sig: "const RTM_DELADDRLABEL", Doc: "", Pos: "zerrors_linux_amd64.go:840"},
+		"syscall.RTM_DELLINK": {Sig: "const RTM_DELLINK", Doc: "", Pos: "zerrors_linux_amd64.go:841"},
+		"syscall.RTM_DELNEIGH": {Sig: "const RTM_DELNEIGH", Doc: "", Pos: "zerrors_linux_amd64.go:842"},
+		"syscall.RTM_DELQDISC": {Sig: "const RTM_DELQDISC", Doc: "", Pos: "zerrors_linux_amd64.go:843"},
+		"syscall.RTM_DELROUTE": {Sig: "const RTM_DELROUTE", Doc: "", Pos: "zerrors_linux_amd64.go:844"},
+		"syscall.RTM_DELRULE": {Sig: "const RTM_DELRULE", Doc: "", Pos: "zerrors_linux_amd64.go:845"},
+		"syscall.RTM_DELTCLASS": {Sig: "const RTM_DELTCLASS", Doc: "", Pos: "zerrors_linux_amd64.go:846"},
+		"syscall.RTM_DELTFILTER": {Sig: "const RTM_DELTFILTER", Doc: "", Pos: "zerrors_linux_amd64.go:847"},
+		"syscall.RTM_F_CLONED": {Sig: "const RTM_F_CLONED", Doc: "", Pos: "zerrors_linux_amd64.go:848"},
+		"syscall.RTM_F_EQUALIZE": {Sig: "const RTM_F_EQUALIZE", Doc: "", Pos: "zerrors_linux_amd64.go:849"},
+		"syscall.RTM_F_NOTIFY": {Sig: "const RTM_F_NOTIFY", Doc: "", Pos: "zerrors_linux_amd64.go:850"},
+		"syscall.RTM_F_PREFIX": {Sig: "const RTM_F_PREFIX", Doc: "", Pos: "zerrors_linux_amd64.go:851"},
+		"syscall.RTM_GETACTION": {Sig: "const RTM_GETACTION", Doc: "", Pos: "zerrors_linux_amd64.go:852"},
+		"syscall.RTM_GETADDR": {Sig: "const RTM_GETADDR", Doc: "", Pos: "zerrors_linux_amd64.go:853"},
+		"syscall.RTM_GETADDRLABEL": {Sig: "const RTM_GETADDRLABEL", Doc: "", Pos: "zerrors_linux_amd64.go:854"},
+		"syscall.RTM_GETANYCAST": {Sig: "const RTM_GETANYCAST", Doc: "", Pos: "zerrors_linux_amd64.go:855"},
+		"syscall.RTM_GETDCB": {Sig: "const RTM_GETDCB", Doc: "", Pos: "zerrors_linux_amd64.go:856"},
+		"syscall.RTM_GETLINK": {Sig: "const RTM_GETLINK", Doc: "", Pos: "zerrors_linux_amd64.go:857"},
+		"syscall.RTM_GETMULTICAST": {Sig: "const RTM_GETMULTICAST", Doc: "", Pos: "zerrors_linux_amd64.go:858"},
+		"syscall.RTM_GETNEIGH": {Sig: "const RTM_GETNEIGH", Doc: "", Pos: "zerrors_linux_amd64.go:859"},
+		"syscall.RTM_GETNEIGHTBL": {Sig: "const RTM_GETNEIGHTBL", Doc: "", Pos: "zerrors_linux_amd64.go:860"},
+		"syscall.RTM_GETQDISC": {Sig: "const RTM_GETQDISC", Doc: "", Pos: "zerrors_linux_amd64.go:861"},
+		"syscall.RTM_GETROUTE": {Sig: "const RTM_GETROUTE", Doc: "", Pos: "zerrors_linux_amd64.go:862"},
+		"syscall.RTM_GETRULE": {Sig: "const RTM_GETRULE", Doc: "", Pos: "zerrors_linux_amd64.go:863"},
+		"syscall.RTM_GETTCLASS": {Sig: "const RTM_GETTCLASS", Doc: "", Pos: "zerrors_linux_amd64.go:864"},
+		"syscall.RTM_GETTFILTER": {Sig: "const RTM_GETTFILTER", Doc: "", Pos: "zerrors_linux_amd64.go:865"},
+		"syscall.RTM_MAX": {Sig: "const RTM_MAX", Doc: "", Pos: "zerrors_linux_amd64.go:866"},
+		"syscall.RTM_NEWACTION": {Sig: "const RTM_NEWACTION", Doc: "", Pos: "zerrors_linux_amd64.go:867"},
+		"syscall.RTM_NEWADDR": {Sig: "const RTM_NEWADDR", Doc: "", Pos: "zerrors_linux_amd64.go:868"},
+		"syscall.RTM_NEWADDRLABEL": {Sig: "const RTM_NEWADDRLABEL", Doc: "", Pos: "zerrors_linux_amd64.go:869"},
+		"syscall.RTM_NEWLINK": {Sig: "const RTM_NEWLINK", Doc: "", Pos: "zerrors_linux_amd64.go:870"},
+		"syscall.RTM_NEWNDUSEROPT": {Sig: "const RTM_NEWNDUSEROPT", Doc: "", Pos: "zerrors_linux_amd64.go:871"},
+		"syscall.RTM_NEWNEIGH": {Sig: "const RTM_NEWNEIGH", Doc: "", Pos: "zerrors_linux_amd64.go:872"},
+		"syscall.RTM_NEWNEIGHTBL": {Sig: "const RTM_NEWNEIGHTBL", Doc: "", Pos: "zerrors_linux_amd64.go:873"},
+		"syscall.RTM_NEWPREFIX": {Sig: "const RTM_NEWPREFIX", Doc: "", Pos: "zerrors_linux_amd64.go:874"},
+		"syscall.RTM_NEWQDISC": {Sig: "const RTM_NEWQDISC", Doc: "", Pos: "zerrors_linux_amd64.go:875"},
+		"syscall.RTM_NEWROUTE": {Sig: "const RTM_NEWROUTE", Doc: "", Pos: "zerrors_linux_amd64.go:876"},
+		"syscall.RTM_NEWRULE": {Sig: "const RTM_NEWRULE", Doc: "", Pos: "zerrors_linux_amd64.go:877"},
+		"syscall.RTM_NEWTCLASS": {Sig: "const RTM_NEWTCLASS", Doc: "", Pos: "zerrors_linux_amd64.go:878"},
+		"syscall.RTM_NEWTFILTER": {Sig: "const RTM_NEWTFILTER", Doc: "", Pos: "zerrors_linux_amd64.go:879"},
+		"syscall.RTM_NR_FAMILIES": {Sig: "const RTM_NR_FAMILIES", Doc: "", Pos: "zerrors_linux_amd64.go:880"},
+		"syscall.RTM_NR_MSGTYPES": {Sig: "const RTM_NR_MSGTYPES", Doc: "", Pos: "zerrors_linux_amd64.go:881"},
+		"syscall.RTM_SETDCB": {Sig: "const RTM_SETDCB", Doc: "", Pos: "zerrors_linux_amd64.go:882"},
+		"syscall.RTM_SETLINK": {Sig: "const RTM_SETLINK", Doc: "", Pos: "zerrors_linux_amd64.go:883"},
+		"syscall.RTM_SETNEIGHTBL": {Sig: "const RTM_SETNEIGHTBL", Doc: "", Pos: "zerrors_linux_amd64.go:884"},
+		"syscall.RTNH_ALIGNTO": {Sig: "const RTNH_ALIGNTO", Doc: "", Pos: "zerrors_linux_amd64.go:885"},
+		"syscall.RTNH_F_DEAD": {Sig: "const RTNH_F_DEAD", Doc: "", Pos: "zerrors_linux_amd64.go:886"},
+		"syscall.RTNH_F_ONLINK": {Sig: "const RTNH_F_ONLINK", Doc: "", Pos: "zerrors_linux_amd64.go:887"},
+		"syscall.RTNH_F_PERVASIVE": {Sig: "const RTNH_F_PERVASIVE", Doc: "", Pos: "zerrors_linux_amd64.go:888"},
+		"syscall.RTN_MAX": {Sig: "const RTN_MAX", Doc: "", Pos: "zerrors_linux_amd64.go:889"},
+		"syscall.RTPROT_BIRD": {Sig: "const RTPROT_BIRD", Doc: "", Pos: "zerrors_linux_amd64.go:890"},
+		"syscall.RTPROT_BOOT": {Sig: "const RTPROT_BOOT", Doc: "", Pos: "zerrors_linux_amd64.go:891"},
+		"syscall.RTPROT_DHCP": {Sig: "const RTPROT_DHCP", Doc: "", Pos: "zerrors_linux_amd64.go:892"},
+		"syscall.RTPROT_DNROUTED": {Sig: "const RTPROT_DNROUTED", Doc: "", Pos: "zerrors_linux_amd64.go:893"},
+		"syscall.RTPROT_GATED": {Sig: "const RTPROT_GATED", Doc: "", Pos: "zerrors_linux_amd64.go:894"},
+		"syscall.RTPROT_KERNEL": {Sig: "const RTPROT_KERNEL", Doc: "", Pos: "zerrors_linux_amd64.go:895"},
+		"syscall.RTPROT_MRT": {Sig: "const RTPROT_MRT", Doc: "", Pos: "zerrors_linux_amd64.go:896"},
+		"syscall.RTPROT_NTK": {Sig: "const RTPROT_NTK", Doc: "", Pos: "zerrors_linux_amd64.go:897"},
+		"syscall.RTPROT_RA": {Sig: "const RTPROT_RA", Doc: "", Pos: "zerrors_linux_amd64.go:898"},
+		"syscall.RTPROT_REDIRECT": {Sig: "const RTPROT_REDIRECT", Doc: "", Pos: "zerrors_linux_amd64.go:899"},
+		"syscall.RTPROT_STATIC": {Sig: "const RTPROT_STATIC", Doc: "", Pos: "zerrors_linux_amd64.go:900"},
+		"syscall.RTPROT_UNSPEC": {Sig: "const RTPROT_UNSPEC", Doc: "", Pos: "zerrors_linux_amd64.go:901"},
+		"syscall.RTPROT_XORP": {Sig: "const RTPROT_XORP", Doc: "", Pos: "zerrors_linux_amd64.go:902"},
+		"syscall.RTPROT_ZEBRA": {Sig: "const RTPROT_ZEBRA", Doc: "", Pos: "zerrors_linux_amd64.go:903"},
+		"syscall.RT_CLASS_DEFAULT": {Sig: "const RT_CLASS_DEFAULT", Doc: "", Pos: "zerrors_linux_amd64.go:904"},
+		"syscall.RT_CLASS_LOCAL": {Sig: "const RT_CLASS_LOCAL", Doc: "", Pos: "zerrors_linux_amd64.go:905"},
+		"syscall.RT_CLASS_MAIN": {Sig: "const RT_CLASS_MAIN", Doc: "", Pos: "zerrors_linux_amd64.go:906"},
+		"syscall.RT_CLASS_MAX": {Sig: "const RT_CLASS_MAX", Doc: "", Pos: "zerrors_linux_amd64.go:907"},
+		"syscall.RT_CLASS_UNSPEC": {Sig: "const RT_CLASS_UNSPEC", Doc: "", Pos: "zerrors_linux_amd64.go:908"},
+		"syscall.RUSAGE_CHILDREN": {Sig: "const RUSAGE_CHILDREN", Doc: "", Pos: "zerrors_linux_amd64.go:909"},
+		"syscall.RUSAGE_SELF": {Sig: "const RUSAGE_SELF", Doc: "", Pos: "zerrors_linux_amd64.go:910"},
+		"syscall.RUSAGE_THREAD": {Sig: "const RUSAGE_THREAD", Doc: "", Pos: "zerrors_linux_amd64.go:911"},
+		"syscall.SCM_CREDENTIALS": {Sig: "const SCM_CREDENTIALS", Doc: "", Pos: "zerrors_linux_amd64.go:912"},
+		"syscall.SCM_RIGHTS": {Sig: "const SCM_RIGHTS", Doc: "", Pos: "zerrors_linux_amd64.go:913"},
+		"syscall.SCM_TIMESTAMP": {Sig: "const SCM_TIMESTAMP", Doc: "", Pos: "zerrors_linux_amd64.go:914"},
+		"syscall.SCM_TIMESTAMPING": {Sig: "const SCM_TIMESTAMPING", Doc: "", Pos: "zerrors_linux_amd64.go:915"},
+		"syscall.SCM_TIMESTAMPNS": {Sig: "const SCM_TIMESTAMPNS", Doc: "", Pos: "zerrors_linux_amd64.go:916"},
+		"syscall.SHUT_RD": {Sig: "const SHUT_RD", Doc: "", Pos: "zerrors_linux_amd64.go:917"},
+		"syscall.SHUT_RDWR": {Sig: "const SHUT_RDWR", Doc: "", Pos: "zerrors_linux_amd64.go:918"},
+		"syscall.SHUT_WR": {Sig: "const SHUT_WR", Doc: "", Pos: "zerrors_linux_amd64.go:919"},
+		"syscall.SIOCADDDLCI": {Sig: "const SIOCADDDLCI", Doc: "", Pos: "zerrors_linux_amd64.go:920"},
+		"syscall.SIOCADDMULTI": {Sig: "const SIOCADDMULTI", Doc: "", Pos: "zerrors_linux_amd64.go:921"},
+		"syscall.SIOCADDRT": {Sig: "const SIOCADDRT", Doc: "", Pos: "zerrors_linux_amd64.go:922"},
+		"syscall.SIOCATMARK": {Sig: "const SIOCATMARK", Doc: "", Pos: "zerrors_linux_amd64.go:923"},
+		"syscall.SIOCDARP": {Sig: "const SIOCDARP", Doc: "", Pos: "zerrors_linux_amd64.go:924"},
+		"syscall.SIOCDELDLCI": {Sig: "const SIOCDELDLCI", Doc: "", Pos: "zerrors_linux_amd64.go:925"},
+		"syscall.SIOCDELMULTI": {Sig: "const SIOCDELMULTI", Doc: "", Pos: "zerrors_linux_amd64.go:926"},
+		"syscall.SIOCDELRT": {Sig: "const SIOCDELRT", Doc: "", Pos: "zerrors_linux_amd64.go:927"},
+		"syscall.SIOCDEVPRIVATE": {Sig: "const SIOCDEVPRIVATE", Doc: "", Pos: "zerrors_linux_amd64.go:928"},
+		"syscall.SIOCDIFADDR": {Sig: "const SIOCDIFADDR", Doc: "", Pos: "zerrors_linux_amd64.go:929"},
+		"syscall.SIOCDRARP": {Sig: "const SIOCDRARP", Doc: "", Pos: "zerrors_linux_amd64.go:930"},
+		"syscall.SIOCGARP": {Sig: "const SIOCGARP", Doc: "", Pos: "zerrors_linux_amd64.go:931"},
+		"syscall.SIOCGIFADDR": {Sig: "const SIOCGIFADDR", Doc: "", Pos: "zerrors_linux_amd64.go:932"},
+		"syscall.SIOCGIFBR": {Sig: "const SIOCGIFBR", Doc: "", Pos: "zerrors_linux_amd64.go:933"},
+		"syscall.SIOCGIFBRDADDR": {Sig: "const SIOCGIFBRDADDR", Doc: "", Pos: "zerrors_linux_amd64.go:934"},
+		"syscall.SIOCGIFCONF": {Sig: "const SIOCGIFCONF", Doc: "", Pos: "zerrors_linux_amd64.go:935"},
+		"syscall.SIOCGIFCOUNT": {Sig: "const SIOCGIFCOUNT", Doc: "", Pos: "zerrors_linux_amd64.go:936"},
+		"syscall.SIOCGIFDSTADDR": {Sig: "const SIOCGIFDSTADDR", Doc: "", Pos: "zerrors_linux_amd64.go:937"},
+		"syscall.SIOCGIFENCAP": {Sig: "const SIOCGIFENCAP", Doc: "", Pos: "zerrors_linux_amd64.go:938"},
+		"syscall.SIOCGIFFLAGS": {Sig: "const SIOCGIFFLAGS", Doc: "", Pos: "zerrors_linux_amd64.go:939"},
+		"syscall.SIOCGIFHWADDR": {Sig: "const SIOCGIFHWADDR", Doc: "", Pos: "zerrors_linux_amd64.go:940"},
+		"syscall.SIOCGIFINDEX": {Sig: "const SIOCGIFINDEX", Doc: "", Pos: "zerrors_linux_amd64.go:941"},
+		"syscall.SIOCGIFMAP": {Sig: "const SIOCGIFMAP", Doc: "", Pos: "zerrors_linux_amd64.go:942"},
+		"syscall.SIOCGIFMEM": {Sig: "const SIOCGIFMEM", Doc: "", Pos: "zerrors_linux_amd64.go:943"},
+		"syscall.SIOCGIFMETRIC": {Sig: "const SIOCGIFMETRIC", Doc: "", Pos: "zerrors_linux_amd64.go:944"},
+		"syscall.SIOCGIFMTU": {Sig: "const SIOCGIFMTU", Doc: "", Pos: "zerrors_linux_amd64.go:945"},
+		"syscall.SIOCGIFNAME": {Sig: "const SIOCGIFNAME", Doc: "", Pos: "zerrors_linux_amd64.go:946"},
+		"syscall.SIOCGIFNETMASK": {Sig: "const SIOCGIFNETMASK", Doc: "", Pos: "zerrors_linux_amd64.go:947"},
+		"syscall.SIOCGIFPFLAGS": {Sig: "const SIOCGIFPFLAGS", Doc: "", Pos: "zerrors_linux_amd64.go:948"},
+		"syscall.SIOCGIFSLAVE": {Sig: "const SIOCGIFSLAVE", Doc: "", Pos: "zerrors_linux_amd64.go:949"},
+		"syscall.SIOCGIFTXQLEN": {Sig: "const SIOCGIFTXQLEN", Doc: "", Pos: "zerrors_linux_amd64.go:950"},
+		"syscall.SIOCGPGRP": {Sig: "const SIOCGPGRP", Doc: "", Pos: "zerrors_linux_amd64.go:951"},
+		"syscall.SIOCGRARP": {Sig: "const SIOCGRARP", Doc: "", Pos: "zerrors_linux_amd64.go:952"},
+		"syscall.SIOCGSTAMP": {Sig: "const SIOCGSTAMP", Doc: "", Pos: "zerrors_linux_amd64.go:953"},
+		"syscall.SIOCGSTAMPNS": {Sig: "const SIOCGSTAMPNS", Doc: "", Pos: "zerrors_linux_amd64.go:954"},
+		"syscall.SIOCPROTOPRIVATE": {Sig: "const SIOCPROTOPRIVATE", Doc: "", Pos: "zerrors_linux_amd64.go:955"},
+		"syscall.SIOCRTMSG": {Sig: "const SIOCRTMSG", Doc: "", Pos: "zerrors_linux_amd64.go:956"},
+		"syscall.SIOCSARP": {Sig: "const SIOCSARP", Doc: "", Pos: "zerrors_linux_amd64.go:957"},
+		"syscall.SIOCSIFADDR": {Sig: "const SIOCSIFADDR", Doc: "", Pos: "zerrors_linux_amd64.go:958"},
+		"syscall.SIOCSIFBR": {Sig: "const SIOCSIFBR", Doc: "", Pos: "zerrors_linux_amd64.go:959"},
+		"syscall.SIOCSIFBRDADDR": {Sig: "const SIOCSIFBRDADDR", Doc: "", Pos: "zerrors_linux_amd64.go:960"},
+		"syscall.SIOCSIFDSTADDR": {Sig: "const SIOCSIFDSTADDR", Doc: "", Pos: "zerrors_linux_amd64.go:961"},
+		"syscall.SIOCSIFENCAP": {Sig: "const SIOCSIFENCAP", Doc: "", Pos: "zerrors_linux_amd64.go:962"},
+		"syscall.SIOCSIFFLAGS": {Sig: "const SIOCSIFFLAGS", Doc: "", Pos: "zerrors_linux_amd64.go:963"},
+		"syscall.SIOCSIFHWADDR": {Sig: "const SIOCSIFHWADDR", Doc: "", Pos: "zerrors_linux_amd64.go:964"},
+		"syscall.SIOCSIFHWBROADCAST": {Sig: "const SIOCSIFHWBROADCAST", Doc: "", Pos: "zerrors_linux_amd64.go:965"},
+		"syscall.SIOCSIFLINK": {Sig: "const SIOCSIFLINK", Doc: "", Pos: "zerrors_linux_amd64.go:966"},
+		"syscall.SIOCSIFMAP": {Sig: "const SIOCSIFMAP", Doc: "", Pos: "zerrors_linux_amd64.go:967"},
+		"syscall.SIOCSIFMEM": {Sig: "const SIOCSIFMEM", Doc: "", Pos: "zerrors_linux_amd64.go:968"},
+		"syscall.SIOCSIFMETRIC": {Sig: "const SIOCSIFMETRIC", Doc: "", Pos: "zerrors_linux_amd64.go:969"},
+		"syscall.SIOCSIFMTU": {Sig: "const SIOCSIFMTU", Doc: "", Pos: "zerrors_linux_amd64.go:970"},
+		"syscall.SIOCSIFNAME": {Sig: "const SIOCSIFNAME", Doc: "", Pos: "zerrors_linux_amd64.go:971"},
+		"syscall.SIOCSIFNETMASK": {Sig: "const SIOCSIFNETMASK", Doc: "", Pos: "zerrors_linux_amd64.go:972"},
+		"syscall.SIOCSIFPFLAGS": {Sig: "const SIOCSIFPFLAGS", Doc: "", Pos: "zerrors_linux_amd64.go:973"},
+		"syscall.SIOCSIFSLAVE": {Sig: "const SIOCSIFSLAVE", Doc: "", Pos: "zerrors_linux_amd64.go:974"},
+		"syscall.SIOCSIFTXQLEN": {Sig: "const SIOCSIFTXQLEN", Doc: "", Pos: "zerrors_linux_amd64.go:975"},
+		"syscall.SIOCSPGRP": {Sig: "const SIOCSPGRP", Doc: "", Pos: "zerrors_linux_amd64.go:976"},
+		"syscall.SIOCSRARP": {Sig: "const SIOCSRARP", Doc: "", Pos: "zerrors_linux_amd64.go:977"},
+		"syscall.SOCK_CLOEXEC": {Sig: "const SOCK_CLOEXEC", Doc: "", Pos: "zerrors_linux_amd64.go:978"},
+		"syscall.SOCK_DCCP": {Sig: "const SOCK_DCCP", Doc: "", Pos: "zerrors_linux_amd64.go:979"},
+		"syscall.SOCK_DGRAM": {Sig: "const SOCK_DGRAM", Doc: "", Pos: "zerrors_linux_amd64.go:980"},
+		"syscall.SOCK_NONBLOCK": {Sig: "const SOCK_NONBLOCK", Doc: "", Pos: "zerrors_linux_amd64.go:981"},
+		"syscall.SOCK_PACKET": {Sig: "const SOCK_PACKET", Doc: "", Pos: "zerrors_linux_amd64.go:982"},
+		"syscall.SOCK_RAW": {Sig: "const SOCK_RAW", Doc: "", Pos: "zerrors_linux_amd64.go:983"},
+		"syscall.SOCK_RDM": {Sig: "const SOCK_RDM", Doc: "", Pos: "zerrors_linux_amd64.go:984"},
+		"syscall.SOCK_SEQPACKET": {Sig: "const SOCK_SEQPACKET", Doc: "", Pos: "zerrors_linux_amd64.go:985"},
+		"syscall.SOCK_STREAM": {Sig: "const SOCK_STREAM", Doc: "", Pos: "zerrors_linux_amd64.go:986"},
+		"syscall.SOL_AAL": {Sig: "const SOL_AAL", Doc: "", Pos: "zerrors_linux_amd64.go:987"},
+		"syscall.SOL_ATM": {Sig: "const SOL_ATM", Doc: "", Pos: "zerrors_linux_amd64.go:988"},
+		"syscall.SOL_DECNET": {Sig: "const SOL_DECNET", Doc: "", Pos: "zerrors_linux_amd64.go:989"},
+		"syscall.SOL_ICMPV6": {Sig: "const SOL_ICMPV6", Doc: "", Pos: "zerrors_linux_amd64.go:990"},
+		"syscall.SOL_IP": {Sig: "const SOL_IP", Doc: "", Pos: "zerrors_linux_amd64.go:991"},
+		"syscall.SOL_IPV6": {Sig: "const SOL_IPV6", Doc: "", Pos: "zerrors_linux_amd64.go:992"},
+		"syscall.SOL_IRDA": {Sig: "const SOL_IRDA", Doc: "", Pos: "zerrors_linux_amd64.go:993"},
+		"syscall.SOL_PACKET": {Sig: "const SOL_PACKET", Doc: "", Pos: "zerrors_linux_amd64.go:994"},
+		"syscall.SOL_RAW": {Sig: "const SOL_RAW", Doc: "", Pos: "zerrors_linux_amd64.go:995"},
+		"syscall.SOL_SOCKET": {Sig: "const SOL_SOCKET", Doc: "", Pos: "zerrors_linux_amd64.go:996"},
+		"syscall.SOL_TCP": {Sig: "const SOL_TCP", Doc: "", Pos: "zerrors_linux_amd64.go:997"},
+		"syscall.SOL_X25": {Sig: "const SOL_X25", Doc: "", Pos: "zerrors_linux_amd64.go:998"},
+		"syscall.SOMAXCONN": {Sig: "const SOMAXCONN", Doc: "", Pos: "zerrors_linux_amd64.go:999"},
+		"syscall.SO_ACCEPTCONN": {Sig: "const SO_ACCEPTCONN", Doc: "", Pos: "zerrors_linux_amd64.go:1000"},
+		"syscall.SO_ATTACH_FILTER": {Sig: "const SO_ATTACH_FILTER", Doc: "", Pos: "zerrors_linux_amd64.go:1001"},
+		"syscall.SO_BINDTODEVICE": {Sig: "const SO_BINDTODEVICE", Doc: "", Pos: "zerrors_linux_amd64.go:1002"},
+		"syscall.SO_BROADCAST": {Sig: "const SO_BROADCAST", Doc: "", Pos: "zerrors_linux_amd64.go:1003"},
+		"syscall.SO_BSDCOMPAT": {Sig: "const SO_BSDCOMPAT", Doc: "", Pos: "zerrors_linux_amd64.go:1004"},
+		"syscall.SO_DEBUG": {Sig: "const SO_DEBUG", Doc: "", Pos: "zerrors_linux_amd64.go:1005"},
+		"syscall.SO_DETACH_FILTER": {Sig: "const SO_DETACH_FILTER", Doc: "", Pos: "zerrors_linux_amd64.go:1006"},
+		"syscall.SO_DOMAIN": {Sig: "const SO_DOMAIN", Doc: "", Pos: "zerrors_linux_amd64.go:1007"},
+		"syscall.SO_DONTROUTE": {Sig: "const SO_DONTROUTE", Doc: "", Pos: "zerrors_linux_amd64.go:1008"},
+		"syscall.SO_ERROR": {Sig: "const SO_ERROR", Doc: "", Pos: "zerrors_linux_amd64.go:1009"},
+		"syscall.SO_KEEPALIVE": {Sig: "const SO_KEEPALIVE", Doc: "", Pos: "zerrors_linux_amd64.go:1010"},
+		"syscall.SO_LINGER": {Sig: "const SO_LINGER", Doc: "", Pos: "zerrors_linux_amd64.go:1011"},
+		"syscall.SO_MARK": {Sig: "const SO_MARK", Doc: "", Pos: "zerrors_linux_amd64.go:1012"},
+		"syscall.SO_NO_CHECK": {Sig: "const SO_NO_CHECK", Doc: "", Pos: "zerrors_linux_amd64.go:1013"},
+		"syscall.SO_OOBINLINE": {Sig: "const SO_OOBINLINE", Doc: "", Pos: "zerrors_linux_amd64.go:1014"},
+		"syscall.SO_PASSCRED": {Sig: "const SO_PASSCRED", Doc: "", Pos: "zerrors_linux_amd64.go:1015"},
+		"syscall.SO_PASSSEC": {Sig: "const SO_PASSSEC", Doc: "", Pos: "zerrors_linux_amd64.go:1016"},
+		"syscall.SO_PEERCRED": {Sig: "const SO_PEERCRED", Doc: "", Pos: "zerrors_linux_amd64.go:1017"},
+		"syscall.SO_PEERNAME": {Sig: "const SO_PEERNAME", Doc: "", Pos: "zerrors_linux_amd64.go:1018"},
+		"syscall.SO_PEERSEC": {Sig: "const SO_PEERSEC", Doc: "", Pos: "zerrors_linux_amd64.go:1019"},
+		"syscall.SO_PRIORITY": {Sig: "const SO_PRIORITY", Doc: "", Pos: "zerrors_linux_amd64.go:1020"},
+		"syscall.SO_PROTOCOL": {Sig: "const SO_PROTOCOL", Doc: "", Pos: "zerrors_linux_amd64.go:1021"},
+		"syscall.SO_RCVBUF": {Sig: "const SO_RCVBUF", Doc: "", Pos: "zerrors_linux_amd64.go:1022"},
+		"syscall.SO_RCVBUFFORCE": {Sig: "const SO_RCVBUFFORCE", Doc: "", Pos: "zerrors_linux_amd64.go:1023"},
+		"syscall.SO_RCVLOWAT": {Sig: "const SO_RCVLOWAT", Doc: "", Pos: "zerrors_linux_amd64.go:1024"},
+		"syscall.SO_RCVTIMEO": {Sig: "const SO_RCVTIMEO", Doc: "", Pos: "zerrors_linux_amd64.go:1025"},
+		"syscall.SO_REUSEADDR": {Sig: "const SO_REUSEADDR", Doc: "", Pos: "zerrors_linux_amd64.go:1026"},
+		"syscall.SO_RXQ_OVFL": {Sig: "const SO_RXQ_OVFL", Doc: "", Pos: "zerrors_linux_amd64.go:1027"},
+		"syscall.SO_SECURITY_AUTHENTICATION": {Sig: "const SO_SECURITY_AUTHENTICATION", Doc: "", Pos: "zerrors_linux_amd64.go:1028"},
+		"syscall.SO_SECURITY_ENCRYPTION_NETWORK": {Sig: "const SO_SECURITY_ENCRYPTION_NETWORK", Doc: "", Pos: "zerrors_linux_amd64.go:1029"},
+		"syscall.SO_SECURITY_ENCRYPTION_TRANSPORT": {Sig: "const SO_SECURITY_ENCRYPTION_TRANSPORT", Doc: "", Pos: "zerrors_linux_amd64.go:1030"},
+		"syscall.SO_SNDBUF": {Sig: "const SO_SNDBUF", Doc: "", Pos: "zerrors_linux_amd64.go:1031"},
+		"syscall.SO_SNDBUFFORCE": {Sig: "const SO_SNDBUFFORCE", Doc: "", Pos: "zerrors_linux_amd64.go:1032"},
+		"syscall.SO_SNDLOWAT": {Sig: "const SO_SNDLOWAT", Doc: "", Pos: "zerrors_linux_amd64.go:1033"},
+		"syscall.SO_SNDTIMEO": {Sig: "const SO_SNDTIMEO", Doc: "", Pos: "zerrors_linux_amd64.go:1034"},
+		"syscall.SO_TIMESTAMP": {Sig: "const SO_TIMESTAMP", Doc: "", Pos: "zerrors_linux_amd64.go:1035"},
+		"syscall.SO_TIMESTAMPING": {Sig: "const SO_TIMESTAMPING", Doc: "", Pos: "zerrors_linux_amd64.go:1036"},
+		"syscall.SO_TIMESTAMPNS": {Sig: "const SO_TIMESTAMPNS", Doc: "", Pos: "zerrors_linux_amd64.go:1037"},
+		"syscall.SO_TYPE": {Sig: "const SO_TYPE", Doc: "", Pos: "zerrors_linux_amd64.go:1038"},
+		"syscall.S_BLKSIZE": {Sig: "const S_BLKSIZE", Doc: "", Pos: "zerrors_linux_amd64.go:1039"},
+		"syscall.S_IEXEC": {Sig: "const S_IEXEC", Doc: "", Pos: "zerrors_linux_amd64.go:1040"},
+		"syscall.S_IFBLK": {Sig: "const S_IFBLK", Doc: "", Pos: "zerrors_linux_amd64.go:1041"},
+		"syscall.S_IFCHR": {Sig: "const S_IFCHR", Doc: "", Pos: "zerrors_linux_amd64.go:1042"},
+		"syscall.S_IFDIR": {Sig: "const S_IFDIR", Doc: "", Pos: "zerrors_linux_amd64.go:1043"},
+		"syscall.S_IFIFO": {Sig: "const S_IFIFO", Doc: "", Pos: "zerrors_linux_amd64.go:1044"},
+		"syscall.S_IFLNK": {Sig: "const S_IFLNK", Doc: "", Pos: "zerrors_linux_amd64.go:1045"},
+		"syscall.S_IFMT": {Sig: "const S_IFMT", Doc: "", Pos: "zerrors_linux_amd64.go:1046"},
+		"syscall.S_IFREG": {Sig: "const S_IFREG", Doc: "", Pos: "zerrors_linux_amd64.go:1047"},
+		"syscall.S_IFSOCK": {Sig: "const S_IFSOCK", Doc: "", Pos: "zerrors_linux_amd64.go:1048"},
+		"syscall.S_IREAD": {Sig: "const S_IREAD", Doc: "", Pos: "zerrors_linux_amd64.go:1049"},
+		"syscall.S_IRGRP": {Sig: "const S_IRGRP", Doc: "", Pos: "zerrors_linux_amd64.go:1050"},
+		"syscall.S_IROTH": {Sig: "const S_IROTH", Doc: "", Pos: "zerrors_linux_amd64.go:1051"},
+		"syscall.S_IRUSR": {Sig: "const S_IRUSR", Doc: "", Pos: "zerrors_linux_amd64.go:1052"},
+		"syscall.S_IRWXG": {Sig: "const S_IRWXG", Doc: "", Pos: "zerrors_linux_amd64.go:1053"},
+		"syscall.S_IRWXO": {Sig: "const S_IRWXO", Doc: "", Pos: "zerrors_linux_amd64.go:1054"},
+		"syscall.S_IRWXU": {Sig: "const S_IRWXU", Doc: "", Pos: "zerrors_linux_amd64.go:1055"},
+		"syscall.S_ISGID": {Sig: "const S_ISGID", Doc: "", Pos: "zerrors_linux_amd64.go:1056"},
+		"syscall.S_ISUID": {Sig: "const S_ISUID", Doc: "", Pos: "zerrors_linux_amd64.go:1057"},
+		"syscall.S_ISVTX": {Sig: "const S_ISVTX", Doc: "", Pos: "zerrors_linux_amd64.go:1058"},
+		"syscall.S_IWGRP": {Sig: "const S_IWGRP", Doc: "", Pos: "zerrors_linux_amd64.go:1059"},
+		"syscall.S_IWOTH": {Sig: "const S_IWOTH", Doc: "", Pos: "zerrors_linux_amd64.go:1060"},
+		"syscall.S_IWRITE": {Sig: "const S_IWRITE", Doc: "", Pos: "zerrors_linux_amd64.go:1061"},
+		"syscall.S_IWUSR": {Sig: "const S_IWUSR", Doc: "", Pos: "zerrors_linux_amd64.go:1062"},
+		"syscall.S_IXGRP": {Sig: "const S_IXGRP", Doc: "", Pos: "zerrors_linux_amd64.go:1063"},
+		"syscall.S_IXOTH": {Sig: "const S_IXOTH", Doc: "", Pos: "zerrors_linux_amd64.go:1064"},
+		"syscall.S_IXUSR": {Sig: "const S_IXUSR", Doc: "", Pos: "zerrors_linux_amd64.go:1065"},
+		"syscall.TCIFLUSH": {Sig: "const TCIFLUSH", Doc: "", Pos: "zerrors_linux_amd64.go:1066"},
+		"syscall.TCIOFLUSH": {Sig: "const TCIOFLUSH", Doc: "", Pos: "zerrors_linux_amd64.go:1067"},
+		"syscall.TCOFLUSH": {Sig: "const TCOFLUSH", Doc: "", Pos: "zerrors_linux_amd64.go:1068"},
+		"syscall.TCP_CONGESTION": {Sig: "const TCP_CONGESTION", Doc: "", Pos: "zerrors_linux_amd64.go:1069"},
+		"syscall.TCP_CORK": {Sig: "const TCP_CORK", Doc: "", Pos: "zerrors_linux_amd64.go:1070"},
+		"syscall.TCP_DEFER_ACCEPT": {Sig: "const TCP_DEFER_ACCEPT", Doc: "", Pos: "zerrors_linux_amd64.go:1071"},
+		"syscall.TCP_INFO": {Sig: "const TCP_INFO", Doc: "", Pos: "zerrors_linux_amd64.go:1072"},
+		"syscall.TCP_KEEPCNT": {Sig: "const TCP_KEEPCNT", Doc: "", Pos: "zerrors_linux_amd64.go:1073"},
+		"syscall.TCP_KEEPIDLE": {Sig: "const TCP_KEEPIDLE", Doc: "", Pos: "zerrors_linux_amd64.go:1074"},
+		"syscall.TCP_KEEPINTVL": {Sig: "const TCP_KEEPINTVL", Doc: "", Pos: "zerrors_linux_amd64.go:1075"},
+		"syscall.TCP_LINGER2": {Sig: "const TCP_LINGER2", Doc: "", Pos: "zerrors_linux_amd64.go:1076"},
+		"syscall.TCP_MAXSEG": {Sig: "const TCP_MAXSEG", Doc: "", Pos: "zerrors_linux_amd64.go:1077"},
+		"syscall.TCP_MAXWIN": {Sig: "const TCP_MAXWIN", Doc: "", Pos: "zerrors_linux_amd64.go:1078"},
+		"syscall.TCP_MAX_WINSHIFT": {Sig: "const TCP_MAX_WINSHIFT", Doc: "", Pos: "zerrors_linux_amd64.go:1079"},
+		"syscall.TCP_MD5SIG": {Sig: "const TCP_MD5SIG", Doc: "", Pos: "zerrors_linux_amd64.go:1080"},
+		"syscall.TCP_MD5SIG_MAXKEYLEN": {Sig: "const TCP_MD5SIG_MAXKEYLEN", Doc: "", Pos: "zerrors_linux_amd64.go:1081"},
+		"syscall.TCP_MSS": {Sig: "const TCP_MSS", Doc: "", Pos: "zerrors_linux_amd64.go:1082"},
+		"syscall.TCP_NODELAY": {Sig: "const TCP_NODELAY", Doc: "", Pos: "zerrors_linux_amd64.go:1083"},
+		"syscall.TCP_QUICKACK": {Sig: "const TCP_QUICKACK", Doc: "", Pos: "zerrors_linux_amd64.go:1084"},
+		"syscall.TCP_SYNCNT": {Sig: "const TCP_SYNCNT", Doc: "", Pos: "zerrors_linux_amd64.go:1085"},
+		"syscall.TCP_WINDOW_CLAMP": {Sig: "const TCP_WINDOW_CLAMP", Doc: "", Pos: "zerrors_linux_amd64.go:1086"},
+		"syscall.TIOCCBRK": {Sig: "const TIOCCBRK", Doc: "", Pos: "zerrors_linux_amd64.go:1087"},
+		"syscall.TIOCCONS": {Sig: "const TIOCCONS", Doc: "", Pos: "zerrors_linux_amd64.go:1088"},
+		"syscall.TIOCEXCL": {Sig: "const TIOCEXCL", Doc: "", Pos: "zerrors_linux_amd64.go:1089"},
+		"syscall.TIOCGDEV": {Sig: "const TIOCGDEV", Doc: "", Pos: "zerrors_linux_amd64.go:1090"},
+		"syscall.TIOCGETD": {Sig: "const TIOCGETD", Doc: "", Pos: "zerrors_linux_amd64.go:1091"},
+		"syscall.TIOCGICOUNT": {Sig: "const TIOCGICOUNT", Doc: "", Pos: "zerrors_linux_amd64.go:1092"},
+		"syscall.TIOCGLCKTRMIOS": {Sig: "const TIOCGLCKTRMIOS", Doc: "", Pos: "zerrors_linux_amd64.go:1093"},
+		"syscall.TIOCGPGRP": {Sig: "const TIOCGPGRP", Doc: "", Pos: "zerrors_linux_amd64.go:1094"},
+		"syscall.TIOCGPTN": {Sig: "const TIOCGPTN", Doc: "", Pos: "zerrors_linux_amd64.go:1095"},
+		"syscall.TIOCGRS485": {Sig: "const TIOCGRS485", Doc: "", Pos: "zerrors_linux_amd64.go:1096"},
+		"syscall.TIOCGSERIAL": {Sig: "const TIOCGSERIAL", Doc: "", Pos: "zerrors_linux_amd64.go:1097"},
+		"syscall.TIOCGSID": {Sig: "const TIOCGSID", Doc: "", Pos: "zerrors_linux_amd64.go:1098"},
+		"syscall.TIOCGSOFTCAR": {Sig: "const TIOCGSOFTCAR", Doc: "", Pos: "zerrors_linux_amd64.go:1099"},
+		"syscall.TIOCGWINSZ": {Sig: "const TIOCGWINSZ", Doc: "", Pos: "zerrors_linux_amd64.go:1100"},
+		"syscall.TIOCINQ": {Sig: "const TIOCINQ", Doc: "", Pos: "zerrors_linux_amd64.go:1101"},
+		"syscall.TIOCLINUX": {Sig: "const TIOCLINUX", Doc: "", Pos: "zerrors_linux_amd64.go:1102"},
+		"syscall.TIOCMBIC": {Sig: "const TIOCMBIC", Doc: "", Pos: "zerrors_linux_amd64.go:1103"},
+		"syscall.TIOCMBIS": {Sig: "const TIOCMBIS", Doc: "", Pos: "zerrors_linux_amd64.go:1104"},
+		"syscall.TIOCMGET": {Sig: "const TIOCMGET", Doc: "", Pos: "zerrors_linux_amd64.go:1105"},
+		"syscall.TIOCMIWAIT": {Sig: "const TIOCMIWAIT", Doc: "", Pos: "zerrors_linux_amd64.go:1106"},
+		"syscall.TIOCMSET": {Sig: "const TIOCMSET", Doc: "", Pos: "zerrors_linux_amd64.go:1107"},
+		"syscall.TIOCM_CAR": {Sig: "const TIOCM_CAR", Doc: "", Pos: "zerrors_linux_amd64.go:1108"},
+		"syscall.TIOCM_CD": {Sig: "const TIOCM_CD", Doc: "", Pos: "zerrors_linux_amd64.go:1109"},
+		"syscall.TIOCM_CTS": {Sig: "const TIOCM_CTS", Doc: "", Pos: "zerrors_linux_amd64.go:1110"},
+		"syscall.TIOCM_DSR": {Sig: "const TIOCM_DSR", Doc: "", Pos: "zerrors_linux_amd64.go:1111"},
+		"syscall.TIOCM_DTR": {Sig: "const TIOCM_DTR", Doc: "", Pos: "zerrors_linux_amd64.go:1112"},
+		"syscall.TIOCM_LE": {Sig: "const TIOCM_LE", Doc: "", Pos: "zerrors_linux_amd64.go:1113"},
+		"syscall.TIOCM_RI": {Sig: "const TIOCM_RI", Doc: "", Pos: "zerrors_linux_amd64.go:1114"},
+		"syscall.TIOCM_RNG": {Sig: "const TIOCM_RNG", Doc: "", Pos: "zerrors_linux_amd64.go:1115"},
+		"syscall.TIOCM_RTS": {Sig: "const TIOCM_RTS", Doc: "", Pos: "zerrors_linux_amd64.go:1116"},
+		"syscall.TIOCM_SR": {Sig: "const TIOCM_SR", Doc: "", Pos: "zerrors_linux_amd64.go:1117"},
+		"syscall.TIOCM_ST": {Sig: "const TIOCM_ST", Doc: "", Pos: "zerrors_linux_amd64.go:1118"},
+		"syscall.TIOCNOTTY": {Sig: "const TIOCNOTTY", Doc: "", Pos: "zerrors_linux_amd64.go:1119"},
+		"syscall.TIOCNXCL": {Sig: "const TIOCNXCL", Doc: "", Pos: "zerrors_linux_amd64.go:1120"},
+		"syscall.TIOCOUTQ": {Sig: "const TIOCOUTQ", Doc: "", Pos: "zerrors_linux_amd64.go:1121"},
+		"syscall.TIOCPKT": {Sig: "const TIOCPKT", Doc: "", Pos: "zerrors_linux_amd64.go:1122"},
+		"syscall.TIOCPKT_DATA": {Sig: "const TIOCPKT_DATA", Doc: "", Pos: "zerrors_linux_amd64.go:1123"},
+		"syscall.TIOCPKT_DOSTOP": {Sig: "const TIOCPKT_DOSTOP", Doc: "", Pos: "zerrors_linux_amd64.go:1124"},
+		"syscall.TIOCPKT_FLUSHREAD": {Sig: "const TIOCPKT_FLUSHREAD", Doc: "", Pos: "zerrors_linux_amd64.go:1125"},
+		"syscall.TIOCPKT_FLUSHWRITE": {Sig: "const TIOCPKT_FLUSHWRITE", Doc: "", Pos: "zerrors_linux_amd64.go:1126"},
+		"syscall.TIOCPKT_IOCTL": {Sig: "const TIOCPKT_IOCTL", Doc: "", Pos: "zerrors_linux_amd64.go:1127"},
+		"syscall.TIOCPKT_NOSTOP": {Sig: "const TIOCPKT_NOSTOP", Doc: "", Pos: "zerrors_linux_amd64.go:1128"},
+		"syscall.TIOCPKT_START": {Sig: "const TIOCPKT_START", Doc: "", Pos: "zerrors_linux_amd64.go:1129"},
+		"syscall.TIOCPKT_STOP": {Sig: "const TIOCPKT_STOP", Doc: "", Pos: "zerrors_linux_amd64.go:1130"},
+		"syscall.TIOCSBRK": {Sig: "const TIOCSBRK", Doc: "", Pos: "zerrors_linux_amd64.go:1131"},
+		"syscall.TIOCSCTTY": {Sig: "const TIOCSCTTY", Doc: "", Pos: "zerrors_linux_amd64.go:1132"},
+		"syscall.TIOCSERCONFIG": {Sig: "const TIOCSERCONFIG", Doc: "", Pos: "zerrors_linux_amd64.go:1133"},
+		"syscall.TIOCSERGETLSR": {Sig: "const TIOCSERGETLSR", Doc: "", Pos: "zerrors_linux_amd64.go:1134"},
+		"syscall.TIOCSERGETMULTI": {Sig: "const TIOCSERGETMULTI", Doc: "", Pos: "zerrors_linux_amd64.go:1135"},
+		"syscall.TIOCSERGSTRUCT": {Sig: "const TIOCSERGSTRUCT", Doc: "", Pos: "zerrors_linux_amd64.go:1136"},
+		"syscall.TIOCSERGWILD": {Sig: "const TIOCSERGWILD", Doc: "", Pos: "zerrors_linux_amd64.go:1137"},
+		"syscall.TIOCSERSETMULTI": {Sig: "const TIOCSERSETMULTI", Doc: "", Pos: "zerrors_linux_amd64.go:1138"},
+		"syscall.TIOCSERSWILD": {Sig: "const TIOCSERSWILD", Doc: "", Pos: "zerrors_linux_amd64.go:1139"},
+		"syscall.TIOCSER_TEMT": {Sig: "const TIOCSER_TEMT", Doc: "", Pos: "zerrors_linux_amd64.go:1140"},
+		"syscall.TIOCSETD": {Sig: "const TIOCSETD", Doc: "", Pos: "zerrors_linux_amd64.go:1141"},
+		"syscall.TIOCSIG": {Sig: "const TIOCSIG", Doc: "", Pos: "zerrors_linux_amd64.go:1142"},
+		"syscall.TIOCSLCKTRMIOS": {Sig: "const TIOCSLCKTRMIOS", Doc: "", Pos: "zerrors_linux_amd64.go:1143"},
+		"syscall.TIOCSPGRP": {Sig: "const TIOCSPGRP", Doc: "", Pos: "zerrors_linux_amd64.go:1144"},
+		"syscall.TIOCSPTLCK": {Sig: "const TIOCSPTLCK", Doc: "", Pos: "zerrors_linux_amd64.go:1145"},
+		"syscall.TIOCSRS485": {Sig: "const TIOCSRS485", Doc: "", Pos: "zerrors_linux_amd64.go:1146"},
+		"syscall.TIOCSSERIAL": {Sig: "const TIOCSSERIAL", Doc: "", Pos: "zerrors_linux_amd64.go:1147"},
+		"syscall.TIOCSSOFTCAR": {Sig: "const TIOCSSOFTCAR", Doc: "", Pos: "zerrors_linux_amd64.go:1148"},
+		"syscall.TIOCSTI": {Sig: "const TIOCSTI", Doc: "", Pos: "zerrors_linux_amd64.go:1149"},
+		"syscall.TIOCSWINSZ": {Sig: "const TIOCSWINSZ", Doc: "", Pos: "zerrors_linux_amd64.go:1150"},
+		"syscall.TUNATTACHFILTER": {Sig: "const TUNATTACHFILTER", Doc: "", Pos: "zerrors_linux_amd64.go:1151"},
+		"syscall.TUNDETACHFILTER": {Sig: "const TUNDETACHFILTER", Doc: "", Pos: "zerrors_linux_amd64.go:1152"},
+		"syscall.TUNGETFEATURES": {Sig: "const TUNGETFEATURES", Doc: "", Pos: "zerrors_linux_amd64.go:1153"},
+		"syscall.TUNGETIFF": {Sig: "const TUNGETIFF", Doc: "", Pos: "zerrors_linux_amd64.go:1154"},
+		"syscall.TUNGETSNDBUF": {Sig: "const TUNGETSNDBUF", Doc: "", Pos: "zerrors_linux_amd64.go:1155"},
+		"syscall.TUNGETVNETHDRSZ": {Sig: "const TUNGETVNETHDRSZ", Doc: "", Pos: "zerrors_linux_amd64.go:1156"},
+		"syscall.TUNSETDEBUG": {Sig: "const TUNSETDEBUG", Doc: "", Pos: "zerrors_linux_amd64.go:1157"},
+		"syscall.TUNSETGROUP": {Sig: "const TUNSETGROUP", Doc: "", Pos: "zerrors_linux_amd64.go:1158"},
+		"syscall.TUNSETIFF": {Sig: "const TUNSETIFF", Doc: "", Pos: "zerrors_linux_amd64.go:1159"},
+		"syscall.TUNSETLINK": {Sig: "const TUNSETLINK", Doc: "", Pos: "zerrors_linux_amd64.go:1160"},
+		"syscall.TUNSETNOCSUM": {Sig: "const TUNSETNOCSUM", Doc: "", Pos: "zerrors_linux_amd64.go:1161"},
+		"syscall.TUNSETOFFLOAD": {Sig: "const TUNSETOFFLOAD", Doc: "", Pos: "zerrors_linux_amd64.go:1162"},
+		"syscall.TUNSETOWNER": {Sig: "const TUNSETOWNER", Doc: "", Pos: "zerrors_linux_amd64.go:1163"},
+		"syscall.TUNSETPERSIST": {Sig: "const TUNSETPERSIST", Doc: "", Pos: "zerrors_linux_amd64.go:1164"},
+		"syscall.TUNSETSNDBUF": {Sig: "const TUNSETSNDBUF", Doc: "", Pos: "zerrors_linux_amd64.go:1165"},
+		"syscall.TUNSETTXFILTER": {Sig: "const TUNSETTXFILTER", Doc: "", Pos: "zerrors_linux_amd64.go:1166"},
+		"syscall.TUNSETVNETHDRSZ": {Sig: "const TUNSETVNETHDRSZ", Doc: "", Pos: "zerrors_linux_amd64.go:1167"},
+		"syscall.WALL": {Sig: "const WALL", Doc: "", Pos: "zerrors_linux_amd64.go:1168"},
+		"syscall.WCLONE": {Sig: "const WCLONE", Doc: "", Pos: "zerrors_linux_amd64.go:1169"},
+		"syscall.WCONTINUED": {Sig: "const WCONTINUED", Doc: "", Pos: "zerrors_linux_amd64.go:1170"},
+		"syscall.WEXITED": {Sig: "const WEXITED", Doc: "", Pos: "zerrors_linux_amd64.go:1171"},
+		"syscall.WNOHANG": {Sig: "const WNOHANG", Doc: "", Pos: "zerrors_linux_amd64.go:1172"},
+		"syscall.WNOTHREAD": {Sig: "const WNOTHREAD", Doc: "", Pos: "zerrors_linux_amd64.go:1173"},
+		"syscall.WNOWAIT": {Sig: "const WNOWAIT", Doc: "", Pos: "zerrors_linux_amd64.go:1174"},
+		"syscall.WORDSIZE": {Sig: "const WORDSIZE", Doc: "", Pos: "zerrors_linux_amd64.go:1175"},
+		"syscall.WSTOPPED": {Sig: "const WSTOPPED", Doc: "", Pos: "zerrors_linux_amd64.go:1176"},
+		"syscall.WUNTRACED": {Sig: "const WUNTRACED", Doc: "", Pos: "zerrors_linux_amd64.go:1177"},
+		"syscall.E2BIG": {Sig: "const E2BIG", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1182"},
+		"syscall.EACCES": {Sig: "const EACCES", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1183"},
+		"syscall.EADDRINUSE": {Sig: "const EADDRINUSE", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1184"},
+		"syscall.EADDRNOTAVAIL": {Sig: "const EADDRNOTAVAIL", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1185"},
+		"syscall.EADV": {Sig: "const EADV", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1186"},
+		"syscall.EAFNOSUPPORT": {Sig: "const EAFNOSUPPORT", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1187"},
+		"syscall.EAGAIN": {Sig: "const EAGAIN", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1188"},
+		"syscall.EALREADY": {Sig: "const EALREADY", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1189"},
+		"syscall.EBADE": {Sig: "const EBADE", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1190"},
+		"syscall.EBADF": {Sig: "const EBADF", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1191"},
+		"syscall.EBADFD": {Sig: "const EBADFD", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1192"},
+		"syscall.EBADMSG": {Sig: "const EBADMSG", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1193"},
+		"syscall.EBADR": {Sig: "const EBADR", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1194"},
+		"syscall.EBADRQC": {Sig: "const EBADRQC", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1195"},
+		"syscall.EBADSLT": {Sig: "const EBADSLT", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1196"},
+		"syscall.EBFONT": {Sig: "const EBFONT", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1197"},
+		"syscall.EBUSY": {Sig: "const EBUSY", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1198"},
+		"syscall.ECANCELED": {Sig: "const ECANCELED", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1199"},
+		"syscall.ECHILD": {Sig: "const ECHILD", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1200"},
+		"syscall.ECHRNG": {Sig: "const ECHRNG", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1201"},
+		"syscall.ECOMM": {Sig: "const ECOMM", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1202"},
+		"syscall.ECONNABORTED": {Sig: "const ECONNABORTED", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1203"},
+		"syscall.ECONNREFUSED": {Sig: "const ECONNREFUSED", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1204"},
+		"syscall.ECONNRESET": {Sig: "const ECONNRESET", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1205"},
+		"syscall.EDEADLK": {Sig: "const EDEADLK", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1206"},
+		"syscall.EDEADLOCK": {Sig: "const EDEADLOCK", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1207"},
+		"syscall.EDESTADDRREQ": {Sig: "const EDESTADDRREQ", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1208"},
+		"syscall.EDOM": {Sig: "const EDOM", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1209"},
+		"syscall.EDOTDOT": {Sig: "const EDOTDOT", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1210"},
+		"syscall.EDQUOT": {Sig: "const EDQUOT", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1211"},
+		"syscall.EEXIST": {Sig: "const EEXIST", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1212"},
+		"syscall.EFAULT": {Sig: "const EFAULT", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1213"},
+		"syscall.EFBIG": {Sig: "const EFBIG", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1214"},
+		"syscall.EHOSTDOWN": {Sig: "const EHOSTDOWN", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1215"},
+		"syscall.EHOSTUNREACH": {Sig: "const EHOSTUNREACH", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1216"},
+		"syscall.EIDRM": {Sig: "const EIDRM", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1217"},
+		"syscall.EILSEQ": {Sig: "const EILSEQ", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1218"},
+		"syscall.EINPROGRESS": {Sig: "const EINPROGRESS", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1219"},
+		"syscall.EINTR": {Sig: "const EINTR", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1220"},
+		"syscall.EINVAL": {Sig: "const EINVAL", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1221"},
+		"syscall.EIO": {Sig: "const EIO", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1222"},
+		"syscall.EISCONN": {Sig: "const EISCONN", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1223"},
+		"syscall.EISDIR": {Sig: "const EISDIR", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1224"},
+		"syscall.EISNAM": {Sig: "const EISNAM", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1225"},
+		"syscall.EKEYEXPIRED": {Sig: "const EKEYEXPIRED", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1226"},
+		"syscall.EKEYREJECTED": {Sig: "const EKEYREJECTED", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1227"},
+		"syscall.EKEYREVOKED": {Sig: "const EKEYREVOKED", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1228"},
+		"syscall.EL2HLT": {Sig: "const EL2HLT", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1229"},
+		"syscall.EL2NSYNC": {Sig: "const EL2NSYNC", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1230"},
+		"syscall.EL3HLT": {Sig: "const EL3HLT", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1231"},
+		"syscall.EL3RST": {Sig: "const EL3RST", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1232"},
+		"syscall.ELIBACC": {Sig: "const ELIBACC", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1233"},
+		"syscall.ELIBBAD": {Sig: "const ELIBBAD", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1234"},
+		"syscall.ELIBEXEC": {Sig: "const ELIBEXEC", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1235"},
+		"syscall.ELIBMAX": {Sig: "const ELIBMAX", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1236"},
+		"syscall.ELIBSCN": {Sig: "const ELIBSCN", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1237"},
+		"syscall.ELNRNG": {Sig: "const ELNRNG", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1238"},
+		"syscall.ELOOP": {Sig: "const ELOOP", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1239"},
+		"syscall.EMEDIUMTYPE": {Sig: "const EMEDIUMTYPE", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1240"},
+		"syscall.EMFILE": {Sig: "const EMFILE", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1241"},
+		"syscall.EMLINK": {Sig: "const EMLINK", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1242"},
+		"syscall.EMSGSIZE": {Sig: "const EMSGSIZE", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1243"},
+		"syscall.EMULTIHOP": {Sig: "const EMULTIHOP", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1244"},
+		"syscall.ENAMETOOLONG": {Sig: "const ENAMETOOLONG", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1245"},
+		"syscall.ENAVAIL": {Sig: "const ENAVAIL", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1246"},
+		"syscall.ENETDOWN": {Sig: "const ENETDOWN", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1247"},
+		"syscall.ENETRESET": {Sig: "const ENETRESET", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1248"},
+		"syscall.ENETUNREACH": {Sig: "const ENETUNREACH", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1249"},
+		"syscall.ENFILE": {Sig: "const ENFILE", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1250"},
+		"syscall.ENOANO": {Sig: "const ENOANO", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1251"},
+		"syscall.ENOBUFS": {Sig: "const ENOBUFS", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1252"},
+		"syscall.ENOCSI": {Sig: "const ENOCSI", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1253"},
+		"syscall.ENODATA": {Sig: "const ENODATA", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1254"},
+		"syscall.ENODEV": {Sig: "const ENODEV", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1255"},
+		"syscall.ENOENT": {Sig: "const ENOENT", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1256"},
+		"syscall.ENOEXEC": {Sig: "const ENOEXEC", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1257"},
+		"syscall.ENOKEY": {Sig: "const ENOKEY", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1258"},
+		"syscall.ENOLCK": {Sig: "const ENOLCK", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1259"},
+		"syscall.ENOLINK": {Sig: "const ENOLINK", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1260"},
+		"syscall.ENOMEDIUM": {Sig: "const ENOMEDIUM", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1261"},
+		"syscall.ENOMEM": {Sig: "const ENOMEM", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1262"},
+		"syscall.ENOMSG": {Sig: "const ENOMSG", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1263"},
+		"syscall.ENONET": {Sig: "const ENONET", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1264"},
+		"syscall.ENOPKG": {Sig: "const ENOPKG", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1265"},
+		"syscall.ENOPROTOOPT": {Sig: "const ENOPROTOOPT", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1266"},
+		"syscall.ENOSPC": {Sig: "const ENOSPC", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1267"},
+		"syscall.ENOSR": {Sig: "const ENOSR", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1268"},
+		"syscall.ENOSTR": {Sig: "const ENOSTR", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1269"},
+		"syscall.ENOSYS": {Sig: "const ENOSYS", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1270"},
+		"syscall.ENOTBLK": {Sig: "const ENOTBLK", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1271"},
+		"syscall.ENOTCONN": {Sig: "const ENOTCONN", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1272"},
+		"syscall.ENOTDIR": {Sig: "const ENOTDIR", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1273"},
+		"syscall.ENOTEMPTY": {Sig: "const ENOTEMPTY", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1274"},
+		"syscall.ENOTNAM": {Sig: "const ENOTNAM", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1275"},
+		"syscall.ENOTRECOVERABLE": {Sig: "const ENOTRECOVERABLE", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1276"},
+		"syscall.ENOTSOCK": {Sig: "const ENOTSOCK", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1277"},
+		"syscall.ENOTSUP": {Sig: "const ENOTSUP", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1278"},
+		"syscall.ENOTTY": {Sig: "const ENOTTY", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1279"},
+		"syscall.ENOTUNIQ": {Sig: "const ENOTUNIQ", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1280"},
+		"syscall.ENXIO": {Sig: "const ENXIO", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1281"},
+		"syscall.EOPNOTSUPP": {Sig: "const EOPNOTSUPP", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1282"},
+		"syscall.EOVERFLOW": {Sig: "const EOVERFLOW", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1283"},
+		"syscall.EOWNERDEAD": {Sig: "const EOWNERDEAD", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1284"},
+		"syscall.EPERM": {Sig: "const EPERM", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1285"},
+		"syscall.EPFNOSUPPORT": {Sig: "const EPFNOSUPPORT", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1286"},
+		"syscall.EPIPE": {Sig: "const EPIPE", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1287"},
+		"syscall.EPROTO": {Sig: "const EPROTO", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1288"},
+		"syscall.EPROTONOSUPPORT": {Sig: "const EPROTONOSUPPORT", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1289"},
+		"syscall.EPROTOTYPE": {Sig: "const EPROTOTYPE", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1290"},
+		"syscall.ERANGE": {Sig: "const ERANGE", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1291"},
+		"syscall.EREMCHG": {Sig: "const EREMCHG", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1292"},
+		"syscall.EREMOTE": {Sig: "const EREMOTE", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1293"},
+		"syscall.EREMOTEIO": {Sig: "const EREMOTEIO", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1294"},
+		"syscall.ERESTART": {Sig: "const ERESTART", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1295"},
+		"syscall.ERFKILL": {Sig: "const ERFKILL", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1296"},
+		"syscall.EROFS": {Sig: "const EROFS", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1297"},
+		"syscall.ESHUTDOWN": {Sig: "const ESHUTDOWN", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1298"},
+		"syscall.ESOCKTNOSUPPORT": {Sig: "const ESOCKTNOSUPPORT", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1299"},
+		"syscall.ESPIPE": {Sig: "const ESPIPE", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1300"},
+		"syscall.ESRCH": {Sig: "const ESRCH", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1301"},
+		"syscall.ESRMNT": {Sig: "const ESRMNT", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1302"},
+		"syscall.ESTALE": {Sig: "const ESTALE", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1303"},
+		"syscall.ESTRPIPE": {Sig: "const ESTRPIPE", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1304"},
+		"syscall.ETIME": {Sig: "const ETIME", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1305"},
+		"syscall.ETIMEDOUT": {Sig: "const ETIMEDOUT", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1306"},
+		"syscall.ETOOMANYREFS": {Sig: "const ETOOMANYREFS", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1307"},
+		"syscall.ETXTBSY": {Sig: "const ETXTBSY", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1308"},
+		"syscall.EUCLEAN": {Sig: "const EUCLEAN", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1309"},
+		"syscall.EUNATCH": {Sig: "const EUNATCH", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1310"},
+		"syscall.EUSERS": {Sig: "const EUSERS", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1311"},
+		"syscall.EWOULDBLOCK": {Sig: "const EWOULDBLOCK", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1312"},
+		"syscall.EXDEV": {Sig: "const EXDEV", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1313"},
+		"syscall.EXFULL": {Sig: "const EXFULL", Doc: "Errors", Pos: "zerrors_linux_amd64.go:1314"},
+		"syscall.SIGABRT": {Sig: "const SIGABRT", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1319"},
+		"syscall.SIGALRM": {Sig: "const SIGALRM", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1320"},
+		"syscall.SIGBUS": {Sig: "const SIGBUS", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1321"},
+		"syscall.SIGCHLD": {Sig: "const SIGCHLD", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1322"},
+		"syscall.SIGCLD": {Sig: "const SIGCLD", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1323"},
+		"syscall.SIGCONT": {Sig: "const SIGCONT", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1324"},
+		"syscall.SIGFPE": {Sig: "const SIGFPE", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1325"},
+		"syscall.SIGHUP": {Sig: "const SIGHUP", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1326"},
+		"syscall.SIGILL": {Sig: "const SIGILL", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1327"},
+		"syscall.SIGINT": {Sig: "const SIGINT", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1328"},
+		"syscall.SIGIO": {Sig: "const SIGIO", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1329"},
+		"syscall.SIGIOT": {Sig: "const SIGIOT", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1330"},
+		"syscall.SIGKILL": {Sig: "const SIGKILL", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1331"},
+		"syscall.SIGPIPE": {Sig: "const SIGPIPE", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1332"},
+		"syscall.SIGPOLL": {Sig: "const SIGPOLL", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1333"},
+		"syscall.SIGPROF": {Sig: "const SIGPROF", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1334"},
+		"syscall.SIGPWR": {Sig: "const SIGPWR", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1335"},
+		"syscall.SIGQUIT": {Sig: "const SIGQUIT", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1336"},
+		"syscall.SIGSEGV": {Sig: "const SIGSEGV", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1337"},
+		"syscall.SIGSTKFLT": {Sig: "const SIGSTKFLT", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1338"},
+		"syscall.SIGSTOP": {Sig: "const SIGSTOP", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1339"},
+		"syscall.SIGSYS": {Sig: "const SIGSYS", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1340"},
+		"syscall.SIGTERM": {Sig: "const SIGTERM", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1341"},
+		"syscall.SIGTRAP": {Sig: "const SIGTRAP", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1342"},
+		"syscall.SIGTSTP": {Sig: "const SIGTSTP", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1343"},
+		"syscall.SIGTTIN": {Sig: "const SIGTTIN", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1344"},
+		"syscall.SIGTTOU": {Sig: "const SIGTTOU", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1345"},
+		"syscall.SIGUNUSED": {Sig: "const SIGUNUSED", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1346"},
+		"syscall.SIGURG": {Sig: "const SIGURG", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1347"},
+		"syscall.SIGUSR1": {Sig: "const SIGUSR1", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1348"},
+		"syscall.SIGUSR2": {Sig: "const SIGUSR2", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1349"},
+		"syscall.SIGVTALRM": {Sig: "const SIGVTALRM", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1350"},
+		"syscall.SIGWINCH": {Sig: "const SIGWINCH", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1351"},
+		"syscall.SIGXCPU": {Sig: "const SIGXCPU", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1352"},
+		"syscall.SIGXFSZ": {Sig: "const SIGXFSZ", Doc: "Signals", Pos: "zerrors_linux_amd64.go:1353"},
+		"syscall.Getcwd": {Sig: "func Getcwd(buf []byte) (n int, err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:190"},
+		"syscall.Acct": {Sig: "func Acct(path string) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:278"},
+		"syscall.Adjtimex": {Sig: "func Adjtimex(buf *Timex) (state int, err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:293"},
+		"syscall.Chdir": {Sig: "func Chdir(path string) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:304"},
+		"syscall.Chroot": {Sig: "func Chroot(path string) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:319"},
+		"syscall.Close": {Sig: "func Close(fd int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:334"},
+		"syscall.Dup": {Sig: "func Dup(oldfd int) (fd int, err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:344"},
+		"syscall.Dup3": {Sig: "func Dup3(oldfd int, newfd int, flags int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:355"},
+		"syscall.EpollCreate1": {Sig: "func EpollCreate1(flag int) (fd int, err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:365"},
+		"syscall.EpollCtl": {Sig: "func EpollCtl(epfd int, op int, fd int, event *EpollEvent) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:376"},
+		"syscall.Fallocate": {Sig: "func Fallocate(fd int, mode uint32, off int64, len int64) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:386"},
+		"syscall.Fchdir": {Sig: "func Fchdir(fd int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:396"},
+		"syscall.Fchmod": {Sig: "func Fchmod(fd int, mode uint32) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:406"},
+		"syscall.Fchownat": {Sig: "func Fchownat(dirfd int, path string, uid int, gid int, flags int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:416"},
+		"syscall.Fdatasync": {Sig: "func Fdatasync(fd int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:442"},
+		"syscall.Flock": {Sig: "func Flock(fd int, how int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:452"},
+		"syscall.Fsync": {Sig: "func Fsync(fd int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:462"},
+		"syscall.Getdents": {Sig: "func Getdents(fd int, buf []byte) (n int, err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:472"},
+		"syscall.Getpgid": {Sig: "func Getpgid(pid int) (pgid int, err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:489"},
+		"syscall.Getpid": {Sig: "func Getpid() (pid int)", Doc: "", Pos: "zsyscall_linux_amd64.go:500"},
+		"syscall.Getppid": {Sig: "func Getppid() (ppid int)", Doc: "", Pos: "zsyscall_linux_amd64.go:508"},
+		"syscall.Getpriority": {Sig: "func Getpriority(which int, who int) (prio int, err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:516"},
+		"syscall.Getrusage": {Sig: "func Getrusage(who int, rusage *Rusage) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:527"},
+		"syscall.Gettid": {Sig: "func Gettid() (tid int)", Doc: "", Pos: "zsyscall_linux_amd64.go:537"},
+		"syscall.Getxattr": {Sig: "func Getxattr(path string, attr string, dest []byte) (sz int, err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:545"},
+		"syscall.InotifyAddWatch": {Sig: "func InotifyAddWatch(fd int, pathname string, mask uint32) (watchdesc int, err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:572"},
+		"syscall.InotifyInit1": {Sig: "func InotifyInit1(flags int) (fd int, err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:588"},
+		"syscall.InotifyRmWatch": {Sig: "func InotifyRmWatch(fd int, watchdesc uint32) (success int, err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:599"},
+		"syscall.Kill": {Sig: "func Kill(pid int, sig Signal) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:610"},
+		"syscall.Klogctl": {Sig: "func Klogctl(typ int, buf []byte) (n int, err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:620"},
+		"syscall.Listxattr": {Sig: "func Listxattr(path string, dest []byte) (sz int, err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:637"},
+		"syscall.Mkdirat": {Sig: "func Mkdirat(dirfd int, path string, mode uint32) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:659"},
+		"syscall.Mknodat": {Sig: "func Mknodat(dirfd int, path string, mode uint32, dev int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:674"},
+		"syscall.Nanosleep": {Sig: "func Nanosleep(time *Timespec, leftover *Timespec) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:689"},
+		"syscall.PivotRoot": {Sig: "func PivotRoot(newroot string, putold string) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:699"},
+		"syscall.Removexattr": {Sig: "func Removexattr(path string, attr string) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:746"},
+		"syscall.Setdomainname": {Sig: "func Setdomainname(p []byte) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:766"},
+		"syscall.Sethostname": {Sig: "func Sethostname(p []byte) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:782"},
+		"syscall.Setpgid": {Sig: "func Setpgid(pid int, pgid int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:798"},
+		"syscall.Setsid": {Sig: "func Setsid() (pid int, err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:808"},
+		"syscall.Settimeofday": {Sig: "func Settimeofday(tv *Timeval) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:819"},
+		"syscall.Setpriority": {Sig: "func Setpriority(which int, who int, prio int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:829"},
+		"syscall.Setxattr": {Sig: "func Setxattr(path string, attr string, data []byte, flags int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:839"},
+		"syscall.Sync": {Sig: "func Sync()", Doc: "", Pos: "zsyscall_linux_amd64.go:865"},
+		"syscall.Sysinfo": {Sig: "func Sysinfo(info *Sysinfo_t) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:872"},
+		"syscall.Tee": {Sig: "func Tee(rfd int, wfd int, len int, flags int) (n int64, err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:882"},
+		"syscall.Tgkill": {Sig: "func Tgkill(tgid int, tid int, sig Signal) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:893"},
+		"syscall.Times": {Sig: "func Times(tms *Tms) (ticks uintptr, err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:903"},
+		"syscall.Umask": {Sig: "func Umask(mask int) (oldmask int)", Doc: "", Pos: "zsyscall_linux_amd64.go:914"},
+		"syscall.Uname": {Sig: "func Uname(buf *Utsname) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:922"},
+		"syscall.Unmount": {Sig: "func Unmount(target string, flags int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:932"},
+		"syscall.Unshare": {Sig: "func Unshare(flags int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:947"},
+		"syscall.Madvise": {Sig: "func Madvise(b []byte, advice int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1005"},
+		"syscall.Mprotect": {Sig: "func Mprotect(b []byte, prot int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1021"},
+		"syscall.Mlock": {Sig: "func Mlock(b []byte) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1037"},
+		"syscall.Munlock": {Sig: "func Munlock(b []byte) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1053"},
+		"syscall.Mlockall": {Sig: "func Mlockall(flags int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1069"},
+		"syscall.Munlockall": {Sig: "func Munlockall() (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1079"},
+		"syscall.Dup2": {Sig: "func Dup2(oldfd int, newfd int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1089"},
+		"syscall.Fchown": {Sig: "func Fchown(fd int, uid int, gid int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1099"},
+		"syscall.Fstat": {Sig: "func Fstat(fd int, stat *Stat_t) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1109"},
+		"syscall.Fstatfs": {Sig: "func Fstatfs(fd int, buf *Statfs_t) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1119"},
+		"syscall.Ftruncate": {Sig: "func Ftruncate(fd int, length int64) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1129"},
+		"syscall.Getegid": {Sig: "func Getegid() (egid int)", Doc: "", Pos: "zsyscall_linux_amd64.go:1139"},
+		"syscall.Geteuid": {Sig: "func Geteuid() (euid int)", Doc: "", Pos: "zsyscall_linux_amd64.go:1147"},
+		"syscall.Getgid": {Sig: "func Getgid() (gid int)", Doc: "", Pos: "zsyscall_linux_amd64.go:1155"},
+		"syscall.Getuid": {Sig: "func Getuid() (uid int)", Doc: "", Pos: "zsyscall_linux_amd64.go:1163"},
+		"syscall.InotifyInit": {Sig: "func InotifyInit() (fd int, err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1171"},
+		"syscall.Ioperm": {Sig: "func Ioperm(from int, num int, on int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1182"},
+		"syscall.Iopl": {Sig: "func Iopl(level int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1192"},
+		"syscall.Listen": {Sig: "func Listen(s int, n int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1202"},
+		"syscall.Pause": {Sig: "func Pause() (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1212"},
+		"syscall.Renameat": {Sig: "func Renameat(olddirfd int, oldpath string, newdirfd int, newpath string) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1256"},
+		"syscall.Seek": {Sig: "func Seek(fd int, offset int64, whence int) (off int64, err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1276"},
+		"syscall.Select": {Sig: "func Select(nfd int, r *FdSet, w *FdSet, e *FdSet, timeout *Timeval) (n int, err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1287"},
+		"syscall.Setfsgid": {Sig: "func Setfsgid(gid int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1309"},
+		"syscall.Setfsuid": {Sig: "func Setfsuid(uid int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1319"},
+		"syscall.Shutdown": {Sig: "func Shutdown(fd int, how int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1329"},
+		"syscall.Splice": {Sig: "func Splice(rfd int, roff *int64, wfd int, woff *int64, len int, flags int) (n int64, err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1339"},
+		"syscall.Statfs": {Sig: "func Statfs(path string, buf *Statfs_t) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1350"},
+		"syscall.SyncFileRange": {Sig: "func SyncFileRange(fd int, off int64, n int64, flags int) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1365"},
+		"syscall.Truncate": {Sig: "func Truncate(path string, length int64) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1375"},
+		"syscall.Ustat": {Sig: "func Ustat(dev int, ubuf *Ustat_t) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1390"},
+		"syscall.EpollWait": {Sig: "func EpollWait(epfd int, events []EpollEvent, msec int) (n int, err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1584"},
+		"syscall.Utime": {Sig: "func Utime(path string, buf *Utimbuf) (err error)", Doc: "", Pos: "zsyscall_linux_amd64.go:1616"},
+		"syscall.SYS_READ": {Sig: "const SYS_READ", Doc: "", Pos: "zsysnum_linux_amd64.go:7"},
+		"syscall.SYS_WRITE": {Sig: "const SYS_WRITE", Doc: "", Pos: "zsysnum_linux_amd64.go:8"},
+		"syscall.SYS_OPEN": {Sig: "const SYS_OPEN", Doc: "", Pos: "zsysnum_linux_amd64.go:9"},
+		"syscall.SYS_CLOSE": {Sig: "const SYS_CLOSE", Doc: "", Pos: "zsysnum_linux_amd64.go:10"},
+		"syscall.SYS_STAT": {Sig: "const SYS_STAT", Doc: "", Pos: "zsysnum_linux_amd64.go:11"},
+		"syscall.SYS_FSTAT": {Sig: "const SYS_FSTAT", Doc: "", Pos: "zsysnum_linux_amd64.go:12"},
+		"syscall.SYS_LSTAT": {Sig: "const SYS_LSTAT", Doc: "", Pos: "zsysnum_linux_amd64.go:13"},
+		"syscall.SYS_POLL": {Sig: "const SYS_POLL", Doc: "", Pos: "zsysnum_linux_amd64.go:14"},
+		"syscall.SYS_LSEEK": {Sig: "const SYS_LSEEK", Doc: "", Pos: "zsysnum_linux_amd64.go:15"},
+		"syscall.SYS_MMAP": {Sig: "const SYS_MMAP", Doc: "", Pos: "zsysnum_linux_amd64.go:16"},
+		"syscall.SYS_MPROTECT": {Sig: "const SYS_MPROTECT", Doc: "", Pos: "zsysnum_linux_amd64.go:17"},
+		"syscall.SYS_MUNMAP": {Sig: "const SYS_MUNMAP", Doc: "", Pos: "zsysnum_linux_amd64.go:18"},
+		"syscall.SYS_BRK": {Sig: "const SYS_BRK", Doc: "", Pos: "zsysnum_linux_amd64.go:19"},
+		"syscall.SYS_RT_SIGACTION": {Sig: "const SYS_RT_SIGACTION", Doc: "", Pos: "zsysnum_linux_amd64.go:20"},
+		"syscall.SYS_RT_SIGPROCMASK": {Sig: "const SYS_RT_SIGPROCMASK", Doc: "", Pos: "zsysnum_linux_amd64.go:21"},
+		"syscall.SYS_RT_SIGRETURN": {Sig: "const SYS_RT_SIGRETURN", Doc: "", Pos: "zsysnum_linux_amd64.go:22"},
+		"syscall.SYS_IOCTL": {Sig: "const SYS_IOCTL", Doc: "", Pos: "zsysnum_linux_amd64.go:23"},
+		"syscall.SYS_PREAD64": {Sig: "const SYS_PREAD64", Doc: "", Pos: "zsysnum_linux_amd64.go:24"},
+		"syscall.SYS_PWRITE64": {Sig: "const SYS_PWRITE64", Doc: "", Pos: "zsysnum_linux_amd64.go:25"},
+		"syscall.SYS_READV": {Sig: "const SYS_READV", Doc: "", Pos: "zsysnum_linux_amd64.go:26"},
+		"syscall.SYS_WRITEV": {Sig: "const SYS_WRITEV", Doc: "", Pos: "zsysnum_linux_amd64.go:27"},
+		"syscall.SYS_ACCESS": {Sig: "const SYS_ACCESS", Doc: "", Pos: "zsysnum_linux_amd64.go:28"},
+		"syscall.SYS_PIPE": {Sig: "const SYS_PIPE", Doc: "", Pos: "zsysnum_linux_amd64.go:29"},
+		"syscall.SYS_SELECT": {Sig: "const SYS_SELECT", Doc: "", Pos: "zsysnum_linux_amd64.go:30"},
+		"syscall.SYS_SCHED_YIELD": {Sig: "const SYS_SCHED_YIELD", Doc: "", Pos: "zsysnum_linux_amd64.go:31"},
+		"syscall.SYS_MREMAP": {Sig: "const SYS_MREMAP", Doc: "", Pos: "zsysnum_linux_amd64.go:32"},
+		"syscall.SYS_MSYNC": {Sig: "const SYS_MSYNC", Doc: "", Pos: "zsysnum_linux_amd64.go:33"},
+		"syscall.SYS_MINCORE": {Sig: "const SYS_MINCORE", Doc: "", Pos: "zsysnum_linux_amd64.go:34"},
+		"syscall.SYS_MADVISE": {Sig: "const SYS_MADVISE", Doc: "", Pos: "zsysnum_linux_amd64.go:35"},
+		"syscall.SYS_SHMGET": {Sig: "const SYS_SHMGET", Doc: "", Pos: "zsysnum_linux_amd64.go:36"},
+		"syscall.SYS_SHMAT": {Sig: "const SYS_SHMAT", Doc: "", Pos: "zsysnum_linux_amd64.go:37"},
+		"syscall.SYS_SHMCTL": {Sig: "const SYS_SHMCTL", Doc: "", Pos: "zsysnum_linux_amd64.go:38"},
+		"syscall.SYS_DUP": {Sig: "const SYS_DUP", Doc: "", Pos: "zsysnum_linux_amd64.go:39"},
+		"syscall.SYS_DUP2": {Sig: "const SYS_DUP2", Doc: "", Pos: "zsysnum_linux_amd64.go:40"},
+		"syscall.SYS_PAUSE": {Sig: "const SYS_PAUSE", Doc: "", Pos: "zsysnum_linux_amd64.go:41"},
+		"syscall.SYS_NANOSLEEP": {Sig: "const SYS_NANOSLEEP", Doc: "", Pos: "zsysnum_linux_amd64.go:42"},
+		"syscall.SYS_GETITIMER": {Sig: "const SYS_GETITIMER", Doc: "", Pos: "zsysnum_linux_amd64.go:43"},
+		"syscall.SYS_ALARM": {Sig: "const SYS_ALARM", Doc: "", Pos: "zsysnum_linux_amd64.go:44"},
+		"syscall.SYS_SETITIMER": {Sig: "const SYS_SETITIMER", Doc: "", Pos: "zsysnum_linux_amd64.go:45"},
+		"syscall.SYS_GETPID": {Sig: "const SYS_GETPID", Doc: "", Pos: "zsysnum_linux_amd64.go:46"},
+		"syscall.SYS_SENDFILE": {Sig: "const SYS_SENDFILE", Doc: "", Pos: "zsysnum_linux_amd64.go:47"},
+		"syscall.SYS_SOCKET": {Sig: "const SYS_SOCKET", Doc: "", Pos: "zsysnum_linux_amd64.go:48"},
+		"syscall.SYS_CONNECT": {Sig: "const SYS_CONNECT", Doc: "", Pos: "zsysnum_linux_amd64.go:49"},
+		"syscall.SYS_ACCEPT": {Sig: "const SYS_ACCEPT", Doc: "", Pos: "zsysnum_linux_amd64.go:50"},
+		"syscall.SYS_SENDTO": {Sig: "const SYS_SENDTO", Doc: "", Pos: "zsysnum_linux_amd64.go:51"},
+		"syscall.SYS_RECVFROM": {Sig: "const SYS_RECVFROM", Doc: "", Pos: "zsysnum_linux_amd64.go:52"},
+		"syscall.SYS_SENDMSG": {Sig: "const SYS_SENDMSG", Doc: "", Pos: "zsysnum_linux_amd64.go:53"},
+		"syscall.SYS_RECVMSG": {Sig: "const SYS_RECVMSG", Doc: "", Pos: "zsysnum_linux_amd64.go:54"},
+		"syscall.SYS_SHUTDOWN": {Sig: "const SYS_SHUTDOWN", Doc: "", Pos: "zsysnum_linux_amd64.go:55"},
+		"syscall.SYS_BIND": {Sig: "const SYS_BIND", Doc: "", Pos: "zsysnum_linux_amd64.go:56"},
+		"syscall.SYS_LISTEN": {Sig: "const SYS_LISTEN", Doc: "", Pos: "zsysnum_linux_amd64.go:57"},
+		"syscall.SYS_GETSOCKNAME": {Sig: "const SYS_GETSOCKNAME", Doc: "", Pos: "zsysnum_linux_amd64.go:58"},
+		"syscall.SYS_GETPEERNAME": {Sig: "const SYS_GETPEERNAME", Doc: "", Pos: "zsysnum_linux_amd64.go:59"},
+		"syscall.SYS_SOCKETPAIR": {Sig: "const SYS_SOCKETPAIR", Doc: "", Pos: "zsysnum_linux_amd64.go:60"},
+		"syscall.SYS_SETSOCKOPT": {Sig: "const SYS_SETSOCKOPT", Doc: "", Pos: "zsysnum_linux_amd64.go:61"},
+		"syscall.SYS_GETSOCKOPT": {Sig: "const SYS_GETSOCKOPT", Doc: "", Pos: "zsysnum_linux_amd64.go:62"},
+		"syscall.SYS_CLONE": {Sig: "const SYS_CLONE", Doc: "", Pos: "zsysnum_linux_amd64.go:63"},
+		"syscall.SYS_FORK": {Sig: "const SYS_FORK", Doc: "", Pos: "zsysnum_linux_amd64.go:64"},
+		"syscall.SYS_VFORK": {Sig: "const SYS_VFORK", Doc: "", Pos: "zsysnum_linux_amd64.go:65"},
+		"syscall.SYS_EXECVE": {Sig: "const SYS_EXECVE", Doc: "", Pos: "zsysnum_linux_amd64.go:66"},
+		"syscall.SYS_EXIT": {Sig: "const SYS_EXIT", Doc: "", Pos: "zsysnum_linux_amd64.go:67"},
+		"syscall.SYS_WAIT4": {Sig: "const SYS_WAIT4", Doc: "", Pos: "zsysnum_linux_amd64.go:68"},
+		"syscall.SYS_KILL": {Sig: "const SYS_KILL", Doc: "", Pos: "zsysnum_linux_amd64.go:69"},
+		"syscall.SYS_UNAME": {Sig: "const SYS_UNAME", Doc: "", Pos: "zsysnum_linux_amd64.go:70"},
+		"syscall.SYS_SEMGET": {Sig: "const SYS_SEMGET", Doc: "", Pos: "zsysnum_linux_amd64.go:71"},
+		"syscall.SYS_SEMOP": {Sig: "const SYS_SEMOP", Doc: "", Pos: "zsysnum_linux_amd64.go:72"},
+		"syscall.SYS_SEMCTL": {Sig: "const SYS_SEMCTL", Doc: "", Pos: "zsysnum_linux_amd64.go:73"},
+		"syscall.SYS_SHMDT": {Sig: "const SYS_SHMDT", Doc: "", Pos: "zsysnum_linux_amd64.go:74"},
+		"syscall.SYS_MSGGET": {Sig: "const SYS_MSGGET", Doc: "", Pos: "zsysnum_linux_amd64.go:75"},
+		"syscall.SYS_MSGSND": {Sig: "const SYS_MSGSND", Doc: "", Pos: "zsysnum_linux_amd64.go:76"},
+		"syscall.SYS_MSGRCV": {Sig: "const SYS_MSGRCV", Doc: "", Pos: "zsysnum_linux_amd64.go:77"},
+		"syscall.SYS_MSGCTL": {Sig: "const SYS_MSGCTL", Doc: "", Pos: "zsysnum_linux_amd64.go:78"},
+		"syscall.SYS_FCNTL": {Sig: "const SYS_FCNTL", Doc: "", Pos: "zsysnum_linux_amd64.go:79"},
+		"syscall.SYS_FLOCK": {Sig: "const SYS_FLOCK", Doc: "", Pos: "zsysnum_linux_amd64.go:80"},
+		"syscall.SYS_FSYNC": {Sig: "const SYS_FSYNC", Doc: "", Pos: "zsysnum_linux_amd64.go:81"},
+		"syscall.SYS_FDATASYNC": {Sig: "const SYS_FDATASYNC", Doc: "", Pos: "zsysnum_linux_amd64.go:82"},
+		"syscall.SYS_TRUNCATE": {Sig: "const SYS_TRUNCATE", Doc: "", Pos: "zsysnum_linux_amd64.go:83"},
+		"syscall.SYS_FTRUNCATE": {Sig: "const SYS_FTRUNCATE", Doc: "", Pos: "zsysnum_linux_amd64.go:84"},
+		"syscall.SYS_GETDENTS": {Sig: "const SYS_GETDENTS", Doc: "", Pos: "zsysnum_linux_amd64.go:85"},
+		"syscall.SYS_GETCWD": {Sig: "const SYS_GETCWD", Doc: "", Pos: "zsysnum_linux_amd64.go:86"},
+		"syscall.SYS_CHDIR": {Sig: "const SYS_CHDIR", Doc: "", Pos: "zsysnum_linux_amd64.go:87"},
+		"syscall.SYS_FCHDIR": {Sig: "const SYS_FCHDIR", Doc: "", Pos: "zsysnum_linux_amd64.go:88"},
+		"syscall.SYS_RENAME": {Sig: "const SYS_RENAME", Doc: "", Pos: "zsysnum_linux_amd64.go:89"},
+		"syscall.SYS_MKDIR": {Sig: "const SYS_MKDIR", Doc: "", Pos: "zsysnum_linux_amd64.go:90"},
+		"syscall.SYS_RMDIR": {Sig: "const SYS_RMDIR", Doc: "", Pos: "zsysnum_linux_amd64.go:91"},
+		"syscall.SYS_CREAT": {Sig: "const SYS_CREAT", Doc: "", Pos: "zsysnum_linux_amd64.go:92"},
+		"syscall.SYS_LINK": {Sig: "const SYS_LINK", Doc: "", Pos: "zsysnum_linux_amd64.go:93"},
+		"syscall.SYS_UNLINK": {Sig: "const SYS_UNLINK", Doc: "", Pos: "zsysnum_linux_amd64.go:94"},
+		"syscall.SYS_SYMLINK": {Sig: "const SYS_SYMLINK", Doc: "", Pos: "zsysnum_linux_amd64.go:95"},
+		"syscall.SYS_READLINK": {Sig: "const SYS_READLINK", Doc: "", Pos: "zsysnum_linux_amd64.go:96"},
+		"syscall.SYS_CHMOD": {Sig: "const SYS_CHMOD", Doc: "", Pos: "zsysnum_linux_amd64.go:97"},
+		"syscall.SYS_FCHMOD": {Sig: "const SYS_FCHMOD", Doc: "", Pos: "zsysnum_linux_amd64.go:98"},
+		"syscall.SYS_CHOWN": {Sig: "const SYS_CHOWN", Doc: "", Pos: "zsysnum_linux_amd64.go:99"},
+		"syscall.SYS_FCHOWN": {Sig: "const SYS_FCHOWN", Doc: "", Pos: "zsysnum_linux_amd64.go:100"},
+		"syscall.SYS_LCHOWN": {Sig: "const SYS_LCHOWN", Doc: "", Pos: "zsysnum_linux_amd64.go:101"},
+		"syscall.SYS_UMASK": {Sig: "const SYS_UMASK", Doc: "", Pos: "zsysnum_linux_amd64.go:102"},
+		"syscall.SYS_GETTIMEOFDAY": {Sig: "const SYS_GETTIMEOFDAY", Doc: "", Pos: "zsysnum_linux_amd64.go:103"},
+		"syscall.SYS_GETRLIMIT": {Sig: "const SYS_GETRLIMIT", Doc: "", Pos: "zsysnum_linux_amd64.go:104"},
+		"syscall.SYS_GETRUSAGE": {Sig: "const SYS_GETRUSAGE", Doc: "", Pos: "zsysnum_linux_amd64.go:105"},
+		"syscall.SYS_SYSINFO": {Sig: "const SYS_SYSINFO", Doc: "", Pos: "zsysnum_linux_amd64.go:106"},
+		"syscall.SYS_TIMES": {Sig: "const SYS_TIMES", Doc: "", Pos: "zsysnum_linux_amd64.go:107"},
+		"syscall.SYS_PTRACE": {Sig: "const SYS_PTRACE", Doc: "", Pos: "zsysnum_linux_amd64.go:108"},
+		"syscall.SYS_GETUID": {Sig: "const SYS_GETUID", Doc: "", Pos: "zsysnum_linux_amd64.go:109"},
+		"syscall.SYS_SYSLOG": {Sig: "const SYS_SYSLOG", Doc: "", Pos: "zsysnum_linux_amd64.go:110"},
+		"syscall.SYS_GETGID": {Sig: "const SYS_GETGID", Doc: "", Pos: "zsysnum_linux_amd64.go:111"},
+		"syscall.SYS_SETUID": {Sig: "const SYS_SETUID", Doc: "", Pos: "zsysnum_linux_amd64.go:112"},
+		"syscall.SYS_SETGID": {Sig: "const SYS_SETGID", Doc: "", Pos: "zsysnum_linux_amd64.go:113"},
+		"syscall.SYS_GETEUID": {Sig: "const SYS_GETEUID", Doc: "", Pos: "zsysnum_linux_amd64.go:114"},
+		"syscall.SYS_GETEGID": {Sig: "const SYS_GETEGID", Doc: "", Pos: "zsysnum_linux_amd64.go:115"},
+		"syscall.SYS_SETPGID": {Sig: "const SYS_SETPGID", Doc: "", Pos: "zsysnum_linux_amd64.go:116"},
+		"syscall.SYS_GETPPID": {Sig: "const SYS_GETPPID", Doc: "", Pos: "zsysnum_linux_amd64.go:117"},
+		"syscall.SYS_GETPGRP": {Sig: "const SYS_GETPGRP", Doc: "", Pos: "zsysnum_linux_amd64.go:118"},
+		"syscall.SYS_SETSID": {Sig: "const SYS_SETSID", Doc: "", Pos: "zsysnum_linux_amd64.go:119"},
+		"syscall.SYS_SETREUID": {Sig: "const SYS_SETREUID", Doc: "", Pos: "zsysnum_linux_amd64.go:120"},
+		"syscall.SYS_SETREGID": {Sig: "const SYS_SETREGID", Doc: "", Pos: "zsysnum_linux_amd64.go:121"},
+		"syscall.SYS_GETGROUPS": {Sig: "const SYS_GETGROUPS", Doc: "", Pos: "zsysnum_linux_amd64.go:122"},
+		"syscall.SYS_SETGROUPS": {Sig: "const SYS_SETGROUPS", Doc: "", Pos: "zsysnum_linux_amd64.go:123"},
+		"syscall.SYS_SETRESUID": {Sig: "const SYS_SETRESUID", Doc: "", Pos: "zsysnum_linux_amd64.go:124"},
+		"syscall.SYS_GETRESUID": {Sig: "const SYS_GETRESUID", Doc: "", Pos: "zsysnum_linux_amd64.go:125"},
+		"syscall.SYS_SETRESGID": {Sig: "const SYS_SETRESGID", Doc: "", Pos: "zsysnum_linux_amd64.go:126"},
+		"syscall.SYS_GETRESGID": {Sig: "const SYS_GETRESGID", Doc: "", Pos: "zsysnum_linux_amd64.go:127"},
+		"syscall.SYS_GETPGID": {Sig: "const SYS_GETPGID", Doc: "", Pos: "zsysnum_linux_amd64.go:128"},
+		"syscall.SYS_SETFSUID": {Sig: "const SYS_SETFSUID", Doc: "", Pos: "zsysnum_linux_amd64.go:129"},
+		"syscall.SYS_SETFSGID": {Sig: "const SYS_SETFSGID", Doc: "", Pos: "zsysnum_linux_amd64.go:130"},
+		"syscall.SYS_GETSID": {Sig: "const SYS_GETSID", Doc: "", Pos: "zsysnum_linux_amd64.go:131"},
+		"syscall.SYS_CAPGET": {Sig: "const SYS_CAPGET", Doc: "", Pos: "zsysnum_linux_amd64.go:132"},
+		"syscall.SYS_CAPSET": {Sig: "const SYS_CAPSET", Doc: "", Pos: "zsysnum_linux_amd64.go:133"},
+		"syscall.SYS_RT_SIGPENDING": {Sig: "const SYS_RT_SIGPENDING", Doc: "", Pos: "zsysnum_linux_amd64.go:134"},
+		"syscall.SYS_RT_SIGTIMEDWAIT": {Sig: "const SYS_RT_SIGTIMEDWAIT", Doc: "", Pos: "zsysnum_linux_amd64.go:135"},
+		"syscall.SYS_RT_SIGQUEUEINFO": {Sig: "const SYS_RT_SIGQUEUEINFO", Doc: "", Pos: "zsysnum_linux_amd64.go:136"},
+		"syscall.SYS_RT_SIGSUSPEND": {Sig: "const SYS_RT_SIGSUSPEND", Doc: "", Pos: "zsysnum_linux_amd64.go:137"},
+		"syscall.SYS_SIGALTSTACK": {Sig: "const SYS_SIGALTSTACK", Doc: "", Pos: "zsysnum_linux_amd64.go:138"},
+		"syscall.SYS_UTIME": {Sig: "const SYS_UTIME", Doc: "", Pos: "zsysnum_linux_amd64.go:139"},
+		"syscall.SYS_MKNOD": {Sig: "const SYS_MKNOD", Doc: "", Pos: "zsysnum_linux_amd64.go:140"},
+		"syscall.SYS_USELIB": {Sig: "const SYS_USELIB", Doc: "", Pos: "zsysnum_linux_amd64.go:141"},
+		"syscall.SYS_PERSONALITY": {Sig: "const SYS_PERSONALITY", Doc: "", Pos: "zsysnum_linux_amd64.go:142"},
+		"syscall.SYS_USTAT": {Sig: "const SYS_USTAT", Doc: "", Pos: "zsysnum_linux_amd64.go:143"},
+		"syscall.SYS_STATFS": {Sig: "const SYS_STATFS", Doc: "", Pos: "zsysnum_linux_amd64.go:144"},
+		"syscall.SYS_FSTATFS": {Sig: "const SYS_FSTATFS", Doc: "", Pos: "zsysnum_linux_amd64.go:145"},
+		"syscall.SYS_SYSFS": {Sig: "const SYS_SYSFS", Doc: "", Pos: "zsysnum_linux_amd64.go:146"},
+		"syscall.SYS_GETPRIORITY": {Sig: "const SYS_GETPRIORITY", Doc: "", Pos: "zsysnum_linux_amd64.go:147"},
+		"syscall.SYS_SETPRIORITY": {Sig: "const SYS_SETPRIORITY", Doc: "", Pos: "zsysnum_linux_amd64.go:148"},
+		"syscall.SYS_SCHED_SETPARAM": {Sig: "const SYS_SCHED_SETPARAM", Doc: "", Pos: "zsysnum_linux_amd64.go:149"},
+		"syscall.SYS_SCHED_GETPARAM": {Sig: "const SYS_SCHED_GETPARAM", Doc: "", Pos: "zsysnum_linux_amd64.go:150"},
+		"syscall.SYS_SCHED_SETSCHEDULER": {Sig: "const SYS_SCHED_SETSCHEDULER", Doc: "", Pos: "zsysnum_linux_amd64.go:151"},
+		"syscall.SYS_SCHED_GETSCHEDULER": {Sig: "const SYS_SCHED_GETSCHEDULER", Doc: "", Pos: "zsysnum_linux_amd64.go:152"},
+		"syscall.SYS_SCHED_GET_PRIORITY_MAX": {Sig: "const SYS_SCHED_GET_PRIORITY_MAX", Doc: "", Pos: "zsysnum_linux_amd64.go:153"},
+		"syscall.SYS_SCHED_GET_PRIORITY_MIN": {Sig: "const SYS_SCHED_GET_PRIORITY_MIN", Doc: "", Pos: "zsysnum_linux_amd64.go:154"},
+		"syscall.SYS_SCHED_RR_GET_INTERVAL": {Sig: "const SYS_SCHED_RR_GET_INTERVAL", Doc: "", Pos: "zsysnum_linux_amd64.go:155"},
+		"syscall.SYS_MLOCK": {Sig: "const SYS_MLOCK", Doc: "", Pos: "zsysnum_linux_amd64.go:156"},
+		"syscall.SYS_MUNLOCK": {Sig: "const SYS_MUNLOCK", Doc: "", Pos: "zsysnum_linux_amd64.go:157"},
+		"syscall.SYS_MLOCKALL": {Sig: "const SYS_MLOCKALL", Doc: "", Pos: "zsysnum_linux_amd64.go:158"},
+		"syscall.SYS_MUNLOCKALL": {Sig: "const SYS_MUNLOCKALL", Doc: "", Pos: "zsysnum_linux_amd64.go:159"},
+		"syscall.SYS_VHANGUP": {Sig: "const SYS_VHANGUP", Doc: "", Pos: "zsysnum_linux_amd64.go:160"},
+		"syscall.SYS_MODIFY_LDT": {Sig: "const SYS_MODIFY_LDT", Doc: "", Pos: "zsysnum_linux_amd64.go:161"},
+		"syscall.SYS_PIVOT_ROOT": {Sig: "const SYS_PIVOT_ROOT", Doc: "", Pos: "zsysnum_linux_amd64.go:162"},
+		"syscall.SYS__SYSCTL": {Sig: "const SYS__SYSCTL", Doc: "", Pos: "zsysnum_linux_amd64.go:163"},
+		"syscall.SYS_PRCTL": {Sig: "const SYS_PRCTL", Doc: "", Pos: "zsysnum_linux_amd64.go:164"},
+		"syscall.SYS_ARCH_PRCTL": {Sig: "const SYS_ARCH_PRCTL", Doc: "", Pos: "zsysnum_linux_amd64.go:165"},
+		"syscall.SYS_ADJTIMEX": {Sig: "const SYS_ADJTIMEX", Doc: "", Pos: "zsysnum_linux_amd64.go:166"},
+		"syscall.SYS_SETRLIMIT": {Sig: "const SYS_SETRLIMIT", Doc: "", Pos: "zsysnum_linux_amd64.go:167"},
+		"syscall.SYS_CHROOT": {Sig: "const SYS_CHROOT", Doc: "", Pos: "zsysnum_linux_amd64.go:168"},
+		"syscall.SYS_SYNC": {Sig: "const SYS_SYNC", Doc: "", Pos: "zsysnum_linux_amd64.go:169"},
+		"syscall.SYS_ACCT": {Sig: "const SYS_ACCT", Doc: "", Pos: "zsysnum_linux_amd64.go:170"},
+		"syscall.SYS_SETTIMEOFDAY": {Sig: "const SYS_SETTIMEOFDAY", Doc: "", Pos: "zsysnum_linux_amd64.go:171"},
+		"syscall.SYS_MOUNT": {Sig: "const SYS_MOUNT", Doc: "", Pos: "zsysnum_linux_amd64.go:172"},
+		"syscall.SYS_UMOUNT2": {Sig: "const SYS_UMOUNT2", Doc: "", Pos: "zsysnum_linux_amd64.go:173"},
+		"syscall.SYS_SWAPON": {Sig: "const SYS_SWAPON", Doc: "", Pos: "zsysnum_linux_amd64.go:174"},
+		"syscall.SYS_SWAPOFF": {Sig: "const SYS_SWAPOFF", Doc: "", Pos: "zsysnum_linux_amd64.go:175"},
+		"syscall.SYS_REBOOT": {Sig: "const SYS_REBOOT", Doc: "", Pos: "zsysnum_linux_amd64.go:176"},
+		"syscall.SYS_SETHOSTNAME": {Sig: "const SYS_SETHOSTNAME", Doc: "", Pos: "zsysnum_linux_amd64.go:177"},
+		"syscall.SYS_SETDOMAINNAME": {Sig: "const SYS_SETDOMAINNAME", Doc: "", Pos: "zsysnum_linux_amd64.go:178"},
+		"syscall.SYS_IOPL": {Sig: "const SYS_IOPL", Doc: "", Pos: "zsysnum_linux_amd64.go:179"},
+		"syscall.SYS_IOPERM": {Sig: "const SYS_IOPERM", Doc: "", Pos: "zsysnum_linux_amd64.go:180"},
+		"syscall.SYS_CREATE_MODULE": {Sig: "const SYS_CREATE_MODULE", Doc: "", Pos: "zsysnum_linux_amd64.go:181"},
+		"syscall.SYS_INIT_MODULE": {Sig: "const SYS_INIT_MODULE", Doc: "", Pos: "zsysnum_linux_amd64.go:182"},
+		"syscall.SYS_DELETE_MODULE": {Sig: "const SYS_DELETE_MODULE", Doc: "", Pos: "zsysnum_linux_amd64.go:183"},
+		"syscall.SYS_GET_KERNEL_SYMS": {Sig: "const SYS_GET_KERNEL_SYMS", Doc: "", Pos: "zsysnum_linux_amd64.go:184"},
+		"syscall.SYS_QUERY_MODULE": {Sig: "const SYS_QUERY_MODULE", Doc: "", Pos: "zsysnum_linux_amd64.go:185"},
+		"syscall.SYS_QUOTACTL": {Sig: "const SYS_QUOTACTL", Doc: "", Pos: "zsysnum_linux_amd64.go:186"},
+		"syscall.SYS_NFSSERVCTL": {Sig: "const SYS_NFSSERVCTL", Doc: "", Pos: "zsysnum_linux_amd64.go:187"},
+		"syscall.SYS_GETPMSG": {Sig: "const SYS_GETPMSG", Doc: "", Pos: "zsysnum_linux_amd64.go:188"},
+		"syscall.SYS_PUTPMSG": {Sig: "const SYS_PUTPMSG", Doc: "", Pos: "zsysnum_linux_amd64.go:189"},
+		"syscall.SYS_AFS_SYSCALL": {Sig: "const SYS_AFS_SYSCALL", Doc: "", Pos: "zsysnum_linux_amd64.go:190"},
+		"syscall.SYS_TUXCALL": {Sig: "const SYS_TUXCALL", Doc: "", Pos: "zsysnum_linux_amd64.go:191"},
+		"syscall.SYS_SECURITY": {Sig: "const SYS_SECURITY", Doc: "", Pos: "zsysnum_linux_amd64.go:192"},
+		"syscall.SYS_GETTID": {Sig: "const SYS_GETTID", Doc: "", Pos: "zsysnum_linux_amd64.go:193"},
+		"syscall.SYS_READAHEAD": {Sig: "const SYS_READAHEAD", Doc: "", Pos: "zsysnum_linux_amd64.go:194"},
+		"syscall.SYS_SETXATTR": {Sig: "const SYS_SETXATTR", Doc: "", Pos: "zsysnum_linux_amd64.go:195"},
+		"syscall.SYS_LSETXATTR": {Sig: "const SYS_LSETXATTR", Doc: "", Pos: "zsysnum_linux_amd64.go:196"},
+		"syscall.SYS_FSETXATTR": {Sig: "const SYS_FSETXATTR", Doc: "", Pos: "zsysnum_linux_amd64.go:197"},
+		"syscall.SYS_GETXATTR": {Sig: "const SYS_GETXATTR", Doc: "", Pos: "zsysnum_linux_amd64.go:198"},
+		"syscall.SYS_LGETXATTR": {Sig: "const SYS_LGETXATTR", Doc: "", Pos: "zsysnum_linux_amd64.go:199"},
+		"syscall.SYS_FGETXATTR": {Sig: "const SYS_FGETXATTR", Doc: "", Pos: "zsysnum_linux_amd64.go:200"},
+		"syscall.SYS_LISTXATTR": {Sig: "const SYS_LISTXATTR", Doc: "", Pos: "zsysnum_linux_amd64.go:201"},
+		"syscall.SYS_LLISTXATTR": {Sig: "const SYS_LLISTXATTR", Doc: "", Pos: "zsysnum_linux_amd64.go:202"},
+		"syscall.SYS_FLISTXATTR": {Sig: "const SYS_FLISTXATTR", Doc: "", Pos: "zsysnum_linux_amd64.go:203"},
+		"syscall.SYS_REMOVEXATTR": {Sig: "const SYS_REMOVEXATTR", Doc: "", Pos: "zsysnum_linux_amd64.go:204"},
+		"syscall.SYS_LREMOVEXATTR": {Sig: "const SYS_LREMOVEXATTR", Doc: "", Pos: "zsysnum_linux_amd64.go:205"},
+		"syscall.SYS_FREMOVEXATTR": {Sig: "const SYS_FREMOVEXATTR", Doc: "", Pos: "zsysnum_linux_amd64.go:206"},
+		"syscall.SYS_TKILL": {Sig: "const SYS_TKILL", Doc: "", Pos: "zsysnum_linux_amd64.go:207"},
+		"syscall.SYS_TIME": {Sig: "const SYS_TIME", Doc: "", Pos: "zsysnum_linux_amd64.go:208"},
+		"syscall.SYS_FUTEX": {Sig: "const SYS_FUTEX", Doc: "", Pos: "zsysnum_linux_amd64.go:209"},
+		"syscall.SYS_SCHED_SETAFFINITY": {Sig: "const SYS_SCHED_SETAFFINITY", Doc: "", Pos: "zsysnum_linux_amd64.go:210"},
+		"syscall.SYS_SCHED_GETAFFINITY": {Sig: "const SYS_SCHED_GETAFFINITY", Doc: "", Pos: "zsysnum_linux_amd64.go:211"},
+		"syscall.SYS_SET_THREAD_AREA": {Sig: "const SYS_SET_THREAD_AREA", Doc: "", Pos: "zsysnum_linux_amd64.go:212"},
+		"syscall.SYS_IO_SETUP": {Sig: "const SYS_IO_SETUP", Doc: "", Pos: "zsysnum_linux_amd64.go:213"},
+		"syscall.SYS_IO_DESTROY": {Sig: "const SYS_IO_DESTROY", Doc: "", Pos: "zsysnum_linux_amd64.go:214"},
+		"syscall.SYS_IO_GETEVENTS": {Sig: "const SYS_IO_GETEVENTS", Doc: "", Pos: "zsysnum_linux_amd64.go:215"},
+		"syscall.SYS_IO_SUBMIT": {Sig: "const SYS_IO_SUBMIT", Doc: "", Pos: "zsysnum_linux_amd64.go:216"},
+		"syscall.SYS_IO_CANCEL": {Sig: "const SYS_IO_CANCEL", Doc: "", Pos: "zsysnum_linux_amd64.go:217"},
+		"syscall.SYS_GET_THREAD_AREA": {Sig: "const SYS_GET_THREAD_AREA", Doc: "", Pos: "zsysnum_linux_amd64.go:218"},
+		"syscall.SYS_LOOKUP_DCOOKIE": {Sig: "const SYS_LOOKUP_DCOOKIE", Doc: "", Pos: "zsysnum_linux_amd64.go:219"},
+		"syscall.SYS_EPOLL_CREATE": {Sig: "const SYS_EPOLL_CREATE", Doc: "", Pos: "zsysnum_linux_amd64.go:220"},
+		"syscall.SYS_EPOLL_CTL_OLD": {Sig: "const SYS_EPOLL_CTL_OLD", Doc: "", Pos: "zsysnum_linux_amd64.go:221"},
+		"syscall.SYS_EPOLL_WAIT_OLD": {Sig: "const SYS_EPOLL_WAIT_OLD", Doc: "", Pos: "zsysnum_linux_amd64.go:222"},
+		"syscall.SYS_REMAP_FILE_PAGES": {Sig: "const SYS_REMAP_FILE_PAGES", Doc: "", Pos: "zsysnum_linux_amd64.go:223"},
+		"syscall.SYS_GETDENTS64": {Sig: "const SYS_GETDENTS64", Doc: "", Pos: "zsysnum_linux_amd64.go:224"},
+		"syscall.SYS_SET_TID_ADDRESS": {Sig: "const SYS_SET_TID_ADDRESS", Doc: "", Pos: "zsysnum_linux_amd64.go:225"},
+		"syscall.SYS_RESTART_SYSCALL": {Sig: "const SYS_RESTART_SYSCALL", Doc: "", Pos: "zsysnum_linux_amd64.go:226"},
+		"syscall.SYS_SEMTIMEDOP": {Sig: "const SYS_SEMTIMEDOP", Doc: "", Pos: "zsysnum_linux_amd64.go:227"},
+		"syscall.SYS_FADVISE64": {Sig: "const SYS_FADVISE64", Doc: "", Pos: "zsysnum_linux_amd64.go:228"},
+		"syscall.SYS_TIMER_CREATE": {Sig: "const SYS_TIMER_CREATE", Doc: "", Pos: "zsysnum_linux_amd64.go:229"},
+		"syscall.SYS_TIMER_SETTIME": {Sig: "const SYS_TIMER_SETTIME", Doc: "", Pos: "zsysnum_linux_amd64.go:230"},
+		"syscall.SYS_TIMER_GETTIME": {Sig: "const SYS_TIMER_GETTIME", Doc: "", Pos: "zsysnum_linux_amd64.go:231"},
+		"syscall.SYS_TIMER_GETOVERRUN": {Sig: "const SYS_TIMER_GETOVERRUN", Doc: "", Pos: "zsysnum_linux_amd64.go:232"},
+		"syscall.SYS_TIMER_DELETE": {Sig: "const SYS_TIMER_DELETE", Doc: "", Pos: "zsysnum_linux_amd64.go:233"},
+		"syscall.SYS_CLOCK_SETTIME": {Sig: "const SYS_CLOCK_SETTIME", Doc: "", Pos: "zsysnum_linux_amd64.go:234"},
+		"syscall.SYS_CLOCK_GETTIME": {Sig: "const SYS_CLOCK_GETTIME", Doc: "", Pos: "zsysnum_linux_amd64.go:235"},
+		"syscall.SYS_CLOCK_GETRES": {Sig: "const SYS_CLOCK_GETRES", Doc: "", Pos: "zsysnum_linux_amd64.go:236"},
+		"syscall.SYS_CLOCK_NANOSLEEP": {Sig: "const SYS_CLOCK_NANOSLEEP", Doc: "", Pos: "zsysnum_linux_amd64.go:237"},
+		"syscall.SYS_EXIT_GROUP": {Sig: "const SYS_EXIT_GROUP", Doc: "", Pos: "zsysnum_linux_amd64.go:238"},
+		"syscall.SYS_EPOLL_WAIT": {Sig: "const SYS_EPOLL_WAIT", Doc: "", Pos: "zsysnum_linux_amd64.go:239"},
+		"syscall.SYS_EPOLL_CTL": {Sig: "const SYS_EPOLL_CTL", Doc: "", Pos: "zsysnum_linux_amd64.go:240"},
+		"syscall.SYS_TGKILL": {Sig: "const SYS_TGKILL", Doc: "", Pos: "zsysnum_linux_amd64.go:241"},
+		"syscall.SYS_UTIMES": {Sig: "const SYS_UTIMES", Doc: "", Pos: "zsysnum_linux_amd64.go:242"},
+		"syscall.SYS_VSERVER": {Sig: "const SYS_VSERVER", Doc: "", Pos: "zsysnum_linux_amd64.go:243"},
+		"syscall.SYS_MBIND": {Sig: "const SYS_MBIND", Doc: "", Pos: "zsysnum_linux_amd64.go:244"},
+		"syscall.SYS_SET_MEMPOLICY": {Sig: "const SYS_SET_MEMPOLICY", Doc: "", Pos: "zsysnum_linux_amd64.go:245"},
+		"syscall.SYS_GET_MEMPOLICY": {Sig: "const SYS_GET_MEMPOLICY", Doc: "", Pos: "zsysnum_linux_amd64.go:246"},
+		"syscall.SYS_MQ_OPEN": {Sig: "const SYS_MQ_OPEN", Doc: "", Pos: "zsysnum_linux_amd64.go:247"},
+		"syscall.SYS_MQ_UNLINK": {Sig: "const SYS_MQ_UNLINK", Doc: "", Pos: "zsysnum_linux_amd64.go:248"},
+		"syscall.SYS_MQ_TIMEDSEND": {Sig: "const SYS_MQ_TIMEDSEND", Doc: "", Pos: "zsysnum_linux_amd64.go:249"},
+		"syscall.SYS_MQ_TIMEDRECEIVE": {Sig: "const SYS_MQ_TIMEDRECEIVE", Doc: "", Pos: "zsysnum_linux_amd64.go:250"},
+		"syscall.SYS_MQ_NOTIFY": {Sig: "const SYS_MQ_NOTIFY", Doc: "", Pos: "zsysnum_linux_amd64.go:251"},
+		"syscall.SYS_MQ_GETSETATTR": {Sig: "const SYS_MQ_GETSETATTR", Doc: "", Pos: "zsysnum_linux_amd64.go:252"},
+		"syscall.SYS_KEXEC_LOAD": {Sig: "const SYS_KEXEC_LOAD", Doc: "", Pos: "zsysnum_linux_amd64.go:253"},
+		"syscall.SYS_WAITID": {Sig: "const SYS_WAITID", Doc: "", Pos: "zsysnum_linux_amd64.go:254"},
+		"syscall.SYS_ADD_KEY": {Sig: "const SYS_ADD_KEY", Doc: "", Pos: "zsysnum_linux_amd64.go:255"},
+		"syscall.SYS_REQUEST_KEY": {Sig: "const SYS_REQUEST_KEY", Doc: "", Pos: "zsysnum_linux_amd64.go:256"},
+		"syscall.SYS_KEYCTL": {Sig: "const SYS_KEYCTL", Doc: "", Pos: "zsysnum_linux_amd64.go:257"},
+		"syscall.SYS_IOPRIO_SET": {Sig: "const SYS_IOPRIO_SET", Doc: "", Pos: "zsysnum_linux_amd64.go:258"},
+		"syscall.SYS_IOPRIO_GET": {Sig: "const SYS_IOPRIO_GET", Doc: "", Pos: "zsysnum_linux_amd64.go:259"},
+		"syscall.SYS_INOTIFY_INIT": {Sig: "const SYS_INOTIFY_INIT", Doc: "", Pos: "zsysnum_linux_amd64.go:260"},
+		"syscall.SYS_INOTIFY_ADD_WATCH": {Sig: "const SYS_INOTIFY_ADD_WATCH", Doc: "", Pos: "zsysnum_linux_amd64.go:261"},
+		"syscall.SYS_INOTIFY_RM_WATCH": {Sig: "const SYS_INOTIFY_RM_WATCH", Doc: "", Pos: "zsysnum_linux_amd64.go:262"},
+		"syscall.SYS_MIGRATE_PAGES": {Sig: "const SYS_MIGRATE_PAGES", Doc: "", Pos: "zsysnum_linux_amd64.go:263"},
+		"syscall.SYS_OPENAT": {Sig: "const SYS_OPENAT", Doc: "", Pos: "zsysnum_linux_amd64.go:264"},
+		"syscall.SYS_MKDIRAT": {Sig: "const SYS_MKDIRAT", Doc: "", Pos: "zsysnum_linux_amd64.go:265"},
+		"syscall.SYS_MKNODAT": {Sig: "const SYS_MKNODAT", Doc: "", Pos: "zsysnum_linux_amd64.go:266"},
+		"syscall.SYS_FCHOWNAT": {Sig: "const SYS_FCHOWNAT", Doc: "", Pos: "zsysnum_linux_amd64.go:267"},
+		"syscall.SYS_FUTIMESAT": {Sig: "const SYS_FUTIMESAT", Doc: "", Pos: "zsysnum_linux_amd64.go:268"},
+		"syscall.SYS_NEWFSTATAT": {Sig: "const SYS_NEWFSTATAT", Doc: "", Pos: "zsysnum_linux_amd64.go:269"},
+		"syscall.SYS_UNLINKAT": {Sig: "const SYS_UNLINKAT", Doc: "", Pos: "zsysnum_linux_amd64.go:270"},
+		"syscall.SYS_RENAMEAT": {Sig: "const SYS_RENAMEAT", Doc: "", Pos: "zsysnum_linux_amd64.go:271"},
+		"syscall.SYS_LINKAT": {Sig: "const SYS_LINKAT", Doc: "", Pos: "zsysnum_linux_amd64.go:272"},
+		"syscall.SYS_SYMLINKAT": {Sig: "const SYS_SYMLINKAT", Doc: "", Pos: "zsysnum_linux_amd64.go:273"},
+		"syscall.SYS_READLINKAT": {Sig: "const SYS_READLINKAT", Doc: "", Pos: "zsysnum_linux_amd64.go:274"},
+		"syscall.SYS_FCHMODAT": {Sig: "const SYS_FCHMODAT", Doc: "", Pos: "zsysnum_linux_amd64.go:275"},
+		"syscall.SYS_FACCESSAT": {Sig: "const SYS_FACCESSAT", Doc: "", Pos: "zsysnum_linux_amd64.go:276"},
+		"syscall.SYS_PSELECT6": {Sig: "const SYS_PSELECT6", Doc: "", Pos: "zsysnum_linux_amd64.go:277"},
+		"syscall.SYS_PPOLL": {Sig: "const SYS_PPOLL", Doc: "", Pos: "zsysnum_linux_amd64.go:278"},
+		"syscall.SYS_UNSHARE": {Sig: "const SYS_UNSHARE", Doc: "", Pos: "zsysnum_linux_amd64.go:279"},
+		"syscall.SYS_SET_ROBUST_LIST": {Sig: "const SYS_SET_ROBUST_LIST", Doc: "", Pos: "zsysnum_linux_amd64.go:280"},
+		"syscall.SYS_GET_ROBUST_LIST": {Sig: "const SYS_GET_ROBUST_LIST", Doc: "", Pos: "zsysnum_linux_amd64.go:281"},
+		"syscall.SYS_SPLICE": {Sig: "const SYS_SPLICE", Doc: "", Pos: "zsysnum_linux_amd64.go:282"},
+		"syscall.SYS_TEE": {Sig: "const SYS_TEE", Doc: "", Pos: "zsysnum_linux_amd64.go:283"},
+		"syscall.SYS_SYNC_FILE_RANGE": {Sig: "const SYS_SYNC_FILE_RANGE", Doc: "", Pos: "zsysnum_linux_amd64.go:284"},
+		"syscall.SYS_VMSPLICE": {Sig: "const SYS_VMSPLICE", Doc: "", Pos: "zsysnum_linux_amd64.go:285"},
+		"syscall.SYS_MOVE_PAGES": {Sig: "const SYS_MOVE_PAGES", Doc: "", Pos: "zsysnum_linux_amd64.go:286"},
+		"syscall.SYS_UTIMENSAT": {Sig: "const SYS_UTIMENSAT", Doc: "", Pos: "zsysnum_linux_amd64.go:287"},
+		"syscall.SYS_EPOLL_PWAIT": {Sig: "const SYS_EPOLL_PWAIT", Doc: "", Pos: "zsysnum_linux_amd64.go:288"},
+		"syscall.SYS_SIGNALFD": {Sig: "const SYS_SIGNALFD", Doc: "", Pos: "zsysnum_linux_amd64.go:289"},
+		"syscall.SYS_TIMERFD_CREATE": {Sig: "const SYS_TIMERFD_CREATE", Doc: "", Pos: "zsysnum_linux_amd64.go:290"},
+		"syscall.SYS_EVENTFD": {Sig: "const SYS_EVENTFD", Doc: "", Pos: "zsysnum_linux_amd64.go:291"},
+		"syscall.SYS_FALLOCATE": {Sig: "const SYS_FALLOCATE", Doc: "", Pos: "zsysnum_linux_amd64.go:292"},
+		"syscall.SYS_TIMERFD_SETTIME": {Sig: "const SYS_TIMERFD_SETTIME", Doc: "", Pos: "zsysnum_linux_amd64.go:293"},
+		"syscall.SYS_TIMERFD_GETTIME": {Sig: "const SYS_TIMERFD_GETTIME", Doc: "", Pos: "zsysnum_linux_amd64.go:294"},
+		"syscall.SYS_ACCEPT4": {Sig: "const SYS_ACCEPT4", Doc: "", Pos: "zsysnum_linux_amd64.go:295"},
+		"syscall.SYS_SIGNALFD4": {Sig: "const SYS_SIGNALFD4", Doc: "", Pos: "zsysnum_linux_amd64.go:296"},
+		"syscall.SYS_EVENTFD2": {Sig: "const SYS_EVENTFD2", Doc: "", Pos: "zsysnum_linux_amd64.go:297"},
+		"syscall.SYS_EPOLL_CREATE1": {Sig: "const SYS_EPOLL_CREATE1", Doc: "", Pos: "zsysnum_linux_amd64.go:298"},
+		"syscall.SYS_DUP3": {Sig: "const SYS_DUP3", Doc: "", Pos: "zsysnum_linux_amd64.go:299"},
+		"syscall.SYS_PIPE2": {Sig: "const SYS_PIPE2", Doc: "", Pos: "zsysnum_linux_amd64.go:300"},
+		"syscall.SYS_INOTIFY_INIT1": {Sig: "const SYS_INOTIFY_INIT1", Doc: "", Pos: "zsysnum_linux_amd64.go:301"},
+		"syscall.SYS_PREADV": {Sig: "const SYS_PREADV", Doc: "", Pos: "zsysnum_linux_amd64.go:302"},
+		"syscall.SYS_PWRITEV": {Sig: "const SYS_PWRITEV", Doc: "", Pos: "zsysnum_linux_amd64.go:303"},
+		"syscall.SYS_RT_TGSIGQUEUEINFO": {Sig: "const SYS_RT_TGSIGQUEUEINFO", Doc: "", Pos: "zsysnum_linux_amd64.go:304"},
+		"syscall.SYS_PERF_EVENT_OPEN": {Sig: "const SYS_PERF_EVENT_OPEN", Doc: "", Pos: "zsysnum_linux_amd64.go:305"},
+		"syscall.SYS_RECVMMSG": {Sig: "const SYS_RECVMMSG", Doc: "", Pos: "zsysnum_linux_amd64.go:306"},
+		"syscall.SYS_FANOTIFY_INIT": {Sig: "const SYS_FANOTIFY_INIT", Doc: "", Pos: "zsysnum_linux_amd64.go:307"},
+		"syscall.SYS_FANOTIFY_MARK": {Sig: "const SYS_FANOTIFY_MARK", Doc: "", Pos: "zsysnum_linux_amd64.go:308"},
+		"syscall.SYS_PRLIMIT64": {Sig: "const SYS_PRLIMIT64", Doc: "", Pos: "zsysnum_linux_amd64.go:309"},
+		"syscall.PathMax": {Sig: "const PathMax", Doc: "", Pos: "ztypes_linux_amd64.go:12"},
+		"syscall.Timespec": {Sig: "type Timespec struct", Doc: "", Pos: "ztypes_linux_amd64.go:22"},
+		"syscall.Timeval": {Sig: "type Timeval struct", Doc: "", Pos: "ztypes_linux_amd64.go:27"},
+		"syscall.Timex": {Sig: "type Timex struct", Doc: "", Pos: "ztypes_linux_amd64.go:32"},
+		"syscall.Time_t": {Sig: "type Time_t int64", Doc: "", Pos: "ztypes_linux_amd64.go:59"},
+		"syscall.Tms": {Sig: "type Tms struct", Doc: "", Pos: "ztypes_linux_amd64.go:61"},
+		"syscall.Utimbuf": {Sig: "type Utimbuf struct", Doc: "", Pos: "ztypes_linux_amd64.go:68"},
+		"syscall.Rusage": {Sig: "type Rusage struct", Doc: "", Pos: "ztypes_linux_amd64.go:73"},
+		"syscall.Rlimit": {Sig: "type Rlimit struct", Doc: "", Pos: "ztypes_linux_amd64.go:92"},
+		"syscall.Stat_t": {Sig: "type Stat_t struct", Doc: "", Pos: "ztypes_linux_amd64.go:99"},
+		"syscall.Statfs_t": {Sig: "type Statfs_t struct", Doc: "", Pos: "ztypes_linux_amd64.go:117"},
+		"syscall.Dirent": {Sig: "type Dirent struct", Doc: "", Pos: "ztypes_linux_amd64.go:132"},
+		"syscall.Fsid": {Sig: "type Fsid struct", Doc: "", Pos: "ztypes_linux_amd64.go:141"},
+		"syscall.Flock_t": {Sig: "type Flock_t struct", Doc: "", Pos: "ztypes_linux_amd64.go:145"},
+		"syscall.RawSockaddrInet4": {Sig: "type RawSockaddrInet4 struct", Doc: "", Pos: "ztypes_linux_amd64.go:155"},
+		"syscall.RawSockaddrInet6": {Sig: "type RawSockaddrInet6 struct", Doc: "", Pos: "ztypes_linux_amd64.go:162"},
+		"syscall.RawSockaddrUnix": {Sig: "type RawSockaddrUnix struct", Doc: "", Pos: "ztypes_linux_amd64.go:170"},
+		"syscall.RawSockaddrLinklayer": {Sig: "type RawSockaddrLinklayer struct", Doc: "", Pos: "ztypes_linux_amd64.go:175"},
+		"syscall.RawSockaddrNetlink": {Sig: "type RawSockaddrNetlink struct", Doc: "", Pos: "ztypes_linux_amd64.go:185"},
+		"syscall.RawSockaddr": {Sig: "type RawSockaddr struct", Doc: "", Pos: "ztypes_linux_amd64.go:192"},
+		"syscall.RawSockaddrAny": {Sig: "type RawSockaddrAny struct", Doc: "", Pos: "ztypes_linux_amd64.go:197"},
+		"syscall.Linger": {Sig: "type Linger struct", Doc: "", Pos: "ztypes_linux_amd64.go:204"},
+		"syscall.Iovec": {Sig: "type Iovec struct", Doc: "", Pos: "ztypes_linux_amd64.go:209"},
+		"syscall.IPMreq": {Sig: "type IPMreq struct", Doc: "", Pos: "ztypes_linux_amd64.go:214"},
+		"syscall.IPMreqn": {Sig: "type IPMreqn struct", Doc: "", Pos: "ztypes_linux_amd64.go:219"},
+		"syscall.IPv6Mreq": {Sig: "type IPv6Mreq struct", Doc: "", Pos: "ztypes_linux_amd64.go:225"},
+		"syscall.Msghdr": {Sig: "type Msghdr struct", Doc: "", Pos: "ztypes_linux_amd64.go:230"},
+		"syscall.Cmsghdr": {Sig: "type Cmsghdr struct", Doc: "", Pos: "ztypes_linux_amd64.go:242"},
+		"syscall.Inet4Pktinfo": {Sig: "type Inet4Pktinfo struct", Doc: "", Pos: "ztypes_linux_amd64.go:248"},
+		"syscall.Inet6Pktinfo": {Sig: "type Inet6Pktinfo struct", Doc: "", Pos: "ztypes_linux_amd64.go:254"},
+		"syscall.IPv6MTUInfo": {Sig: "type IPv6MTUInfo struct", Doc: "", Pos: "ztypes_linux_amd64.go:259"},
+		"syscall.ICMPv6Filter": {Sig: "type ICMPv6Filter struct", Doc: "", Pos: "ztypes_linux_amd64.go:264"},
+		"syscall.Ucred": {Sig: "type Ucred struct", Doc: "", Pos: "ztypes_linux_amd64.go:268"},
+		"syscall.TCPInfo": {Sig: "type TCPInfo struct", Doc: "", Pos: "ztypes_linux_amd64.go:274"},
+		"syscall.SizeofSockaddrInet4": {Sig: "const SizeofSockaddrInet4", Doc: "", Pos: "ztypes_linux_amd64.go:309"},
+		"syscall.SizeofSockaddrInet6": {Sig: "const SizeofSockaddrInet6", Doc: "", Pos: "ztypes_linux_amd64.go:310"},
+		"syscall.SizeofSockaddrAny": {Sig: "const SizeofSockaddrAny", Doc: "", Pos: "ztypes_linux_amd64.go:311"},
+		"syscall.SizeofSockaddrUnix": {Sig: "const SizeofSockaddrUnix", Doc: "", Pos: "ztypes_linux_amd64.go:312"},
+		"syscall.SizeofSockaddrLinklayer": {Sig: "const SizeofSockaddrLinklayer", Doc: "", Pos: "ztypes_linux_amd64.go:313"},
+		"syscall.SizeofSockaddrNetlink": {Sig: "const SizeofSockaddrNetlink", Doc: "", Pos: "ztypes_linux_amd64.go:314"},
+		"syscall.SizeofLinger": {Sig: "const SizeofLinger", Doc: "", Pos: "ztypes_linux_amd64.go:315"},
+		"syscall.SizeofIPMreq": {Sig: "const SizeofIPMreq", Doc: "", Pos: "ztypes_linux_amd64.go:316"},
+		"syscall.SizeofIPMreqn": {Sig: "const SizeofIPMreqn", Doc: "", Pos: "ztypes_linux_amd64.go:317"},
+		"syscall.SizeofIPv6Mreq": {Sig: "const SizeofIPv6Mreq", Doc: "", Pos: "ztypes_linux_amd64.go:318"},
+		"syscall.SizeofMsghdr": {Sig: "const SizeofMsghdr", Doc: "", Pos: "ztypes_linux_amd64.go:319"},
+		"syscall.SizeofCmsghdr": {Sig: "const SizeofCmsghdr", Doc: "", Pos: "ztypes_linux_amd64.go:320"},
+		"syscall.SizeofInet4Pktinfo": {Sig: "const SizeofInet4Pktinfo", Doc: "", Pos: "ztypes_linux_amd64.go:321"},
+		"syscall.SizeofInet6Pktinfo": {Sig: "const SizeofInet6Pktinfo", Doc: "", Pos: "ztypes_linux_amd64.go:322"},
+		"syscall.SizeofIPv6MTUInfo": {Sig: "const SizeofIPv6MTUInfo", Doc: "", Pos: "ztypes_linux_amd64.go:323"},
+		"syscall.SizeofICMPv6Filter": {Sig: "const SizeofICMPv6Filter", Doc: "", Pos: "ztypes_linux_amd64.go:324"},
+		"syscall.SizeofUcred": {Sig: "const SizeofUcred", Doc: "", Pos: "ztypes_linux_amd64.go:325"},
+		"syscall.SizeofTCPInfo": {Sig: "const SizeofTCPInfo", Doc: "", Pos: "ztypes_linux_amd64.go:326"},
+		"syscall.IFA_UNSPEC": {Sig: "const IFA_UNSPEC", Doc: "", Pos: "ztypes_linux_amd64.go:330"},
+		"syscall.IFA_ADDRESS": {Sig: "const IFA_ADDRESS", Doc: "", Pos: "ztypes_linux_amd64.go:331"},
+		"syscall.IFA_LOCAL": {Sig: "const IFA_LOCAL", Doc: "", Pos: "ztypes_linux_amd64.go:332"},
+		"syscall.IFA_LABEL": {Sig: "const IFA_LABEL", Doc: "", Pos: "ztypes_linux_amd64.go:333"},
+		"syscall.IFA_BROADCAST": {Sig: "const IFA_BROADCAST", Doc: "", Pos: "ztypes_linux_amd64.go:334"},
+		"syscall.IFA_ANYCAST": {Sig: "const IFA_ANYCAST", Doc: "", Pos: "ztypes_linux_amd64.go:335"},
+		"syscall.IFA_CACHEINFO": {Sig: "const IFA_CACHEINFO", Doc: "", Pos: "ztypes_linux_amd64.go:336"},
+		"syscall.IFA_MULTICAST": {Sig: "const IFA_MULTICAST", Doc: "", Pos: "ztypes_linux_amd64.go:337"},
+		"syscall.IFLA_UNSPEC": {Sig: "const IFLA_UNSPEC", Doc: "", Pos: "ztypes_linux_amd64.go:338"},
+		"syscall.IFLA_ADDRESS": {Sig: "const IFLA_ADDRESS", Doc: "", Pos: "ztypes_linux_amd64.go:339"},
+		"syscall.IFLA_BROADCAST": {Sig: "const IFLA_BROADCAST", Doc: "", Pos: "ztypes_linux_amd64.go:340"},
+		"syscall.IFLA_IFNAME": {Sig: "const IFLA_IFNAME", Doc: "", Pos: "ztypes_linux_amd64.go:341"},
+		"syscall.IFLA_MTU": {Sig: "const IFLA_MTU", Doc: "", Pos: "ztypes_linux_amd64.go:342"},
+		"syscall.IFLA_LINK": {Sig: "const IFLA_LINK", Doc: "", Pos: "ztypes_linux_amd64.go:343"},
+		"syscall.IFLA_QDISC": {Sig: "const IFLA_QDISC", Doc: "", Pos: "ztypes_linux_amd64.go:344"},
+		"syscall.IFLA_STATS": {Sig: "const IFLA_STATS", Doc: "", Pos: "ztypes_linux_amd64.go:345"},
+		"syscall.IFLA_COST": {Sig: "const IFLA_COST", Doc: "", Pos: "ztypes_linux_amd64.go:346"},
+		"syscall.IFLA_PRIORITY": {Sig: "const IFLA_PRIORITY", Doc: "", Pos: "ztypes_linux_amd64.go:347"},
+		"syscall.IFLA_MASTER": {Sig: "const IFLA_MASTER", Doc: "", Pos: "ztypes_linux_amd64.go:348"},
+		"syscall.IFLA_WIRELESS": {Sig: "const IFLA_WIRELESS", Doc: "", Pos: "ztypes_linux_amd64.go:349"},
+		"syscall.IFLA_PROTINFO": {Sig: "const IFLA_PROTINFO", Doc: "", Pos: "ztypes_linux_amd64.go:350"},
+		"syscall.IFLA_TXQLEN": {Sig: "const IFLA_TXQLEN", Doc: "", Pos: "ztypes_linux_amd64.go:351"},
+		"syscall.IFLA_MAP": {Sig: "const IFLA_MAP", Doc: "", Pos: "ztypes_linux_amd64.go:352"},
+		"syscall.IFLA_WEIGHT": {Sig: "const IFLA_WEIGHT", Doc: "", Pos: "ztypes_linux_amd64.go:353"},
+		"syscall.IFLA_OPERSTATE": {Sig: "const IFLA_OPERSTATE", Doc: "", Pos: "ztypes_linux_amd64.go:354"},
+		"syscall.IFLA_LINKMODE": {Sig: "const IFLA_LINKMODE", Doc: "", Pos: "ztypes_linux_amd64.go:355"},
+		"syscall.IFLA_LINKINFO": {Sig: "const IFLA_LINKINFO", Doc: "", Pos: "ztypes_linux_amd64.go:356"},
+		"syscall.IFLA_NET_NS_PID": {Sig: "const IFLA_NET_NS_PID", Doc: "", Pos: "ztypes_linux_amd64.go:357"},
+		"syscall.IFLA_IFALIAS": {Sig: "const IFLA_IFALIAS", Doc: "", Pos: "ztypes_linux_amd64.go:358"},
+		"syscall.IFLA_MAX": {Sig: "const IFLA_MAX", Doc: "", Pos: "ztypes_linux_amd64.go:359"},
+		"syscall.RT_SCOPE_UNIVERSE": {Sig: "const RT_SCOPE_UNIVERSE", Doc: "", Pos: "ztypes_linux_amd64.go:360"},
+		"syscall.RT_SCOPE_SITE": {Sig: "const RT_SCOPE_SITE", Doc: "", Pos: "ztypes_linux_amd64.go:361"},
+		"syscall.RT_SCOPE_LINK": {Sig: "const RT_SCOPE_LINK", Doc: "", Pos: "ztypes_linux_amd64.go:362"},
+		"syscall.RT_SCOPE_HOST": {Sig: "const RT_SCOPE_HOST", Doc: "", Pos: "ztypes_linux_amd64.go:363"},
+		"syscall.RT_SCOPE_NOWHERE": {Sig: "const RT_SCOPE_NOWHERE", Doc: "", Pos: "ztypes_linux_amd64.go:364"},
+		"syscall.RT_TABLE_UNSPEC": {Sig: "const RT_TABLE_UNSPEC", Doc: "", Pos: "ztypes_linux_amd64.go:365"},
+		"syscall.RT_TABLE_COMPAT": {Sig: "const RT_TABLE_COMPAT", Doc: "", Pos: "ztypes_linux_amd64.go:366"},
+		"syscall.RT_TABLE_DEFAULT": {Sig: "const RT_TABLE_DEFAULT", Doc: "", Pos: "ztypes_linux_amd64.go:367"},
+		"syscall.RT_TABLE_MAIN": {Sig: "const RT_TABLE_MAIN", Doc: "", Pos: "ztypes_linux_amd64.go:368"},
+		"syscall.RT_TABLE_LOCAL": {Sig: "const RT_TABLE_LOCAL", Doc: "", Pos: "ztypes_linux_amd64.go:369"},
+		"syscall.RT_TABLE_MAX": {Sig: "const RT_TABLE_MAX", Doc: "", Pos: "ztypes_linux_amd64.go:370"},
+		"syscall.RTA_UNSPEC": {Sig: "const RTA_UNSPEC", Doc: "", Pos: "ztypes_linux_amd64.go:371"},
+		"syscall.RTA_DST": {Sig: "const RTA_DST", Doc: "", Pos: "ztypes_linux_amd64.go:372"},
+		"syscall.RTA_SRC": {Sig: "const RTA_SRC", Doc: "", Pos: "ztypes_linux_amd64.go:373"},
+		"syscall.RTA_IIF": {Sig: "const RTA_IIF", Doc: "", Pos: "ztypes_linux_amd64.go:374"},
+		"syscall.RTA_OIF": {Sig: "const RTA_OIF", Doc: "", Pos: "ztypes_linux_amd64.go:375"},
+		"syscall.RTA_GATEWAY": {Sig: "const RTA_GATEWAY", Doc: "", Pos: "ztypes_linux_amd64.go:376"},
+		"syscall.RTA_PRIORITY": {Sig: "const RTA_PRIORITY", Doc: "", Pos: "ztypes_linux_amd64.go:377"},
+		"syscall.RTA_PREFSRC": {Sig: "const RTA_PREFSRC", Doc: "", Pos: "ztypes_linux_amd64.go:378"},
+		"syscall.RTA_METRICS": {Sig: "const RTA_METRICS", Doc: "", Pos: "ztypes_linux_amd64.go:379"},
+		"syscall.RTA_MULTIPATH": {Sig: "const RTA_MULTIPATH", Doc: "", Pos: "ztypes_linux_amd64.go:380"},
+		"syscall.RTA_FLOW": {Sig: "const RTA_FLOW", Doc: "", Pos: "ztypes_linux_amd64.go:381"},
+		"syscall.RTA_CACHEINFO": {Sig: "const RTA_CACHEINFO", Doc: "", Pos: "ztypes_linux_amd64.go:382"},
+		"syscall.RTA_TABLE": {Sig: "const RTA_TABLE", Doc: "", Pos: "ztypes_linux_amd64.go:383"},
+		"syscall.RTN_UNSPEC": {Sig: "const RTN_UNSPEC", Doc: "", Pos: "ztypes_linux_amd64.go:384"},
+		"syscall.RTN_UNICAST": {Sig: "const RTN_UNICAST", Doc: "", Pos: "ztypes_linux_amd64.go:385"},
+		"syscall.RTN_LOCAL": {Sig: "const RTN_LOCAL", Doc: "", Pos: "ztypes_linux_amd64.go:386"},
+		"syscall.RTN_BROADCAST": {Sig: "const RTN_BROADCAST", Doc: "", Pos: "ztypes_linux_amd64.go:387"},
+		"syscall.RTN_ANYCAST": {Sig: "const RTN_ANYCAST", Doc: "", Pos: "ztypes_linux_amd64.go:388"},
+		"syscall.RTN_MULTICAST": {Sig: "const RTN_MULTICAST", Doc: "", Pos: "ztypes_linux_amd64.go:389"},
+		"syscall.RTN_BLACKHOLE": {Sig: "const RTN_BLACKHOLE", Doc: "", Pos: "ztypes_linux_amd64.go:390"},
+		"syscall.RTN_UNREACHABLE": {Sig: "const RTN_UNREACHABLE", Doc: "", Pos: "ztypes_linux_amd64.go:391"},
+		"syscall.RTN_PROHIBIT": {Sig: "const RTN_PROHIBIT", Doc: "", Pos: "ztypes_linux_amd64.go:392"},
+		"syscall.RTN_THROW": {Sig: "const RTN_THROW", Doc: "", Pos: "ztypes_linux_amd64.go:393"},
+		"syscall.RTN_NAT": {Sig: "const RTN_NAT", Doc: "", Pos: "ztypes_linux_amd64.go:394"},
+		"syscall.RTN_XRESOLVE": {Sig: "const RTN_XRESOLVE", Doc: "", Pos: "ztypes_linux_amd64.go:395"},
+		"syscall.RTNLGRP_NONE": {Sig: "const RTNLGRP_NONE", Doc: "", Pos: "ztypes_linux_amd64.go:396"},
+		"syscall.RTNLGRP_LINK": {Sig: "const RTNLGRP_LINK", Doc: "", Pos: "ztypes_linux_amd64.go:397"},
+		"syscall.RTNLGRP_NOTIFY": {Sig: "const RTNLGRP_NOTIFY", Doc: "", Pos: "ztypes_linux_amd64.go:398"},
+		"syscall.RTNLGRP_NEIGH": {Sig: "const RTNLGRP_NEIGH", Doc: "", Pos: "ztypes_linux_amd64.go:399"},
+		"syscall.RTNLGRP_TC": {Sig: "const RTNLGRP_TC", Doc: "", Pos: "ztypes_linux_amd64.go:400"},
+		"syscall.RTNLGRP_IPV4_IFADDR": {Sig: "const RTNLGRP_IPV4_IFADDR", Doc: "", Pos: "ztypes_linux_amd64.go:401"},
+		"syscall.RTNLGRP_IPV4_MROUTE": {Sig: "const RTNLGRP_IPV4_MROUTE", Doc: "", Pos: "ztypes_linux_amd64.go:402"},
+		"syscall.RTNLGRP_IPV4_ROUTE": {Sig: "const RTNLGRP_IPV4_ROUTE", Doc: "", Pos: "ztypes_linux_amd64.go:403"},
+		"syscall.RTNLGRP_IPV4_RULE": {Sig: "const RTNLGRP_IPV4_RULE", Doc: "", Pos: "ztypes_linux_amd64.go:404"},
+		"syscall.RTNLGRP_IPV6_IFADDR": {Sig: "const RTNLGRP_IPV6_IFADDR", Doc: "", Pos: "ztypes_linux_amd64.go:405"},
+		"syscall.RTNLGRP_IPV6_MROUTE": {Sig: "const RTNLGRP_IPV6_MROUTE", Doc: "", Pos: "ztypes_linux_amd64.go:406"},
+		"syscall.RTNLGRP_IPV6_ROUTE": {Sig: "const RTNLGRP_IPV6_ROUTE", Doc: "", Pos: "ztypes_linux_amd64.go:407"},
+		"syscall.RTNLGRP_IPV6_IFINFO": {Sig: "const RTNLGRP_IPV6_IFINFO", Doc: "", Pos: "ztypes_linux_amd64.go:408"},
+		"syscall.RTNLGRP_IPV6_PREFIX": {Sig: "const RTNLGRP_IPV6_PREFIX", Doc: "", Pos: "ztypes_linux_amd64.go:409"},
+		"syscall.RTNLGRP_IPV6_RULE": {Sig: "const RTNLGRP_IPV6_RULE", Doc: "", Pos: "ztypes_linux_amd64.go:410"},
+		"syscall.RTNLGRP_ND_USEROPT": {Sig: "const RTNLGRP_ND_USEROPT", Doc: "", Pos: "ztypes_linux_amd64.go:411"},
+		"syscall.SizeofNlMsghdr": {Sig: "const SizeofNlMsghdr", Doc: "", Pos: "ztypes_linux_amd64.go:412"},
+		"syscall.SizeofNlMsgerr": {Sig: "const SizeofNlMsgerr", Doc: "", Pos: "ztypes_linux_amd64.go:413"},
+		"syscall.SizeofRtGenmsg": {Sig: "const SizeofRtGenmsg", Doc: "", Pos: "ztypes_linux_amd64.go:414"},
+		"syscall.SizeofNlAttr": {Sig: "const SizeofNlAttr", Doc: "", Pos: "ztypes_linux_amd64.go:415"},
+		"syscall.SizeofRtAttr": {Sig: "const SizeofRtAttr", Doc: "", Pos: "ztypes_linux_amd64.go:416"},
+		"syscall.SizeofIfInfomsg": {Sig: "const SizeofIfInfomsg", Doc: "", Pos: "ztypes_linux_amd64.go:417"},
+		"syscall.SizeofIfAddrmsg": {Sig: "const SizeofIfAddrmsg", Doc: "", Pos: "ztypes_linux_amd64.go:418"},
+		"syscall.SizeofRtMsg": {Sig: "const SizeofRtMsg", Doc: "", Pos: "ztypes_linux_amd64.go:419"},
+		"syscall.SizeofRtNexthop": {Sig: "const SizeofRtNexthop", Doc: "", Pos: "ztypes_linux_amd64.go:420"},
+		"syscall.NlMsghdr": {Sig: "type NlMsghdr struct", Doc: "", Pos: "ztypes_linux_amd64.go:423"},
+		"syscall.NlMsgerr": {Sig: "type NlMsgerr struct", Doc: "", Pos: "ztypes_linux_amd64.go:431"},
+		"syscall.RtGenmsg": {Sig: "type RtGenmsg struct", Doc: "", Pos: "ztypes_linux_amd64.go:436"},
+		"syscall.NlAttr": {Sig: "type NlAttr struct", Doc: "", Pos: "ztypes_linux_amd64.go:440"},
+		"syscall.RtAttr": {Sig: "type RtAttr struct", Doc: "", Pos: "ztypes_linux_amd64.go:445"},
+		"syscall.IfInfomsg": {Sig: "type IfInfomsg struct", Doc: "", Pos: "ztypes_linux_amd64.go:450"},
+		"syscall.IfAddrmsg": {Sig: "type IfAddrmsg struct", Doc: "", Pos: "ztypes_linux_amd64.go:459"},
+		"syscall.RtMsg": {Sig: "type RtMsg struct", Doc: "", Pos: "ztypes_linux_amd64.go:467"},
+		"syscall.RtNexthop": {Sig: "type RtNexthop struct", Doc: "", Pos: "ztypes_linux_amd64.go:479"},
+		"syscall.SizeofSockFilter": {Sig: "const SizeofSockFilter", Doc: "", Pos: "ztypes_linux_amd64.go:487"},
+		"syscall.SizeofSockFprog": {Sig: "const SizeofSockFprog", Doc: "", Pos: "ztypes_linux_amd64.go:488"},
+		"syscall.SockFilter": {Sig: "type SockFilter struct", Doc: "", Pos: "ztypes_linux_amd64.go:491"},
+		"syscall.SockFprog": {Sig: "type SockFprog struct", Doc: "", Pos: "ztypes_linux_amd64.go:498"},
+		"syscall.InotifyEvent": {Sig: "type InotifyEvent struct", Doc: "", Pos: "ztypes_linux_amd64.go:504"},
+		"syscall.SizeofInotifyEvent": {Sig: "const SizeofInotifyEvent", Doc: "", Pos: "ztypes_linux_amd64.go:512"},
+		"syscall.PtraceRegs": {Sig: "type PtraceRegs struct", Doc: "", Pos: "ztypes_linux_amd64.go:514"},
+		"syscall.FdSet": {Sig: "type FdSet struct", Doc: "", Pos: "ztypes_linux_amd64.go:544"},
+		"syscall.Sysinfo_t": {Sig: "type Sysinfo_t struct", Doc: "", Pos: "ztypes_linux_amd64.go:548"},
+		"syscall.Utsname": {Sig: "type Utsname struct", Doc: "", Pos: "ztypes_linux_amd64.go:567"},
+		"syscall.Ustat_t": {Sig: "type Ustat_t struct", Doc: "", Pos: "ztypes_linux_amd64.go:576"},
+		"syscall.EpollEvent": {Sig: "type EpollEvent struct", Doc: "", Pos: "ztypes_linux_amd64.go:585"},
+		"syscall.Termios": {Sig: "type Termios struct", Doc: "", Pos: "ztypes_linux_amd64.go:605"},
+		"syscall.VINTR": {Sig: "const VINTR", Doc: "", Pos: "ztypes_linux_amd64.go:618"},
+		"syscall.VQUIT": {Sig: "const VQUIT", Doc: "", Pos: "ztypes_linux_amd64.go:619"},
+		"syscall.VERASE": {Sig: "const VERASE", Doc: "", Pos: "ztypes_linux_amd64.go:620"},
+		"syscall.VKILL": {Sig: "const VKILL", Doc: "", Pos: "ztypes_linux_amd64.go:621"},
+		"syscall.VEOF": {Sig: "const VEOF", Doc: "", Pos: "ztypes_linux_amd64.go:622"},
+		"syscall.VTIME": {Sig: "const VTIME", Doc: "", Pos: "ztypes_linux_amd64.go:623"},
+		"syscall.VMIN": {Sig: "const VMIN", Doc: "", Pos: "ztypes_linux_amd64.go:624"},
+		"syscall.VSWTC": {Sig: "const VSWTC", Doc: "", Pos: "ztypes_linux_amd64.go:625"},
+		"syscall.VSTART": {Sig: "const VSTART", Doc: "", Pos: "ztypes_linux_amd64.go:626"},
+		"syscall.VSTOP": {Sig: "const VSTOP", Doc: "", Pos: "ztypes_linux_amd64.go:627"},
+		"syscall.VSUSP": {Sig: "const VSUSP", Doc: "", Pos: "ztypes_linux_amd64.go:628"},
+		"syscall.VEOL": {Sig: "const VEOL", Doc: "", Pos: "ztypes_linux_amd64.go:629"},
+		"syscall.VREPRINT": {Sig: "const VREPRINT", Doc: "", Pos: "ztypes_linux_amd64.go:630"},
+		"syscall.VDISCARD": {Sig: "const VDISCARD", Doc: "", Pos: "ztypes_linux_amd64.go:631"},
+		"syscall.VWERASE": {Sig: "const VWERASE", Doc: "", Pos: "ztypes_linux_amd64.go:632"},
+		"syscall.VLNEXT": {Sig: "const VLNEXT", Doc: "", Pos: "ztypes_linux_amd64.go:633"},
+		"syscall.VEOL2": {Sig: "const VEOL2", Doc: "", Pos: "ztypes_linux_amd64.go:634"},
+		"syscall.IGNBRK": {Sig: "const IGNBRK", Doc: "", Pos: "ztypes_linux_amd64.go:635"},
+		"syscall.BRKINT": {Sig: "const BRKINT", Doc: "", Pos: "ztypes_linux_amd64.go:636"},
+		"syscall.IGNPAR": {Sig: "const IGNPAR", Doc: "", Pos: "ztypes_linux_amd64.go:637"},
+		"syscall.PARMRK": {Sig: "const PARMRK", Doc: "", Pos: "ztypes_linux_amd64.go:638"},
+		"syscall.INPCK": {Sig: "const INPCK", Doc: "", Pos: "ztypes_linux_amd64.go:639"},
+		"syscall.ISTRIP": {Sig: "const ISTRIP", Doc: "", Pos: "ztypes_linux_amd64.go:640"},
+		"syscall.INLCR": {Sig: "const INLCR", Doc: "", Pos: "ztypes_linux_amd64.go:641"},
+		"syscall.IGNCR": {Sig: "const IGNCR", Doc: "", Pos: "ztypes_linux_amd64.go:642"},
+		"syscall.ICRNL": {Sig: "const ICRNL", Doc: "", Pos: "ztypes_linux_amd64.go:643"},
+		"syscall.IUCLC": {Sig: "const IUCLC", Doc: "", Pos: "ztypes_linux_amd64.go:644"},
+		"syscall.IXON": {Sig: "const IXON", Doc: "", Pos: "ztypes_linux_amd64.go:645"},
+		"syscall.IXANY": {Sig: "const IXANY", Doc: "", Pos: "ztypes_linux_amd64.go:646"},
+		"syscall.IXOFF": {Sig: "const IXOFF", Doc: "", Pos: "ztypes_linux_amd64.go:647"},
+		"syscall.IMAXBEL": {Sig: "const IMAXBEL", Doc: "", Pos: "ztypes_linux_amd64.go:648"},
+		"syscall.IUTF8": {Sig: "const IUTF8", Doc: "", Pos: "ztypes_linux_amd64.go:649"},
+		"syscall.OPOST": {Sig: "const OPOST", Doc: "", Pos: "ztypes_linux_amd64.go:650"},
+		"syscall.OLCUC": {Sig: "const OLCUC", Doc: "", Pos: "ztypes_linux_amd64.go:651"},
+		"syscall.ONLCR": {Sig: "const ONLCR", Doc: "", Pos: "ztypes_linux_amd64.go:652"},
+		"syscall.OCRNL": {Sig: "const OCRNL", Doc: "", Pos: "ztypes_linux_amd64.go:653"},
+		"syscall.ONOCR": {Sig: "const ONOCR", Doc: "", Pos: "ztypes_linux_amd64.go:654"},
+		"syscall.ONLRET": {Sig: "const ONLRET", Doc: "", Pos: "ztypes_linux_amd64.go:655"},
+		"syscall.OFILL": {Sig: "const OFILL", Doc: "", Pos: "ztypes_linux_amd64.go:656"},
+		"syscall.OFDEL": {Sig: "const OFDEL", Doc: "", Pos: "ztypes_linux_amd64.go:657"},
+		"syscall.B0": {Sig: "const B0", Doc: "", Pos: "ztypes_linux_amd64.go:658"},
+		"syscall.B50": {Sig: "const B50", Doc: "", Pos: "ztypes_linux_amd64.go:659"},
+		"syscall.B75": {Sig: "const B75", Doc: "", Pos: "ztypes_linux_amd64.go:660"},
+		"syscall.B110": {Sig: "const B110", Doc: "", Pos: "ztypes_linux_amd64.go:661"},
+		"syscall.B134": {Sig: "const B134", Doc: "", Pos: "ztypes_linux_amd64.go:662"},
+		"syscall.B150": {Sig: "const B150", Doc: "", Pos: "ztypes_linux_amd64.go:663"},
+		"syscall.B200": {Sig: "const B200", Doc: "", Pos: "ztypes_linux_amd64.go:664"},
+		"syscall.B300": {Sig: "const B300", Doc: "", Pos: "ztypes_linux_amd64.go:665"},
+		"syscall.B600": {Sig: "const B600", Doc: "", Pos: "ztypes_linux_amd64.go:666"},
+		"syscall.B1200": {Sig: "const B1200", Doc: "", Pos: "ztypes_linux_amd64.go:667"},
+		"syscall.B1800": {Sig: "const B1800", Doc: "", Pos: "ztypes_linux_amd64.go:668"},
+		"syscall.B2400": {Sig: "const B2400", Doc: "", Pos: "ztypes_linux_amd64.go:669"},
+		"syscall.B4800": {Sig: "const B4800", Doc: "", Pos: "ztypes_linux_amd64.go:670"},
+		"syscall.B9600": {Sig: "const B9600", Doc: "", Pos: "ztypes_linux_amd64.go:671"},
+		"syscall.B19200": {Sig: "const B19200", Doc: "", Pos: "ztypes_linux_amd64.go:672"},
+		"syscall.B38400": {Sig: "const B38400", Doc: "", Pos: "ztypes_linux_amd64.go:673"},
+		"syscall.CSIZE": {Sig: "const CSIZE", Doc: "", Pos: "ztypes_linux_amd64.go:674"},
+		"syscall.CS5": {Sig: "const CS5", Doc: "", Pos: "ztypes_linux_amd64.go:675"},
+		"syscall.CS6": {Sig: "const CS6", Doc: "", Pos: "ztypes_linux_amd64.go:676"},
+		"syscall.CS7": {Sig: "const CS7", Doc: "", Pos: "ztypes_linux_amd64.go:677"},
+		"syscall.CS8": {Sig: "const CS8", Doc: "", Pos: "ztypes_linux_amd64.go:678"},
+		"syscall.CSTOPB": {Sig: "const CSTOPB", Doc: "", Pos: "ztypes_linux_amd64.go:679"},
+		"syscall.CREAD": {Sig: "const CREAD", Doc: "", Pos: "ztypes_linux_amd64.go:680"},
+		"syscall.PARENB": {Sig: "const PARENB", Doc: "", Pos: "ztypes_linux_amd64.go:681"},
+		"syscall.PARODD": {Sig: "const PARODD", Doc: "", Pos: "ztypes_linux_amd64.go:682"},
+		"syscall.HUPCL": {Sig: "const HUPCL", Doc: "", Pos: "ztypes_linux_amd64.go:683"},
+		"syscall.CLOCAL": {Sig: "const CLOCAL", Doc: "", Pos: "ztypes_linux_amd64.go:684"},
+		"syscall.B57600": {Sig: "const B57600", Doc: "", Pos: "ztypes_linux_amd64.go:685"},
+		"syscall.B115200": {Sig: "const B115200", Doc: "", Pos: "ztypes_linux_amd64.go:686"},
+		"syscall.B230400": {Sig: "const B230400", Doc: "", Pos: "ztypes_linux_amd64.go:687"},
+		"syscall.B460800": {Sig: "const B460800", Doc: "", Pos: "ztypes_linux_amd64.go:688"},
+		"syscall.B500000": {Sig: "const B500000", Doc: "", Pos: "ztypes_linux_amd64.go:689"},
+		"syscall.B576000": {Sig: "const B576000", Doc: "", Pos: "ztypes_linux_amd64.go:690"},
+		"syscall.B921600": {Sig: "const B921600", Doc: "", Pos: "ztypes_linux_amd64.go:691"},
+		"syscall.B1000000": {Sig: "const B1000000", Doc: "", Pos: "ztypes_linux_amd64.go:692"},
+		"syscall.B1152000": {Sig: "const B1152000", Doc: "", Pos: "ztypes_linux_amd64.go:693"},
+		"syscall.B1500000": {Sig: "const B1500000", Doc: "", Pos: "ztypes_linux_amd64.go:694"},
+		"syscall.B2000000": {Sig: "const B2000000", Doc: "", Pos: "ztypes_linux_amd64.go:695"},
+		"syscall.B2500000": {Sig: "const B2500000", Doc: "", Pos: "ztypes_linux_amd64.go:696"},
+		"syscall.B3000000": {Sig: "const B3000000", Doc: "", Pos: "ztypes_linux_amd64.go:697"},
+		"syscall.B3500000": {Sig: "const B3500000", Doc: "", Pos: "ztypes_linux_amd64.go:698"},
+		"syscall.B4000000": {Sig: "const B4000000", Doc: "", Pos: "ztypes_linux_amd64.go:699"},
+		"syscall.ISIG": {Sig: "const ISIG", Doc: "", Pos: "ztypes_linux_amd64.go:700"},
+		"syscall.ICANON": {Sig: "const ICANON", Doc: "", Pos: "ztypes_linux_amd64.go:701"},
+		"syscall.XCASE": {Sig: "const XCASE", Doc: "", Pos: "ztypes_linux_amd64.go:702"},
+		"syscall.ECHO": {Sig: "const ECHO", Doc: "", Pos: "ztypes_linux_amd64.go:703"},
+		"syscall.ECHOE": {Sig: "const ECHOE", Doc: "", Pos: "ztypes_linux_amd64.go:704"},
+		"syscall.ECHOK": {Sig: "const ECHOK", Doc: "", Pos: "ztypes_linux_amd64.go:705"},
+		"syscall.ECHONL": {Sig: "const ECHONL", Doc: "", Pos: "ztypes_linux_amd64.go:706"},
+		"syscall.NOFLSH": {Sig: "const NOFLSH", Doc: "", Pos: "ztypes_linux_amd64.go:707"},
+		"syscall.TOSTOP": {Sig: "const TOSTOP", Doc: "", Pos: "ztypes_linux_amd64.go:708"},
+		"syscall.ECHOCTL": {Sig: "const ECHOCTL", Doc: "", Pos: "ztypes_linux_amd64.go:709"},
+		"syscall.ECHOPRT": {Sig: "const ECHOPRT", Doc: "", Pos: "ztypes_linux_amd64.go:710"},
+		"syscall.ECHOKE": {Sig: "const ECHOKE", Doc: "", Pos: "ztypes_linux_amd64.go:711"},
+		"syscall.FLUSHO": {Sig: "const FLUSHO", Doc: "", Pos: "ztypes_linux_amd64.go:712"},
+		"syscall.PENDIN": {Sig: "const PENDIN", Doc: "", Pos: "ztypes_linux_amd64.go:713"},
+		"syscall.IEXTEN": {Sig: "const IEXTEN", Doc: "", Pos: "ztypes_linux_amd64.go:714"},
+		"syscall.TCGETS": {Sig: "const TCGETS", Doc: "", Pos: "ztypes_linux_amd64.go:715"},
+		"syscall.TCSETS": {Sig: "const TCSETS", Doc: "", Pos: "ztypes_linux_amd64.go:716"},
+		"testing": {Doc: "Package testing provides support for automated testing of Go packages."},
+		"testing.AllocsPerRun": {Sig: "func AllocsPerRun(runs int, f func()) (avg float64)", Doc: "AllocsPerRun returns the average number of allocations during calls to f.", Pos: "allocs.go:20"},
+		"testing.InternalBenchmark": {Sig: "type InternalBenchmark struct", Doc: "InternalBenchmark is an internal type but exported because it is cross-package; it is part of the implementation of the \"go test\" command.", Pos: "benchmark.go:76"},
+		"testing.B": {Sig: "type B struct", Doc: "B is a type passed to [Benchmark] functions to manage benchmark timing and control the number of iterations.", Pos: "benchmark.go:94"},
+		"testing.B.StartTimer": {Sig: "func (b *B) StartTimer()", Doc: "StartTimer starts timing a test.", Pos: "benchmark.go:138"},
+		"testing.B.StopTimer": {Sig: "func (b *B) StopTimer()", Doc: "StopTimer stops timing a test.", Pos: "benchmark.go:151"},
+		"testing.B.ResetTimer": {Sig: "func (b *B) ResetTimer()", Doc: "ResetTimer zeroes the elapsed benchmark time and memory allocation counters and deletes user-reported metrics.", Pos: "benchmark.go:166"},
+		"testing.B.SetBytes": {Sig: "func (b *B) SetBytes(n int64)", Doc: "SetBytes records the number of bytes processed in a single operation.", Pos: "benchmark.go:187"},
+		"testing.B.ReportAllocs": {Sig: "func (b *B) ReportAllocs()", Doc: "ReportAllocs enables malloc statistics for this benchmark.", Pos: "benchmark.go:192"},
+		"testing.B.Elapsed": {Sig: "func (b *B) Elapsed() time.Duration", Doc: "Elapsed returns the measured elapsed time of the benchmark.", Pos: "benchmark.go:367"},
+		"testing.B.ReportMetric": {Sig: "func (b *B) ReportMetric(n float64, unit string)", Doc: "ReportMetric adds \"n unit\" to the reported benchmark results.", Pos: "benchmark.go:384"},
+		"testing.B.Loop": {Sig: "func (b *B) Loop() bool", Doc: "Loop returns true as long as the benchmark should continue running.", Pos: "benchmark.go:502"},
+		"testing.BenchmarkResult": {Sig: "type BenchmarkResult struct", Doc: "BenchmarkResult contains the results of a benchmark run.", Pos: "benchmark.go:536"},
+		"testing.BenchmarkResult.NsPerOp": {Sig: "func (r BenchmarkResult) NsPerOp() int64", Doc: "NsPerOp returns the \"ns/op\" metric.", Pos: "benchmark.go:548"},
+		"testing.BenchmarkResult.AllocsPerOp": {Sig: "func (r BenchmarkResult) AllocsPerOp() int64", Doc: "AllocsPerOp returns the \"allocs/op\" metric, which is calculated as r.MemAllocs / r.N.", Pos: "benchmark.go:571"},
+		"testing.BenchmarkResult.AllocedBytesPerOp": {Sig: "func (r BenchmarkResult) AllocedBytesPerOp() int64", Doc: "AllocedBytesPerOp returns the \"B/op\" metric, which is calculated as r.MemBytes / r.N.", Pos: "benchmark.go:583"},
+		"testing.BenchmarkResult.String": {Sig: "func (r BenchmarkResult) String() string", Doc: "String returns a summary of the benchmark results.", Pos: "benchmark.go:600"},
+		"testing.BenchmarkResult.MemString": {Sig: "func (r BenchmarkResult) MemString() string", Doc: "MemString returns r.AllocedBytesPerOp and r.AllocsPerOp in the same format as 'go test'.", Pos: "benchmark.go:665"},
+		"testing.RunBenchmarks": {Sig: "func RunBenchmarks(matchString func(pat, str string) (bool, error), benchmarks []InternalBenchmark)", Doc: "RunBenchmarks is an internal function but exported because it is cross-package; it is part of the implementation of the \"go test\" command.", Pos: "benchmark.go:687"},
+		"testing.B.Run": {Sig: "func (b *B) Run(name string, f func(b *B)) bool", Doc: "Run benchmarks f as a subbenchmark with the given name.", Pos: "benchmark.go:808"},
+		"testing.PB": {Sig: "type PB struct", Doc: "A PB is used by RunParallel for running parallel benchmarks.", Pos: "benchmark.go:914"},
+		"testing.PB.Next": {Sig: "func (pb *PB) Next() bool", Doc: "Next reports whether there are more iterations to execute.", Pos: "benchmark.go:922"},
+		"testing.B.RunParallel": {Sig: "func (b *B) RunParallel(body func(*PB))", Doc: "RunParallel runs a benchmark in parallel.", Pos: "benchmark.go:950"},
+		"testing.B.SetParallelism": {Sig: "func (b *B) SetParallelism(p int)", Doc: "SetParallelism sets the number of goroutines used by [B.RunParallel] to p*GOMAXPROCS.", Pos: "benchmark.go:994"},
+		"testing.Benchmark": {Sig: "func Benchmark(f func(b *B)) BenchmarkResult", Doc: "Benchmark benchmarks a single function.", Pos: "benchmark.go:1008"},
+		"testing.CoverBlock": {Sig: "type CoverBlock struct", Doc: "CoverBlock records the coverage data for a single basic block.", Pos: "cover.go:15"},
+		"testing.Cover": {Sig: "type Cover struct", Doc: "Cover records information about test coverage checking.", Pos: "cover.go:26"},
+		"testing.RegisterCover": {Sig: "func RegisterCover(c Cover)", Doc: "RegisterCover records the coverage data accumulators for the tests.", Pos: "cover.go:36"},
+		"testing.InternalExample": {Sig: "type InternalExample struct", Doc: "", Pos: "example.go:15"},
+		"testing.RunExamples": {Sig: "func RunExamples(matchString func(pat, str string) (bool, error), examples []InternalExample) (ok bool)", Doc: "RunExamples is an internal function but exported because it is cross-package; it is part of the implementation of the \"go test\" command.", Pos: "example.go:24"},
+		"testing.InternalFuzzTarget": {Sig: "type InternalFuzzTarget struct", Doc: "InternalFuzzTarget is an internal type but exported because it is cross-package; it is part of the implementation of the \"go test\" command.", Pos: "fuzz.go:49"},
+		"testing.F": {Sig: "type F struct", Doc: "F is a type passed to fuzz tests.", Pos: "fuzz.go:69"},
+		"testing.F.Helper": {Sig: "func (f *F) Helper()", Doc: "Helper marks the calling function as a test helper function.", Pos: "fuzz.go:103"},
+		"testing.F.Fail": {Sig: "func (f *F) Fail()", Doc: "Fail marks the function as having failed but continues execution.", Pos: "fuzz.go:129"},
+		"testing.F.Skipped": {Sig: "func (f *F) Skipped() bool", Doc: "Skipped reports whether the test was skipped.", Pos: "fuzz.go:140"},
+		"testing.F.Add": {Sig: "func (f *F) Add(args ...any)", Doc: "Add will add the arguments to the seed corpus for the fuzz test.", Pos: "fuzz.go:153"},
+		"testing.F.Fuzz": {Sig: "func (f *F) Fuzz(ff any)", Doc: "Fuzz runs the fuzz function, ff, for fuzz testing.", Pos: "fuzz.go:211"},
+		"testing.Coverage": {Sig: "func Coverage() float64", Doc: "Coverage reports the current code coverage as a fraction in the range [0, 1].", Pos: "newcover.go:54"},
+		"testing.Init": {Sig: "func Init()", Doc: "Init registers testing flags.", Pos: "testing.go:438"},
+		"testing.Short": {Sig: "func Short() bool", Doc: "Short reports whether the -test.short flag is set.", Pos: "testing.go:746"},
+		"testing.CoverMode": {Sig: "func CoverMode() string", Doc: "CoverMode reports what the test coverage mode is set to.", Pos: "testing.go:777"},
+		"testing.Verbose": {Sig: "func Verbose() bool", Doc: "Verbose reports whether the -test.v flag is set.", Pos: "testing.go:782"},
+		"testing.TB": {Sig: "type TB interface", Doc: "TB is the interface common to [T], [B], and [F].", Pos: "testing.go:973"},
+		"testing.T": {Sig: "type T struct", Doc: "T is a type passed to Test functions to manage test state and support formatted test logs.", Pos: "testing.go:1018"},
+		"testing.T.Parallel": {Sig: "func (t *T) Parallel()", Doc: "Parallel signals that this test is to be run in parallel with (and only with) other parallel tests, and pauses until all non-parallel tests have finished.", Pos: "testing.go:1912"},
+		"testing.T.Setenv": {Sig: "func (t *T) Setenv(key, value string)", Doc: "Setenv calls os.Setenv(key, value) and uses Cleanup to restore the environment variable to its original value after the test.", Pos: "testing.go:2005"},
+		"testing.T.Chdir": {Sig: "func (t *T) Chdir(dir string)", Doc: "Chdir calls os.Chdir and uses Cleanup to restore the current working directory to its original value after the test.", Pos: "testing.go:2016"},
+		"testing.InternalTest": {Sig: "type InternalTest struct", Doc: "InternalTest is an internal type but exported because it is cross-package; it is part of the implementation of the \"go test\" command.", Pos: "testing.go:2023"},
+		"testing.T.Run": {Sig: "func (t *T) Run(name string, f func(t *T)) bool", Doc: "Run runs f as a subtest of t called name.", Pos: "testing.go:2207"},
+		"testing.T.Deadline": {Sig: "func (t *T) Deadline() (deadline time.Time, ok bool)", Doc: "Deadline reports the time at which the test binary will have exceeded the timeout specified by the -timeout flag.", Pos: "testing.go:2320"},
+		"testing.Main": {Sig: "func Main(matchString func(pat, str string) (bool, error), tests []InternalTest, benchmarks []InternalBenchmark, examples []InternalExample)", Doc: "Main is an internal function, part of the implementation of the \"go test\" command.", Pos: "testing.go:2428"},
+		"testing.M": {Sig: "type M struct", Doc: "M is a type passed to a TestMain function to run the actual tests.", Pos: "testing.go:2433"},
+		"testing.MainStart": {Sig: "func MainStart(deps testDeps, tests []InternalTest, benchmarks []InternalBenchmark, fuzzTargets []InternalFuzzTarget, examples []InternalExample) *M", Doc: "MainStart is meant for use by tests generated by 'go test'.", Pos: "testing.go:2476"},
+		"testing.M.Run": {Sig: "func (m *M) Run() (code int)", Doc: "Run runs the tests.", Pos: "testing.go:2497"},
+		"testing.RunTests": {Sig: "func RunTests(matchString func(pat, str string) (bool, error), tests []InternalTest) (ok bool)", Doc: "RunTests is an internal function but exported because it is cross-package; it is part of the implementation of the \"go test\" command.", Pos: "testing.go:2696"},
+		"text/tabwriter": {Doc: "Package tabwriter implements a write filter (tabwriter.Writer) that translates tabbed columns in input into properly aligned text."},
+		"text/tabwriter.Writer": {Sig: "type Writer struct", Doc: "A Writer is a filter that inserts padding around tab-delimited columns in its input to align them in the output.", Pos: "tabwriter.go:90"},
+		"text/tabwriter.FilterHTML": {Sig: "const FilterHTML uint", Doc: "Ignore html tags and treat entities (starting with '&' and ending in ';') as single characters (width = 1).", Pos: "tabwriter.go:173"},
+		"text/tabwriter.StripEscape": {Sig: "const StripEscape", Doc: "Strip Escape characters bracketing escaped text segments instead of passing them through unchanged with the text.", Pos: "tabwriter.go:177"},
+		"text/tabwriter.AlignRight": {Sig: "const AlignRight", Doc: "Force right-alignment of cell content.", Pos: "tabwriter.go:181"},
+		"text/tabwriter.DiscardEmptyColumns": {Sig: "const DiscardEmptyColumns", Doc: "Handle empty columns as if they were not present in the input in the first place.", Pos: "tabwriter.go:185"},
+		"text/tabwriter.TabIndent": {Sig: "const TabIndent", Doc: "Always use tabs for indentation columns (i.e., padding of leading empty cells on the left) independent of padchar.", Pos: "tabwriter.go:189"},
+		"text/tabwriter.Debug": {Sig: "const Debug", Doc: "Print a vertical bar ('|') between columns (after formatting).", Pos: "tabwriter.go:193"},
+		"text/tabwriter.Writer.Init": {Sig: "func (b *Writer) Init(output io.Writer, minwidth, tabwidth, padding int, padchar byte, flags uint) *Writer", Doc: "A [Writer] must be initialized with a call to Init.", Pos: "tabwriter.go:209"},
+		"text/tabwriter.Escape": {Sig: "const Escape", Doc: "To escape a text segment, bracket it with Escape characters.", Pos: "tabwriter.go:427"},
+		"text/tabwriter.Writer.Flush": {Sig: "func (b *Writer) Flush() error", Doc: "Flush should be called after the last call to [Writer.Write] to ensure that any data buffered in the [Writer] is written to output.", Pos: "tabwriter.go:488"},
+		"text/tabwriter.Writer.Write": {Sig: "func (b *Writer) Write(buf []byte) (n int, err error)", Doc: "Write writes buf to the writer b.", Pos: "tabwriter.go:523"},
+		"text/tabwriter.NewWriter": {Sig: "func NewWriter(output io.Writer, minwidth, tabwidth, padding int, padchar byte, flags uint) *Writer", Doc: "NewWriter allocates and initializes a new [Writer].", Pos: "tabwriter.go:599"},
+		"time": {Doc: "Package time provides functionality for measuring and displaying time."},
+		"time.Layout": {Sig: "const Layout", Doc: "These are predefined layouts for use in [Time.Format] and time.Parse.", Pos: "format.go:110"},
+		"time.ANSIC": {Sig: "const ANSIC", Doc: "These are predefined layouts for use in [Time.Format] and time.Parse.", Pos: "format.go:111"},
+		"time.UnixDate": {Sig: "const UnixDate", Doc: "These are predefined layouts for use in [Time.Format] and time.Parse.", Pos: "format.go:112"},
+		"time.RubyDate": {Sig: "const RubyDate", Doc: "These are predefined layouts for use in [Time.Format] and time.Parse.", Pos: "format.go:113"},
+		"time.RFC822": {Sig: "const RFC822", Doc: "These are predefined layouts for use in [Time.Format] and time.Parse.", Pos: "format.go:114"},
+		"time.RFC822Z": {Sig: "const RFC822Z", Doc: "These are predefined layouts for use in [Time.Format] and time.Parse.", Pos: "format.go:115"},
+		"time.RFC850": {Sig: "const RFC850", Doc: "These are predefined layouts for use in [Time.Format] and time.Parse.", Pos: "format.go:116"},
+		"time.RFC1123": {Sig: "const RFC1123", Doc: "These are predefined layouts for use in [Time.Format] and time.Parse.", Pos: "format.go:117"},
+		"time.RFC1123Z": {Sig: "const RFC1123Z", Doc: "These are predefined layouts for use in [Time.Format] and time.Parse.", Pos: "format.go:118"},
+		"time.RFC3339": {Sig: "const RFC3339", Doc: "These are predefined layouts for use in [Time.Format] and time.Parse.", Pos: "format.go:119"},
+		"time.RFC3339Nano": {Sig: "const RFC3339Nano", Doc: "These are predefined layouts for use in [Time.Format] and time.Parse.", Pos: "format.go:120"},
+		"time.Kitchen": {Sig: "const Kitchen", Doc: "These are predefined layouts for use in [Time.Format] and time.Parse.", Pos: "format.go:121"},
+		"time.Stamp": {Sig: "const Stamp", Doc: "Handy time stamps.", Pos: "format.go:123"},
+		"time.StampMilli": {Sig: "const StampMilli", Doc: "These are predefined layouts for use in [Time.Format] and time.Parse.", Pos: "format.go:124"},
+		"time.StampMicro": {Sig: "const StampMicro", Doc: "These are predefined layouts for use in [Time.Format] and time.Parse.", Pos: "format.go:125"},
+		"time.StampNano": {Sig: "const StampNano", Doc: "These are predefined layouts for use in [Time.Format] and time.Parse.", Pos: "format.go:126"},
+		"time.DateTime": {Sig: "const DateTime", Doc: "These are predefined layouts for use in [Time.Format] and time.Parse.", Pos: "format.go:127"},
+		"time.DateOnly": {Sig: "const DateOnly", Doc: "These are predefined layouts for use in [Time.Format] and time.Parse.", Pos: "format.go:128"},
+		"time.TimeOnly": {Sig: "const TimeOnly", Doc: "These are predefined layouts for use in [Time.Format] and time.Parse.", Pos: "format.go:129"},
+		"time.Time.String": {Sig: "func (t Time) String() string", Doc: "String returns the time formatted using the format string", Pos: "format.go:546"},
+		"time.Time.GoString": {Sig: "func (t Time) GoString() string", Doc: "GoString implements fmt.GoStringer and formats t to be printed in Go source code.", Pos: "format.go:577"},
+		"time.Time.Format": {Sig: "func (t Time) Format(layout string) string", Doc: "Format returns a textual representation of the time value formatted according to the layout defined by the argument.", Pos: "format.go:639"},
+		"time.Time.AppendFormat": {Sig: "func (t Time) AppendFormat(b []byte, layout string) []byte", Doc: "AppendFormat is like [Time.Format] but appends the textual representation to b and returns the extended buffer.", Pos: "format.go:655"},
+		"time.ParseError": {Sig: "type ParseError struct", Doc: "ParseError describes a problem parsing a time string.", Pos: "format.go:841"},
+		"time.ParseError.Error": {Sig: "func (e *ParseError) Error() string", Doc: "Error returns the string representation of a ParseError.", Pos: "format.go:902"},
+		"time.Parse": {Sig: "func Parse(layout, value string) (Time, error)", Doc: "Parse parses a formatted string and returns the time value it represents.", Pos: "format.go:1026"},
+		"time.ParseInLocation": {Sig: "func ParseInLocation(layout, value string, loc *Location) (Time, error)", Doc: "ParseInLocation is like Parse but differs in two important ways.", Pos: "format.go:1041"},
+		"time.ParseDuration": {Sig: "func ParseDuration(s string) (Duration, error)", Doc: "ParseDuration parses a duration string.", Pos: "format.go:1634"},
+		"time.Sleep": {Sig: "func Sleep(d Duration)", Doc: "Sleep pauses the current goroutine for at least the duration d.", Pos: "sleep.go:13"},
+		"time.Timer": {Sig: "type Timer struct", Doc: "The Timer type represents a single event.", Pos: "sleep.go:61"},
+		"time.Timer.Stop": {Sig: "func (t *Timer) Stop() bool", Doc: "Stop prevents the [Timer] from firing.", Pos: "sleep.go:85"},
+		"time.NewTimer": {Sig: "func NewTimer(d Duration) *Timer", Doc: "NewTimer creates a new Timer that will send the current time on its channel after at least duration d.", Pos: "sleep.go:110"},
+		"time.Timer.Reset": {Sig: "func (t *Timer) Reset(d Duration) bool", Doc: "Reset changes the timer to expire after duration d.", Pos: "sleep.go:138"},
+		"time.After": {Sig: "func After(d Duration) <-chan Time", Doc: "After waits for the duration to elapse and then sends the current time on the returned channel.", Pos: "sleep.go:169"},
+		"time.AfterFunc": {Sig: "func AfterFunc(d Duration, f func()) *Timer", Doc: "AfterFunc waits for the duration to elapse and then calls f in its own goroutine.", Pos: "sleep.go:177"},
+		"time.Ticker": {Sig: "type Ticker struct", Doc: "A Ticker holds a channel that delivers “ticks” of a clock at intervals.", Pos: "tick.go:16"},
+		"time.NewTicker": {Sig: "func NewTicker(d Duration) *Ticker", Doc: "NewTicker returns a new [Ticker] containing a channel that will send the current time on the channel after each tick.", Pos: "tick.go:36"},
+		"time.Ticker.Stop": {Sig: "func (t *Ticker) Stop()", Doc: "Stop turns off a ticker.", Pos: "tick.go:53"},
+		"time.Ticker.Reset": {Sig: "func (t *Ticker) Reset(d Duration)", Doc: "Reset stops a ticker and resets its period to the specified duration.", Pos: "tick.go:66"},
+		"time.Tick": {Sig: "func Tick(d Duration) <-chan Time", Doc: "Tick is a convenience wrapper for [NewTicker] providing access to the ticking channel only.", Pos: "tick.go:87"},
+		"time.Time": {Sig: "type Time struct", Doc: "A Time represents an instant in time with nanosecond precision.", Pos: "time.go:140"},
+		"time.Time.IsZero": {Sig: "func (t Time) IsZero() bool", Doc: "IsZero reports whether t represents the zero time instant, January 1, year 1, 00:00:00 UTC.", Pos: "time.go:262"},
+		"time.Time.After": {Sig: "func (t Time) After(u Time) bool", Doc: "After reports whether the time instant t is after u.", Pos: "time.go:271"},
+		"time.Time.Before": {Sig: "func (t Time) Before(u Time) bool", Doc: "Before reports whether the time instant t is before u.", Pos: "time.go:281"},
+		"time.Time.Compare": {Sig: "func (t Time) Compare(u Time) int", Doc: "Compare compares the time instant t with u.", Pos: "time.go:292"},
+		"time.Time.Equal": {Sig: "func (t Time) Equal(u Time) bool", Doc: "Equal reports whether t and u represent the same time instant.", Pos: "time.go:316"},
+		"time.Month": {Sig: "type Month int", Doc: "A Month specifies a month of the year (January = 1, ...).", Pos: "time.go:324"},
+		"time.January": {Sig: "const January Month", Doc: "", Pos: "time.go:327"},
+		"time.February": {Sig: "const February", Doc: "", Pos: "time.go:328"},
+		"time.March": {Sig: "const March", Doc: "", Pos: "time.go:329"},
+		"time.April": {Sig: "const April", Doc: "", Pos: "time.go:330"},
+		"time.May": {Sig: "const May", Doc: "", Pos: "time.go:331"},
+		"time.June": {Sig: "const June", Doc: "", Pos: "time.go:332"},
+		"time.July": {Sig: "const July", Doc: "", Pos: "time.go:333"},
+		"time.August": {Sig: "const August", Doc: "", Pos: "time.go:334"},
+		"time.September": {Sig: "const September", Doc: "", Pos: "time.go:335"},
+		"time.October": {Sig: "const October", Doc: "", Pos: "time.go:336"},
+		"time.November": {Sig: "const November", Doc: "", Pos: "time.go:337"},
+		"time.December": {Sig: "const December", Doc: "", Pos: "time.go:338"},
+		"time.Month.String": {Sig: "func (m Month) String() string", Doc: "String returns the English name of the month (\"January\", \"February\", ...).", Pos: "time.go:342"},
+		"time.Weekday": {Sig: "type Weekday int", Doc: "A Weekday specifies a day of the week (Sunday = 0, ...).", Pos: "time.go:352"},
+		"time.Sunday": {Sig: "const Sunday Weekday", Doc: "", Pos: "time.go:355"},
+		"time.Monday": {Sig: "const Monday", Doc: "", Pos: "time.go:356"},
+		"time.Tuesday": {Sig: "const Tuesday", Doc: "", Pos: "time.go:357"},
+		"time.Wednesday": {Sig: "const Wednesday", Doc: "", Pos: "time.go:358"},
+		"time.Thursday": {Sig: "const Thursday", Doc: "", Pos: "time.go:359"},
+		"time.Friday": {Sig: "const Friday", Doc: "", Pos: "time.go:360"},
+		"time.Saturday": {Sig: "const Saturday", Doc: "", Pos: "time.go:361"},
+		"time.Weekday.String": {Sig: "func (d Weekday) String() string", Doc: "String returns the English name of the day (\"Sunday\", \"Monday\", ...).", Pos: "time.go:365"},
+		"time.Time.Date": {Sig: "func (t Time) Date() (year int, month Month, day int)", Doc: "Date returns the year, month, and day in which t occurs.", Pos: "time.go:812"},
+		"time.Time.Year": {Sig: "func (t Time) Year() int", Doc: "Year returns the year in which t occurs.", Pos: "time.go:817"},
+		"time.Time.Month": {Sig: "func (t Time) Month() Month", Doc: "Month returns the month of the year specified by t.", Pos: "time.go:824"},
+		"time.Time.Day": {Sig: "func (t Time) Day() int", Doc: "Day returns the day of the month specified by t.", Pos: "time.go:831"},
+		"time.Time.Weekday": {Sig: "func (t Time) Weekday() Weekday", Doc: "Weekday returns the day of the week specified by t.", Pos: "time.go:838"},
+		"time.Time.ISOWeek": {Sig: "func (t Time) ISOWeek() (year, week int)", Doc: "ISOWeek returns the ISO 8601 year and week number in which t occurs.", Pos: "time.go:852"},
+		"time.Time.Clock": {Sig: "func (t Time) Clock() (hour, min, sec int)", Doc: "Clock returns the hour, minute, and second within the day specified by t.", Pos: "time.go:870"},
+		"time.Time.Hour": {Sig: "func (t Time) Hour() int", Doc: "Hour returns the hour within the day specified by t, in the range [0, 23].", Pos: "time.go:885"},
+		"time.Time.Minute": {Sig: "func (t Time) Minute() int", Doc: "Minute returns the minute offset within the hour specified by t, in the range [0, 59].", Pos: "time.go:890"},
+		"time.Time.Second": {Sig: "func (t Time) Second() int", Doc: "Second returns the second offset within the minute specified by t, in the range [0, 59].", Pos: "time.go:895"},
+		"time.Time.Nanosecond": {Sig: "func (t Time) Nanosecond() int", Doc: "Nanosecond returns the nanosecond offset within the second specified by t, in the range [0, 999999999].", Pos: "time.go:901"},
+		"time.Time.YearDay": {Sig: "func (t Time) YearDay() int", Doc: "YearDay returns the day of the year specified by t, in the range [1,365] for non-leap years, and [1,366] in leap years.", Pos: "time.go:907"},
+		"time.Duration": {Sig: "type Duration int64", Doc: "A Duration represents the elapsed time between two instants as an int64 nanosecond count.", Pos: "time.go:915"},
+		"time.Nanosecond": {Sig: "const Nanosecond Duration", Doc: "Common durations.", Pos: "time.go:935"},
+		"time.Microsecond": {Sig: "const Microsecond", Doc: "Common durations.", Pos: "time.go:936"},
+		"time.Millisecond": {Sig: "const Millisecond", Doc: "Common durations.", Pos: "time.go:937"},
+		"time.Second": {Sig: "const Second", Doc: "Common durations.", Pos: "time.go:938"},
+		"time.Minute": {Sig: "const Minute", Doc: "Common durations.", Pos: "time.go:939"},
+		"time.Hour": {Sig: "const Hour", Doc: "Common durations.", Pos: "time.go:940"},
+		"time.Duration.String": {Sig: "func (d Duration) String() string", Doc: "String returns a string representing the duration in the form \"72h3m0.5s\".", Pos: "time.go:947"},
+		"time.Duration.Nanoseconds": {Sig: "func (d Duration) Nanoseconds() int64", Doc: "Nanoseconds returns the duration as an integer nanosecond count.", Pos: "time.go:1072"},
+		"time.Duration.Microseconds": {Sig: "func (d Duration) Microseconds() int64", Doc: "Microseconds returns the duration as an integer microsecond count.", Pos: "time.go:1075"},
+		"time.Duration.Milliseconds": {Sig: "func (d Duration) Milliseconds() int64", Doc: "Milliseconds returns the duration as an integer millisecond count.", Pos: "time.go:1078"},
+		"time.Duration.Seconds": {Sig: "func (d Duration) Seconds() float64", Doc: "Seconds returns the duration as a floating point number of seconds.", Pos: "time.go:1090"},
+		"time.Duration.Minutes": {Sig: "func (d Duration) Minutes() float64", Doc: "Minutes returns the duration as a floating point number of minutes.", Pos: "time.go:1097"},
+		"time.Duration.Hours": {Sig: "func (d Duration) Hours() float64", Doc: "Hours returns the duration as a floating point number of hours.", Pos: "time.go:1104"},
+		"time.Duration.Truncate": {Sig: "func (d Duration) Truncate(m Duration) Duration", Doc: "Truncate returns the result of rounding d toward zero to a multiple of m.", Pos: "time.go:1112"},
+		"time.Duration.Round": {Sig: "func (d Duration) Round(m Duration) Duration", Doc: "Round returns the result of rounding d to the nearest multiple of m.", Pos: "time.go:1131"},
+		"time.Duration.Abs": {Sig: "func (d Duration) Abs() Duration", Doc: "Abs returns the absolute value of d.", Pos: "time.go:1158"},
+		"time.Time.Add": {Sig: "func (t Time) Add(d Duration) Time", Doc: "Add returns the time t+d.", Pos: "time.go:1170"},
+		"time.Time.Sub": {Sig: "func (t Time) Sub(u Time) Duration", Doc: "Sub returns the duration t-u.", Pos: "time.go:1198"},
+		"time.Since": {Sig: "func Since(t Time) Duration", Doc: "Since returns the time elapsed since t.", Pos: "time.go:1227"},
+		"time.Until": {Sig: "func Until(t Time) Duration", Doc: "Until returns the duration until t.", Pos: "time.go:1237"},
+		"time.Time.AddDate": {Sig: "func (t Time) AddDate(years int, months int, days int) Time", Doc: "AddDate returns the time corresponding to adding the given number of years, months, and days to t.", Pos: "time.go:1262"},
+		"time.Now": {Sig: "func Now() Time", Doc: "Now returns the current local time.", Pos: "time.go:1347"},
+		"time.Time.UTC": {Sig: "func (t Time) UTC() Time", Doc: "UTC returns t with the location set to UTC.", Pos: "time.go:1368"},
+		"time.Time.Local": {Sig: "func (t Time) Local() Time", Doc: "Local returns t with the location set to local time.", Pos: "time.go:1374"},
+		"time.Time.In": {Sig: "func (t Time) In(loc *Location) Time", Doc: "In returns a copy of t representing the same time instant, but with the copy's location information set to loc for display purposes.", Pos: "time.go:1384"},
+		"time.Time.Location": {Sig: "func (t Time) Location() *Location", Doc: "Location returns the time zone information associated with t.", Pos: "time.go:1393"},
+		"time.Time.Zone": {Sig: "func (t Time) Zone() (name string, offset int)", Doc: "Zone computes the time zone in effect at time t, returning the abbreviated name of the zone (such as \"CET\") and its offset in seconds east of UTC.", Pos: "time.go:1403"},
+		"time.Time.ZoneBounds": {Sig: "func (t Time) ZoneBounds() (start, end Time)", Doc: "ZoneBounds returns the bounds of the time zone in effect at time t.", Pos: "time.go:1413"},
+		"time.Time.Unix": {Sig: "func (t Time) Unix() int64", Doc: "Unix returns t as a Unix time, the number of seconds elapsed since January 1, 1970 UTC.", Pos: "time.go:1432"},
+		"time.Time.UnixMilli": {Sig: "func (t Time) UnixMilli() int64", Doc: "UnixMilli returns t as a Unix time, the number of milliseconds elapsed since January 1, 1970 UTC.", Pos: "time.go:1441"},
+		"time.Time.UnixMicro": {Sig: "func (t Time) UnixMicro() int64", Doc: "UnixMicro returns t as a Unix time, the number of microseconds elapsed since January 1, 1970 UTC.", Pos: "time.go:1450"},
+		"time.Time.UnixNano": {Sig: "func (t Time) UnixNano() int64", Doc: "UnixNano returns t as a Unix time, the number of nanoseconds elapsed since January 1, 1970 UTC.", Pos: "time.go:1460"},
+		"time.Time.AppendBinary": {Sig: "func (t Time) AppendBinary(b []byte) ([]byte, error)", Doc: "AppendBinary implements the encoding.BinaryAppender interface.", Pos: "time.go:1470"},
+		"time.Time.MarshalBinary": {Sig: "func (t Time) MarshalBinary() ([]byte, error)", Doc: "MarshalBinary implements the encoding.BinaryMarshaler interface.", Pos: "time.go:1517"},
+		"time.Time.UnmarshalBinary": {Sig: "func (t *Time) UnmarshalBinary(data []byte) error", Doc: "UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.", Pos: "time.go:1526"},
+		"time.Time.GobEncode": {Sig: "func (t Time) GobEncode() ([]byte, error)", Doc: "GobEncode implements the gob.GobEncoder interface.", Pos: "time.go:1578"},
+		"time.Time.GobDecode": {Sig: "func (t *Time) GobDecode(data []byte) error", Doc: "GobDecode implements the gob.GobDecoder interface.", Pos: "time.go:1583"},
+		"time.Time.MarshalJSON": {Sig: "func (t Time) MarshalJSON() ([]byte, error)", Doc: "MarshalJSON implements the encoding/json.Marshaler interface.", Pos: "time.go:1591"},
+		"time.Time.UnmarshalJSON": {Sig: "func (t *Time) UnmarshalJSON(data []byte) error", Doc: "UnmarshalJSON implements the encoding/json.Unmarshaler interface.", Pos: "time.go:1604"},
+		"time.Time.AppendText": {Sig: "func (t Time) AppendText(b []byte) ([]byte, error)", Doc: "AppendText implements the encoding.TextAppender interface.", Pos: "time.go:1630"},
+		"time.Time.MarshalText": {Sig: "func (t Time) MarshalText() ([]byte, error)", Doc: "MarshalText implements the encoding.TextMarshaler interface.", Pos: "time.go:1638"},
+		"time.Time.UnmarshalText": {Sig: "func (t *Time) UnmarshalText(data []byte) error", Doc: "UnmarshalText implements the encoding.TextUnmarshaler interface.", Pos: "time.go:1644"},
+		"time.Unix": {Sig: "func Unix(sec int64, nsec int64) Time", Doc: "Unix returns the local Time corresponding to the given Unix time, sec seconds and nsec nanoseconds since January 1, 1970 UTC.", Pos: "time.go:1655"},
+		"time.UnixMilli": {Sig: "func UnixMilli(msec int64) Time", Doc: "UnixMilli returns the local Time corresponding to the given Unix time, msec milliseconds since January 1, 1970 UTC.", Pos: "time.go:1670"},
+		"time.UnixMicro": {Sig: "func UnixMicro(usec int64) Time", Doc: "UnixMicro returns the local Time corresponding to the given Unix time, usec microseconds since January 1, 1970 UTC.", Pos: "time.go:1676"},
+		"time.Time.IsDST": {Sig: "func (t Time) IsDST() bool", Doc: "IsDST reports whether the time in the configured location is in Daylight Savings Time.", Pos: "time.go:1681"},
+		"time.Date": {Sig: "func Date(year int, month Month, day, hour, min, sec, nsec int, loc *Location) Time", Doc: "Date returns the Time corresponding to", Pos: "time.go:1734"},
+		"time.Time.Truncate": {Sig: "func (t Time) Truncate(d Duration) Time", Doc: "Truncate returns the result of rounding t down to a multiple of d (since the zero time).", Pos: "time.go:1782"},
+		"time.Time.Round": {Sig: "func (t Time) Round(d Duration) Time", Doc: "Round returns the result of rounding t to the nearest multiple of d (since the zero time).", Pos: "time.go:1799"},
+		"time.Location": {Sig: "type Location struct", Doc: "A Location maps time instants to the zone in use at that time.", Pos: "zoneinfo.go:23"},
+		"time.UTC": {Sig: "var UTC *Location", Doc: "UTC represents Universal Coordinated Time (UTC).", Pos: "zoneinfo.go:71"},
+		"time.Local": {Sig: "var Local *Location", Doc: "Local represents the system's local time zone.", Pos: "zoneinfo.go:84"},
+		"time.Location.String": {Sig: "func (l *Location) String() string", Doc: "String returns a descriptive name for the time zone information, corresponding to the name argument to [LoadLocation] or [FixedZone].", Pos: "zoneinfo.go:103"},
+		"time.FixedZone": {Sig: "func FixedZone(name string, offset int) *Location", Doc: "FixedZone returns a [Location] that always uses the given zone name and offset (seconds east of UTC).", Pos: "zoneinfo.go:112"},
+		"time.LoadLocation": {Sig: "func LoadLocation(name string) (*Location, error)", Doc: "LoadLocation returns a [Location] with the given name.", Pos: "zoneinfo.go:664"},
+		"time.LoadLocationFromTZData": {Sig: "func LoadLocationFromTZData(name string, data []byte) (*Location, error)", Doc: "LoadLocationFromTZData returns a new [Location] with the given name initialized from the IANA Time Zone database-formatted data.", Pos: "zoneinfo_read.go:118"},
+		"unicode": {Doc: "Package unicode provides data and functions to test some properties of Unicode code points."},
+		"unicode.TurkishCase": {Sig: "var TurkishCase SpecialCase", Doc: "", Pos: "casetables.go:12"},
+		"unicode.AzeriCase": {Sig: "var AzeriCase SpecialCase", Doc: "", Pos: "casetables.go:20"},
+		"unicode.IsDigit": {Sig: "func IsDigit(r rune) bool", Doc: "IsDigit reports whether the rune is a decimal digit.", Pos: "digit.go:8"},
+		"unicode.GraphicRanges": {Sig: "var GraphicRanges", Doc: "GraphicRanges defines the set of graphic characters according to Unicode.", Pos: "graphic.go:23"},
+		"unicode.PrintRanges": {Sig: "var PrintRanges", Doc: "PrintRanges defines the set of printable characters according to Go.", Pos: "graphic.go:29"},
+		"unicode.IsGraphic": {Sig: "func IsGraphic(r rune) bool", Doc: "IsGraphic reports whether the rune is defined as a Graphic by Unicode.", Pos: "graphic.go:36"},
+		"unicode.IsPrint": {Sig: "func IsPrint(r rune) bool", Doc: "IsPrint reports whether the rune is defined as printable by Go.", Pos: "graphic.go:50"},
+		"unicode.IsOneOf": {Sig: "func IsOneOf(ranges []*RangeTable, r rune) bool", Doc: "IsOneOf reports whether the rune is a member of one of the ranges.", Pos: "graphic.go:59"},
+		"unicode.In": {Sig: "func In(r rune, ranges ...*RangeTable) bool", Doc: "In reports whether the rune is a member of one of the ranges.", Pos: "graphic.go:69"},
+		"unicode.IsControl": {Sig: "func IsControl(r rune) bool", Doc: "IsControl reports whether the rune is a control character.", Pos: "graphic.go:81"},
+		"unicode.IsLetter": {Sig: "func IsLetter(r rune) bool", Doc: "IsLetter reports whether the rune is a letter (category [L]).", Pos: "graphic.go:90"},
+		"unicode.IsMark": {Sig: "func IsMark(r rune) bool", Doc: "IsMark reports whether the rune is a mark character (category [M]).", Pos: "graphic.go:98"},
+		"unicode.IsNumber": {Sig: "func IsNumber(r rune) bool", Doc: "IsNumber reports whether the rune is a number (category [N]).", Pos: "graphic.go:104"},
+		"unicode.IsPunct": {Sig: "func IsPunct(r rune) bool", Doc: "IsPunct reports whether the rune is a Unicode punctuation character (category [P]).", Pos: "graphic.go:113"},
+		"unicode.IsSpace": {Sig: "func IsSpace(r rune) bool", Doc: "IsSpace reports whether the rune is a space character as defined by Unicode's White Space property; in the Latin-1 space this is", Pos: "graphic.go:128"},
+		"unicode.IsSymbol": {Sig: "func IsSymbol(r rune) bool", Doc: "IsSymbol reports whether the rune is a symbolic character.", Pos: "graphic.go:141"},
+		"unicode.MaxRune": {Sig: "const MaxRune", Doc: "", Pos: "letter.go:10"},
+		"unicode.ReplacementChar": {Sig: "const ReplacementChar", Doc: "", Pos: "letter.go:11"},
+		"unicode.MaxASCII": {Sig: "const MaxASCII", Doc: "", Pos: "letter.go:12"},
+		"unicode.MaxLatin1": {Sig: "const MaxLatin1", Doc: "", Pos: "letter.go:13"},
+		"unicode.RangeTable": {Sig: "type RangeTable struct", Doc: "RangeTable defines a set of Unicode code points by listing the ranges of code points within the set.", Pos: "letter.go:21"},
+		"unicode.Range16": {Sig: "type Range16 struct", Doc: "Range16 represents of a range of 16-bit Unicode code points.", Pos: "letter.go:29"},
+		"unicode.Range32": {Sig: "type Range32 struct", Doc: "Range32 represents of a range of Unicode code points and is used when one or more of the values will not fit in 16 bits.", Pos: "letter.go:38"},
+		"unicode.CaseRange": {Sig: "type CaseRange struct", Doc: "CaseRange represents a range of Unicode code points for simple (one code point to one code point) case conversion.", Pos: "letter.go:56"},
+		"unicode.SpecialCase": {Sig: "type SpecialCase []CaseRange", Doc: "SpecialCase represents language-specific case mappings such as Turkish.", Pos: "letter.go:64"},
+		"unicode.UpperCase": {Sig: "const UpperCase", Doc: "Indices into the Delta arrays inside CaseRanges for case mapping.", Pos: "letter.go:71"},
+		"unicode.LowerCase": {Sig: "const LowerCase", Doc: "Indices into the Delta arrays inside CaseRanges for case mapping.", Pos: "letter.go:72"},
+		"unicode.TitleCase": {Sig: "const TitleCase", Doc: "Indices into the Delta arrays inside CaseRanges for case mapping.", Pos: "letter.go:73"},
+		"unicode.MaxCase": {Sig: "const MaxCase", Doc: "Indices into the Delta arrays inside CaseRanges for case mapping.", Pos: "letter.go:74"},
+		"unicode.UpperLower": {Sig: "const UpperLower", Doc: "If the Delta field of a [CaseRange] is UpperLower, it means this CaseRange represents a sequence of the form (say) [Upper] [Lower] [Upper] [Lower].", Pos: "letter.go:83"},
+		"unicode.Is": {Sig: "func Is(rangeTab *RangeTable, r rune) bool", Doc: "Is reports whether the rune is in the specified table of ranges.", Pos: "letter.go:157"},
+		"unicode.IsUpper": {Sig: "func IsUpper(r rune) bool", Doc: "IsUpper reports whether the rune is an upper case letter.", Pos: "letter.go:184"},
+		"unicode.IsLower": {Sig: "func IsLower(r rune) bool", Doc: "IsLower reports whether the rune is a lower case letter.", Pos: "letter.go:193"},
+		"unicode.IsTitle": {Sig: "func IsTitle(r rune) bool", Doc: "IsTitle reports whether the rune is a title case letter.", Pos: "letter.go:202"},
+		"unicode.To": {Sig: "func To(_case int, r rune) rune", Doc: "To maps the rune to the specified case: [UpperCase], [LowerCase], or [TitleCase].", Pos: "letter.go:262"},
+		"unicode.ToUpper": {Sig: "func ToUpper(r rune) rune", Doc: "ToUpper maps the rune to upper case.", Pos: "letter.go:268"},
+		"unicode.ToLower": {Sig: "func ToLower(r rune) rune", Doc: "ToLower maps the rune to lower case.", Pos: "letter.go:279"},
+		"unicode.ToTitle": {Sig: "func ToTitle(r rune) rune", Doc: "ToTitle maps the rune to title case.", Pos: "letter.go:290"},
+		"unicode.SpecialCase.ToUpper": {Sig: "func (special SpecialCase) ToUpper(r rune) rune", Doc: "ToUpper maps the rune to upper case giving priority to the special mapping.", Pos: "letter.go:301"},
+		"unicode.SpecialCase.ToTitle": {Sig: "func (special SpecialCase) ToTitle(r rune) rune", Doc: "ToTitle maps the rune to title case giving priority to the special mapping.", Pos: "letter.go:310"},
+		"unicode.SpecialCase.ToLower": {Sig: "func (special SpecialCase) ToLower(r rune) rune", Doc: "ToLower maps the rune to lower case giving priority to the special mapping.", Pos: "letter.go:319"},
+		"unicode.SimpleFold": {Sig: "func SimpleFold(r rune) rune", Doc: "SimpleFold iterates over Unicode code points equivalent under the Unicode-defined simple case folding.", Pos: "letter.go:354"},
+		"unicode.Version": {Sig: "const Version", Doc: "Version is the Unicode edition from which the tables are derived.", Pos: "tables.go:6"},
+		"unicode.Categories": {Sig: "var Categories", Doc: "Categories is the set of Unicode category tables.", Pos: "tables.go:9"},
+		"unicode.CategoryAliases": {Sig: "var CategoryAliases", Doc: "CategoryAliases maps category aliases to standard category names.", Pos: "tables.go:51"},
+		"unicode.Cc": {Sig: "var Cc", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5347"},
+		"unicode.Cf": {Sig: "var Cf", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5348"},
+		"unicode.Cn": {Sig: "var Cn", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5349"},
+		"unicode.Co": {Sig: "var Co", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5350"},
+		"unicode.Cs": {Sig: "var Cs", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5351"},
+		"unicode.Digit": {Sig: "var Digit", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5352"},
+		"unicode.Nd": {Sig: "var Nd", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5353"},
+		"unicode.LC": {Sig: "var LC", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5354"},
+		"unicode.Letter": {Sig: "var Letter", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5355"},
+		"unicode.L": {Sig: "var L", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5356"},
+		"unicode.Lm": {Sig: "var Lm", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5357"},
+		"unicode.Lo": {Sig: "var Lo", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5358"},
+		"unicode.Lower": {Sig: "var Lower", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5359"},
+		"unicode.Ll": {Sig: "var Ll", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5360"},
+		"unicode.Mark": {Sig: "var Mark", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5361"},
+		"unicode.M": {Sig: "var M", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5362"},
+		"unicode.Mc": {Sig: "var Mc", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5363"},
+		"unicode.Me": {Sig: "var Me", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5364"},
+		"unicode.Mn": {Sig: "var Mn", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5365"},
+		"unicode.Nl": {Sig: "var Nl", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5366"},
+		"unicode.No": {Sig: "var No", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5367"},
+		"unicode.Number": {Sig: "var Number", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5368"},
+		"unicode.N": {Sig: "var N", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5369"},
+		"unicode.Other": {Sig: "var Other", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5370"},
+		"unicode.C": {Sig: "var C", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5371"},
+		"unicode.Pc": {Sig: "var Pc", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5372"},
+		"unicode.Pd": {Sig: "var Pd", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5373"},
+		"unicode.Pe": {Sig: "var Pe", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5374"},
+		"unicode.Pf": {Sig: "var Pf", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5375"},
+		"unicode.Pi": {Sig: "var Pi", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5376"},
+		"unicode.Po": {Sig: "var Po", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5377"},
+		"unicode.Ps": {Sig: "var Ps", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5378"},
+		"unicode.Punct": {Sig: "var Punct", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5379"},
+		"unicode.P": {Sig: "var P", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5380"},
+		"unicode.Sc": {Sig: "var Sc", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5381"},
+		"unicode.Sk": {Sig: "var Sk", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5382"},
+		"unicode.Sm": {Sig: "var Sm", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5383"},
+		"unicode.So": {Sig: "var So", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5384"},
+		"unicode.Space": {Sig: "var Space", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5385"},
+		"unicode.Z": {Sig: "var Z", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5386"},
+		"unicode.Symbol": {Sig: "var Symbol", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5387"},
+		"unicode.S": {Sig: "var S", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5388"},
+		"unicode.Title": {Sig: "var Title", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5389"},
+		"unicode.Lt": {Sig: "var Lt", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5390"},
+		"unicode.Upper": {Sig: "var Upper", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5391"},
+		"unicode.Lu": {Sig: "var Lu", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5392"},
+		"unicode.Zl": {Sig: "var Zl", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5393"},
+		"unicode.Zp": {Sig: "var Zp", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5394"},
+		"unicode.Zs": {Sig: "var Zs", Doc: "These variables have type *RangeTable.", Pos: "tables.go:5395"},
+		"unicode.Scripts": {Sig: "var Scripts", Doc: "Scripts is the set of Unicode script tables.", Pos: "tables.go:5399"},
+		"unicode.Adlam": {Sig: "var Adlam", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7539"},
+		"unicode.Ahom": {Sig: "var Ahom", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7540"},
+		"unicode.Anatolian_Hieroglyphs": {Sig: "var Anatolian_Hieroglyphs", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7541"},
+		"unicode.Arabic": {Sig: "var Arabic", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7542"},
+		"unicode.Armenian": {Sig: "var Armenian", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7543"},
+		"unicode.Avestan": {Sig: "var Avestan", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7544"},
+		"unicode.Balinese": {Sig: "var Balinese", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7545"},
+		"unicode.Bamum": {Sig: "var Bamum", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7546"},
+		"unicode.Bassa_Vah": {Sig: "var Bassa_Vah", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7547"},
+		"unicode.Batak": {Sig: "var Batak", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7548"},
+		"unicode.Bengali": {Sig: "var Bengali", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7549"},
+		"unicode.Beria_Erfe": {Sig: "var Beria_Erfe", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7550"},
+		"unicode.Bhaiksuki": {Sig: "var Bhaiksuki", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7551"},
+		"unicode.Bopomofo": {Sig: "var Bopomofo", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7552"},
+		"unicode.Brahmi": {Sig: "var Brahmi", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7553"},
+		"unicode.Braille": {Sig: "var Braille", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7554"},
+		"unicode.Buginese": {Sig: "var Buginese", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7555"},
+		"unicode.Buhid": {Sig: "var Buhid", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7556"},
+		"unicode.Canadian_Aboriginal": {Sig: "var Canadian_Aboriginal", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7557"},
+		"unicode.Carian": {Sig: "var Carian", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7558"},
+		"unicode.Caucasian_Albanian": {Sig: "var Caucasian_Albanian", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7559"},
+		"unicode.Chakma": {Sig: "var Chakma", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7560"},
+		"unicode.Cham": {Sig: "var Cham", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7561"},
+		"unicode.Cherokee": {Sig: "var Cherokee", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7562"},
+		"unicode.Chorasmian": {Sig: "var Chorasmian", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7563"},
+		"unicode.Common": {Sig: "var Common", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7564"},
+		"unicode.Coptic": {Sig: "var Coptic", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7565"},
+		"unicode.Cuneiform": {Sig: "var Cuneiform", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7566"},
+		"unicode.Cypriot": {Sig: "var Cypriot", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7567"},
+		"unicode.Cypro_Minoan": {Sig: "var Cypro_Minoan", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7568"},
+		"unicode.Cyrillic": {Sig: "var Cyrillic", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7569"},
+		"unicode.Deseret": {Sig: "var Deseret", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7570"},
+		"unicode.Devanagari": {Sig: "var Devanagari", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7571"},
+		"unicode.Dives_Akuru": {Sig: "var Dives_Akuru", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7572"},
+		"unicode.Dogra": {Sig: "var Dogra", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7573"},
+		"unicode.Duployan": {Sig: "var Duployan", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7574"},
+		"unicode.Egyptian_Hieroglyphs": {Sig: "var Egyptian_Hieroglyphs", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7575"},
+		"unicode.Elbasan": {Sig: "var Elbasan", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7576"},
+		"unicode.Elymaic": {Sig: "var Elymaic", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7577"},
+		"unicode.Ethiopic": {Sig: "var Ethiopic", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7578"},
+		"unicode.Garay": {Sig: "var Garay", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7579"},
+		"unicode.Georgian": {Sig: "var Georgian", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7580"},
+		"unicode.Glagolitic": {Sig: "var Glagolitic", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7581"},
+		"unicode.Gothic": {Sig: "var Gothic", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7582"},
+		"unicode.Grantha": {Sig: "var Grantha", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7583"},
+		"unicode.Greek": {Sig: "var Greek", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7584"},
+		"unicode.Gujarati": {Sig: "var Gujarati", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7585"},
+		"unicode.Gunjala_Gondi": {Sig: "var Gunjala_Gondi", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7586"},
+		"unicode.Gurmukhi": {Sig: "var Gurmukhi", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7587"},
+		"unicode.Gurung_Khema": {Sig: "var Gurung_Khema", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7588"},
+		"unicode.Han": {Sig: "var Han", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7589"},
+		"unicode.Hangul": {Sig: "var Hangul", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7590"},
+		"unicode.Hanifi_Rohingya": {Sig: "var Hanifi_Rohingya", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7591"},
+		"unicode.Hanunoo": {Sig: "var Hanunoo", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7592"},
+		"unicode.Hatran": {Sig: "var Hatran", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7593"},
+		"unicode.Hebrew": {Sig: "var Hebrew", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7594"},
+		"unicode.Hiragana": {Sig: "var Hiragana", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7595"},
+		"unicode.Imperial_Aramaic": {Sig: "var Imperial_Aramaic", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7596"},
+		"unicode.Inherited": {Sig: "var Inherited", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7597"},
+		"unicode.Inscriptional_Pahlavi": {Sig: "var Inscriptional_Pahlavi", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7598"},
+		"unicode.Inscriptional_Parthian": {Sig: "var Inscriptional_Parthian", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7599"},
+		"unicode.Javanese": {Sig: "var Javanese", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7600"},
+		"unicode.Kaithi": {Sig: "var Kaithi", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7601"},
+		"unicode.Kannada": {Sig: "var Kannada", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7602"},
+		"unicode.Katakana": {Sig: "var Katakana", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7603"},
+		"unicode.Kawi": {Sig: "var Kawi", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7604"},
+		"unicode.Kayah_Li": {Sig: "var Kayah_Li", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7605"},
+		"unicode.Kharoshthi": {Sig: "var Kharoshthi", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7606"},
+		"unicode.Khitan_Small_Script": {Sig: "var Khitan_Small_Script", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7607"},
+		"unicode.Khmer": {Sig: "var Khmer", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7608"},
+		"unicode.Khojki": {Sig: "var Khojki", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7609"},
+		"unicode.Khudawadi": {Sig: "var Khudawadi", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7610"},
+		"unicode.Kirat_Rai": {Sig: "var Kirat_Rai", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7611"},
+		"unicode.Lao": {Sig: "var Lao", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7612"},
+		"unicode.Latin": {Sig: "var Latin", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7613"},
+		"unicode.Lepcha": {Sig: "var Lepcha", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7614"},
+		"unicode.Limbu": {Sig: "var Limbu", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7615"},
+		"unicode.Linear_A": {Sig: "var Linear_A", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7616"},
+		"unicode.Linear_B": {Sig: "var Linear_B", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7617"},
+		"unicode.Lisu": {Sig: "var Lisu", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7618"},
+		"unicode.Lycian": {Sig: "var Lycian", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7619"},
+		"unicode.Lydian": {Sig: "var Lydian", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7620"},
+		"unicode.Mahajani": {Sig: "var Mahajani", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7621"},
+		"unicode.Makasar": {Sig: "var Makasar", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7622"},
+		"unicode.Malayalam": {Sig: "var Malayalam", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7623"},
+		"unicode.Mandaic": {Sig: "var Mandaic", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7624"},
+		"unicode.Manichaean": {Sig: "var Manichaean", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7625"},
+		"unicode.Marchen": {Sig: "var Marchen", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7626"},
+		"unicode.Masaram_Gondi": {Sig: "var Masaram_Gondi", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7627"},
+		"unicode.Medefaidrin": {Sig: "var Medefaidrin", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7628"},
+		"unicode.Meetei_Mayek": {Sig: "var Meetei_Mayek", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7629"},
+		"unicode.Mende_Kikakui": {Sig: "var Mende_Kikakui", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7630"},
+		"unicode.Meroitic_Cursive": {Sig: "var Meroitic_Cursive", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7631"},
+		"unicode.Meroitic_Hieroglyphs": {Sig: "var Meroitic_Hieroglyphs", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7632"},
+		"unicode.Miao": {Sig: "var Miao", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7633"},
+		"unicode.Modi": {Sig: "var Modi", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7634"},
+		"unicode.Mongolian": {Sig: "var Mongolian", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7635"},
+		"unicode.Mro": {Sig: "var Mro", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7636"},
+		"unicode.Multani": {Sig: "var Multani", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7637"},
+		"unicode.Myanmar": {Sig: "var Myanmar", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7638"},
+		"unicode.Nabataean": {Sig: "var Nabataean", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7639"},
+		"unicode.Nag_Mundari": {Sig: "var Nag_Mundari", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7640"},
+		"unicode.Nandinagari": {Sig: "var Nandinagari", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7641"},
+		"unicode.New_Tai_Lue": {Sig: "var New_Tai_Lue", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7642"},
+		"unicode.Newa": {Sig: "var Newa", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7643"},
+		"unicode.Nko": {Sig: "var Nko", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7644"},
+		"unicode.Nushu": {Sig: "var Nushu", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7645"},
+		"unicode.Nyiakeng_Puachue_Hmong": {Sig: "var Nyiakeng_Puachue_Hmong", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7646"},
+		"unicode.Ogham": {Sig: "var Ogham", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7647"},
+		"unicode.Ol_Chiki": {Sig: "var Ol_Chiki", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7648"},
+		"unicode.Ol_Onal": {Sig: "var Ol_Onal", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7649"},
+		"unicode.Old_Hungarian": {Sig: "var Old_Hungarian", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7650"},
+		"unicode.Old_Italic": {Sig: "var Old_Italic", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7651"},
+		"unicode.Old_North_Arabian": {Sig: "var Old_North_Arabian", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7652"},
+		"unicode.Old_Permic": {Sig: "var Old_Permic", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7653"},
+		"unicode.Old_Persian": {Sig: "var Old_Persian", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7654"},
+		"unicode.Old_Sogdian": {Sig: "var Old_Sogdian", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7655"},
+		"unicode.Old_South_Arabian": {Sig: "var Old_South_Arabian", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7656"},
+		"unicode.Old_Turkic": {Sig: "var Old_Turkic", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7657"},
+		"unicode.Old_Uyghur": {Sig: "var Old_Uyghur", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7658"},
+		"unicode.Oriya": {Sig: "var Oriya", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7659"},
+		"unicode.Osage": {Sig: "var Osage", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7660"},
+		"unicode.Osmanya": {Sig: "var Osmanya", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7661"},
+		"unicode.Pahawh_Hmong": {Sig: "var Pahawh_Hmong", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7662"},
+		"unicode.Palmyrene": {Sig: "var Palmyrene", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7663"},
+		"unicode.Pau_Cin_Hau": {Sig: "var Pau_Cin_Hau", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7664"},
+		"unicode.Phags_Pa": {Sig: "var Phags_Pa", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7665"},
+		"unicode.Phoenician": {Sig: "var Phoenician", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7666"},
+		"unicode.Psalter_Pahlavi": {Sig: "var Psalter_Pahlavi", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7667"},
+		"unicode.Rejang": {Sig: "var Rejang", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7668"},
+		"unicode.Runic": {Sig: "var Runic", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7669"},
+		"unicode.Samaritan": {Sig: "var Samaritan", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7670"},
+		"unicode.Saurashtra": {Sig: "var Saurashtra", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7671"},
+		"unicode.Sharada": {Sig: "var Sharada", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7672"},
+		"unicode.Shavian": {Sig: "var Shavian", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7673"},
+		"unicode.Siddham": {Sig: "var Siddham", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7674"},
+		"unicode.Sidetic": {Sig: "var Sidetic", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7675"},
+		"unicode.SignWriting": {Sig: "var SignWriting", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7676"},
+		"unicode.Sinhala": {Sig: "var Sinhala", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7677"},
+		"unicode.Sogdian": {Sig: "var Sogdian", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7678"},
+		"unicode.Sora_Sompeng": {Sig: "var Sora_Sompeng", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7679"},
+		"unicode.Soyombo": {Sig: "var Soyombo", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7680"},
+		"unicode.Sundanese": {Sig: "var Sundanese", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7681"},
+		"unicode.Sunuwar": {Sig: "var Sunuwar", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7682"},
+		"unicode.Syloti_Nagri": {Sig: "var Syloti_Nagri", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7683"},
+		"unicode.Syriac": {Sig: "var Syriac", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7684"},
+		"unicode.Tagalog": {Sig: "var Tagalog", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7685"},
+		"unicode.Tagbanwa": {Sig: "var Tagbanwa", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7686"},
+		"unicode.Tai_Le": {Sig: "var Tai_Le", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7687"},
+		"unicode.Tai_Tham": {Sig: "var Tai_Tham", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7688"},
+		"unicode.Tai_Viet": {Sig: "var Tai_Viet", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7689"},
+		"unicode.Tai_Yo": {Sig: "var Tai_Yo", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7690"},
+		"unicode.Takri": {Sig: "var Takri", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7691"},
+		"unicode.Tamil": {Sig: "var Tamil", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7692"},
+		"unicode.Tangsa": {Sig: "var Tangsa", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7693"},
+		"unicode.Tangut": {Sig: "var Tangut", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7694"},
+		"unicode.Telugu": {Sig: "var Telugu", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7695"},
+		"unicode.Thaana": {Sig: "var Thaana", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7696"},
+		"unicode.Thai": {Sig: "var Thai", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7697"},
+		"unicode.Tibetan": {Sig: "var Tibetan", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7698"},
+		"unicode.Tifinagh": {Sig: "var Tifinagh", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7699"},
+		"unicode.Tirhuta": {Sig: "var Tirhuta", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7700"},
+		"unicode.Todhri": {Sig: "var Todhri", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7701"},
+		"unicode.Tolong_Siki": {Sig: "var Tolong_Siki", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7702"},
+		"unicode.Toto": {Sig: "var Toto", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7703"},
+		"unicode.Tulu_Tigalari": {Sig: "var Tulu_Tigalari", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7704"},
+		"unicode.Ugaritic": {Sig: "var Ugaritic", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7705"},
+		"unicode.Vai": {Sig: "var Vai", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7706"},
+		"unicode.Vithkuqi": {Sig: "var Vithkuqi", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7707"},
+		"unicode.Wancho": {Sig: "var Wancho", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7708"},
+		"unicode.Warang_Citi": {Sig: "var Warang_Citi", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7709"},
+		"unicode.Yezidi": {Sig: "var Yezidi", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7710"},
+		"unicode.Yi": {Sig: "var Yi", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7711"},
+		"unicode.Zanabazar_Square": {Sig: "var Zanabazar_Square", Doc: "These variables have type *RangeTable.", Pos: "tables.go:7712"},
+		"unicode.Properties": {Sig: "var Properties", Doc: "Properties is the set of Unicode property tables.", Pos: "tables.go:7716"},
+		"unicode.ASCII_Hex_Digit": {Sig: "var ASCII_Hex_Digit", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9044"},
+		"unicode.Bidi_Control": {Sig: "var Bidi_Control", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9045"},
+		"unicode.Dash": {Sig: "var Dash", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9046"},
+		"unicode.Deprecated": {Sig: "var Deprecated", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9047"},
+		"unicode.Diacritic": {Sig: "var Diacritic", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9048"},
+		"unicode.Extender": {Sig: "var Extender", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9049"},
+		"unicode.Hex_Digit": {Sig: "var Hex_Digit", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9050"},
+		"unicode.Hyphen": {Sig: "var Hyphen", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9051"},
+		"unicode.IDS_Binary_Operator": {Sig: "var IDS_Binary_Operator", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9052"},
+		"unicode.IDS_Trinary_Operator": {Sig: "var IDS_Trinary_Operator", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9053"},
+		"unicode.IDS_Unary_Operator": {Sig: "var IDS_Unary_Operator", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9054"},
+		"unicode.ID_Compat_Math_Continue": {Sig: "var ID_Compat_Math_Continue", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9055"},
+		"unicode.ID_Compat_Math_Start": {Sig: "var ID_Compat_Math_Start", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9056"},
+		"unicode.Ideographic": {Sig: "var Ideographic", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9057"},
+		"unicode.Join_Control": {Sig: "var Join_Control", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9058"},
+		"unicode.Logical_Order_Exception": {Sig: "var Logical_Order_Exception", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9059"},
+		"unicode.Modifier_Combining_Mark": {Sig: "var Modifier_Combining_Mark", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9060"},
+		"unicode.Noncharacter_Code_Point": {Sig: "var Noncharacter_Code_Point", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9061"},
+		"unicode.Other_Alphabetic": {Sig: "var Other_Alphabetic", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9062"},
+		"unicode.Other_Default_Ignorable_Code_Point": {Sig: "var Other_Default_Ignorable_Code_Point", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9063"},
+		"unicode.Other_Grapheme_Extend": {Sig: "var Other_Grapheme_Extend", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9064"},
+		"unicode.Other_ID_Continue": {Sig: "var Other_ID_Continue", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9065"},
+		"unicode.Other_ID_Start": {Sig: "var Other_ID_Start", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9066"},
+		"unicode.Other_Lowercase": {Sig: "var Other_Lowercase", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9067"},
+		"unicode.Other_Math": {Sig: "var Other_Math", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9068"},
+		"unicode.Other_Uppercase": {Sig: "var Other_Uppercase", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9069"},
+		"unicode.Pattern_Syntax": {Sig: "var Pattern_Syntax", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9070"},
+		"unicode.Pattern_White_Space": {Sig: "var Pattern_White_Space", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9071"},
+		"unicode.Prepended_Concatenation_Mark": {Sig: "var Prepended_Concatenation_Mark", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9072"},
+		"unicode.Quotation_Mark": {Sig: "var Quotation_Mark", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9073"},
+		"unicode.Radical": {Sig: "var Radical", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9074"},
+		"unicode.Regional_Indicator": {Sig: "var Regional_Indicator", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9075"},
+		"unicode.STerm": {Sig: "var STerm", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9076"},
+		"unicode.Sentence_Terminal": {Sig: "var Sentence_Terminal", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9077"},
+		"unicode.Soft_Dotted": {Sig: "var Soft_Dotted", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9078"},
+		"unicode.Terminal_Punctuation": {Sig: "var Terminal_Punctuation", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9079"},
+		"unicode.Unified_Ideograph": {Sig: "var Unified_Ideograph", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9080"},
+		"unicode.Variation_Selector": {Sig: "var Variation_Selector", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9081"},
+		"unicode.White_Space": {Sig: "var White_Space", Doc: "These variables have type *RangeTable.", Pos: "tables.go:9082"},
+		"unicode.CaseRanges": {Sig: "var CaseRanges", Doc: "CaseRanges is the table describing case mappings for all letters with non-self mappings.", Pos: "tables.go:9087"},
+		"unicode.FoldCategory": {Sig: "var FoldCategory", Doc: "FoldCategory maps a category name to a table of code points outside the category that are equivalent under simple case folding to code points inside the category.", Pos: "tables.go:9917"},
+		"unicode.FoldScript": {Sig: "var FoldScript", Doc: "FoldScript maps a script name to a table of code points outside the script that are equivalent under simple case folding to code points inside the script.", Pos: "tables.go:10221"},
+		"unicode/utf8": {Doc: "Package utf8 implements functions and constants to support text encoded in UTF-8."},
+		"unicode/utf8.RuneError": {Sig: "const RuneError", Doc: "Numbers fundamental to the encoding.", Pos: "utf8.go:16"},
+		"unicode/utf8.RuneSelf": {Sig: "const RuneSelf", Doc: "Numbers fundamental to the encoding.", Pos: "utf8.go:17"},
+		"unicode/utf8.MaxRune": {Sig: "const MaxRune", Doc: "Numbers fundamental to the encoding.", Pos: "utf8.go:18"},
+		"unicode/utf8.UTFMax": {Sig: "const UTFMax", Doc: "Numbers fundamental to the encoding.", Pos: "utf8.go:19"},
+		"unicode/utf8.FullRune": {Sig: "func FullRune(p []byte) bool", Doc: "FullRune reports whether the bytes in p begin with a full UTF-8 encoding of a rune.", Pos: "utf8.go:110"},
+		"unicode/utf8.FullRuneInString": {Sig: "func FullRuneInString(s string) bool", Doc: "FullRuneInString is like FullRune but its input is a string.", Pos: "utf8.go:130"},
+		"unicode/utf8.DecodeRune": {Sig: "func DecodeRune(p []byte) (r rune, size int)", Doc: "DecodeRune unpacks the first UTF-8 encoding in p and returns the rune and its width in bytes.", Pos: "utf8.go:157"},
+		"unicode/utf8.DecodeRuneInString": {Sig: "func DecodeRuneInString(s string) (r rune, size int)", Doc: "DecodeRuneInString is like [DecodeRune] but its input is a string.", Pos: "utf8.go:219"},
+		"unicode/utf8.DecodeLastRune": {Sig: "func DecodeLastRune(p []byte) (r rune, size int)", Doc: "DecodeLastRune unpacks the last UTF-8 encoding in p and returns the rune and its width in bytes.", Pos: "utf8.go:279"},
+		"unicode/utf8.DecodeLastRuneInString": {Sig: "func DecodeLastRuneInString(s string) (r rune, size int)", Doc: "DecodeLastRuneInString is like [DecodeLastRune] but its input is a string.", Pos: "utf8.go:316"},
+		"unicode/utf8.RuneLen": {Sig: "func RuneLen(r rune) int", Doc: "RuneLen returns the number of bytes in the UTF-8 encoding of the rune.", Pos: "utf8.go:347"},
+		"unicode/utf8.EncodeRune": {Sig: "func EncodeRune(p []byte, r rune) int", Doc: "EncodeRune writes into p (which must be large enough) the UTF-8 encoding of the rune.", Pos: "utf8.go:368"},
+		"unicode/utf8.AppendRune": {Sig: "func AppendRune(p []byte, r rune) []byte", Doc: "AppendRune appends the UTF-8 encoding of r to the end of p and returns the extended buffer.", Pos: "utf8.go:410"},
+		"unicode/utf8.RuneCount": {Sig: "func RuneCount(p []byte) int", Doc: "RuneCount returns the number of runes in p.", Pos: "utf8.go:434"},
+		"unicode/utf8.RuneCountInString": {Sig: "func RuneCountInString(s string) (n int)", Doc: "RuneCountInString is like [RuneCount] but its input is a string.", Pos: "utf8.go:447"},
+		"unicode/utf8.RuneStart": {Sig: "func RuneStart(b byte) bool", Doc: "RuneStart reports whether the byte could be the first byte of an encoded, possibly invalid rune.", Pos: "utf8.go:457"},
+		"unicode/utf8.Valid": {Sig: "func Valid(p []byte) bool", Doc: "Valid reports whether p consists entirely of valid UTF-8-encoded runes.", Pos: "utf8.go:470"},
+		"unicode/utf8.ValidString": {Sig: "func ValidString(s string) bool", Doc: "ValidString reports whether s consists entirely of valid UTF-8-encoded runes.", Pos: "utf8.go:522"},
+		"unicode/utf8.ValidRune": {Sig: "func ValidRune(r rune) bool", Doc: "ValidRune reports whether r can be legally encoded as UTF-8.", Pos: "utf8.go:570"},
+	})
+}
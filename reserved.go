@@ -0,0 +1,65 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Names the REPL reserves for itself
+
+package repl
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"reflect"
+
+	"github.com/rocky/eval"
+)
+
+// GofishPkgName is the name of the pseudo-package holding what go-fish
+// provides: gofish.Results and gofish.Env.
+const GofishPkgName = "gofish"
+
+// ReservedNames are the names go-fish puts in the environment, which
+// can't be declared in the session.
+var ReservedNames = map[string]bool{
+	"env":         true,
+	"results":     true,
+	GofishPkgName: true,
+}
+
+// CheckReserved returns an error if name is reserved.
+func CheckReserved(name string) error {
+	if ReservedNames[name] {
+		return fmt.Errorf("%s is reserved by go-fish; use another name", name)
+	}
+	return nil
+}
+
+// checkReservedStmt returns an error if stmt declares a reserved name,
+// or assigns to one other than "results". Assigning to "results"
+// changes go-fish's own slice, which is fine.
+func checkReservedStmt(stmt ast.Stmt) error {
+	names, _, _ := definedNames(stmt)
+	if assign, ok := stmt.(*ast.AssignStmt); ok && assign.Tok != token.DEFINE {
+		for _, lhs := range assign.Lhs {
+			if id, ok := lhs.(*ast.Ident); ok && id.Name != "results" {
+				names = append(names, id.Name)
+			}
+		}
+	}
+	for _, name := range names {
+		if err := CheckReserved(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// gofishPkg returns the pseudo-package gofish. Its variables refer to
+// go-fish's own, so they follow "reset" and "env use".
+func gofishPkg() *eval.SimpleEnv {
+	pkg := eval.MakeSimpleEnv()
+	pkg.Vars["Results"] = reflect.ValueOf(&results)
+	pkg.Vars["Env"] = reflect.ValueOf(&Env)
+	return pkg
+}
@@ -0,0 +1,39 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rocky/go-fish"
+)
+
+// TestReservedNames checks that statements declaring or assigning
+// go-fish's own names are refused, leaving them as they were.
+func TestReservedNames(t *testing.T) {
+	for _, line := range []string{
+		"env := 1",
+		"x, results := 1, 2",
+		"env = nil",
+		"var gofish int",
+		"const results = 1",
+	} {
+		env := repl.NewEnv()
+		out := runCommands(env, line)
+		if !strings.Contains(out, "is reserved by go-fish") {
+			t.Errorf("%s: not refused:\n%s", line, out)
+		}
+		if got := env.Vars["env"].Interface(); got != env {
+			t.Errorf("%s: env is now %v", line, got)
+		}
+		if _, ok := env.Vars["x"]; ok {
+			t.Errorf("%s: x was defined", line)
+		}
+	}
+	if err := repl.CheckReserved("fish"); err != nil {
+		t.Errorf("CheckReserved(fish): %s", err)
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Restarting go-fish
+
+package repl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"syscall"
+)
+
+// ReplayEnvVar names the environment variable that, on restart, gives
+// the file holding the input to replay.
+const ReplayEnvVar = "GOFISH_REPLAY"
+
+// BeforeRestart, if not nil, is called just before go-fish restarts.
+// Front ends set it to save state, such as command history, since
+// deferred functions don't run when we restart.
+var BeforeRestart func()
+
+// Restart runs go-fish again, from the directory it was started in
+// and with the same arguments, replacing the current process. If
+// GOFISH_RESTART_CMD was set, that shell command is run instead. If
+// replay is true, the input that was evaluated without error is
+// replayed after the restart. Restart only returns if it fails.
+func Restart(replay bool) error {
+	if err := os.Chdir(initial_cwd); err != nil {
+		return err
+	}
+	var argv0 string
+	var argv []string
+	if GOFISH_RESTART_CMD != "" {
+		argv0, argv = "/bin/sh", []string{"sh", "-c", GOFISH_RESTART_CMD}
+	} else {
+		path, err := exec.LookPath(os.Args[0])
+		if err != nil {
+			return err
+		}
+		argv0, argv = path, os.Args
+	}
+	os.Unsetenv(ReplayEnvVar)
+	if replay {
+		filename, err := writeReplay()
+		if err != nil {
+			return err
+		}
+		os.Setenv(ReplayEnvVar, filename)
+	}
+	if BeforeRestart != nil {
+		BeforeRestart()
+	}
+	if Logging() {
+		StopLogging()
+	}
+	return syscall.Exec(argv0, argv, os.Environ())
+}
+
+// replayEntries returns the input to replay to rebuild the session:
+// the imports done at the prompt, then the session's input. An entry
+// may span several lines, say a function declaration.
+func replayEntries() []string {
+	var entries []string
+	names := make([]string, 0, len(ImportPaths))
+	for name := range ImportPaths {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		entries = append(entries, fmt.Sprintf("import %s %q", name, ImportPaths[name]))
+	}
+	for _, entry := range Session {
+		entries = append(entries, entry.Input)
+	}
+	return entries
+}
+
+// writeReplay writes the input to replay to a temporary file, as a
+// JSON list of entries, and returns its name.
+func writeReplay() (string, error) {
+	file, err := ioutil.TempFile("", "gofish-replay")
+	if err != nil {
+		return "", err
+	}
+	if err := json.NewEncoder(file).Encode(replayEntries()); err != nil {
+		file.Close()
+		return "", err
+	}
+	if err := file.Close(); err != nil {
+		return "", err
+	}
+	return file.Name(), nil
+}
+
+// replayReadLineFn returns a read line function which first returns
+// the entries of the replay file given by the environment, if any,
+// and then the lines of readLineFn. An entry is returned whole, even
+// if it spans several lines. The replay file is removed once read.
+//
+// Paging is off while replaying: the pager's prompt would otherwise
+// read the entries that follow.
+func replayReadLineFn(readLineFn ReadLineFnType) ReadLineFnType {
+	filename := os.Getenv(ReplayEnvVar)
+	if filename == "" {
+		return readLineFn
+	}
+	os.Unsetenv(ReplayEnvVar)
+	data, err := ioutil.ReadFile(filename)
+	os.Remove(filename)
+	if err != nil {
+		Errmsg("Can't replay session: %s", err)
+		return readLineFn
+	}
+	var entries []string
+	if err := json.Unmarshal(data, &entries); err != nil {
+		Errmsg("Can't replay session: %s", err)
+		return readLineFn
+	}
+	if len(entries) == 0 {
+		return readLineFn
+	}
+	Msg("Replaying %d entries of input", len(entries))
+	savedPager := Pager
+	Pager = PAGER_OFF
+	return func(prompt string, add_history ...bool) (string, error) {
+		if len(entries) == 0 {
+			Pager = savedPager
+			return readLineFn(prompt, add_history...)
+		}
+		entry := entries[0]
+		entries = entries[1:]
+		Msg("%s%s", prompt, strings.Replace(entry, "\n", "\n"+ContinuationPrompt, -1))
+		return entry, nil
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/rocky/go-fish"
+)
+
+// TestReplay checks that replayed entries are each read whole, and
+// that the pager doesn't read any of them as an answer to its prompt.
+func TestReplay(t *testing.T) {
+	entries := []string{
+		"help",
+		"type point struct {\n\tX, Y int\n}",
+		"help set",
+	}
+	data, err := json.Marshal(entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	filename, cleanup := writeTemp(t, "replay.json", string(data))
+	defer cleanup()
+
+	savedPager, savedHeight := repl.Pager, repl.Maxheight
+	defer func() { repl.Pager, repl.Maxheight = savedPager, savedHeight }()
+	repl.Pager, repl.Maxheight = repl.PAGER_ON, 3
+
+	repl.Reset(false)
+	os.Setenv(repl.ReplayEnvVar, filename)
+	defer os.Unsetenv(repl.ReplayEnvVar)
+	out := captureOutput(func() {
+		repl.REPL(repl.Env, scriptedInput(), repl.SimpleInspect)
+	})
+	for _, want := range []string{
+		repl.Prompt + "help\n",
+		repl.Prompt + "type point struct {\n" + repl.ContinuationPrompt + "\tX, Y int\n" +
+			repl.ContinuationPrompt + "}\n",
+		repl.Prompt + "help set\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("%q wasn't replayed; got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "--More--") {
+		t.Errorf("output was paged while replaying")
+	}
+	if repl.Pager != repl.PAGER_ON {
+		t.Errorf("paging wasn't turned back on after replaying")
+	}
+	if repl.Env.Type("point") == nil {
+		t.Errorf("type point wasn't declared")
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Saving session variables to, and restoring them from, a file
+
+package repl
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/parser"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/rocky/eval"
+)
+
+// savedVar is how a variable is written by SaveVars. Type is the Go
+// type as reflect shows it, e.g. "map[string]*bytes.Buffer"; package
+// qualifiers are package names, which must be in the environment on
+// loading.
+type savedVar struct {
+	Name  string          `json:"name"`
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// savedVars is the contents of a file written by SaveVars.
+type savedVars struct {
+	Vars []savedVar `json:"vars"`
+}
+
+// SaveVars writes the variables defined in env, with their types, to
+// filename as JSON. Variables whose values can't be represented, such
+// as functions and channels, are skipped with a warning. So are those
+// that wouldn't read back the same, say because of unexported fields
+// or numbers held in an interface{}. The number of variables saved is
+// returned.
+func SaveVars(filename string, env *eval.SimpleEnv) (saved int, warnings []string, err error) {
+	var out savedVars
+	for _, name := range UserVars(env) {
+		v, _ := VarValue(env, name)
+		if !v.IsValid() {
+			continue
+		}
+		switch v.Kind() {
+		case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+			warnings = append(warnings,
+				fmt.Sprintf("skipping %s: a %s can't be saved", name, v.Kind()))
+			continue
+		}
+		data, err := json.Marshal(v.Interface())
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("skipping %s: %s", name, err))
+			continue
+		}
+		if !roundTrips(v, data) {
+			warnings = append(warnings,
+				fmt.Sprintf("skipping %s: a %s doesn't read back the same from JSON", name, v.Type()))
+			continue
+		}
+		out.Vars = append(out.Vars, savedVar{Name: name, Type: v.Type().String(), Value: data})
+	}
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return 0, warnings, err
+	}
+	if err := ioutil.WriteFile(filename, append(data, '\n'), 0644); err != nil {
+		return 0, warnings, err
+	}
+	return len(out.Vars), warnings, nil
+}
+
+// roundTrips returns true if data, the JSON for v, reads back as a
+// value equal to v.
+func roundTrips(v reflect.Value, data []byte) bool {
+	back := reflect.New(v.Type())
+	if err := json.Unmarshal(data, back.Interface()); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(back.Elem().Interface(), v.Interface())
+}
+
+// LoadVars defines in env the variables saved in filename by
+// SaveVars, replacing variables with the same names. Types are
+// resolved in env, so packages the types come from must be there.
+// Variables that can't be restored are skipped with a warning. The
+// number of variables loaded is returned.
+func LoadVars(filename string, env *eval.SimpleEnv) (loaded int, warnings []string, err error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return 0, nil, err
+	}
+	var in savedVars
+	if err := json.Unmarshal(data, &in); err != nil {
+		return 0, nil, fmt.Errorf("%s: %s", filename, err)
+	}
+	for _, saved := range in.Vars {
+		if err := CheckReserved(saved.Name); err != nil {
+			warnings = append(warnings, fmt.Sprintf("skipping %s: %s", saved.Name, err))
+			continue
+		}
+		typ, err := savedType(saved.Type, env)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("skipping %s: %s", saved.Name, err))
+			continue
+		}
+		v := reflect.New(typ)
+		if err := json.Unmarshal(saved.Value, v.Interface()); err != nil {
+			warnings = append(warnings, fmt.Sprintf("skipping %s: %s", saved.Name, err))
+			continue
+		}
+		env.Vars[saved.Name] = v
+		loaded++
+	}
+	return loaded, warnings, nil
+}
+
+// savedType returns the type that typeString, as written by SaveVars,
+// denotes in env.
+func savedType(typeString string, env *eval.SimpleEnv) (reflect.Type, error) {
+	expr, err := parser.ParseExpr(typeString)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse type %s: %s", typeString, err)
+	}
+	return EvalType(expr, env)
+}
@@ -0,0 +1,73 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/rocky/go-fish"
+)
+
+// TestSaveVarsRoundTrip checks that variables read back by LoadVars
+// are equal to those SaveVars wrote, and that values JSON would change
+// are skipped with a warning rather than saved.
+func TestSaveVarsRoundTrip(t *testing.T) {
+	filename, cleanup := writeTemp(t, "vars.json", "")
+	defer cleanup()
+
+	repl.Reset(false)
+	var any interface{} = 1
+	keep := map[string]interface{}{
+		"n":     42,
+		"s":     "fish",
+		"words": []string{"a", "b"},
+		"count": map[string]int{"a": 1},
+	}
+	for name, value := range keep {
+		setVar(repl.Env.Vars, name, value)
+	}
+	buf := new(bytes.Buffer)
+	buf.WriteString("lost")
+	repl.Env.Vars["buf"] = reflect.ValueOf(&buf)
+	repl.Env.Vars["any"] = reflect.ValueOf(&any)
+
+	saved, warnings, err := repl.SaveVars(filename, repl.Env)
+	if err != nil {
+		t.Fatalf("SaveVars: %s", err)
+	}
+	if saved != len(keep) {
+		t.Errorf("saved %d variables; want %d", saved, len(keep))
+	}
+	if len(warnings) != 2 {
+		t.Errorf("got warnings %q; want one each for any and buf", warnings)
+	}
+
+	repl.Reset(false)
+	loaded, warnings, err := repl.LoadVars(filename, repl.Env)
+	if err != nil {
+		t.Fatalf("LoadVars: %s", err)
+	}
+	if loaded != len(keep) || len(warnings) != 0 {
+		t.Errorf("loaded %d variables with warnings %q; want %d and none",
+			loaded, warnings, len(keep))
+	}
+	for name, want := range keep {
+		v, ok := repl.VarValue(repl.Env, name)
+		if !ok {
+			t.Errorf("%s wasn't restored", name)
+			continue
+		}
+		if !reflect.DeepEqual(v.Interface(), want) {
+			t.Errorf("%s = %#v; want %#v", name, v.Interface(), want)
+		}
+	}
+	for _, name := range []string{"any", "buf"} {
+		if _, ok := repl.VarValue(repl.Env, name); ok {
+			t.Errorf("%s was restored even though it was skipped", name)
+		}
+	}
+}
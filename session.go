@@ -0,0 +1,42 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Recording what was evaluated in the session
+
+package repl
+
+import (
+	"go/ast"
+)
+
+// SessionEntry is a line of input that was evaluated without error.
+type SessionEntry struct {
+	// Input is the text as it was entered.
+	Input string
+
+	// Stmt is the parsed statement, or nil if the input was a
+	// declaration.
+	Stmt ast.Stmt
+
+	// Decl is the parsed declaration, or nil if the input was a
+	// statement.
+	Decl ast.Decl
+
+	// Values is the number of values produced when Stmt is an
+	// expression statement, and -1 otherwise.
+	Values int
+
+	// Output is what a program running Stmt would print: what the
+	// evaluation wrote to stdout, followed by the values produced,
+	// as fmt.Println would show them.
+	Output string
+}
+
+// Session contains the statements and expressions successfully
+// evaluated so far, in the order they were entered.
+var Session []SessionEntry
+
+func recordSession(entry SessionEntry) {
+	Session = append(Session, entry)
+}
@@ -0,0 +1,77 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rocky/eval"
+	"github.com/rocky/go-fish"
+)
+
+// Package shapes, made from the declarations below, gives the tests of
+// the commands that inspect types something small to look at.
+
+type Shape interface {
+	Area() float64
+}
+
+type Square struct {
+	Side  float64
+	label string
+	ok    bool
+}
+
+func (s Square) Area() float64 { return s.Side * s.Side }
+
+type Circle struct {
+	R float64
+}
+
+func (c *Circle) Area() float64 { return 3 * c.R * c.R }
+
+type Labeled struct {
+	*Square
+	Name string `json:"name"`
+}
+
+type Padded struct {
+	A bool
+	B int64
+	C bool
+}
+
+func Scale(s Square, by float64) Square {
+	s.Side *= by
+	return s
+}
+
+// shapesEnv returns a fresh environment, made current, with package
+// shapes in it.
+func shapesEnv() *eval.SimpleEnv {
+	shapes := eval.MakeSimpleEnv()
+	shapes.Types["Shape"] = reflect.TypeOf((*Shape)(nil)).Elem()
+	shapes.Types["Square"] = reflect.TypeOf(Square{})
+	shapes.Types["Circle"] = reflect.TypeOf(Circle{})
+	shapes.Types["Labeled"] = reflect.TypeOf(Labeled{})
+	shapes.Types["Padded"] = reflect.TypeOf(Padded{})
+	shapes.Funcs["Scale"] = reflect.ValueOf(Scale)
+	repl.Reset(false)
+	repl.Env.Pkgs["shapes"] = shapes
+	return repl.Env
+}
+
+// checkCommand runs line in env and checks that what it shows
+// includes each of wants.
+func checkCommand(t *testing.T, env *eval.SimpleEnv, line string, wants ...string) {
+	out := runCommands(env, line)
+	for _, want := range wants {
+		if !strings.Contains(out, want) {
+			t.Errorf("%s: output doesn't contain %q:\n%s", line, want, out)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Formatting and describing function signatures
+
+package repl
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"reflect"
+	"strings"
+
+	"github.com/rocky/eval"
+)
+
+// FuncSignature returns the parameters and results of function type t,
+// e.g. "(string, string) []string"; reflect doesn't know parameter
+// names. If skipRecv is set, the first parameter, a method's receiver,
+// is left out.
+func FuncSignature(t reflect.Type, skipRecv bool) string {
+	var params []string
+	first := 0
+	if skipRecv {
+		first = 1
+	}
+	for i := first; i < t.NumIn(); i++ {
+		if t.IsVariadic() && i == t.NumIn()-1 {
+			params = append(params, "..."+t.In(i).Elem().String())
+		} else {
+			params = append(params, t.In(i).String())
+		}
+	}
+	sig := "(" + strings.Join(params, ", ") + ")"
+	switch t.NumOut() {
+	case 0:
+	case 1:
+		sig += " " + t.Out(0).String()
+	default:
+		var results []string
+		for i := 0; i < t.NumOut(); i++ {
+			results = append(results, t.Out(i).String())
+		}
+		sig += " (" + strings.Join(results, ", ") + ")"
+	}
+	return sig
+}
+
+// MethodSignatures returns "Name(params) results" for each method in
+// the method set of t.
+func MethodSignatures(t reflect.Type) []string {
+	var sigs []string
+	// Interface methods have no receiver parameter.
+	skipRecv := t.Kind() != reflect.Interface
+	for i := 0; i < t.NumMethod(); i++ {
+		m := t.Method(i)
+		sigs = append(sigs, m.Name+FuncSignature(m.Type, skipRecv))
+	}
+	return sigs
+}
+
+// CallSignature describes the function or method that expr denotes,
+// e.g. strings.Split, buf.Write or bytes.Buffer.Write: its signature,
+// the receiver for a method, and what the last parameter takes if the
+// function is variadic. Reflection doesn't know parameter names, so
+// the declaration is shown too when it was compiled in or entered in
+// the session.
+func CallSignature(expr ast.Expr, env *eval.SimpleEnv) ([]string, error) {
+	name := exprString(expr)
+	if id, ok := expr.(*ast.Ident); ok {
+		if fn, ok := UserFuncs[id.Name]; ok {
+			lines := []string{"func " + name + FuncSignature(fn.Type, false),
+				"declared as: " + declString(token.NewFileSet(), fn.Decl)}
+			return append(lines, variadicLines(fn.Type, false)...), nil
+		}
+	}
+	var lines []string
+	if sel, ok := expr.(*ast.SelectorExpr); ok && !isPkgName(sel.X, env) {
+		if recv, m, ok := methodOf(sel, env); ok {
+			skipRecv := recv.Kind() != reflect.Interface
+			named := recv
+			if named.Kind() == reflect.Ptr {
+				named = named.Elem()
+			}
+			lines = append(lines, fmt.Sprintf("func (%s) %s%s", recv, m.Name,
+				FuncSignature(m.Type, skipRecv)))
+			if info, ok := LookupSymbol(TypeSymbolName(named) + "." + m.Name); ok && info.Sig != "" {
+				lines = append(lines, "declared as: "+info.Sig)
+			}
+			lines = append(lines, "receiver: "+recv.String())
+			return append(lines, variadicLines(m.Type, skipRecv)...), nil
+		}
+	}
+	cexpr, errs := eval.CheckExpr(expr, env)
+	if len(errs) != 0 {
+		return nil, errs[0]
+	}
+	types := cexpr.KnownType()
+	if len(types) != 1 || types[0] == nil || types[0].Kind() != reflect.Func {
+		return nil, fmt.Errorf("%s is not a function", name)
+	}
+	typ := types[0]
+	lines = append(lines, "func "+name+FuncSignature(typ, false))
+	if info, ok := LookupSymbol(name); ok && info.Sig != "" {
+		lines = append(lines, "declared as: "+info.Sig)
+	}
+	return append(lines, variadicLines(typ, false)...), nil
+}
+
+// methodOf returns the method that selector sel picks out, from a type
+// (a method expression) or from a value (a method value), along with
+// the receiver type whose method set it is in.
+func methodOf(sel *ast.SelectorExpr, env *eval.SimpleEnv) (reflect.Type, reflect.Method, bool) {
+	typ, err := EvalType(sel.X, env)
+	if err != nil {
+		cexpr, errs := eval.CheckExpr(sel.X, env)
+		if len(errs) != 0 {
+			return nil, reflect.Method{}, false
+		}
+		types := cexpr.KnownType()
+		if len(types) != 1 || types[0] == nil {
+			return nil, reflect.Method{}, false
+		}
+		typ = types[0]
+	}
+	if m, ok := typ.MethodByName(sel.Sel.Name); ok {
+		return typ, m, true
+	}
+	if typ.Kind() != reflect.Ptr && typ.Kind() != reflect.Interface {
+		ptr := reflect.PtrTo(typ)
+		if m, ok := ptr.MethodByName(sel.Sel.Name); ok {
+			return ptr, m, true
+		}
+	}
+	return nil, reflect.Method{}, false
+}
+
+// isPkgName returns true if expr names a package in env.
+func isPkgName(expr ast.Expr, env *eval.SimpleEnv) bool {
+	id, ok := expr.(*ast.Ident)
+	if !ok || env.Var(id.Name).IsValid() {
+		return false
+	}
+	_, ok = env.Pkgs[id.Name]
+	return ok
+}
+
+// variadicLines says what the last parameter of function type t takes,
+// if it is variadic.
+func variadicLines(t reflect.Type, skipRecv bool) []string {
+	if !t.IsVariadic() {
+		return nil
+	}
+	n := t.NumIn() - 1
+	if skipRecv {
+		n--
+	}
+	return []string{fmt.Sprintf("variadic: after %d fixed parameter(s), takes any number of %s",
+		n, t.In(t.NumIn()-1).Elem())}
+}
+
+// PrintCallHints shows the signature of the function called in node
+// when errs, from checking node, include a call with the wrong number
+// or types of arguments. The call shown is the one that fails to check
+// even though its arguments check.
+func PrintCallHints(node ast.Node, errs []error) {
+	if !hasArgError(errs) {
+		return
+	}
+	ast.Inspect(node, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if _, errs := eval.CheckExpr(call, Env); !hasArgError(errs) {
+			return false
+		}
+		for _, arg := range call.Args {
+			if _, errs := eval.CheckExpr(arg, Env); len(errs) != 0 {
+				return true
+			}
+		}
+		if lines, err := CallSignature(call.Fun, Env); err == nil {
+			for _, line := range lines {
+				Msg("%s", line)
+			}
+		}
+		return false
+	})
+}
+
+// hasArgError returns true if one of errs is about the number or types
+// of arguments in a call.
+func hasArgError(errs []error) bool {
+	for _, err := range errs {
+		switch err.(type) {
+		case eval.ErrWrongNumberOfArgs, eval.ErrWrongArgType:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"testing"
+
+	"github.com/rocky/go-fish"
+)
+
+func TestFuncSignature(t *testing.T) {
+	tests := []struct {
+		fn   interface{}
+		want string
+	}{
+		{Scale, "(repl_test.Square, float64) repl_test.Square"},
+		{fmt.Printf, "(string, ...interface {}) (int, error)"},
+		{func() {}, "()"},
+	}
+	for _, test := range tests {
+		if got := repl.FuncSignature(reflect.TypeOf(test.fn), false); got != test.want {
+			t.Errorf("FuncSignature = %q; want %q", got, test.want)
+		}
+	}
+	area, _ := reflect.TypeOf(Square{}).MethodByName("Area")
+	if got := repl.FuncSignature(area.Type, true); got != "() float64" {
+		t.Errorf("FuncSignature of Square.Area without the receiver = %q", got)
+	}
+}
+
+func TestSig(t *testing.T) {
+	env := shapesEnv()
+	key := repl.TypeSymbolName(reflect.TypeOf(Square{})) + ".Area"
+	repl.SymbolMeta[key] = repl.SymbolInfo{Sig: "func (s Square) Area() float64"}
+	defer delete(repl.SymbolMeta, key)
+	checkCommand(t, env, "sig shapes.Square.Area",
+		"func (repl_test.Square) Area() float64\n"+
+			"declared as: func (s Square) Area() float64\n"+
+			"receiver: repl_test.Square")
+	checkCommand(t, env, "sig shapes.Circle.Area",
+		"func (*repl_test.Circle) Area() float64\nreceiver: *repl_test.Circle")
+
+	file, err := parser.ParseFile(token.NewFileSet(), "",
+		"package p; func join(sep string, parts ...string) string { return \"\" }", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repl.UserFuncs["join"] = &repl.UserFunc{
+		Name: "join",
+		Decl: file.Decls[0].(*ast.FuncDecl),
+		Type: reflect.TypeOf(func(string, ...string) string { return "" }),
+	}
+	checkCommand(t, env, "sig join",
+		"func join(string, ...string) string\n"+
+			"declared as: func join(sep string, parts ...string) string\n"+
+			"variadic: after 1 fixed parameter(s), takes any number of string")
+}
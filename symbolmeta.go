@@ -0,0 +1,54 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Documentation and signatures compiled into go-fish
+
+package repl
+
+import (
+	"reflect"
+	"strings"
+)
+
+// SymbolInfo is what we know about a package-level symbol, or method,
+// without needing the package source: its signature (for functions
+// and methods, with parameter names), a one-sentence documentation
+// summary, and where it is declared as "file.go:line".
+type SymbolInfo struct {
+	Sig string
+	Doc string
+	Pos string
+}
+
+// SymbolMeta maps "path.Symbol", "path.Type.Method" and, for the
+// package synopsis, "path", where path is a package's import path, to
+// what we know about it. It is filled in by repl_docs.go, which "make
+// repl_docs.go" generates with "make_env -docs".
+var SymbolMeta = make(map[string]SymbolInfo)
+
+// addSymbolMeta adds the entries of meta to SymbolMeta.
+func addSymbolMeta(meta map[string]SymbolInfo) {
+	for key, info := range meta {
+		SymbolMeta[key] = info
+	}
+}
+
+// LookupSymbol returns what is known about name, e.g. "strings.Split",
+// "bytes.Buffer.Len" or "math/rand.Intn". A package name is taken to be
+// the package known by that name in the environment.
+func LookupSymbol(name string) (SymbolInfo, bool) {
+	pkg, names := splitDocArg(name)
+	key := strings.Join(append([]string{DocPkgPath(pkg)}, names...), ".")
+	info, ok := SymbolMeta[key]
+	return info, ok
+}
+
+// TypeSymbolName returns the name LookupSymbol knows named type t by,
+// its import path and name.
+func TypeSymbolName(t reflect.Type) string {
+	if t.PkgPath() == "" || t.Name() == "" {
+		return t.String()
+	}
+	return t.PkgPath() + "." + t.Name()
+}
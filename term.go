@@ -0,0 +1,54 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Terminal size tracking
+
+package repl
+
+import (
+	"os"
+	"strconv"
+	"sync/atomic"
+)
+
+// AutoWidth is true when Maxwidth follows the width of the
+// terminal. Setting the width explicitly with "set width" clears it;
+// "set width auto" turns it back on.
+var AutoWidth bool = true
+
+// resized is set when we receive a window-change signal. It is
+// checked, and cleared, before each line of input is processed.
+var resized int32
+
+// TermSize returns the number of columns and rows of the terminal
+// attached to stdout. Zeros are returned if stdout is not a terminal
+// or its size can't be determined.
+func TermSize() (width int, height int) {
+	return termSize(os.Stdout.Fd())
+}
+
+// UpdateWidth sets Maxwidth from the terminal size when AutoWidth is
+// set. If the terminal size is not available, the COLUMNS environment
+// variable is used and failing that, 80.
+func UpdateWidth() {
+	if !AutoWidth {
+		return
+	}
+	if width, _ := TermSize(); width > 0 {
+		Maxwidth = width
+	} else if i, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && i > 0 {
+		Maxwidth = i
+	} else {
+		Maxwidth = 80
+	}
+}
+
+// CheckResize updates Maxwidth and Maxheight if the terminal has been
+// resized since the last time we looked.
+func CheckResize() {
+	if atomic.SwapInt32(&resized, 0) != 0 {
+		UpdateWidth()
+		UpdateHeight()
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd
+
+package repl
+
+// termSize has no portable implementation here; callers fall back to
+// COLUMNS or a default.
+func termSize(fd uintptr) (width int, height int) {
+	return 0, 0
+}
+
+func watchResize() {
+}
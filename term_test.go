@@ -0,0 +1,25 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/rocky/go-fish"
+)
+
+// TestREPLGoroutines checks that running the REPL again doesn't leave
+// goroutines, such as one watching for terminal resizes, behind.
+func TestREPLGoroutines(t *testing.T) {
+	runCommands(repl.NewEnv(), "help quit")
+	before := runtime.NumGoroutine()
+	for i := 0; i < 5; i++ {
+		runCommands(repl.NewEnv(), "help quit")
+	}
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("%d goroutines after running the REPL 5 more times; want %d", after, before)
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build darwin dragonfly freebsd linux netbsd openbsd
+
+package repl
+
+import (
+	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"unsafe"
+)
+
+// winsize is struct winsize from <sys/ioctl.h>.
+type winsize struct {
+	Row    uint16
+	Col    uint16
+	Xpixel uint16
+	Ypixel uint16
+}
+
+func termSize(fd uintptr) (width int, height int) {
+	var ws winsize
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd,
+		uintptr(syscall.TIOCGWINSZ), uintptr(unsafe.Pointer(&ws)))
+	if errno != 0 {
+		return 0, 0
+	}
+	return int(ws.Col), int(ws.Row)
+}
+
+// watchResizeOnce makes sure we only watch for SIGWINCH once, however
+// many times REPL is called.
+var watchResizeOnce sync.Once
+
+// watchResize arranges for SIGWINCH to flag that the terminal size
+// should be looked at again.
+func watchResize() {
+	watchResizeOnce.Do(func() {
+		ch := make(chan os.Signal, 1)
+		signal.Notify(ch, syscall.SIGWINCH)
+		go func() {
+			for {
+				<-ch
+				atomic.StoreInt32(&resized, 1)
+			}
+		}()
+	})
+}
@@ -3,7 +3,9 @@ package repl
 
 import (
 	"errors"
+	"github.com/rocky/eval"
 	"io"
+	"reflect"
 	"runtime"
 	"strings"
 	"sync"
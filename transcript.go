@@ -0,0 +1,137 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Replaying a session transcript and checking its output
+
+package repl
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/rocky/eval"
+)
+
+// transcriptInput is a line of input in a transcript along with the
+// output recorded after it.
+type transcriptInput struct {
+	lineno int
+	input  string
+	output []string
+}
+
+// readTranscript reads a transcript, as written by "set logging",
+// into its inputs. Lines before the first prompt are skipped.
+func readTranscript(filename string) ([]*transcriptInput, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var inputs []*transcriptInput
+	var current *transcriptInput
+	scanner := bufio.NewScanner(f)
+	for lineno := 1; scanner.Scan(); lineno++ {
+		line := scanner.Text()
+		if strings.HasPrefix(line, Prompt) {
+			current = &transcriptInput{lineno: lineno, input: line[len(Prompt):]}
+			inputs = append(inputs, current)
+		} else if strings.HasPrefix(line, ContinuationPrompt) {
+			current = &transcriptInput{lineno: lineno,
+				input: line[len(ContinuationPrompt):]}
+			inputs = append(inputs, current)
+		} else if current != nil {
+			current.output = append(current.output, line)
+		}
+	}
+	return inputs, scanner.Err()
+}
+
+// CheckTranscript replays the input lines of a transcript file in
+// env and compares the output with what the transcript recorded.
+// Differences are reported, and the number of inputs whose output
+// differs is returned.
+//
+// Output is compared with terminal escape sequences removed, so a
+// transcript made with "set logging on" can be checked as is, as long
+// as it doesn't have timestamps. "set logging" inputs, such as the
+// "set logging off" that ends such a transcript, are skipped.
+func CheckTranscript(filename string, env *eval.SimpleEnv, inspectFn InspectFnType) (int, error) {
+	inputs, err := readTranscript(filename)
+	if err != nil {
+		return 0, err
+	}
+	Pager = PAGER_OFF
+	failures := 0
+	checked := 0
+	next := 0
+	eof := false
+	var prev *transcriptInput
+	var got bytes.Buffer
+
+	// compare checks the output gathered for input prev. Differences
+	// are written to the real stdout, since os.Stdout is gathering
+	// output.
+	compare := func() {
+		syncTee()
+		if prev == nil {
+			return
+		}
+		gotText := strings.TrimSuffix(ansiRE.ReplaceAllString(got.String(), ""), "\n")
+		wantText := strings.Join(prev.output, "\n")
+		if gotText != wantText {
+			failures++
+			saved := os.Stdout
+			os.Stdout = stdout
+			Errmsg("%s:%d: output of %q differs", filename, prev.lineno, prev.input)
+			Msg("want:\n%s", indentLines(wantText))
+			Msg("got:\n%s", indentLines(gotText))
+			os.Stdout = saved
+		}
+	}
+
+	replay := func(prompt string, add_history ...bool) (string, error) {
+		compare()
+		got.Reset()
+		for next < len(inputs) && isLoggingCommand(inputs[next].input) {
+			next++
+		}
+		if next == len(inputs) {
+			prev = nil
+			eof = true
+			return "", io.EOF
+		}
+		prev = inputs[next]
+		next++
+		checked++
+		return prev.input, nil
+	}
+
+	teeEcho = false
+	TeeStdout(&got, func() {
+		REPL(env, replay, inspectFn)
+	})
+	teeEcho = true
+	if !eof {
+		// We left the REPL early, say on "quit".
+		compare()
+	}
+	Msg("%s: %d inputs checked, %d differ", filename, checked, failures)
+	return failures, nil
+}
+
+// isLoggingCommand returns true if line is a "set logging" command.
+// Replaying those would start or stop a transcript rather than check
+// one.
+func isLoggingCommand(line string) bool {
+	fields := strings.Fields(line)
+	return len(fields) >= 2 && CommandName(line) == "set" && fields[1] == "logging"
+}
+
+func indentLines(text string) string {
+	return "  " + strings.Replace(text, "\n", "\n  ", -1)
+}
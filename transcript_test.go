@@ -0,0 +1,59 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rocky/go-fish"
+)
+
+// scriptedInput returns a read line function that returns lines, one
+// at a time, and then io.EOF.
+func scriptedInput(lines ...string) repl.ReadLineFnType {
+	return func(prompt string, add_history ...bool) (string, error) {
+		if len(lines) == 0 {
+			return "", io.EOF
+		}
+		line := lines[0]
+		lines = lines[1:]
+		return line, nil
+	}
+}
+
+// TestTranscriptRoundTrip records a transcript with "set logging" and
+// checks that replaying it gives the same output.
+func TestTranscriptRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-fish-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "session.txt")
+
+	repl.Pager = repl.PAGER_OFF
+	env := repl.NewEnv()
+	repl.REPL(env, scriptedInput(
+		"set logging on "+filename,
+		"help set logging",
+		"help quit",
+		"set logging off",
+	), repl.SimpleInspect)
+	if repl.Logging() {
+		t.Fatalf("still logging after \"set logging off\"")
+	}
+
+	failures, err := repl.CheckTranscript(filename, repl.NewEnv(), repl.SimpleInspect)
+	if err != nil {
+		t.Fatalf("CheckTranscript: %s", err)
+	}
+	if failures != 0 {
+		t.Errorf("replaying the transcript gave %d differences; want none", failures)
+	}
+}
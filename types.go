@@ -0,0 +1,321 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Turning type expressions into reflect.Types
+
+package repl
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/printer"
+	"go/token"
+	"reflect"
+	"strconv"
+
+	"github.com/rocky/eval"
+)
+
+// builtinTypes are the predeclared Go types.
+var builtinTypes = map[string]reflect.Type{
+	"bool":       reflect.TypeOf(false),
+	"byte":       reflect.TypeOf(byte(0)),
+	"complex64":  reflect.TypeOf(complex64(0)),
+	"complex128": reflect.TypeOf(complex128(0)),
+	"error":      reflect.TypeOf(new(error)).Elem(),
+	"float32":    reflect.TypeOf(float32(0)),
+	"float64":    reflect.TypeOf(float64(0)),
+	"int":        reflect.TypeOf(int(0)),
+	"int8":       reflect.TypeOf(int8(0)),
+	"int16":      reflect.TypeOf(int16(0)),
+	"int32":      reflect.TypeOf(int32(0)),
+	"int64":      reflect.TypeOf(int64(0)),
+	"rune":       reflect.TypeOf(rune(0)),
+	"string":     reflect.TypeOf(""),
+	"uint":       reflect.TypeOf(uint(0)),
+	"uint8":      reflect.TypeOf(uint8(0)),
+	"uint16":     reflect.TypeOf(uint16(0)),
+	"uint32":     reflect.TypeOf(uint32(0)),
+	"uint64":     reflect.TypeOf(uint64(0)),
+	"uintptr":    reflect.TypeOf(uintptr(0)),
+}
+
+// UserTypes are the types declared in the REPL, by name.
+var UserTypes = make(map[string]reflect.Type)
+
+// DefineType makes the type declared by spec usable in env. reflect
+// can't create named types, so the name stands for the underlying
+// type; two REPL types with the same definition are the same type,
+// and they can't have methods.
+func DefineType(spec *ast.TypeSpec, env *eval.SimpleEnv) (reflect.Type, error) {
+	name := spec.Name.Name
+	if err := CheckReserved(name); err != nil {
+		return nil, err
+	}
+	if _, ok := builtinTypes[name]; ok {
+		return nil, fmt.Errorf("can't redefine predeclared type %s", name)
+	}
+	used := map[string]bool{}
+	usedNames(spec.Type, used)
+	if used[name] {
+		return nil, fmt.Errorf("recursive types can't be created in the REPL")
+	}
+	typ, err := EvalType(spec.Type, env)
+	if err != nil {
+		return nil, err
+	}
+	env.Types[name] = typ
+	UserTypes[name] = typ
+	return typ, nil
+}
+
+// EvalType returns the reflect.Type that type expression expr
+// denotes in env. Named types are looked up in env and its packages.
+func EvalType(expr ast.Expr, env eval.Env) (reflect.Type, error) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if typ := env.Type(t.Name); typ != nil {
+			return typ, nil
+		}
+		if typ, ok := builtinTypes[t.Name]; ok {
+			return typ, nil
+		}
+		return nil, fmt.Errorf("undefined type %s", t.Name)
+	case *ast.ParenExpr:
+		return EvalType(t.X, env)
+	case *ast.SelectorExpr:
+		pkgIdent, ok := t.X.(*ast.Ident)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a type", exprString(t))
+		}
+		pkg := env.Pkg(pkgIdent.Name)
+		if pkg == nil {
+			return nil, fmt.Errorf("undefined package %s", pkgIdent.Name)
+		}
+		if typ := pkg.Type(t.Sel.Name); typ != nil {
+			return typ, nil
+		}
+		return nil, fmt.Errorf("undefined type %s", exprString(t))
+	case *ast.StarExpr:
+		elem, err := EvalType(t.X, env)
+		if err != nil {
+			return nil, err
+		}
+		return reflect.PtrTo(elem), nil
+	case *ast.ArrayType:
+		elem, err := EvalType(t.Elt, env)
+		if err != nil {
+			return nil, err
+		}
+		if t.Len == nil {
+			return reflect.SliceOf(elem), nil
+		}
+		if _, ok := t.Len.(*ast.Ellipsis); ok {
+			return nil, fmt.Errorf("[...] array types are only allowed in composite literals")
+		}
+		n, err := arrayLen(t.Len, env)
+		if err != nil {
+			return nil, err
+		}
+		return reflect.ArrayOf(n, elem), nil
+	case *ast.MapType:
+		key, err := EvalType(t.Key, env)
+		if err != nil {
+			return nil, err
+		}
+		elem, err := EvalType(t.Value, env)
+		if err != nil {
+			return nil, err
+		}
+		if !key.Comparable() {
+			return nil, fmt.Errorf("invalid map key type %s", key)
+		}
+		return reflect.MapOf(key, elem), nil
+	case *ast.ChanType:
+		elem, err := EvalType(t.Value, env)
+		if err != nil {
+			return nil, err
+		}
+		dir := reflect.BothDir
+		switch t.Dir {
+		case ast.SEND:
+			dir = reflect.SendDir
+		case ast.RECV:
+			dir = reflect.RecvDir
+		}
+		return reflect.ChanOf(dir, elem), nil
+	case *ast.FuncType:
+		typ, _, _, err := funcTypeOf(t, env)
+		return typ, err
+	case *ast.StructType:
+		return structTypeOf(t, env)
+	case *ast.InterfaceType:
+		if t.Methods != nil && len(t.Methods.List) > 0 {
+			return nil, fmt.Errorf("interface types with methods can't be created in the REPL")
+		}
+		return reflect.TypeOf(new(interface{})).Elem(), nil
+	case *ast.Ellipsis:
+		return nil, fmt.Errorf("... is only allowed on the last parameter of a function")
+	}
+	return nil, fmt.Errorf("%s is not a type", exprString(expr))
+}
+
+// structTypeOf returns the reflect.Type of struct type st. reflect
+// can only create structs whose fields are exported.
+func structTypeOf(st *ast.StructType, env eval.Env) (reflect.Type, error) {
+	var fields []reflect.StructField
+	seen := map[string]bool{}
+	for _, field := range st.Fields.List {
+		t, err := EvalType(field.Type, env)
+		if err != nil {
+			return nil, err
+		}
+		var tag reflect.StructTag
+		if field.Tag != nil {
+			s, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid struct tag %s", field.Tag.Value)
+			}
+			tag = reflect.StructTag(s)
+		}
+		if len(field.Names) == 0 {
+			// An embedded field is named after its type.
+			name := embeddedName(field.Type)
+			if !ast.IsExported(name) {
+				return nil, fmt.Errorf("embedded field %s is unexported; "+
+					"only exported fields can be created in the REPL", name)
+			}
+			if t.NumMethod() > 0 || (t.Kind() != reflect.Ptr && reflect.PtrTo(t).NumMethod() > 0) {
+				return nil, fmt.Errorf("embedded field %s has methods; "+
+					"embedding types with methods isn't supported in the REPL", name)
+			}
+			if seen[name] {
+				return nil, fmt.Errorf("duplicate field %s", name)
+			}
+			seen[name] = true
+			fields = append(fields, reflect.StructField{Name: name, Type: t,
+				Tag: tag, Anonymous: true})
+			continue
+		}
+		for _, ident := range field.Names {
+			name := ident.Name
+			if !ast.IsExported(name) {
+				return nil, fmt.Errorf("field %s is unexported; "+
+					"only exported fields can be created in the REPL", name)
+			}
+			if seen[name] {
+				return nil, fmt.Errorf("duplicate field %s", name)
+			}
+			seen[name] = true
+			fields = append(fields, reflect.StructField{Name: name, Type: t, Tag: tag})
+		}
+	}
+	return reflect.StructOf(fields), nil
+}
+
+// embeddedName returns the field name of an embedded field of type
+// expr.
+func embeddedName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return embeddedName(t.X)
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	case *ast.Ident:
+		return t.Name
+	}
+	return exprString(expr)
+}
+
+// arrayLen evaluates the length of an array type.
+func arrayLen(expr ast.Expr, env eval.Env) (int, error) {
+	if lit, ok := expr.(*ast.BasicLit); ok && lit.Kind == token.INT {
+		n, err := strconv.ParseInt(lit.Value, 0, 0)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid array length %s", lit.Value)
+		}
+		return int(n), nil
+	}
+	cexpr, errs := eval.CheckExpr(expr, env)
+	if len(errs) != 0 {
+		return 0, errs[0]
+	}
+	if !cexpr.IsConst() {
+		return 0, fmt.Errorf("array length %s is not a constant", exprString(expr))
+	}
+	vals, err := eval.EvalExpr(cexpr, env)
+	if err != nil {
+		return 0, err
+	}
+	if len(vals) == 1 {
+		switch v := vals[0]; v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if v.Int() >= 0 {
+				return int(v.Int()), nil
+			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return int(v.Uint()), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid array length %s", exprString(expr))
+}
+
+// funcTypeOf returns the reflect.Type of function type ft, along with
+// the names of its parameters and results. Unnamed ones are given as
+// "_".
+func funcTypeOf(ft *ast.FuncType, env eval.Env) (typ reflect.Type,
+	params []string, results []string, err error) {
+	var in, out []reflect.Type
+	variadic := false
+	if ft.Params != nil {
+		for i, field := range ft.Params.List {
+			typeExpr := field.Type
+			if ellipsis, ok := typeExpr.(*ast.Ellipsis); ok {
+				if i != len(ft.Params.List)-1 || len(field.Names) > 1 {
+					return nil, nil, nil,
+						fmt.Errorf("... is only allowed on the last parameter of a function")
+				}
+				variadic = true
+				typeExpr = &ast.ArrayType{Elt: ellipsis.Elt}
+			}
+			t, err := EvalType(typeExpr, env)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			in, params = appendFields(in, params, field, t)
+		}
+	}
+	if ft.Results != nil {
+		for _, field := range ft.Results.List {
+			t, err := EvalType(field.Type, env)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			out, results = appendFields(out, results, field, t)
+		}
+	}
+	return reflect.FuncOf(in, out, variadic), params, results, nil
+}
+
+// appendFields adds a type and name for each of the names in a
+// parameter or result field.
+func appendFields(types []reflect.Type, names []string, field *ast.Field,
+	t reflect.Type) ([]reflect.Type, []string) {
+	if len(field.Names) == 0 {
+		return append(types, t), append(names, "_")
+	}
+	for _, name := range field.Names {
+		types = append(types, t)
+		names = append(names, name.Name)
+	}
+	return types, names
+}
+
+// exprString returns the source text of expr.
+func exprString(expr ast.Node) string {
+	var buf bytes.Buffer
+	printer.Fprint(&buf, token.NewFileSet(), expr)
+	return buf.String()
+}
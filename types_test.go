@@ -0,0 +1,52 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"testing"
+
+	"github.com/rocky/go-fish"
+)
+
+// typeSpec parses the single type declaration in src.
+func typeSpec(t *testing.T, src string) *ast.TypeSpec {
+	file, err := parser.ParseFile(token.NewFileSet(), "", "package p\n"+src, 0)
+	if err != nil {
+		t.Fatalf("%s: %s", src, err)
+	}
+	return file.Decls[0].(*ast.GenDecl).Specs[0].(*ast.TypeSpec)
+}
+
+func TestDefineType(t *testing.T) {
+	repl.Reset(false)
+	point, err := repl.DefineType(typeSpec(t, "type Point struct{ X, Y int }"), repl.Env)
+	if err != nil {
+		t.Fatalf("DefineType: %s", err)
+	}
+	want := reflect.TypeOf(struct{ X, Y int }{})
+	if point != want {
+		t.Errorf("Point is %s; want %s", point, want)
+	}
+	expr, _ := parser.ParseExpr("[]map[string]*Point")
+	composite := reflect.SliceOf(reflect.MapOf(reflect.TypeOf(""), reflect.PtrTo(want)))
+	if typ, err := repl.EvalType(expr, repl.Env); err != nil || typ != composite {
+		t.Errorf("[]map[string]*Point is %v, %v; want %s", typ, err, composite)
+	}
+
+	for _, src := range []string{
+		"type int string",
+		"type List struct{ next *List }",
+		"type env int",
+		"type T NoSuchType",
+	} {
+		if _, err := repl.DefineType(typeSpec(t, src), repl.Env); err == nil {
+			t.Errorf("%s: expecting an error", src)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Variables, functions and types defined in the session
+
+package repl
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/rocky/eval"
+)
+
+// UserVars returns the sorted names of the variables in env that were
+// defined in the session.
+func UserVars(env *eval.SimpleEnv) []string {
+	var names []string
+	for name := range env.Vars {
+		if !ReservedNames[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// VarValue returns the value of the variable called name in env.
+// Variables are held by pointer.
+func VarValue(env *eval.SimpleEnv, name string) (reflect.Value, bool) {
+	v, ok := env.Vars[name]
+	if !ok {
+		return v, false
+	}
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		return v.Elem(), true
+	}
+	return v, true
+}
+
+// Unset removes the variable, or function or type declared in the
+// session, called name from env, and returns what kind of thing it
+// was.
+func Unset(env *eval.SimpleEnv, name string) (string, error) {
+	if ReservedNames[name] {
+		return "", fmt.Errorf("%s is defined by go-fish and can't be unset", name)
+	}
+	if _, ok := env.Vars[name]; ok {
+		delete(env.Vars, name)
+		return "variable", nil
+	}
+	if _, ok := UserFuncs[name]; ok {
+		delete(env.Funcs, name)
+		delete(UserFuncs, name)
+		return "function", nil
+	}
+	if _, ok := UserTypes[name]; ok {
+		delete(env.Types, name)
+		delete(UserTypes, name)
+		return "type", nil
+	}
+	if _, ok := env.Consts[name]; ok {
+		delete(env.Consts, name)
+		return "constant", nil
+	}
+	return "", fmt.Errorf("no variable, function or type %s was defined in this session", name)
+}
+
+// NewEnv returns a fresh evaluation environment holding the
+// variables, and the gofish pseudo-package, that go-fish provides.
+func NewEnv() *eval.SimpleEnv {
+	env := MakeEvalEnv()
+	env.Vars["env"] = reflect.ValueOf(env)
+	env.Vars["results"] = reflect.ValueOf(&results)
+	env.Pkgs[GofishPkgName] = gofishPkg()
+	return env
+}
+
+// Reset replaces Env with a fresh one, dropping everything defined or
+// imported in the session. Unless keepResults is set, the results of
+// expressions are cleared too.
+func Reset(keepResults bool) {
+	if !keepResults {
+		results = make([]interface{}, 0, 10)
+	}
+	Env = NewEnv()
+	UserFuncs = make(map[string]*UserFunc)
+	UserTypes = make(map[string]reflect.Type)
+	ImportPaths = make(map[string]string)
+	Session = nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rocky/eval"
+	"github.com/rocky/go-fish"
+)
+
+// runCommands runs lines in the REPL with env, without paging, and
+// returns what it shows.
+func runCommands(env *eval.SimpleEnv, lines ...string) string {
+	repl.Pager = repl.PAGER_OFF
+	return captureOutput(func() {
+		repl.REPL(env, scriptedInput(lines...), repl.SimpleInspect)
+	})
+}
+
+func TestUnset(t *testing.T) {
+	repl.Reset(false)
+	setVar(repl.Env.Vars, "s", "fish")
+	setVar(repl.Env.Vars, "n", 42)
+	if names := repl.UserVars(repl.Env); !reflect.DeepEqual(names, []string{"n", "s"}) {
+		t.Errorf("UserVars = %q; want [n s]", names)
+	}
+
+	out := runCommands(repl.Env, "info vars")
+	n, s := strings.Index(out, "n int = "), strings.Index(out, "s string = ")
+	if n < 0 || s < n {
+		t.Errorf("\"info vars\" doesn't list n and then s:\n%s", out)
+	}
+
+	if kind, err := repl.Unset(repl.Env, "n"); err != nil || kind != "variable" {
+		t.Errorf("Unset(n) = %q, %v; want variable", kind, err)
+	}
+	if _, ok := repl.VarValue(repl.Env, "n"); ok {
+		t.Errorf("n is still defined after unset")
+	}
+	for _, name := range []string{"n", "env", "results"} {
+		if _, err := repl.Unset(repl.Env, name); err == nil {
+			t.Errorf("Unset(%s): expecting an error", name)
+		}
+	}
+
+	repl.Reset(false)
+	if names := repl.UserVars(repl.Env); len(names) != 0 {
+		t.Errorf("variables %q are still defined after reset", names)
+	}
+	if out := runCommands(repl.Env, "info vars"); !strings.Contains(out, "No variables have been defined") {
+		t.Errorf("\"info vars\" after reset shows:\n%s", out)
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright 2015 Rocky Bernstein.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repl_test
+
+import "testing"
+
+func TestWhatisType(t *testing.T) {
+	env := shapesEnv()
+	checkCommand(t, env, "whatis shapes.Square",
+		"shapes.Square is a type: repl_test.Square",
+		"underlying:\tstruct { Side float64; label string; ok bool }",
+		"kind:\tstruct",
+		"  label string\t(unexported)",
+		"Method set of repl_test.Square\n  Area() float64",
+		"Method set of *repl_test.Square\n  Area() float64")
+	checkCommand(t, env, "whatis shapes.Circle",
+		"method set of repl_test.Circle is empty",
+		"Method set of *repl_test.Circle\n  Area() float64")
+	checkCommand(t, env, "whatis shapes.Shape",
+		"kind:\tinterface",
+		"Method set of repl_test.Shape\n  Area() float64")
+	checkCommand(t, env, "whatis shapes", "`shapes' is a package")
+}
+
+func TestWhatisUserType(t *testing.T) {
+	env := shapesEnv()
+	runCommands(env, "type Point struct{ X, Y int }")
+	checkCommand(t, env, "whatis Point",
+		"Point is a type: struct { X int; Y int }",
+		"Point was defined in the REPL and stands for its underlying type",
+		"  X int\n  Y int")
+}